@@ -269,6 +269,9 @@ func initGenesis(ctx *cli.Context) error {
 	if err != nil {
 		utils.Fatalf("maxCodeSize data invalid: %v", err)
 	}
+	if err := genesis.Config.CheckTransitionsData(); err != nil {
+		utils.Fatalf("transitions data invalid: %v", err)
+	}
 	// End Quorum
 
 	// Open and initialise both full and light databases
@@ -168,7 +168,7 @@ func makeFullNode(ctx *cli.Context) (*node.Node, ethapi.Backend) {
 	}
 
 	if ctx.GlobalBool(utils.RaftModeFlag.Name) {
-		utils.RegisterRaftService(stack, ctx, &cfg.Node, ethService)
+		utils.RegisterRaftService(stack, ctx, &cfg.Node, &cfg.Eth.Raft, ethService)
 	}
 
 	if private.IsQuorumPrivacyEnabled() {
@@ -47,6 +47,7 @@ import (
 	"github.com/ethereum/go-ethereum/multitenancy"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/permission"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
 	"github.com/ethereum/go-ethereum/plugin"
 	"github.com/ethereum/go-ethereum/private"
 	gopsutil "github.com/shirou/gopsutil/mem"
@@ -173,6 +174,23 @@ var (
 		utils.RaftJoinExistingFlag,
 		utils.RaftPortFlag,
 		utils.RaftDNSEnabledFlag,
+		utils.RaftTLSModeFlag,
+		utils.RaftTLSRootCAFlag,
+		utils.RaftTLSCertFlag,
+		utils.RaftTLSKeyFlag,
+		utils.RaftTickIntervalFlag,
+		utils.RaftElectionTickFlag,
+		utils.RaftHeartbeatTickFlag,
+		utils.RaftSnapshotPeriodFlag,
+		utils.RaftPreVoteFlag,
+		utils.RaftCheckQuorumFlag,
+		utils.RaftAutoPromoteLearnersFlag,
+		utils.RaftLearnerMaxLagFlag,
+		utils.RaftLearnerPromotionPeriodFlag,
+		utils.RaftTxOrderingFlag,
+		utils.RaftTxQuotaPerSenderFlag,
+		utils.RaftTxQuotaPerOrgFlag,
+		utils.RaftStateSyncFlag,
 		utils.EmitCheckpointsFlag,
 		utils.IstanbulRequestTimeoutFlag,
 		utils.IstanbulBlockPeriodFlag,
@@ -180,6 +198,7 @@ var (
 		utils.PluginSkipVerifyFlag,
 		utils.PluginLocalVerifyFlag,
 		utils.PluginPublicKeyFlag,
+		utils.PermissionPluginModeFlag,
 		utils.AllowedFutureBlockTimeFlag,
 		utils.EVMCallTimeOutFlag,
 		utils.MultitenancyFlag,
@@ -284,6 +303,8 @@ func init() {
 		dumpConfigCommand,
 		// See retesteth.go
 		retestethCommand,
+		// See raftcmd.go
+		raftCommand,
 		// See cmd/utils/flags_legacy.go
 		utils.ShowDeprecated,
 	}
@@ -548,6 +569,22 @@ func startNode(ctx *cli.Context, stack *node.Node, backend ethapi.Backend) {
 
 	// Quorum
 	//
+	// delegate permission decisions to the permission plugin if configured
+	if stack.PluginManager().IsEnabled(plugin.PermissionPluginInterfaceName) {
+		pp := new(plugin.PermissionPluginTemplate)
+		if err := stack.PluginManager().GetPluginTemplate(plugin.PermissionPluginInterfaceName, pp); err != nil {
+			utils.Fatalf("Permission plugin not available: %v", err)
+		}
+		service, err := pp.Get()
+		if err != nil {
+			utils.Fatalf("Permission plugin not available: %v", err)
+		}
+		if err := pcore.SetPermissionPlugin(service, pcore.PluginMode(ctx.GlobalString(utils.PermissionPluginModeFlag.Name))); err != nil {
+			utils.Fatalf("Permission plugin setup failed: %v", err)
+		}
+		log.Info("permission decisions delegated to the permission plugin", "mode", ctx.GlobalString(utils.PermissionPluginModeFlag.Name))
+	}
+
 	// checking if permissions is enabled and staring the permissions service
 	if stack.Config().EnableNodePermission {
 		stack.Server().SetIsNodePermissioned(permission.IsNodePermissioned)
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/console/prompt"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/raft"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	raftCommand = cli.Command{
+		Name:     "raft",
+		Usage:    "Inspect and recover the raft state of a stopped node",
+		Category: "RAFT COMMANDS",
+		Description: `
+The raft commands operate on the raft WAL, snapshots and applied index of a
+stopped node. They allow inspecting the raft state, backing it up and
+restoring it, and re-forming a cluster from a surviving node after the
+majority of the cluster has been permanently lost.`,
+		Subcommands: []cli.Command{
+			{
+				Name:     "inspect",
+				Usage:    "Print the raft membership, indexes and removed raft IDs",
+				Action:   utils.MigrateFlags(raftInspect),
+				Category: "RAFT COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+				},
+				Description: `
+    geth raft inspect
+
+prints the raft state of the node as JSON: the latest snapshot, the hard state
+and last index of the WAL, the applied index and the cluster membership as of
+the last committed configuration change.`,
+			},
+			{
+				Name:      "backup",
+				Usage:     "Back up the raft state",
+				ArgsUsage: "<backupDir>",
+				Action:    utils.MigrateFlags(raftBackup),
+				Category:  "RAFT COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+				},
+				Description: `
+    geth raft backup <backupDir>
+
+copies the raft WAL, snapshots and applied index into backupDir, which must
+not exist or be empty. The chain head of the node is recorded with the backup.`,
+			},
+			{
+				Name:      "restore",
+				Usage:     "Restore the raft state from a backup",
+				ArgsUsage: "<backupDir>",
+				Action:    utils.MigrateFlags(raftRestore),
+				Category:  "RAFT COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+				},
+				Description: `
+    geth raft restore <backupDir>
+
+replaces the raft state of the node with a backup taken by 'geth raft backup'.
+The existing raft directories are moved aside. The applied index is rolled
+back to the snapshot of the backup, so that on start the node re-applies the
+raft entries which are not yet in its chain.`,
+			},
+			{
+				Name:     "force-new-cluster",
+				Usage:    "Re-form a single node cluster from this node after the quorum is lost",
+				Action:   utils.MigrateFlags(raftForceNewCluster),
+				Category: "RAFT COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+				},
+				Description: `
+    geth raft force-new-cluster
+
+rewrites the raft state of this node so that it forms a new cluster on its
+own, starting from its current chain head. Use it on the most up to date
+surviving node only when the majority of the cluster has been permanently
+lost. The raft state is backed up first.
+
+All other members are removed from the cluster. Once this node is running,
+the surviving nodes must wipe their raft state and rejoin with the new raft
+IDs issued by raft.addPeer.`,
+			},
+		},
+	}
+)
+
+func raftInspect(ctx *cli.Context) error {
+	state, err := raft.InspectRaftState(ctx.GlobalString(utils.DataDirFlag.Name))
+	if err != nil {
+		utils.Fatalf("Failed to inspect raft state: %v", err)
+	}
+	return printJSON(state)
+}
+
+func raftBackup(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires the backup directory as argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	hash, number := chainHead(ctx, stack)
+	manifest, err := raft.BackupRaftState(ctx.GlobalString(utils.DataDirFlag.Name), ctx.Args().First(), hash, number)
+	if err != nil {
+		utils.Fatalf("Failed to back up raft state: %v", err)
+	}
+	log.Info("Backed up raft state", "dir", ctx.Args().First(), "applied index", manifest.AppliedIndex, "chain head", manifest.ChainHeadNumber)
+	return nil
+}
+
+func raftRestore(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires the backup directory as argument.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	manifest, err := raft.RestoreRaftState(ctx.Args().First(), ctx.GlobalString(utils.DataDirFlag.Name))
+	if err != nil {
+		utils.Fatalf("Failed to restore raft state: %v", err)
+	}
+	if hash, number := chainHead(ctx, stack); hash != manifest.ChainHeadHash {
+		log.Warn("Chain head differs from the backup, the node re-applies raft entries and syncs missing blocks from its peers on start",
+			"chain head", number, "backup chain head", manifest.ChainHeadNumber)
+	}
+	log.Info("Restored raft state", "dir", ctx.Args().First(), "snapshot index", manifest.SnapshotIndex)
+	return nil
+}
+
+func raftForceNewCluster(ctx *cli.Context) error {
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	datadir := ctx.GlobalString(utils.DataDirFlag.Name)
+	nodeKey := stack.Config().NodeKey()
+	nodeId, err := enode.RaftHexID(enode.NewV4(&nodeKey.PublicKey, nil, 0, 0).EnodeID())
+	if err != nil {
+		utils.Fatalf("Failed to derive node ID: %v", err)
+	}
+	hash, number := chainHead(ctx, stack)
+
+	confirm, err := prompt.Stdin.PromptConfirm(fmt.Sprintf("Force a new raft cluster with this node only, starting from block %d?", number))
+	switch {
+	case err != nil:
+		utils.Fatalf("%v", err)
+	case !confirm:
+		log.Info("Forcing a new raft cluster skipped")
+		return nil
+	}
+
+	backupDir := filepath.Join(datadir, fmt.Sprintf("raft-backup-%d", time.Now().Unix()))
+	if _, err := raft.BackupRaftState(datadir, backupDir, hash, number); err != nil {
+		utils.Fatalf("Failed to back up raft state: %v", err)
+	}
+	log.Info("Backed up raft state", "dir", backupDir)
+
+	state, err := raft.ForceNewCluster(datadir, nodeId, hash)
+	if err != nil {
+		utils.Fatalf("Failed to force a new raft cluster: %v", err)
+	}
+	log.Info("Forced a new raft cluster; the node must keep its raft ID when started, via static-nodes.json or --raftjoinexisting",
+		"raft id", state.Peers[0], "removed raft ids", state.RemovedRaftIds)
+	return printJSON(state)
+}
+
+// returns the hash and number of the head block of the chain DB
+func chainHead(ctx *cli.Context, stack *node.Node) (common.Hash, uint64) {
+	db := utils.MakeChainDatabase(ctx, stack)
+	defer db.Close()
+
+	hash := rawdb.ReadHeadBlockHash(db)
+	number := rawdb.ReadHeaderNumber(db, hash)
+	if number == nil {
+		utils.Fatalf("Failed to read the chain head")
+	}
+	return hash, *number
+}
+
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
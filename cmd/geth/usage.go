@@ -255,6 +255,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.PluginSkipVerifyFlag,
 			utils.PluginLocalVerifyFlag,
 			utils.PluginPublicKeyFlag,
+			utils.PermissionPluginModeFlag,
 			utils.AllowedFutureBlockTimeFlag,
 			utils.MultitenancyFlag,
 		},
@@ -290,6 +291,23 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.RaftJoinExistingFlag,
 			utils.RaftPortFlag,
 			utils.RaftDNSEnabledFlag,
+			utils.RaftTLSModeFlag,
+			utils.RaftTLSRootCAFlag,
+			utils.RaftTLSCertFlag,
+			utils.RaftTLSKeyFlag,
+			utils.RaftTickIntervalFlag,
+			utils.RaftElectionTickFlag,
+			utils.RaftHeartbeatTickFlag,
+			utils.RaftSnapshotPeriodFlag,
+			utils.RaftPreVoteFlag,
+			utils.RaftCheckQuorumFlag,
+			utils.RaftAutoPromoteLearnersFlag,
+			utils.RaftLearnerMaxLagFlag,
+			utils.RaftLearnerPromotionPeriodFlag,
+			utils.RaftTxOrderingFlag,
+			utils.RaftTxQuotaPerSenderFlag,
+			utils.RaftTxQuotaPerOrgFlag,
+			utils.RaftStateSyncFlag,
 		},
 	},
 	{
@@ -0,0 +1,351 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var errInvalidVanity = fmt.Errorf("vanity longer than %d bytes", types.IstanbulExtraVanity)
+
+// outputVote is a validator vote carried in QBFT extra-data.
+type outputVote struct {
+	Recipient common.Address `json:"recipient"`
+	Authorize bool           `json:"authorize"`
+}
+
+// outputExtra is the readable form of the extra-data of an Istanbul header.
+// The hash, proposer and committers are only known when decoding a full
+// header rather than its extra-data alone, and the committers of aggregated
+// BLS committed seals only by their index in the validator set of the parent.
+type outputExtra struct {
+	Consensus      string           `json:"consensus"`
+	Vanity         hexutil.Bytes    `json:"vanity"`
+	Validators     []common.Address `json:"validators"`
+	Seal           hexutil.Bytes    `json:"seal,omitempty"`
+	Vote           *outputVote      `json:"vote,omitempty"`
+	BLSKey         *params.BLSKey   `json:"blsKey,omitempty"` // BLS key registered with the vote
+	Round          *uint32          `json:"round,omitempty"`
+	CommittedSeals []hexutil.Bytes  `json:"committedSeals,omitempty"`
+	CommitterBits  hexutil.Bytes    `json:"committerBitmap,omitempty"` // Validators committing with an aggregated BLS seal, by index
+	AggregatedSeal hexutil.Bytes    `json:"aggregatedSeal,omitempty"`
+	Hash           *common.Hash     `json:"hash,omitempty"`
+	Proposer       *common.Address  `json:"proposer,omitempty"`
+	Committers     []common.Address `json:"committers,omitempty"`
+}
+
+var commandExtra = cli.Command{
+	Name:  "extra",
+	Usage: "encode and decode Istanbul extra-data",
+	Subcommands: []cli.Command{
+		commandExtraEncode,
+		commandExtraDecode,
+	},
+}
+
+var commandExtraEncode = cli.Command{
+	Name:      "encode",
+	Usage:     "encode a list of validators into genesis extra-data",
+	ArgsUsage: "<address> [<address>...]",
+	Description: `
+Print the extra-data of a genesis block whose validators are the given
+addresses, in the IBFT format unless --qbft is set.`,
+	Flags: []cli.Flag{
+		qbftFlag,
+		cli.StringFlag{
+			Name:  "vanity",
+			Usage: "hex encoded vanity data, at most 32 bytes",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		if ctx.NArg() == 0 {
+			return errors.New("no validators given")
+		}
+		validators := make([]common.Address, ctx.NArg())
+		for i, arg := range ctx.Args() {
+			if !common.IsHexAddress(arg) {
+				return fmt.Errorf("invalid validator address %q", arg)
+			}
+			validators[i] = common.HexToAddress(arg)
+		}
+		var vanity []byte
+		if ctx.IsSet("vanity") {
+			var err error
+			if vanity, err = hexutil.Decode(ctx.String("vanity")); err != nil {
+				return fmt.Errorf("invalid vanity: %v", err)
+			}
+		}
+		extra, err := encodeExtra(vanity, validators, ctx.Bool(qbftFlag.Name))
+		if err != nil {
+			return err
+		}
+		fmt.Println(hexutil.Encode(extra))
+		return nil
+	},
+}
+
+var commandExtraDecode = cli.Command{
+	Name:      "decode",
+	Usage:     "decode the extra-data of a header",
+	ArgsUsage: "<extra-data | header file | ->",
+	Description: `
+Print the content of Istanbul extra-data as JSON, detecting whether it is in
+the IBFT or QBFT format.
+
+The argument is either hex encoded extra-data, or a file holding the JSON of a
+header or block, such as the response of eth_getBlockByNumber. A file name of
+"-" reads the standard input. The proposer and the committers of a block are
+only recovered when a whole header is given.`,
+	Action: func(ctx *cli.Context) error {
+		if ctx.NArg() != 1 {
+			return errors.New("expected one extra-data or header file")
+		}
+		header, extra, err := readExtraInput(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+		var out *outputExtra
+		if header != nil {
+			out, err = decodeHeader(header)
+		} else {
+			out, err = decodeExtra(extra)
+		}
+		if err != nil {
+			return err
+		}
+		return printJSON(out)
+	},
+}
+
+// encodeExtra encodes the extra-data of a genesis block with the given vanity
+// and validators, in the QBFT format if qbft is set and the IBFT one otherwise.
+func encodeExtra(vanity []byte, validators []common.Address, qbft bool) ([]byte, error) {
+	if len(vanity) > types.IstanbulExtraVanity {
+		return nil, errInvalidVanity
+	}
+	vanity = common.RightPadBytes(vanity, types.IstanbulExtraVanity)
+
+	if qbft {
+		return rlp.EncodeToBytes(&types.QBFTExtra{
+			VanityData:    vanity,
+			Validators:    validators,
+			CommittedSeal: [][]byte{},
+		})
+	}
+	payload, err := rlp.EncodeToBytes(&types.IstanbulExtra{
+		Validators:    validators,
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(vanity, payload...), nil
+}
+
+// decodeExtra decodes extra-data in the QBFT format, or in the IBFT one if it
+// is not QBFT extra-data, the same way headers are hashed.
+func decodeExtra(extra []byte) (*outputExtra, error) {
+	header := &types.Header{Extra: extra}
+	if qbftExtra, err := types.ExtractQBFTExtra(header); err == nil {
+		out := &outputExtra{
+			Consensus:      "qbft",
+			Vanity:         qbftExtra.VanityData,
+			Validators:     qbftExtra.Validators,
+			Round:          &qbftExtra.Round,
+			CommittedSeals: committedSeals(qbftExtra.CommittedSeal),
+			BLSKey:         registeredBLSKey(qbftExtra.BLSKey),
+		}
+		if qbftExtra.Vote != nil {
+			out.Vote = &outputVote{
+				Recipient: qbftExtra.Vote.RecipientAddress,
+				Authorize: qbftExtra.Vote.VoteType == types.QBFTAuthVote,
+			}
+		}
+		aggregateSeals(out)
+		return out, nil
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Istanbul extra-data: %v", err)
+	}
+	out := &outputExtra{
+		Consensus:      "ibft",
+		Vanity:         extra[:types.IstanbulExtraVanity],
+		Validators:     istanbulExtra.Validators,
+		Seal:           istanbulExtra.Seal,
+		CommittedSeals: committedSeals(istanbulExtra.CommittedSeal),
+		BLSKey:         registeredBLSKey(istanbulExtra.BLSKey),
+	}
+	aggregateSeals(out)
+	return out, nil
+}
+
+// registeredBLSKey returns the readable form of a BLS key registration, nil if
+// the extra-data registers no key.
+func registeredBLSKey(registration *types.BLSKeyRegistration) *params.BLSKey {
+	if registration == nil {
+		return nil
+	}
+	return &params.BLSKey{PublicKey: registration.PublicKey, Proof: registration.Proof}
+}
+
+// aggregateSeals moves committed seals which are a committer bitmap followed by
+// an aggregated BLS signature to their own fields. ECDSA committed seals are
+// all of the same length, so they are never taken for BLS ones.
+func aggregateSeals(out *outputExtra) {
+	seals := out.CommittedSeals
+	if len(seals) == 2 && len(seals[0]) != types.IstanbulExtraSeal && len(seals[1]) == bls.SignatureLength {
+		out.CommitterBits, out.AggregatedSeal, out.CommittedSeals = seals[0], seals[1], nil
+	}
+}
+
+// decodeHeader decodes the extra-data of the given header and recovers the
+// proposer and the committers of the block from its seals.
+func decodeHeader(header *types.Header) (*outputExtra, error) {
+	out, err := decodeExtra(header.Extra)
+	if err != nil {
+		return nil, err
+	}
+	hash := blockHash(header, out.Consensus == "qbft")
+	out.Hash = &hash
+
+	var proposalSeal []byte
+	if out.Consensus == "qbft" {
+		// QBFT records the proposer in the coinbase
+		out.Proposer = &header.Coinbase
+		proposalSeal = qbft.PrepareCommittedSeal(hash, new(big.Int).SetUint64(uint64(*out.Round)))
+	} else {
+		// IBFT blocks are signed by their proposer, except the genesis block
+		if len(out.Seal) > 0 {
+			proposer, err := istanbul.GetSignatureAddress(sigHash(header).Bytes(), out.Seal)
+			if err != nil {
+				return nil, fmt.Errorf("invalid seal: %v", err)
+			}
+			out.Proposer = &proposer
+		}
+		proposalSeal = istanbulCore.PrepareCommittedSeal(hash)
+	}
+	if out.AggregatedSeal != nil {
+		// the committers of the aggregated seal are only known by index
+		return out, nil
+	}
+	for _, seal := range out.CommittedSeals {
+		committer, err := istanbul.GetSignatureAddress(proposalSeal, seal)
+		if err != nil {
+			return nil, fmt.Errorf("invalid committed seal: %v", err)
+		}
+		out.Committers = append(out.Committers, committer)
+	}
+	return out, nil
+}
+
+// blockHash returns the hash of an Istanbul block, which leaves out the seals
+// only known once the block is committed. Without the chain configuration the
+// header is hashed according to the consensus of its extra-data.
+func blockHash(header *types.Header, isQBFT bool) common.Hash {
+	filtered := types.IstanbulFilteredHeader(header, true)
+	if isQBFT {
+		filtered = types.QBFTFilteredHeader(header)
+	}
+	blob, _ := rlp.EncodeToBytes(filtered)
+	return crypto.Keccak256Hash(blob)
+}
+
+// sigHash returns the hash signed by the proposer of an IBFT block, that is
+// the hash of the header without its seals.
+func sigHash(header *types.Header) common.Hash {
+	blob, _ := rlp.EncodeToBytes(types.IstanbulFilteredHeader(header, false))
+	return crypto.Keccak256Hash(blob)
+}
+
+// readExtraInput interprets the argument of the decode command, returning the
+// header if a header file is given, and the extra-data otherwise.
+func readExtraInput(arg string) (*types.Header, []byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	switch _, statErr := os.Stat(arg); {
+	case arg == "-":
+		data, err = ioutil.ReadAll(os.Stdin)
+	case statErr == nil:
+		data, err = ioutil.ReadFile(arg)
+	default:
+		data = []byte(arg)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	data = bytes.TrimSpace(data)
+
+	if !bytes.HasPrefix(data, []byte("{")) {
+		extra, err := hexutil.Decode(string(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid extra-data: %v", err)
+		}
+		return nil, extra, nil
+	}
+	// Unwrap JSON-RPC responses
+	var response struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(data, &response); err == nil && len(response.Result) > 0 {
+		data = response.Result
+	}
+	header := new(types.Header)
+	if err := json.Unmarshal(data, header); err != nil {
+		return nil, nil, fmt.Errorf("invalid header: %v", err)
+	}
+	return header, nil, nil
+}
+
+func committedSeals(seals [][]byte) []hexutil.Bytes {
+	out := make([]hexutil.Bytes, len(seals))
+	for i, seal := range seals {
+		out[i] = seal
+	}
+	return out
+}
+
+// printJSON prints the indented JSON encoding of the given object.
+func printJSON(jsonObject interface{}) error {
+	str, err := json.MarshalIndent(jsonObject, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(str))
+	return nil
+}
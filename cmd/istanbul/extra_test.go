@@ -0,0 +1,275 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newTestKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i], addrs[i] = key, crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+func sign(t *testing.T, data []byte, key *ecdsa.PrivateKey) []byte {
+	sig, err := crypto.Sign(crypto.Keccak256(data), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func TestEncodeDecodeExtra(t *testing.T) {
+	_, validators := newTestKeys(t, 3)
+	vanity := []byte("vanity")
+	for _, qbft := range []bool{false, true} {
+		extra, err := encodeExtra(vanity, validators, qbft)
+		if err != nil {
+			t.Fatalf("qbft %v: failed to encode: %v", qbft, err)
+		}
+		out, err := decodeExtra(extra)
+		if err != nil {
+			t.Fatalf("qbft %v: failed to decode: %v", qbft, err)
+		}
+		consensus := "ibft"
+		if qbft {
+			consensus = "qbft"
+		}
+		if out.Consensus != consensus {
+			t.Errorf("consensus mismatch: have %s, want %s", out.Consensus, consensus)
+		}
+		if want := common.RightPadBytes(vanity, types.IstanbulExtraVanity); !reflect.DeepEqual([]byte(out.Vanity), want) {
+			t.Errorf("qbft %v: vanity mismatch: have %x, want %x", qbft, out.Vanity, want)
+		}
+		if !reflect.DeepEqual(out.Validators, validators) {
+			t.Errorf("qbft %v: validators mismatch: have %v, want %v", qbft, out.Validators, validators)
+		}
+	}
+
+	if _, err := encodeExtra(make([]byte, types.IstanbulExtraVanity+1), validators, false); err != errInvalidVanity {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidVanity)
+	}
+	if _, err := decodeExtra([]byte{0x01, 0x02}); err == nil {
+		t.Errorf("expected an error decoding invalid extra-data")
+	}
+}
+
+func TestDecodeHeader(t *testing.T) {
+	keys, validators := newTestKeys(t, 4)
+
+	// IBFT block proposed by the first validator and committed by the others
+	extra, err := encodeExtra(nil, validators, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := &types.Header{Number: big.NewInt(1), Extra: extra, MixDigest: types.IstanbulDigest}
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeExtra := func() {
+		payload, err := rlp.EncodeToBytes(istanbulExtra)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header.Extra = append(extra[:types.IstanbulExtraVanity:types.IstanbulExtraVanity], payload...)
+	}
+	// the committed seals sign the hash of the header including its seal
+	istanbulExtra.Seal = sign(t, sigHash(header).Bytes(), keys[0])
+	writeExtra()
+	for _, key := range keys[1:] {
+		istanbulExtra.CommittedSeal = append(istanbulExtra.CommittedSeal, sign(t, istanbulCore.PrepareCommittedSeal(header.Hash()), key))
+	}
+	writeExtra()
+
+	out, err := decodeHeader(header)
+	if err != nil {
+		t.Fatalf("failed to decode the IBFT header: %v", err)
+	}
+	if out.Proposer == nil || *out.Proposer != validators[0] {
+		t.Errorf("proposer mismatch: have %v, want %v", out.Proposer, validators[0])
+	}
+	if !reflect.DeepEqual(out.Committers, validators[1:]) {
+		t.Errorf("committers mismatch: have %v, want %v", out.Committers, validators[1:])
+	}
+
+	// QBFT block proposed by the second validator and committed in round 1
+	if extra, err = encodeExtra(nil, validators, true); err != nil {
+		t.Fatal(err)
+	}
+	header = &types.Header{Number: big.NewInt(1), Coinbase: validators[1], Extra: extra, MixDigest: types.IstanbulDigest}
+	qbftExtra, err := types.ExtractQBFTExtra(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qbftExtra.Round = 1
+	for _, key := range keys[:3] {
+		qbftExtra.CommittedSeal = append(qbftExtra.CommittedSeal, sign(t, qbft.PrepareCommittedSeal(blockHash(header, true), big.NewInt(1)), key))
+	}
+	if header.Extra, err = rlp.EncodeToBytes(qbftExtra); err != nil {
+		t.Fatal(err)
+	}
+
+	if out, err = decodeHeader(header); err != nil {
+		t.Fatalf("failed to decode the QBFT header: %v", err)
+	}
+	if out.Proposer == nil || *out.Proposer != validators[1] {
+		t.Errorf("proposer mismatch: have %v, want %v", out.Proposer, validators[1])
+	}
+	if out.Round == nil || *out.Round != 1 {
+		t.Errorf("round mismatch: have %v, want 1", out.Round)
+	}
+	if !reflect.DeepEqual(out.Committers, validators[:3]) {
+		t.Errorf("committers mismatch: have %v, want %v", out.Committers, validators[:3])
+	}
+}
+
+func TestMakeGenesis(t *testing.T) {
+	_, validators := newTestKeys(t, 4)
+	for _, qbft := range []bool{false, true} {
+		genesis, err := makeGenesis(validators, 10, 30000, qbft)
+		if err != nil {
+			t.Fatalf("qbft %v: failed to make the genesis: %v", qbft, err)
+		}
+		block, err := genesis.Commit(rawdb.NewMemoryDatabase())
+		if err != nil {
+			t.Fatalf("qbft %v: failed to commit the genesis: %v", qbft, err)
+		}
+		out, err := decodeHeader(block.Header())
+		if err != nil {
+			t.Fatalf("qbft %v: failed to decode the genesis header: %v", qbft, err)
+		}
+		if !reflect.DeepEqual(out.Validators, validators) {
+			t.Errorf("qbft %v: validators mismatch: have %v, want %v", qbft, out.Validators, validators)
+		}
+		if out.Proposer != nil && !qbft {
+			t.Errorf("qbft %v: unexpected proposer %v", qbft, out.Proposer)
+		}
+	}
+}
+
+func TestDecodeAggregatedSeals(t *testing.T) {
+	_, validators := newTestKeys(t, 4)
+	extra, err := encodeExtra(nil, validators, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := &types.Header{Number: big.NewInt(1), Coinbase: validators[0], Extra: extra, MixDigest: types.IstanbulDigest}
+	qbftExtra, err := types.ExtractQBFTExtra(header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bitmap, aggregate := []byte{0x07}, make([]byte, bls.SignatureLength)
+	qbftExtra.CommittedSeal = [][]byte{bitmap, aggregate}
+	if header.Extra, err = rlp.EncodeToBytes(qbftExtra); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := decodeHeader(header)
+	if err != nil {
+		t.Fatalf("failed to decode the header: %v", err)
+	}
+	if !bytes.Equal(out.CommitterBits, bitmap) || !bytes.Equal(out.AggregatedSeal, aggregate) || len(out.CommittedSeals) != 0 {
+		t.Errorf("aggregated seals mismatch: have bitmap %x, seal %x, seals %x", out.CommitterBits, out.AggregatedSeal, out.CommittedSeals)
+	}
+	if len(out.Committers) != 0 {
+		t.Errorf("unexpected committers %v", out.Committers)
+	}
+}
+
+func TestDecodeBLSKeyRegistration(t *testing.T) {
+	_, validators := newTestKeys(t, 4)
+	registration := &types.BLSKeyRegistration{PublicKey: make([]byte, bls.PublicKeyLength), Proof: make([]byte, bls.SignatureLength)}
+	for _, qbft := range []bool{false, true} {
+		extra, err := encodeExtra(nil, validators, qbft)
+		if err != nil {
+			t.Fatal(err)
+		}
+		header := &types.Header{Extra: extra}
+		if qbft {
+			qbftExtra, _ := types.ExtractQBFTExtra(header)
+			qbftExtra.Vote = &types.ValidatorVote{RecipientAddress: validators[0], VoteType: types.QBFTAuthVote}
+			qbftExtra.BLSKey = registration
+			extra, err = rlp.EncodeToBytes(qbftExtra)
+		} else {
+			istanbulExtra, _ := types.ExtractIstanbulExtra(header)
+			istanbulExtra.BLSKey = registration
+			payload, _ := rlp.EncodeToBytes(istanbulExtra)
+			extra = append(extra[:types.IstanbulExtraVanity:types.IstanbulExtraVanity], payload...)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := decodeExtra(extra)
+		if err != nil {
+			t.Fatalf("qbft %v: failed to decode the extra-data: %v", qbft, err)
+		}
+		if out.BLSKey == nil || !bytes.Equal(out.BLSKey.PublicKey, registration.PublicKey) || !bytes.Equal(out.BLSKey.Proof, registration.Proof) {
+			t.Errorf("qbft %v: BLS key mismatch: have %v", qbft, out.BLSKey)
+		}
+	}
+}
+
+func TestRegisterBLSKeys(t *testing.T) {
+	_, validators := newTestKeys(t, 4)
+	genesis, err := makeGenesis(validators, 10, 30000, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]*bls.SecretKey, len(validators))
+	for i := range keys {
+		if keys[i], err = bls.GenerateKey(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	registerBLSKeys(genesis, validators, keys)
+	// the proofs of possession are checked along with the transitions
+	if err := genesis.Config.CheckTransitionsData(); err != nil {
+		t.Fatalf("invalid transitions: %v", err)
+	}
+	transition := genesis.Config.Transitions[0]
+	if transition.CommittedSealScheme != params.BLSSealScheme || len(transition.BLSKeys) != len(validators) {
+		t.Fatalf("transition mismatch: have %+v", transition)
+	}
+	for i, key := range keys {
+		if registered := transition.BLSKeys[validators[i]]; !bytes.Equal(registered.PublicKey, key.PublicKey().Bytes()) {
+			t.Errorf("validator %d: public key mismatch", i)
+		}
+	}
+}
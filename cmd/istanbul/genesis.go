@@ -0,0 +1,227 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	genesisFileName     = "genesis.json"
+	staticNodesFileName = "static-nodes.json"
+	genesisGasLimit     = 0xE0000000 // Gas limit of generated genesis blocks, high enough not to constrain testnets
+)
+
+var commandGenesis = cli.Command{
+	Name:      "genesis",
+	Usage:     "generate an Istanbul genesis with validator node keys",
+	ArgsUsage: "[ <directory> ]",
+	Description: `
+Generate the node keys of a set of validators and an Istanbul genesis whose
+validators are these nodes, to run a local testnet.
+
+The directory, the current one by default, receives the genesis in genesis.json
+and a node<i> data directory per validator, holding its node key and the list of
+the validators as static nodes listening on consecutive ports of localhost.
+With --bls, the committed seals are aggregated into a single BLS signature, each
+node directory holding a BLS key generated apart from its node key, which the
+genesis registers.
+Each node is initialised with:
+
+    geth --datadir <directory>/node<i> init <directory>/genesis.json`,
+	Flags: []cli.Flag{
+		qbftFlag,
+		cli.IntFlag{
+			Name:  "validators",
+			Usage: "number of validators",
+			Value: 4,
+		},
+		cli.Uint64Flag{
+			Name:  "chainid",
+			Usage: "chain identifier",
+			Value: 10,
+		},
+		cli.Uint64Flag{
+			Name:  "epoch",
+			Usage: "number of blocks after which votes are reset",
+			Value: 30000,
+		},
+		cli.BoolFlag{
+			Name:  "bls",
+			Usage: "aggregate the committed seals into a BLS signature, registering the BLS keys of the validators",
+		},
+		cli.IntFlag{
+			Name:  "port",
+			Usage: "listening port of the first validator",
+			Value: 30303,
+		},
+		jsonFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		dir := ctx.Args().First()
+		if dir == "" {
+			dir = "."
+		}
+		genesisPath := filepath.Join(dir, genesisFileName)
+		if _, err := os.Stat(genesisPath); err == nil {
+			return fmt.Errorf("genesis already exists at %s", genesisPath)
+		}
+		n := ctx.Int("validators")
+		if n < 1 {
+			return fmt.Errorf("invalid number of validators %d", n)
+		}
+
+		keys := make([]*ecdsa.PrivateKey, n)
+		validators := make([]common.Address, n)
+		nodes := make([]string, n)
+		for i := range keys {
+			key, err := crypto.GenerateKey()
+			if err != nil {
+				return fmt.Errorf("failed to generate node key: %v", err)
+			}
+			keys[i], validators[i] = key, crypto.PubkeyToAddress(key.PublicKey)
+			nodes[i] = enode.NewV4(&key.PublicKey, net.IPv4(127, 0, 0, 1), ctx.Int("port")+i, 0).URLv4()
+		}
+
+		genesis, err := makeGenesis(validators, ctx.Uint64("chainid"), ctx.Uint64("epoch"), ctx.Bool(qbftFlag.Name))
+		if err != nil {
+			return err
+		}
+		var blsKeys []*bls.SecretKey
+		if ctx.Bool("bls") {
+			blsKeys = make([]*bls.SecretKey, n)
+			for i := range blsKeys {
+				if blsKeys[i], err = bls.GenerateKey(); err != nil {
+					return fmt.Errorf("failed to generate BLS key: %v", err)
+				}
+			}
+			registerBLSKeys(genesis, validators, blsKeys)
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+		if err := writeJSON(genesisPath, genesis, 0644); err != nil {
+			return err
+		}
+		for i, key := range keys {
+			instanceDir := filepath.Join(dir, fmt.Sprintf("node%d", i), "geth")
+			if err := os.MkdirAll(instanceDir, 0700); err != nil {
+				return err
+			}
+			if err := crypto.SaveECDSA(filepath.Join(instanceDir, "nodekey"), key); err != nil {
+				return fmt.Errorf("failed to write node key: %v", err)
+			}
+			if blsKeys != nil {
+				if err := bls.SaveKey(filepath.Join(instanceDir, "blskey"), blsKeys[i]); err != nil {
+					return fmt.Errorf("failed to write BLS key: %v", err)
+				}
+			}
+			if err := writeJSON(filepath.Join(instanceDir, staticNodesFileName), nodes, 0644); err != nil {
+				return err
+			}
+		}
+
+		if ctx.Bool(jsonFlag.Name) {
+			return printJSON(validators)
+		}
+		for i, validator := range validators {
+			fmt.Printf("node%d: %s %s\n", i, validator.Hex(), nodes[i])
+		}
+		return nil
+	},
+}
+
+// makeGenesis returns an Istanbul genesis with the given validators, sealed
+// with QBFT from the genesis block if qbft is set and with IBFT otherwise.
+func makeGenesis(validators []common.Address, chainID uint64, epoch uint64, qbft bool) (*core.Genesis, error) {
+	extra, err := encodeExtra(nil, validators, qbft)
+	if err != nil {
+		return nil, err
+	}
+	istanbulConfig := &params.IstanbulConfig{Epoch: epoch}
+	if qbft {
+		istanbulConfig.QbftBlock = big.NewInt(0)
+	}
+	return &core.Genesis{
+		Config: &params.ChainConfig{
+			ChainID:              new(big.Int).SetUint64(chainID),
+			HomesteadBlock:       big.NewInt(0),
+			EIP150Block:          big.NewInt(0),
+			EIP155Block:          big.NewInt(0),
+			EIP158Block:          big.NewInt(0),
+			ByzantiumBlock:       big.NewInt(0),
+			ConstantinopleBlock:  big.NewInt(0),
+			PetersburgBlock:      big.NewInt(0),
+			IstanbulBlock:        big.NewInt(0),
+			Istanbul:             istanbulConfig,
+			IsQuorum:             true,
+			TransactionSizeLimit: 64,
+		},
+		Timestamp:  uint64(time.Now().Unix()),
+		ExtraData:  extra,
+		GasLimit:   genesisGasLimit,
+		Difficulty: big.NewInt(1),
+		Mixhash:    types.IstanbulDigest,
+		Alloc:      core.GenesisAlloc{},
+	}, nil
+}
+
+// registerBLSKeys switches the committed seals of the genesis to aggregated BLS
+// signatures, registering the BLS keys of the given validators
+func registerBLSKeys(genesis *core.Genesis, validators []common.Address, keys []*bls.SecretKey) {
+	blsKeys := make(map[common.Address]params.BLSKey, len(keys))
+	for i, key := range keys {
+		blsKeys[validators[i]] = params.BLSKey{
+			PublicKey: key.PublicKey().Bytes(),
+			Proof:     key.ProvePossession().Bytes(),
+		}
+	}
+	genesis.Config.Transitions = append(genesis.Config.Transitions, params.Transition{
+		Block:               big.NewInt(0),
+		CommittedSealScheme: params.BLSSealScheme,
+		BLSKeys:             blsKeys,
+	})
+}
+
+// writeJSON writes the indented JSON encoding of the given object to a file.
+func writeJSON(path string, jsonObject interface{}, perm os.FileMode) error {
+	blob, err := json.MarshalIndent(jsonObject, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, blob, perm); err != nil {
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	return nil
+}
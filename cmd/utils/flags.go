@@ -794,6 +794,82 @@ var (
 		Name:  "raftdnsenable",
 		Usage: "Enable DNS resolution of peers",
 	}
+	RaftTLSModeFlag = cli.StringFlag{
+		Name:  "raft.tls.mode",
+		Usage: `If "off" then TLS disabled (default). If "strict" then the raft transport uses TLS with mutual authentication`,
+		Value: raft.TLSModeOff,
+	}
+	RaftTLSRootCAFlag = DirectoryFlag{
+		Name:  "raft.tls.rootca",
+		Usage: "Path to file containing root CA certificate used to verify the certificates of raft peers",
+	}
+	RaftTLSCertFlag = DirectoryFlag{
+		Name:  "raft.tls.cert",
+		Usage: "Path to file containing the node certificate (or chain of certs) for the raft transport. It must carry the enode ID as common name or as enode://<enode ID> URI SAN",
+	}
+	RaftTLSKeyFlag = DirectoryFlag{
+		Name:  "raft.tls.key",
+		Usage: "Path to file containing the node private key for the raft transport",
+	}
+	RaftTickIntervalFlag = cli.IntFlag{
+		Name:  "raft.tick",
+		Usage: "Interval of the raft logical clock in milliseconds. Election and heartbeat timeouts are multiples of it",
+		Value: int(eth.DefaultRaftConfig.TickInterval / time.Millisecond),
+	}
+	RaftElectionTickFlag = cli.IntFlag{
+		Name:  "raft.electiontick",
+		Usage: "Number of ticks without contact from the leader after which a follower starts an election. Must be greater than the heartbeat tick, at least 10 heartbeats is recommended",
+		Value: eth.DefaultRaftConfig.ElectionTick,
+	}
+	RaftHeartbeatTickFlag = cli.IntFlag{
+		Name:  "raft.heartbeattick",
+		Usage: "Number of ticks between heartbeats sent by the leader",
+		Value: eth.DefaultRaftConfig.HeartbeatTick,
+	}
+	RaftSnapshotPeriodFlag = cli.Uint64Flag{
+		Name:  "raft.snapshotperiod",
+		Usage: "Number of applied raft entries after which a raft snapshot is taken",
+		Value: eth.DefaultRaftConfig.SnapshotPeriod,
+	}
+	RaftPreVoteFlag = cli.BoolFlag{
+		Name:  "raft.prevote",
+		Usage: "Enable the raft pre-vote phase so that a partitioned node does not disrupt the cluster when it rejoins. Must be enabled on all nodes",
+	}
+	RaftCheckQuorumFlag = cli.BoolFlag{
+		Name:  "raft.checkquorum",
+		Usage: "Make the raft leader step down when it loses contact with the majority of the cluster. Must be enabled on all nodes",
+	}
+	RaftAutoPromoteLearnersFlag = cli.BoolFlag{
+		Name:  "raft.learner.autopromote",
+		Usage: "Let the raft leader promote learners to peers once they have caught up for the promotion period",
+	}
+	RaftLearnerMaxLagFlag = cli.Uint64Flag{
+		Name:  "raft.learner.maxlag",
+		Usage: "Maximum number of raft entries a learner may lag behind the leader to be promoted. Manual promotion of a lagging learner requires raft.forcePromoteToPeer",
+		Value: eth.DefaultRaftConfig.LearnerMaxLag,
+	}
+	RaftLearnerPromotionPeriodFlag = cli.DurationFlag{
+		Name:  "raft.learner.promotionperiod",
+		Usage: "Time a learner must be caught up before it is promoted automatically",
+		Value: eth.DefaultRaftConfig.LearnerPromotionPeriod,
+	}
+	RaftTxOrderingFlag = cli.StringFlag{
+		Name:  "raft.txordering",
+		Usage: "Order of pending transactions in minted blocks: price (by gas price, then arrival), fifo (by arrival time) or roundrobin (one transaction per sender in turn)",
+		Value: eth.DefaultRaftConfig.TxOrdering,
+	}
+	RaftTxQuotaPerSenderFlag = cli.IntFlag{
+		Name:  "raft.txquota.sender",
+		Usage: "Maximum number of transactions of a sender in a minted block (0 = unlimited)",
+	}
+	RaftTxQuotaPerOrgFlag = cli.IntFlag{
+		Name:  "raft.txquota.org",
+		Usage: "Maximum number of transactions of the accounts of a permission org in a minted block (0 = unlimited)",
+	}
+	RaftStateSyncFlag = cli.BoolFlag{
+		Name:  "raft.statesync",
+		Usage: "When joining with an empty chain, download the state of the raft snapshot head instead of executing all blocks, unless the node is party to private transactions of those blocks",
+	}
 
 	// Permission
 	EnableNodePermissionFlag = cli.BoolFlag{
@@ -827,6 +903,12 @@ var (
 		Name:  "plugins.account.config",
 		Usage: "Value will be passed to an account plugin if being used.  See the account plugin implementation's documentation for further details",
 	}
+	// permission plugin flags
+	PermissionPluginModeFlag = cli.StringFlag{
+		Name:  "plugins.permission.mode",
+		Usage: "How the permission plugin decision is combined with the built-in permission checks: all (both should allow), any (either should allow) or plugin (plugin decides)",
+		Value: "all",
+	}
 	// Istanbul settings
 	IstanbulRequestTimeoutFlag = cli.Uint64Flag{
 		Name:  "istanbul.requesttimeout",
@@ -1669,6 +1751,45 @@ func setIstanbul(ctx *cli.Context, cfg *eth.Config) {
 
 func setRaft(ctx *cli.Context, cfg *eth.Config) {
 	cfg.RaftMode = ctx.GlobalBool(RaftModeFlag.Name)
+	if ctx.GlobalIsSet(RaftTickIntervalFlag.Name) {
+		cfg.Raft.TickInterval = time.Duration(ctx.GlobalInt(RaftTickIntervalFlag.Name)) * time.Millisecond
+	}
+	if ctx.GlobalIsSet(RaftElectionTickFlag.Name) {
+		cfg.Raft.ElectionTick = ctx.GlobalInt(RaftElectionTickFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftHeartbeatTickFlag.Name) {
+		cfg.Raft.HeartbeatTick = ctx.GlobalInt(RaftHeartbeatTickFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftSnapshotPeriodFlag.Name) {
+		cfg.Raft.SnapshotPeriod = ctx.GlobalUint64(RaftSnapshotPeriodFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftPreVoteFlag.Name) {
+		cfg.Raft.PreVote = ctx.GlobalBool(RaftPreVoteFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftCheckQuorumFlag.Name) {
+		cfg.Raft.CheckQuorum = ctx.GlobalBool(RaftCheckQuorumFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftAutoPromoteLearnersFlag.Name) {
+		cfg.Raft.AutoPromoteLearners = ctx.GlobalBool(RaftAutoPromoteLearnersFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftLearnerMaxLagFlag.Name) {
+		cfg.Raft.LearnerMaxLag = ctx.GlobalUint64(RaftLearnerMaxLagFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftLearnerPromotionPeriodFlag.Name) {
+		cfg.Raft.LearnerPromotionPeriod = ctx.GlobalDuration(RaftLearnerPromotionPeriodFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftTxOrderingFlag.Name) {
+		cfg.Raft.TxOrdering = ctx.GlobalString(RaftTxOrderingFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftTxQuotaPerSenderFlag.Name) {
+		cfg.Raft.TxQuotaPerSender = ctx.GlobalInt(RaftTxQuotaPerSenderFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftTxQuotaPerOrgFlag.Name) {
+		cfg.Raft.TxQuotaPerOrg = ctx.GlobalInt(RaftTxQuotaPerOrgFlag.Name)
+	}
+	if ctx.GlobalIsSet(RaftStateSyncFlag.Name) {
+		cfg.Raft.StateSync = ctx.GlobalBool(RaftStateSyncFlag.Name)
+	}
 }
 
 func setQuorumConfig(ctx *cli.Context, cfg *eth.Config) {
@@ -2013,12 +2134,18 @@ func RegisterPermissionService(stack *node.Node, useDns bool) {
 	log.Info("permission service registered")
 }
 
-func RegisterRaftService(stack *node.Node, ctx *cli.Context, nodeCfg *node.Config, ethService *eth.Ethereum) {
+func RegisterRaftService(stack *node.Node, ctx *cli.Context, nodeCfg *node.Config, raftCfg *eth.RaftConfig, ethService *eth.Ethereum) {
 	blockTimeMillis := ctx.GlobalInt(RaftBlockTimeFlag.Name)
 	datadir := ctx.GlobalString(DataDirFlag.Name)
 	joinExistingId := ctx.GlobalInt(RaftJoinExistingFlag.Name)
 	useDns := ctx.GlobalBool(RaftDNSEnabledFlag.Name)
 	raftPort := uint16(ctx.GlobalInt(RaftPortFlag.Name))
+	tlsConfig := &raft.TLSConfig{
+		Mode:   ctx.GlobalString(RaftTLSModeFlag.Name),
+		RootCA: ctx.GlobalString(RaftTLSRootCAFlag.Name),
+		Cert:   ctx.GlobalString(RaftTLSCertFlag.Name),
+		Key:    ctx.GlobalString(RaftTLSKeyFlag.Name),
+	}
 
 	privkey := nodeCfg.NodeKey()
 	strId := enode.PubkeyToIDV4(&privkey.PublicKey).String()
@@ -2053,7 +2180,7 @@ func RegisterRaftService(stack *node.Node, ctx *cli.Context, nodeCfg *node.Confi
 		}
 	}
 
-	_, err := raft.New(stack, ethService.BlockChain().Config(), myId, raftPort, joinExisting, blockTimeNanos, ethService, peers, datadir, useDns)
+	_, err := raft.New(stack, ethService.BlockChain().Config(), myId, raftPort, joinExisting, blockTimeNanos, ethService, peers, datadir, useDns, tlsConfig, raftCfg)
 	if err != nil {
 		Fatalf("raft: Failed to register the Raft service: %v", err)
 	}
@@ -2179,13 +2306,23 @@ func MakeChain(ctx *cli.Context, stack *node.Node, readOnly bool, useExist bool)
 	if config.Clique != nil {
 		engine = clique.New(config.Clique, chainDb)
 	} else if config.Istanbul != nil {
-		// for IBFT
+		// for IBFT and QBFT
 		istanbulConfig := istanbul.DefaultConfig
 		if config.Istanbul.Epoch != 0 {
 			istanbulConfig.Epoch = config.Istanbul.Epoch
 		}
 		istanbulConfig.ProposerPolicy = istanbul.ProposerPolicy(config.Istanbul.ProposerPolicy)
 		istanbulConfig.Ceil2Nby3Block = config.Istanbul.Ceil2Nby3Block
+		istanbulConfig.QbftBlock = config.Istanbul.QbftBlock
+		istanbulConfig.ValidatorContract = config.Istanbul.ValidatorContractAddress
+		istanbulConfig.ValidatorContractBlock = config.Istanbul.ValidatorContractBlock
+		istanbulConfig.Transitions = config.Transitions
+		istanbulConfig.BlockReward = config.Istanbul.BlockReward
+		istanbulConfig.BlockRewardBeneficiary = config.Istanbul.BlockRewardBeneficiary
+		istanbulConfig.BlockRewardBlock = config.Istanbul.BlockRewardBlock
+		istanbulConfig.RoundTimeoutStrategy = config.Istanbul.RoundTimeoutStrategy
+		istanbulConfig.MaxRequestTimeout = config.Istanbul.MaxRequestTimeout
+		istanbulConfig.EmptyBlockPeriod = config.Istanbul.EmptyBlockPeriod
 		engine = istanbulBackend.New(istanbulConfig, stack.GetNodeKey(), chainDb)
 	} else if config.IsQuorum {
 		// for Raft
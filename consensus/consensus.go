@@ -92,7 +92,7 @@ type Engine interface {
 	// Note: The block header and state database might be updated to reflect any
 	// consensus rules that happen at finalization (e.g. block rewards).
 	Finalize(chain ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-		uncles []*types.Header)
+		uncles []*types.Header) error
 
 	// FinalizeAndAssemble runs any post-transaction state modifications (e.g. block
 	// rewards) and assembles the final block.
@@ -138,6 +138,14 @@ type Handler interface {
 	SetBroadcaster(Broadcaster)
 }
 
+// ParentStateVerifier should be implemented if the consensus rules of a block
+// depend on the state of its parent, which is available once the block body is
+// validated
+type ParentStateVerifier interface {
+	// VerifyParentState verifies the block against the state of its parent
+	VerifyParentState(chain ChainReader, block *types.Block) error
+}
+
 // PoW is a consensus engine based on proof-of-work.
 type PoW interface {
 	Engine
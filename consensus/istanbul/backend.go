@@ -41,9 +41,10 @@ type Backend interface {
 	// Gossip sends a message to all validators (exclude self)
 	Gossip(valSet ValidatorSet, payload []byte) error
 
-	// Commit delivers an approved proposal to backend.
+	// Commit delivers an approved proposal to backend, along with the committed
+	// seals of the given committers and the round in which it was committed.
 	// The delivered proposal will be put into blockchain.
-	Commit(proposal Proposal, seals [][]byte) error
+	Commit(proposal Proposal, seals [][]byte, committers []common.Address, round *big.Int) error
 
 	// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
 	// the time difference of the proposal and current time is also returned.
@@ -52,6 +53,11 @@ type Backend interface {
 	// Sign signs input data with the backend's private key
 	Sign([]byte) ([]byte, error)
 
+	// SignCommittedSeal signs the committed seal of the proposal with the given
+	// number, using the BLS key of the backend if the committed seals of the
+	// proposal are aggregated BLS signatures
+	SignCommittedSeal(number *big.Int, seal []byte) ([]byte, error)
+
 	// CheckSignature verifies the signature by checking if it's signed by
 	// the given validator
 	CheckSignature(data []byte, addr common.Address, sig []byte) error
@@ -71,5 +77,13 @@ type Backend interface {
 	// HasBadBlock returns whether the block with the hash is a bad block
 	HasBadProposal(hash common.Hash) bool
 
+	// WriteCoreState stores the state of the consensus core with the given
+	// name, replacing the state stored before
+	WriteCoreState(name string, state []byte) error
+
+	// ReadCoreState retrieves the state stored by the consensus core with the
+	// given name, nil if none was stored
+	ReadCoreState(name string) ([]byte, error)
+
 	Close() error
 }
@@ -18,10 +18,14 @@ package backend
 
 import (
 	"errors"
+	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -42,6 +46,26 @@ type BlockSigners struct {
 type Status struct {
 	SigningStatus map[common.Address]int `json:"sealerActivity"`
 	NumBlocks     uint64                 `json:"numBlocks"`
+	RoundTimeout  *RoundTimeoutStatus    `json:"roundTimeout"`
+	Rounds        []*RoundStatus         `json:"rounds"`
+}
+
+// RoundTimeoutStatus is the round-change timeout configuration in force for the
+// block after the status range
+type RoundTimeoutStatus struct {
+	Strategy          string `json:"strategy"`
+	RequestTimeout    uint64 `json:"requestTimeout"`    // in milliseconds
+	MaxRequestTimeout uint64 `json:"maxRequestTimeout"` // in milliseconds, 0 if unbounded
+}
+
+// RoundStatus is a consensus round run by this node, as recorded by the core
+type RoundStatus struct {
+	Sequence uint64     `json:"sequence"`
+	Round    uint64     `json:"round"`
+	Start    time.Time  `json:"start"`
+	End      *time.Time `json:"end"`      // nil while the round is running
+	Timeout  uint64     `json:"timeout"`  // in milliseconds
+	TimedOut bool       `json:"timedOut"` // whether the round ended because the timeout expired
 }
 
 // NodeAddress returns the public address that is used to sign block headers in IBFT
@@ -49,6 +73,17 @@ func (api *API) NodeAddress() common.Address {
 	return api.istanbul.Address()
 }
 
+// BLSKey returns the BLS public key signing the committed seals of the node under
+// the BLS seal scheme, with its proof of possession, to be registered in the
+// transitions of the genesis, through votes or in the validator contract
+func (api *API) BLSKey() (*params.BLSKey, error) {
+	sk := api.istanbul.blsKey
+	if sk == nil {
+		return nil, errInvalidBLSKey
+	}
+	return &params.BLSKey{PublicKey: sk.PublicKey().Bytes(), Proof: sk.ProvePossession().Bytes()}, nil
+}
+
 // GetSignersFromBlock returns the signers and minter for a given block number, or the
 // latest block available if none is specified
 func (api *API) GetSignersFromBlock(number *rpc.BlockNumber) (*BlockSigners, error) {
@@ -130,15 +165,11 @@ func (api *API) GetValidators(number *rpc.BlockNumber) ([]common.Address, error)
 	} else {
 		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
 	}
-	// Ensure we have an actually valid block and return the validators from its snapshot
+	// Ensure we have an actually valid block and return its validators
 	if header == nil {
 		return nil, errUnknownBlock
 	}
-	snap, err := api.istanbul.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
-	if err != nil {
-		return nil, err
-	}
-	return snap.validators(), nil
+	return api.validators(header)
 }
 
 // GetValidatorsAtHash retrieves the state snapshot at a given block.
@@ -147,11 +178,48 @@ func (api *API) GetValidatorsAtHash(hash common.Hash) ([]common.Address, error)
 	if header == nil {
 		return nil, errUnknownBlock
 	}
-	snap, err := api.istanbul.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	return api.validators(header)
+}
+
+// validators returns the validators following the given block, read from the
+// validator contract once it is in force
+func (api *API) validators(header *types.Header) ([]common.Address, error) {
+	valSet, err := api.istanbul.validatorsAt(api.chain, header.Number.Uint64(), header.Hash(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return snap.validators(), nil
+	return validatorAddresses(valSet), nil
+}
+
+// GetParticipation retrieves the participation of the validators in the epoch of
+// the specified block, as recorded up to the current head.
+func (api *API) GetParticipation(number *rpc.BlockNumber) (*EpochParticipation, error) {
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	if err := api.istanbul.indexParticipation(api.chain); err != nil {
+		return nil, err
+	}
+	return api.istanbul.loadParticipation(api.istanbul.participationEpoch(header.Number.Uint64()))
+}
+
+// GetValidatorParticipation retrieves the participation of a validator in the
+// epoch of the specified block, as recorded up to the current head.
+func (api *API) GetValidatorParticipation(address common.Address, number *rpc.BlockNumber) (*ValidatorParticipation, error) {
+	record, err := api.GetParticipation(number)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := record.Validators[address]; ok {
+		return p, nil
+	}
+	return nil, errNoParticipation
 }
 
 // Candidates returns the current candidates the node tries to uphold and vote on.
@@ -173,6 +241,23 @@ func (api *API) Propose(address common.Address, auth bool) {
 	defer api.istanbul.candidatesLock.Unlock()
 
 	api.istanbul.candidates[address] = auth
+	delete(api.istanbul.blsCandidates, address)
+}
+
+// ProposeBLSKey injects a new authorization candidate along with the BLS key it
+// signs committed seals with, as returned by its istanbul_blsKey, registering
+// the key once the candidate is voted in. Validators are authorized again to
+// register another key.
+func (api *API) ProposeBLSKey(address common.Address, key params.BLSKey) error {
+	if _, err := bls.VerifyRegistration(key.PublicKey, key.Proof); err != nil {
+		return err
+	}
+	api.istanbul.candidatesLock.Lock()
+	defer api.istanbul.candidatesLock.Unlock()
+
+	api.istanbul.candidates[address] = true
+	api.istanbul.blsCandidates[address] = key
+	return nil
 }
 
 // Discard drops a currently running candidate, stopping the validator from casting
@@ -182,6 +267,7 @@ func (api *API) Discard(address common.Address) {
 	defer api.istanbul.candidatesLock.Unlock()
 
 	delete(api.istanbul.candidates, address)
+	delete(api.istanbul.blsCandidates, address)
 }
 
 func (api *API) Status(startBlockNum *rpc.BlockNumber, endBlockNum *rpc.BlockNumber) (*Status, error) {
@@ -250,9 +336,51 @@ func (api *API) Status(startBlockNum *rpc.BlockNumber, endBlockNum *rpc.BlockNum
 	return &Status{
 		SigningStatus: signStatus,
 		NumBlocks:     numBlocks,
+		RoundTimeout:  api.roundTimeoutStatus(new(big.Int).SetUint64(end + 1)),
+		Rounds:        api.roundStatus(start, end+1),
 	}, nil
 }
 
+// roundTimeoutStatus returns the round timeout configuration of the block with
+// the given number
+func (api *API) roundTimeoutStatus(number *big.Int) *RoundTimeoutStatus {
+	config := api.istanbul.config.GetConfig(number)
+	status := &RoundTimeoutStatus{
+		Strategy:          config.RoundTimeoutStrategy,
+		RequestTimeout:    config.RequestTimeout,
+		MaxRequestTimeout: config.MaxRequestTimeout,
+	}
+	if status.Strategy == "" {
+		status.Strategy = params.ExponentialTimeout
+	}
+	return status
+}
+
+// roundStatus lists the rounds recorded by the cores for the sequences in the
+// given range, the oldest first
+func (api *API) roundStatus(start, end uint64) []*RoundStatus {
+	rounds := make([]*RoundStatus, 0)
+	for _, info := range api.istanbul.rounds() {
+		sequence := info.View.Sequence.Uint64()
+		if sequence < start || sequence > end {
+			continue
+		}
+		round := &RoundStatus{
+			Sequence: sequence,
+			Round:    info.View.Round.Uint64(),
+			Start:    info.Start,
+			Timeout:  uint64(info.Timeout / time.Millisecond),
+			TimedOut: info.TimedOut,
+		}
+		if !info.End.IsZero() {
+			end := info.End
+			round.End = &end
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
+
 func (api *API) IsValidator(blockNum *rpc.BlockNumber) (bool, error) {
 	var blockNumber rpc.BlockNumber
 	if blockNum != nil {
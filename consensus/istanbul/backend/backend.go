@@ -25,7 +25,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -33,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/trie"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -40,6 +43,8 @@ import (
 const (
 	// fetcherID is the ID indicates the block is from Istanbul engine
 	fetcherID = "istanbul"
+	// dbKeyCoreStatePrefix prefixes the database keys of the consensus core states
+	dbKeyCoreStatePrefix = "istanbul-core-state-"
 )
 
 // New creates an Ethereum backend for Istanbul core engine.
@@ -48,6 +53,9 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	contractValidatorSets, _ := lru.NewARC(inmemoryContractValidators)
+	contractBLSKeySets, _ := lru.NewARC(inmemoryContractValidators)
+	blsPublicKeys, _ := lru.NewARC(inmemoryBLSKeys)
 	backend := &backend{
 		config:           config,
 		istanbulEventMux: new(event.TypeMux),
@@ -58,11 +66,21 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		commitCh:         make(chan *types.Block, 1),
 		recents:          recents,
 		candidates:       make(map[common.Address]bool),
+		blsCandidates:    make(map[common.Address]params.BLSKey),
 		coreStarted:      false,
 		recentMessages:   recentMessages,
 		knownMessages:    knownMessages,
+
+		contractValidatorSets: contractValidatorSets,
+		contractBLSKeySets:    contractBLSKeySets,
+		blsKey:                loadBLSKey(config.BLSKeyFile),
+		blsPublicKeys:         blsPublicKeys,
 	}
-	backend.core = istanbulCore.New(backend, backend.config)
+	// the hash of the headers depends on whether they carry QBFT extra-data
+	types.SetQBFTBlock(config.QbftBlock)
+	backend.ibftCore = istanbulCore.New(backend, backend.config)
+	backend.qbftCore = qbft.New(backend, backend.config)
+	backend.core = backend.ibftCore
 	return backend
 }
 
@@ -73,7 +91,9 @@ type backend struct {
 	istanbulEventMux *event.TypeMux
 	privateKey       *ecdsa.PrivateKey
 	address          common.Address
-	core             istanbulCore.Engine
+	core             istanbulCore.Engine // the running consensus engine, either ibftCore or qbftCore
+	ibftCore         istanbulCore.Engine
+	qbftCore         istanbulCore.Engine
 	logger           log.Logger
 	db               ethdb.Database
 	chain            consensus.ChainHeaderReader
@@ -86,19 +106,31 @@ type backend struct {
 	sealMu            sync.Mutex
 	coreStarted       bool
 	coreMu            sync.RWMutex
+	coreLifecycleMu   sync.Mutex // serialises starting, stopping and switching the engine
 
 	// Current list of candidates we are pushing
 	candidates map[common.Address]bool
+	// BLS keys registered with the votes authorizing the candidates
+	blsCandidates map[common.Address]params.BLSKey
 	// Protects the signer fields
 	candidatesLock sync.RWMutex
 	// Snapshots for recent block to speed up reorgs
 	recents *lru.ARCCache
+	// Validators read from the validator contract at recent blocks
+	contractValidatorSets *lru.ARCCache
+	// BLS keys of the validators read from the validator contract at recent blocks
+	contractBLSKeySets *lru.ARCCache
 
 	// event subscription for ChainHeadEvent event
 	broadcaster consensus.Broadcaster
 
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+
+	participation participationIndex // participation of the validators in the epoch of the last indexed block
+
+	blsKey        *bls.SecretKey // key signing the committed seals aggregated under the BLS seal scheme, independent of the private key
+	blsPublicKeys *lru.ARCCache  // registered BLS keys whose proof of possession was checked
 }
 
 // zekun: HACK
@@ -163,7 +195,7 @@ func (sb *backend) Gossip(valSet istanbul.ValidatorSet, payload []byte) error {
 }
 
 // Commit implements istanbul.Backend.Commit
-func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
+func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address, round *big.Int) error {
 	// Check if the proposal is a valid block
 	block, ok := proposal.(*types.Block)
 	if !ok {
@@ -173,7 +205,16 @@ func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
 
 	h := block.Header()
 	// Append seals into extra-data
-	err := writeCommittedSeals(h, seals)
+	var err error
+	if sb.config.UseBLSSealsAt(h.Number) {
+		if seals, err = sb.aggregateCommittedSeals(h, seals, committers, round); err == nil {
+			err = writeBLSCommittedSeals(sb.config, h, seals, round)
+		}
+	} else if sb.config.IsQBFTConsensusAt(h.Number) {
+		err = writeQBFTCommittedSeals(h, seals, round)
+	} else {
+		err = writeCommittedSeals(h, seals)
+	}
 	if err != nil {
 		return err
 	}
@@ -282,11 +323,11 @@ func (sb *backend) ParentValidators(proposal istanbul.Proposal) istanbul.Validat
 }
 
 func (sb *backend) getValidators(number uint64, hash common.Hash) istanbul.ValidatorSet {
-	snap, err := sb.snapshot(sb.chain, number, hash, nil)
+	valSet, err := sb.validatorsAt(sb.chain, number, hash, nil)
 	if err != nil {
 		return validator.NewSet(nil, sb.config.ProposerPolicy)
 	}
-	return snap.ValSet
+	return valSet
 }
 
 func (sb *backend) LastProposal() (istanbul.Proposal, common.Address) {
@@ -306,6 +347,25 @@ func (sb *backend) LastProposal() (istanbul.Proposal, common.Address) {
 	return block, proposer
 }
 
+// rounds returns the rounds recorded by the IBFT core, then by the QBFT core
+// which takes over from it
+func (sb *backend) rounds() []istanbul.RoundInfo {
+	return append(sb.ibftCore.Rounds(), sb.qbftCore.Rounds()...)
+}
+
+// coreFor returns the consensus engine which seals the block with the given number
+func (sb *backend) coreFor(number *big.Int) istanbulCore.Engine {
+	if sb.config.IsQBFTConsensusAt(number) {
+		return sb.qbftCore
+	}
+	return sb.ibftCore
+}
+
+// nextBlockNumber returns the number of the block following the current head
+func (sb *backend) nextBlockNumber() *big.Int {
+	return new(big.Int).Add(sb.currentBlock().Number(), common.Big1)
+}
+
 func (sb *backend) HasBadProposal(hash common.Hash) bool {
 	if sb.hasBadBlock == nil {
 		return false
@@ -313,6 +373,20 @@ func (sb *backend) HasBadProposal(hash common.Hash) bool {
 	return sb.hasBadBlock(hash)
 }
 
+// WriteCoreState implements istanbul.Backend.WriteCoreState
+func (sb *backend) WriteCoreState(name string, state []byte) error {
+	return sb.db.Put([]byte(dbKeyCoreStatePrefix+name), state)
+}
+
+// ReadCoreState implements istanbul.Backend.ReadCoreState
+func (sb *backend) ReadCoreState(name string) ([]byte, error) {
+	key := []byte(dbKeyCoreStatePrefix + name)
+	if has, err := sb.db.Has(key); err != nil || !has {
+		return nil, err
+	}
+	return sb.db.Get(key)
+}
+
 func (sb *backend) Close() error {
 	return nil
 }
@@ -0,0 +1,374 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	inmemoryBLSKeys = 128 // Number of registered BLS keys whose proof of possession was checked
+
+	// DatadirBLSKey is the path within the datadir to the BLS key of the node
+	DatadirBLSKey = "blskey"
+)
+
+var (
+	// errMissingBLSKey is returned if a validator committing with a BLS seal has no registered BLS key.
+	errMissingBLSKey = errors.New("no BLS key registered for the validator")
+	// errInvalidBLSKey is returned if a registered BLS key is malformed or its proof of possession is invalid.
+	errInvalidBLSKey = errors.New("invalid BLS key")
+)
+
+// loadBLSKey loads the BLS key of the node from the given file, generating and
+// storing a new key if there is none yet. Without a file, the key is ephemeral.
+func loadBLSKey(file string) *bls.SecretKey {
+	if file != "" {
+		if key, err := bls.LoadKey(file); err == nil {
+			return key
+		}
+	}
+	key, err := bls.GenerateKey()
+	if err != nil {
+		log.Error("Failed to generate the BLS key", "err", err)
+		return nil
+	}
+	if file == "" {
+		return key
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		log.Error("Failed to persist the BLS key", "err", err)
+		return key
+	}
+	if err := bls.SaveKey(file, key); err != nil {
+		log.Error("Failed to persist the BLS key", "err", err)
+	}
+	return key
+}
+
+// SignCommittedSeal implements istanbul.Backend.SignCommittedSeal
+func (sb *backend) SignCommittedSeal(number *big.Int, seal []byte) ([]byte, error) {
+	if !sb.config.UseBLSSealsAt(number) {
+		return sb.Sign(seal)
+	}
+	if sb.blsKey == nil {
+		return nil, errInvalidBLSKey
+	}
+	return sb.blsKey.Sign(seal).Bytes(), nil
+}
+
+// blsPublicKey returns the BLS public key registered for the validator, once
+// its proof of possession is checked
+func (sb *backend) blsPublicKey(keys map[common.Address]params.BLSKey, addr common.Address) (*bls.PublicKey, error) {
+	key, ok := keys[addr]
+	if !ok {
+		return nil, errMissingBLSKey
+	}
+	id := string(key.PublicKey) + string(key.Proof)
+	if pk, ok := sb.blsPublicKeys.Get(id); ok {
+		return pk.(*bls.PublicKey), nil
+	}
+	pk, err := bls.VerifyRegistration(key.PublicKey, key.Proof)
+	if err != nil {
+		return nil, errInvalidBLSKey
+	}
+	sb.blsPublicKeys.Add(id, pk)
+	return pk, nil
+}
+
+// blsKeys returns the BLS keys of the validators which may seal the given
+// header. Keys registered by the transitions are replaced by those registered
+// through the management of the validator set: read from the validator
+// contract at the parent state once the contract is in force, or voted in with
+// the validators otherwise. Reading the contract requires the parent state,
+// errNoValidatorState being returned without it.
+func (sb *backend) blsKeys(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) (map[common.Address]params.BLSKey, error) {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil, errUnknownBlock
+	}
+	var registered map[common.Address]params.BLSKey
+	if sb.config.UseValidatorContractAt(header.Number) {
+		var parent *types.Header
+		if len(parents) > 0 {
+			parent = parents[len(parents)-1]
+		} else {
+			parent = chain.GetHeader(header.ParentHash, number-1)
+		}
+		if parent == nil || parent.Hash() != header.ParentHash {
+			return nil, errUnknownBlock
+		}
+		if keys, ok := sb.contractBLSKeySets.Get(parent.Hash()); ok {
+			registered = keys.(map[common.Address]params.BLSKey)
+		} else {
+			if !hasState(chain, parent) {
+				return nil, errNoValidatorState
+			}
+			validators, err := sb.contractValidators(chain, parent)
+			if err != nil {
+				return nil, err
+			}
+			if registered, err = sb.contractBLSKeys(chain, parent, validators); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		snap, err := sb.snapshot(chain, number-1, header.ParentHash, parents)
+		if err != nil {
+			return nil, err
+		}
+		registered = snap.BLSKeys
+	}
+
+	config := sb.config.GetConfig(header.Number)
+	keys := make(map[common.Address]params.BLSKey, len(config.BLSKeys)+len(registered))
+	for addr, key := range config.BLSKeys {
+		keys[addr] = key
+	}
+	for addr, key := range registered {
+		keys[addr] = key
+	}
+	return keys, nil
+}
+
+// headerBLSKey returns the BLS key registered with the vote of the header, if
+// any. Keys may only be registered with a vote authorizing a validator.
+func headerBLSKey(config *istanbul.Config, header *types.Header) (*params.BLSKey, error) {
+	var registration *types.BLSKeyRegistration
+	if config.IsQBFTConsensusAt(header.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return nil, err
+		}
+		if qbftExtra.BLSKey != nil && (qbftExtra.Vote == nil || qbftExtra.Vote.VoteType != types.QBFTAuthVote) {
+			return nil, errInvalidVote
+		}
+		registration = qbftExtra.BLSKey
+	} else {
+		istanbulExtra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return nil, err
+		}
+		if istanbulExtra.BLSKey != nil && !bytes.Equal(header.Nonce[:], nonceAuthVote) {
+			return nil, errInvalidVote
+		}
+		registration = istanbulExtra.BLSKey
+	}
+	if registration == nil {
+		return nil, nil
+	}
+	return &params.BLSKey{PublicKey: registration.PublicKey, Proof: registration.Proof}, nil
+}
+
+// sameBLSKey reports whether both BLS keys are the same registration, or both
+// are missing
+func sameBLSKey(a, b *params.BLSKey) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.PublicKey, b.PublicKey) && bytes.Equal(a.Proof, b.Proof)
+}
+
+// aggregateCommittedSeals aggregates the BLS committed seals of the given
+// committers into the committed seals of a header: a bitmap of the committers
+// in the validator set followed by the aggregation of their signatures. The
+// seals which do not verify are left out.
+func (sb *backend) aggregateCommittedSeals(h *types.Header, seals [][]byte, committers []common.Address, round *big.Int) ([][]byte, error) {
+	valSet, err := sb.headerValidatorSet(sb.chain, h, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := sb.blsKeys(sb.chain, h, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := committedSealData(sb.config, h, uint32(round.Uint64()))
+
+	bitmap := make([]byte, (valSet.Size()+7)/8)
+	var signatures []*bls.Signature
+	for i, seal := range seals {
+		index, _ := valSet.GetByAddress(committers[i])
+		if index < 0 || bitmap[index/8]&(1<<uint(index%8)) != 0 {
+			continue
+		}
+		pk, err := sb.blsPublicKey(keys, committers[i])
+		if err != nil {
+			sb.logger.Warn("Committed seal without BLS key", "address", committers[i], "err", err)
+			continue
+		}
+		signature, err := bls.SignatureFromBytes(seal)
+		if err != nil || !signature.Verify(pk, data) {
+			sb.logger.Warn("Invalid BLS committed seal", "address", committers[i])
+			continue
+		}
+		bitmap[index/8] |= 1 << uint(index%8)
+		signatures = append(signatures, signature)
+	}
+	if len(signatures) <= valSet.F() {
+		return nil, errInvalidCommittedSeals
+	}
+	return [][]byte{bitmap, bls.AggregateSignatures(signatures).Bytes()}, nil
+}
+
+// verifyBLSCommittedSeals checks whether the aggregated BLS signature in the
+// committed seals of the header is signed by the given committers. Without the
+// parent state, the keys registered in the validator contract are unknown and
+// the signature is checked by VerifyParentState instead.
+func (sb *backend) verifyBLSCommittedSeals(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header, committers []common.Address) error {
+	seals, round, err := headerCommittedSeals(sb.config, header)
+	if err != nil {
+		return err
+	}
+	keys, err := sb.blsKeys(chain, header, parents)
+	if err == errNoValidatorState {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	pks := make([]*bls.PublicKey, len(committers))
+	for i, addr := range committers {
+		if pks[i], err = sb.blsPublicKey(keys, addr); err != nil {
+			return err
+		}
+	}
+	signature, err := bls.SignatureFromBytes(seals[1])
+	if err != nil || !signature.Verify(bls.AggregatePublicKeys(pks), committedSealData(sb.config, header, round)) {
+		return errInvalidCommittedSeals
+	}
+	return nil
+}
+
+// blsCommitters returns the validators marked in the bitmap of aggregated BLS
+// committed seals
+func blsCommitters(seals [][]byte, valSet istanbul.ValidatorSet) ([]common.Address, error) {
+	if len(seals) != 2 || len(seals[0]) != (valSet.Size()+7)/8 || len(seals[1]) != bls.SignatureLength {
+		return nil, errInvalidCommittedSeals
+	}
+	bitmap := seals[0]
+	var committers []common.Address
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= valSet.Size() {
+			return nil, errInvalidCommittedSeals
+		}
+		committers = append(committers, valSet.GetByIndex(uint64(i)).Address())
+	}
+	return committers, nil
+}
+
+// headerCommittedSeals returns the committed seals of the header, and the round
+// in which it was committed for QBFT blocks
+func headerCommittedSeals(config *istanbul.Config, header *types.Header) ([][]byte, uint32, error) {
+	if config.IsQBFTConsensusAt(header.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return nil, 0, err
+		}
+		return qbftExtra.CommittedSeal, qbftExtra.Round, nil
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, 0, err
+	}
+	return istanbulExtra.CommittedSeal, 0, nil
+}
+
+// committedSealData returns the data signed by the committed seals of the header
+func committedSealData(config *istanbul.Config, header *types.Header, round uint32) []byte {
+	if config.IsQBFTConsensusAt(header.Number) {
+		return qbft.PrepareCommittedSeal(header.Hash(), new(big.Int).SetUint64(uint64(round)))
+	}
+	return istanbulCore.PrepareCommittedSeal(header.Hash())
+}
+
+// writeBLSCommittedSeals writes aggregated BLS committed seals into the
+// extra-data of a block header, along with the round for QBFT blocks
+func writeBLSCommittedSeals(config *istanbul.Config, h *types.Header, committedSeals [][]byte, round *big.Int) error {
+	if config.IsQBFTConsensusAt(h.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(h)
+		if err != nil {
+			return err
+		}
+		qbftExtra.Round = uint32(round.Uint64())
+		qbftExtra.CommittedSeal = committedSeals
+		payload, err := rlp.EncodeToBytes(&qbftExtra)
+		if err != nil {
+			return err
+		}
+		h.Extra = payload
+		return nil
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+	istanbulExtra.CommittedSeal = committedSeals
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
+// writeBLSKeyRegistration writes the BLS key registered with the vote of the
+// header into its extra-data
+func writeBLSKeyRegistration(config *istanbul.Config, h *types.Header, key *params.BLSKey) error {
+	registration := &types.BLSKeyRegistration{PublicKey: key.PublicKey, Proof: key.Proof}
+	if config.IsQBFTConsensusAt(h.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(h)
+		if err != nil {
+			return err
+		}
+		qbftExtra.BLSKey = registration
+		payload, err := rlp.EncodeToBytes(&qbftExtra)
+		if err != nil {
+			return err
+		}
+		h.Extra = payload
+		return nil
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+	istanbulExtra.BLSKey = registration
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func newBLSKey(t *testing.T) (*bls.SecretKey, params.BLSKey) {
+	sk, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk, params.BLSKey{PublicKey: sk.PublicKey().Bytes(), Proof: sk.ProvePossession().Bytes()}
+}
+
+func TestBLSCommittedSeals(t *testing.T) {
+	for _, qbftBlock := range []*big.Int{nil, big.NewInt(1)} {
+		genesis, keys := getGenesisAndKeys(1)
+		sk, key := newBLSKey(t)
+		config := *istanbul.DefaultConfig
+		config.QbftBlock = qbftBlock
+		config.Transitions = []params.Transition{{
+			Block:               big.NewInt(1),
+			CommittedSealScheme: params.BLSSealScheme,
+			BLSKeys:             map[common.Address]params.BLSKey{crypto.PubkeyToAddress(keys[0].PublicKey): key},
+		}}
+		chain, engine := newBlockChainFromGenesis(genesis, keys, &config)
+		engine.blsKey = sk
+		api := &API{chain: chain, istanbul: engine}
+		if key, err := api.BLSKey(); err != nil || !reflect.DeepEqual(*key, config.Transitions[0].BLSKeys[engine.Address()]) {
+			t.Errorf("qbft %v: BLS key mismatch: have %v %v", qbftBlock, key, err)
+		}
+
+		// the committed seal of the validator is aggregated into a single signature
+		block := makeBlock(chain, engine, chain.Genesis())
+		seals, _, err := headerCommittedSeals(engine.config, block.Header())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(seals) != 2 || len(seals[0]) != 1 || seals[0][0] != 1 || len(seals[1]) != bls.SignatureLength {
+			t.Fatalf("qbft %v: committed seals mismatch: have %x", qbftBlock, seals)
+		}
+		if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("qbft %v: failed to insert the block: %v", qbftBlock, err)
+		}
+		number := rpc.BlockNumber(1)
+		signers, err := api.GetSignersFromBlock(&number)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(signers.Committers) != 1 || signers.Committers[0] != engine.Address() {
+			t.Errorf("qbft %v: committers mismatch: have %v, want [%v]", qbftBlock, signers.Committers, engine.Address())
+		}
+
+		// a header whose bitmap leaves out the committer does not verify
+		header := block.Header()
+		if err := writeBLSCommittedSeals(engine.config, header, [][]byte{{0}, seals[1]}, big.NewInt(0)); err != nil {
+			t.Fatal(err)
+		}
+		if err := engine.VerifyHeader(chain, header, false); err != errEmptyCommittedSeals {
+			t.Errorf("qbft %v: error mismatch: have %v, want %v", qbftBlock, err, errEmptyCommittedSeals)
+		}
+		// nor does a header whose aggregated seal is signed by another key
+		other, _ := newBLSKey(t)
+		forged := other.Sign(committedSealData(engine.config, header, 0)).Bytes()
+		if err := writeBLSCommittedSeals(engine.config, header, [][]byte{seals[0], forged}, big.NewInt(0)); err != nil {
+			t.Fatal(err)
+		}
+		if err := engine.VerifyHeader(chain, header, false); err != errInvalidCommittedSeals {
+			t.Errorf("qbft %v: error mismatch: have %v, want %v", qbftBlock, err, errInvalidCommittedSeals)
+		}
+		// nor does a header without committed seals
+		unsealed := makeBlockWithoutSeal(chain, engine, chain.Genesis())
+		if err := engine.verifyCommittedSeals(chain, unsealed.Header(), nil); err != errEmptyCommittedSeals {
+			t.Errorf("qbft %v: error mismatch: have %v, want %v", qbftBlock, err, errEmptyCommittedSeals)
+		}
+	}
+}
+
+func TestBLSPublicKey(t *testing.T) {
+	_, engine := newBlockChain(1)
+	_, key := newBLSKey(t)
+	_, other := newBLSKey(t)
+	validator := common.HexToAddress("0x1234")
+
+	if _, err := engine.blsPublicKey(nil, validator); err != errMissingBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errMissingBLSKey)
+	}
+	// the proof of possession of another key is rejected
+	keys := map[common.Address]params.BLSKey{validator: {PublicKey: key.PublicKey, Proof: other.Proof}}
+	if _, err := engine.blsPublicKey(keys, validator); err != errInvalidBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidBLSKey)
+	}
+	keys[validator] = key
+	pk, err := engine.blsPublicKey(keys, validator)
+	if err != nil {
+		t.Fatalf("failed to get the BLS public key: %v", err)
+	}
+	if string(pk.Bytes()) != string(key.PublicKey) {
+		t.Errorf("public key mismatch: have %x, want %x", pk.Bytes(), key.PublicKey)
+	}
+}
+
+func TestBLSKeyVote(t *testing.T) {
+	for _, qbftBlock := range []*big.Int{nil, big.NewInt(1)} {
+		// the committed seals are aggregated from block 2 on, the key of the
+		// validator being voted in with block 1
+		config := *istanbul.DefaultConfig
+		config.QbftBlock = qbftBlock
+		config.Transitions = []params.Transition{{Block: big.NewInt(2), CommittedSealScheme: params.BLSSealScheme}}
+		chain, engine := newBlockChainWithConfig(1, &config)
+		sk, key := newBLSKey(t)
+		engine.blsKey = sk
+
+		api := &API{chain: chain, istanbul: engine}
+		_, other := newBLSKey(t)
+		if err := api.ProposeBLSKey(engine.Address(), params.BLSKey{PublicKey: key.PublicKey, Proof: other.Proof}); err == nil {
+			t.Errorf("qbft %v: BLS key without proof of possession proposed", qbftBlock)
+		}
+		if err := api.ProposeBLSKey(engine.Address(), key); err != nil {
+			t.Fatalf("qbft %v: failed to propose the BLS key: %v", qbftBlock, err)
+		}
+
+		// the validator is authorized again along with its key
+		block := makeBlock(chain, engine, chain.Genesis())
+		registered, err := headerBLSKey(engine.config, block.Header())
+		if err != nil || registered == nil || !reflect.DeepEqual(*registered, key) {
+			t.Fatalf("qbft %v: registered BLS key mismatch: have %v %v", qbftBlock, registered, err)
+		}
+		if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("qbft %v: failed to insert the block: %v", qbftBlock, err)
+		}
+		snap, err := engine.snapshot(chain, 1, block.Hash(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(snap.BLSKeys, map[common.Address]params.BLSKey{engine.Address(): key}) {
+			t.Errorf("qbft %v: snapshot BLS keys mismatch: have %v", qbftBlock, snap.BLSKeys)
+		}
+
+		// the key is registered, so the vote is no longer cast
+		block = makeBlock(chain, engine, block)
+		if registered, _ := headerBLSKey(engine.config, block.Header()); registered != nil {
+			t.Errorf("qbft %v: BLS key registered again", qbftBlock)
+		}
+		if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("qbft %v: failed to insert the BLS sealed block: %v", qbftBlock, err)
+		}
+		signers, err := engine.Signers(block.Header())
+		if err != nil || len(signers) != 1 || signers[0] != engine.Address() {
+			t.Errorf("qbft %v: signers mismatch: have %v %v", qbftBlock, signers, err)
+		}
+	}
+}
+
+func TestSnapshotBLSKeyVotes(t *testing.T) {
+	validators := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")}
+	candidate := common.HexToAddress("0x4")
+	snap := newSnapshot(30000, 0, common.Hash{}, validator.NewSet(validators, istanbul.RoundRobin))
+	_, key := newBLSKey(t)
+	_, other := newBLSKey(t)
+
+	// validators are only authorized again with a key to register
+	if snap.checkVote(validators[0], true, nil) {
+		t.Errorf("validator authorized again without a BLS key")
+	}
+	if !snap.checkVote(validators[0], true, &key) {
+		t.Errorf("validator not authorized again with a BLS key")
+	}
+	snap.BLSKeys[validators[0]] = key
+	if snap.checkVote(validators[0], true, &key) {
+		t.Errorf("validator authorized again with its registered BLS key")
+	}
+
+	// votes registering another key do not count towards the tally
+	if !snap.cast(candidate, true, &key) {
+		t.Fatalf("vote not cast")
+	}
+	if snap.cast(candidate, true, &other) || snap.cast(candidate, true, nil) {
+		t.Errorf("vote with another BLS key cast")
+	}
+	if snap.uncast(candidate, true, &other) {
+		t.Errorf("vote with another BLS key uncast")
+	}
+	if !snap.cast(candidate, true, &key) || snap.Tally[candidate].Votes != 2 {
+		t.Errorf("tally mismatch: have %+v, want 2 votes", snap.Tally[candidate])
+	}
+
+	// the registered keys survive a round trip through the database
+	blob, err := snap.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(Snapshot)
+	if err := decoded.UnmarshalJSON(blob); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.BLSKeys, snap.BLSKeys) || !reflect.DeepEqual(decoded.Tally, snap.Tally) {
+		t.Errorf("decoded snapshot mismatch: have %v %v, want %v %v", decoded.BLSKeys, decoded.Tally, snap.BLSKeys, snap.Tally)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
@@ -99,15 +100,41 @@ var (
 
 // Author retrieves the Ethereum address of the account that minted the given
 // block, which may be different from the header's coinbase if a consensus
-// engine is based on signatures.
+// engine is based on signatures. The author of a QBFT block is its coinbase.
 func (sb *backend) Author(header *types.Header) (common.Address, error) {
+	if sb.config.IsQBFTConsensusAt(header.Number) {
+		return header.Coinbase, nil
+	}
 	return ecrecover(header)
 }
 
 // Signers extracts all the addresses who have signed the given header
 // It will extract for each seal who signed it, regardless of if the seal is
-// repeated
+// repeated. The signers of aggregated BLS committed seals are the validators
+// marked in their bitmap, whose signature is checked by verifyCommittedSeals.
 func (sb *backend) Signers(header *types.Header) ([]common.Address, error) {
+	return sb.signers(sb.chain, header, nil)
+}
+
+// signers implements Signers, looking up the validators marked in aggregated
+// BLS committed seals in the given chain. The caller may pass in a batch of
+// parents to avoid looking those up from the database.
+func (sb *backend) signers(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) ([]common.Address, error) {
+	if sb.config.UseBLSSealsAt(header.Number) {
+		seals, _, err := headerCommittedSeals(sb.config, header)
+		if err != nil || len(seals) == 0 {
+			return nil, err
+		}
+		valSet, err := sb.headerValidatorSet(chain, header, parents)
+		if err != nil {
+			return nil, err
+		}
+		return blsCommitters(seals, valSet)
+	}
+	if sb.config.IsQBFTConsensusAt(header.Number) {
+		return qbftSigners(header)
+	}
+
 	extra, err := types.ExtractIstanbulExtra(header)
 	if err != nil {
 		return []common.Address{}, err
@@ -151,14 +178,35 @@ func (sb *backend) verifyHeader(chain consensus.ChainHeaderReader, header *types
 		return consensus.ErrFutureBlock
 	}
 
-	// Ensure that the extra data format is satisfied
-	if _, err := types.ExtractIstanbulExtra(header); err != nil {
-		return errInvalidExtraDataFormat
-	}
+	if sb.config.IsQBFTConsensusAt(header.Number) {
+		// Ensure that the extra data format is satisfied
+		qbftExtra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return errInvalidExtraDataFormat
+		}
+		if qbftExtra.Vote != nil && qbftExtra.Vote.VoteType != types.QBFTAuthVote && qbftExtra.Vote.VoteType != types.QBFTDropVote {
+			return errInvalidVote
+		}
+		// Votes are cast in the extra data, so the nonce is unused
+		if header.Nonce != (emptyNonce) {
+			return errInvalidNonce
+		}
+		if qbftExtra.Vote != nil && sb.config.UseValidatorContractAt(header.Number) {
+			return errInvalidVote
+		}
+	} else {
+		// Ensure that the extra data format is satisfied
+		if _, err := types.ExtractIstanbulExtra(header); err != nil {
+			return errInvalidExtraDataFormat
+		}
 
-	// Ensure that the coinbase is valid
-	if header.Nonce != (emptyNonce) && !bytes.Equal(header.Nonce[:], nonceAuthVote) && !bytes.Equal(header.Nonce[:], nonceDropVote) {
-		return errInvalidNonce
+		// Ensure that the coinbase is valid
+		if header.Nonce != (emptyNonce) && !bytes.Equal(header.Nonce[:], nonceAuthVote) && !bytes.Equal(header.Nonce[:], nonceDropVote) {
+			return errInvalidNonce
+		}
+		if (header.Nonce != (emptyNonce) || header.Coinbase != (common.Address{})) && sb.config.UseValidatorContractAt(header.Number) {
+			return errInvalidVote
+		}
 	}
 	// Ensure that the mix digest is zero as we don't have fork protection currently
 	if header.MixDigest != types.IstanbulDigest {
@@ -196,7 +244,7 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainHeaderReader, head
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
-	if parent.Time+sb.config.BlockPeriod > header.Time {
+	if parent.Time+sb.config.GetConfig(header.Number).BlockPeriod > header.Time {
 		return errInvalidTimestamp
 	}
 	// Verify validators in extraData. Validators in snapshot and extraData should be the same.
@@ -255,6 +303,25 @@ func (sb *backend) VerifyUncles(chain consensus.ChainReader, block *types.Block)
 	return nil
 }
 
+// VerifyParentState implements consensus.ParentStateVerifier, checking the
+// validators in the extra-data of the block against the validator contract
+// once it is in force. The header may have been verified against those
+// validators when the parent state was not available.
+func (sb *backend) VerifyParentState(chain consensus.ChainReader, block *types.Block) error {
+	if !sb.config.UseValidatorContractAt(block.Number()) {
+		return nil
+	}
+	if err := sb.verifyContractValidators(chain, block.Header()); err != nil {
+		return err
+	}
+	// the aggregated BLS committed seals are signed by the keys registered
+	// in the validator contract, unknown without the parent state
+	if sb.config.UseBLSSealsAt(block.Number()) {
+		return sb.verifyCommittedSeals(chain, block.Header(), nil)
+	}
+	return nil
+}
+
 // verifySigner checks whether the signer is in parent's validator set
 func (sb *backend) verifySigner(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) error {
 	// Verifying the genesis block is not supported
@@ -263,20 +330,20 @@ func (sb *backend) verifySigner(chain consensus.ChainHeaderReader, header *types
 		return errUnknownBlock
 	}
 
-	// Retrieve the snapshot needed to verify this header and cache it
-	snap, err := sb.snapshot(chain, number-1, header.ParentHash, parents)
+	// Retrieve the validators needed to verify this header
+	valSet, err := sb.headerValidatorSet(chain, header, parents)
 	if err != nil {
 		return err
 	}
 
 	// resolve the authorization key and check against signers
-	signer, err := ecrecover(header)
+	signer, err := sb.Author(header)
 	if err != nil {
 		return err
 	}
 
 	// Signer should be in the validator set of previous block's extraData.
-	if _, v := snap.ValSet.GetByAddress(signer); v == nil {
+	if _, v := valSet.GetByAddress(signer); v == nil {
 		return errUnauthorized
 	}
 	return nil
@@ -290,28 +357,24 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainHeaderReader, heade
 		return nil
 	}
 
-	// Retrieve the snapshot needed to verify this header and cache it
-	snap, err := sb.snapshot(chain, number-1, header.ParentHash, parents)
+	// Retrieve the validators needed to verify this header
+	valSet, err := sb.headerValidatorSet(chain, header, parents)
 	if err != nil {
 		return err
 	}
 
-	extra, err := types.ExtractIstanbulExtra(header)
+	committers, err := sb.signers(chain, header, parents)
 	if err != nil {
 		return err
 	}
 	// The length of Committed seals should be larger than 0
-	if len(extra.CommittedSeal) == 0 {
+	if len(committers) == 0 {
 		return errEmptyCommittedSeals
 	}
 
-	validators := snap.ValSet.Copy()
+	validators := valSet.Copy()
 	// Check whether the committed seals are generated by parent's validators
 	validSeal := 0
-	committers, err := sb.Signers(header)
-	if err != nil {
-		return err
-	}
 	for _, addr := range committers {
 		if validators.RemoveValidator(addr) {
 			validSeal++
@@ -321,10 +384,15 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainHeaderReader, heade
 	}
 
 	// The length of validSeal should be larger than number of faulty node + 1
-	if validSeal <= snap.ValSet.F() {
+	if validSeal <= valSet.F() {
 		return errInvalidCommittedSeals
 	}
 
+	// The committers of aggregated BLS committed seals are only marked, check
+	// that they signed them
+	if sb.config.UseBLSSealsAt(header.Number) {
+		return sb.verifyBLSCommittedSeals(chain, header, parents, committers)
+	}
 	return nil
 }
 
@@ -351,6 +419,7 @@ func (sb *backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 	header.Coinbase = common.Address{}
 	header.Nonce = emptyNonce
 	header.MixDigest = types.IstanbulDigest
+	isQBFT := sb.config.IsQBFTConsensusAt(header.Number)
 
 	// copy the parent extra data as the header extra data
 	number := header.Number.Uint64()
@@ -361,45 +430,87 @@ func (sb *backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 	// use the same difficulty for all blocks
 	header.Difficulty = defaultDifficulty
 
-	// Assemble the voting snapshot
-	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
+	// Assemble the validators of the block
+	valSet, err := sb.validatorsAt(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
+	validators := validatorAddresses(valSet)
 
-	// get valid candidate list
-	sb.candidatesLock.RLock()
+	// get valid candidate list, unless the validator contract is in force
 	var addresses []common.Address
 	var authorizes []bool
-	for address, authorize := range sb.candidates {
-		if snap.checkVote(address, authorize) {
-			addresses = append(addresses, address)
-			authorizes = append(authorizes, authorize)
+	var blsKeys []*params.BLSKey
+	if !sb.config.UseValidatorContractAt(header.Number) {
+		snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
+		if err != nil {
+			return err
 		}
+		sb.candidatesLock.RLock()
+		for address, authorize := range sb.candidates {
+			var blsKey *params.BLSKey
+			if key, ok := sb.blsCandidates[address]; ok && authorize {
+				blsKey = &key
+			}
+			if snap.checkVote(address, authorize, blsKey) {
+				addresses = append(addresses, address)
+				authorizes = append(authorizes, authorize)
+				blsKeys = append(blsKeys, blsKey)
+			}
+		}
+		sb.candidatesLock.RUnlock()
 	}
-	sb.candidatesLock.RUnlock()
 
-	// pick one of the candidates randomly
-	if len(addresses) > 0 {
-		index := rand.Intn(len(addresses))
-		// add validator voting in coinbase
-		header.Coinbase = addresses[index]
-		if authorizes[index] {
-			copy(header.Nonce[:], nonceAuthVote)
-		} else {
-			copy(header.Nonce[:], nonceDropVote)
+	// the BLS key registered with the vote, if any
+	var blsKey *params.BLSKey
+
+	// QBFT blocks name their proposer in the coinbase and cast votes in the extra data
+	if isQBFT {
+		var vote *types.ValidatorVote
+		if len(addresses) > 0 {
+			index := rand.Intn(len(addresses))
+			vote = &types.ValidatorVote{RecipientAddress: addresses[index], VoteType: types.QBFTDropVote}
+			if authorizes[index] {
+				vote.VoteType = types.QBFTAuthVote
+			}
+			blsKey = blsKeys[index]
 		}
-	}
+		header.Coinbase = sb.address
 
-	// add validators in snapshot to extraData's validators section
-	extra, err := prepareExtra(header, snap.validators())
-	if err != nil {
-		return err
+		extra, err := prepareQBFTExtra(header, validators, vote)
+		if err != nil {
+			return err
+		}
+		header.Extra = extra
+	} else {
+		// pick one of the candidates randomly
+		if len(addresses) > 0 {
+			index := rand.Intn(len(addresses))
+			// add validator voting in coinbase
+			header.Coinbase = addresses[index]
+			if authorizes[index] {
+				copy(header.Nonce[:], nonceAuthVote)
+			} else {
+				copy(header.Nonce[:], nonceDropVote)
+			}
+			blsKey = blsKeys[index]
+		}
+
+		// add validators to extraData's validators section
+		extra, err := prepareExtra(header, validators)
+		if err != nil {
+			return err
+		}
+		header.Extra = extra
+	}
+	if blsKey != nil {
+		if err := writeBLSKeyRegistration(sb.config, header, blsKey); err != nil {
+			return err
+		}
 	}
-	header.Extra = extra
 
 	// set header's timestamp
-	header.Time = parent.Time + sb.config.BlockPeriod
+	header.Time = parent.Time + sb.config.GetConfig(header.Number).BlockPeriod
 	if header.Time < uint64(time.Now().Unix()) {
 		header.Time = uint64(time.Now().Unix())
 	}
@@ -412,16 +523,34 @@ func (sb *backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 // Note, the block header and state database might be updated to reflect any
 // consensus rules that happen at finalization (e.g. block rewards).
 func (sb *backend) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-	uncles []*types.Header) {
-	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
+	uncles []*types.Header) error {
+	// Reward the proposer of the block if configured to, uncles are dropped
+	if reward, _ := sb.config.BlockRewardAt(header.Number); reward != nil {
+		proposer, err := sb.Author(header)
+		if err != nil {
+			return err
+		}
+		sb.accumulateRewards(state, header, proposer)
+	}
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
+	return nil
 }
 
 // FinalizeAndAssemble implements consensus.Engine, ensuring no uncles are set,
-// nor block rewards given, and returns the final block.
+// rewarding the block if configured to, and returns the final block.
 func (sb *backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
-	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
+	// Hold back empty blocks for the empty block period. If transactions arrive
+	// in the meantime, the miner replaces the block before it is proposed.
+	if len(txs) == 0 {
+		config := sb.config.GetConfig(header.Number)
+		parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+		if parent != nil && config.EmptyBlockPeriod > config.BlockPeriod && header.Time < parent.Time+config.EmptyBlockPeriod {
+			header.Time = parent.Time + config.EmptyBlockPeriod
+		}
+	}
+	// The block is yet to be sealed, so we are its proposer. Uncles are dropped
+	sb.accumulateRewards(state, header, sb.address)
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
 
@@ -429,6 +558,19 @@ func (sb *backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header
 	return types.NewBlock(header, txs, nil, receipts, new(trie.Trie)), nil
 }
 
+// accumulateRewards credits the block reward, if any, to the configured
+// beneficiary or else to the proposer of the block.
+func (sb *backend) accumulateRewards(state *state.StateDB, header *types.Header, proposer common.Address) {
+	reward, beneficiary := sb.config.BlockRewardAt(header.Number)
+	if reward == nil {
+		return
+	}
+	if beneficiary == (common.Address{}) {
+		beneficiary = proposer
+	}
+	state.AddBalance(beneficiary, reward)
+}
+
 // Seal generates a new block for the given input block with the local miner's
 // seal place on top.
 func (sb *backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
@@ -437,11 +579,11 @@ func (sb *backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 	header := block.Header()
 	number := header.Number.Uint64()
 	// Bail out if we're unauthorized to sign a block
-	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
+	valSet, err := sb.validatorsAt(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
-	if _, v := snap.ValSet.GetByAddress(sb.address); v == nil {
+	if _, v := valSet.GetByAddress(sb.address); v == nil {
 		return errUnauthorized
 	}
 
@@ -449,9 +591,12 @@ func (sb *backend) Seal(chain consensus.ChainHeaderReader, block *types.Block, r
 	if parent == nil {
 		return consensus.ErrUnknownAncestor
 	}
-	block, err = sb.updateBlock(parent, block)
-	if err != nil {
-		return err
+	// QBFT blocks carry no proposer seal
+	if !sb.config.IsQBFTConsensusAt(header.Number) {
+		block, err = sb.updateBlock(parent, block)
+		if err != nil {
+			return err
+		}
 	}
 
 	delay := time.Unix(int64(block.Header().Time), 0).Sub(now())
@@ -524,6 +669,8 @@ func (sb *backend) APIs(chain consensus.ChainHeaderReader) []rpc.API {
 
 // Start implements consensus.Istanbul.Start
 func (sb *backend) Start(chain consensus.ChainHeaderReader, currentBlock func() *types.Block, hasBadBlock func(hash common.Hash) bool) error {
+	sb.coreLifecycleMu.Lock()
+	defer sb.coreLifecycleMu.Unlock()
 	sb.coreMu.Lock()
 	defer sb.coreMu.Unlock()
 	if sb.coreStarted {
@@ -541,6 +688,7 @@ func (sb *backend) Start(chain consensus.ChainHeaderReader, currentBlock func()
 	sb.currentBlock = currentBlock
 	sb.hasBadBlock = hasBadBlock
 
+	sb.core = sb.coreFor(sb.nextBlockNumber())
 	if err := sb.core.Start(); err != nil {
 		return err
 	}
@@ -551,6 +699,8 @@ func (sb *backend) Start(chain consensus.ChainHeaderReader, currentBlock func()
 
 // Stop implements consensus.Istanbul.Stop
 func (sb *backend) Stop() error {
+	sb.coreLifecycleMu.Lock()
+	defer sb.coreLifecycleMu.Unlock()
 	sb.coreMu.Lock()
 	defer sb.coreMu.Unlock()
 	if !sb.coreStarted {
@@ -578,7 +728,7 @@ func (sb *backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 		}
 		// If an on-disk checkpoint snapshot can be found, use that
 		if number%checkpointInterval == 0 {
-			if s, err := loadSnapshot(sb.config.Epoch, sb.db, hash); err == nil {
+			if s, err := loadSnapshot(sb.config.GetConfig(new(big.Int).SetUint64(number)).Epoch, sb.db, hash); err == nil {
 				log.Trace("Loaded voting snapshot form disk", "number", number, "hash", hash)
 				snap = s
 				break
@@ -590,11 +740,11 @@ func (sb *backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 			if err := sb.VerifyHeader(chain, genesis, false); err != nil {
 				return nil, err
 			}
-			istanbulExtra, err := types.ExtractIstanbulExtra(genesis)
+			validators, err := headerValidators(sb.config, genesis)
 			if err != nil {
 				return nil, err
 			}
-			snap = newSnapshot(sb.config.Epoch, 0, genesis.Hash(), validator.NewSet(istanbulExtra.Validators, sb.config.ProposerPolicy))
+			snap = newSnapshot(sb.config.GetConfig(common.Big0).Epoch, 0, genesis.Hash(), validator.NewSet(validators, sb.config.ProposerPolicy))
 			if err := snap.store(sb.db); err != nil {
 				return nil, err
 			}
@@ -624,7 +774,7 @@ func (sb *backend) snapshot(chain consensus.ChainHeaderReader, number uint64, ha
 	for i := 0; i < len(headers)/2; i++ {
 		headers[i], headers[len(headers)-1-i] = headers[len(headers)-1-i], headers[i]
 	}
-	snap, err := snap.apply(headers)
+	snap, err := snap.apply(headers, sb.config)
 	if err != nil {
 		return nil, err
 	}
@@ -659,6 +809,10 @@ func sigHash(header *types.Header) (hash common.Hash) {
 
 // SealHash returns the hash of a block prior to it being sealed.
 func (sb *backend) SealHash(header *types.Header) common.Hash {
+	if sb.config.IsQBFTConsensusAt(header.Number) {
+		// QBFT blocks are hashed without their seals
+		return header.Hash()
+	}
 	return sigHash(header)
 }
 
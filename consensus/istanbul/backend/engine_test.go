@@ -41,9 +41,16 @@ import (
 // block by one node. Otherwise, if n is larger than 1, we have to generate
 // other fake events to process Istanbul.
 func newBlockChain(n int) (*core.BlockChain, *backend) {
+	return newBlockChainWithConfig(n, istanbul.DefaultConfig)
+}
+
+func newBlockChainWithConfig(n int, config *istanbul.Config) (*core.BlockChain, *backend) {
 	genesis, nodeKeys := getGenesisAndKeys(n)
+	return newBlockChainFromGenesis(genesis, nodeKeys, config)
+}
+
+func newBlockChainFromGenesis(genesis *core.Genesis, nodeKeys []*ecdsa.PrivateKey, config *istanbul.Config) (*core.BlockChain, *backend) {
 	memDB := rawdb.NewMemoryDatabase()
-	config := istanbul.DefaultConfig
 	// Use the first key as private key
 	b, _ := New(config, nodeKeys[0], memDB).(*backend)
 	genesis.MustCommit(memDB)
@@ -52,14 +59,11 @@ func newBlockChain(n int) (*core.BlockChain, *backend) {
 		panic(err)
 	}
 	b.Start(blockchain, blockchain.CurrentBlock, blockchain.HasBadBlock)
-	snap, err := b.snapshot(blockchain, 0, common.Hash{}, nil)
+	valSet, err := b.validatorsAt(blockchain, 0, blockchain.Genesis().Hash(), nil)
 	if err != nil {
 		panic(err)
 	}
-	if snap == nil {
-		panic("failed to get snapshot")
-	}
-	proposerAddr := snap.ValSet.GetProposer().Address()
+	proposerAddr := valSet.GetProposer().Address()
 
 	// find proposer key
 	for _, key := range nodeKeys {
@@ -131,7 +135,10 @@ func makeHeader(parent *types.Block, config *istanbul.Config) *types.Header {
 }
 
 func makeBlock(chain *core.BlockChain, engine *backend, parent *types.Block) *types.Block {
-	block := makeBlockWithoutSeal(chain, engine, parent)
+	return sealBlock(chain, engine, makeBlockWithoutSeal(chain, engine, parent))
+}
+
+func sealBlock(chain *core.BlockChain, engine *backend, block *types.Block) *types.Block {
 	stopCh := make(chan struct{})
 	resultCh := make(chan *types.Block, 10)
 	go engine.Seal(chain, block, resultCh, stopCh)
@@ -205,7 +212,7 @@ func TestSealCommittedOtherHash(t *testing.T) {
 		if _, ok := ev.Data.(istanbul.RequestEvent); !ok {
 			t.Errorf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
 		}
-		if err := engine.Commit(otherBlock, [][]byte{expectedCommittedSeal}); err != nil {
+		if err := engine.Commit(otherBlock, [][]byte{expectedCommittedSeal}, []common.Address{engine.Address()}, big.NewInt(0)); err != nil {
 			t.Error(err.Error())
 		}
 		eventSub.Unsubscribe()
@@ -577,3 +584,236 @@ func TestWriteCommittedSeals(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
 	}
 }
+
+func TestQBFTSealAndVerify(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.QbftBlock = big.NewInt(1)
+	chain, engine := newBlockChainWithConfig(1, &config)
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	header := block.Header()
+	if _, err := types.ExtractQBFTExtra(header); err != nil {
+		t.Fatalf("failed to extract the QBFT extra-data: %v", err)
+	}
+	if author, err := engine.Author(header); err != nil || author != engine.Address() {
+		t.Errorf("author mismatch: have %v %v, want %v", author, err, engine.Address())
+	}
+	if signers, err := engine.Signers(header); err != nil || len(signers) != 1 || signers[0] != engine.Address() {
+		t.Errorf("signers mismatch: have %v %v, want [%v]", signers, err, engine.Address())
+	}
+	if err := engine.VerifyHeader(chain, header, false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert the QBFT block: %v", err)
+	}
+
+	// a block which nobody committed to is rejected
+	unsealed := makeBlockWithoutSeal(chain, engine, chain.Genesis())
+	if err := engine.VerifyHeader(chain, unsealed.Header(), false); err != errEmptyCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, errEmptyCommittedSeals)
+	}
+}
+
+func TestVerifyHeaderBlockPeriodTransition(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.Transitions = []params.Transition{{Block: big.NewInt(1), BlockPeriod: 10}}
+	chain, engine := newBlockChainWithConfig(1, &config)
+	genesis := chain.Genesis()
+
+	block := makeBlockWithoutSeal(chain, engine, genesis)
+	for _, test := range []struct {
+		period uint64
+		err    error
+	}{
+		{5, errInvalidTimestamp},
+		{10, errEmptyCommittedSeals},
+	} {
+		header := block.Header()
+		header.Time = genesis.Time() + test.period
+		proposal, _ := engine.updateBlock(genesis.Header(), block.WithSeal(header))
+		if err := engine.VerifyHeader(chain, proposal.Header(), false); err != test.err {
+			t.Errorf("period %d: error mismatch: have %v, want %v", test.period, err, test.err)
+		}
+	}
+}
+
+func TestValidatorsProposerPolicyTransition(t *testing.T) {
+	sticky := uint64(istanbul.Sticky)
+	config := *istanbul.DefaultConfig
+	config.Transitions = []params.Transition{{Block: big.NewInt(1), ProposerPolicy: &sticky}}
+	chain, engine := newBlockChainWithConfig(4, &config)
+
+	// the genesis snapshot was taken with the round robin policy
+	snap, err := engine.snapshot(chain, 0, chain.Genesis().Hash(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.ValSet.Policy() != istanbul.RoundRobin {
+		t.Errorf("policy mismatch: have %v, want %v", snap.ValSet.Policy(), istanbul.RoundRobin)
+	}
+	if policy := engine.Validators(chain.Genesis()).Policy(); policy != istanbul.Sticky {
+		t.Errorf("policy mismatch: have %v, want %v", policy, istanbul.Sticky)
+	}
+}
+
+func TestBlockReward(t *testing.T) {
+	reward := big.NewInt(params.Ether)
+	beneficiary := common.HexToAddress("0x1234")
+	for _, qbftBlock := range []*big.Int{nil, big.NewInt(1)} {
+		for _, fixed := range []bool{false, true} {
+			config := *istanbul.DefaultConfig
+			config.QbftBlock = qbftBlock
+			config.BlockReward = reward
+			if fixed {
+				config.BlockRewardBeneficiary = beneficiary
+			}
+			chain, engine := newBlockChainWithConfig(1, &config)
+
+			// the reward is credited when the block is assembled for sealing,
+			// and again when the block is imported, ending up with the same state
+			block := makeBlock(chain, engine, chain.Genesis())
+			if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+				t.Fatalf("qbft %v, fixed %v: failed to insert the block: %v", qbftBlock, fixed, err)
+			}
+
+			statedb, _, err := chain.State()
+			if err != nil {
+				t.Fatal(err)
+			}
+			rewarded := engine.Address()
+			if fixed {
+				rewarded = beneficiary
+			}
+			if balance := statedb.GetBalance(rewarded); balance.Cmp(reward) != 0 {
+				t.Errorf("qbft %v, fixed %v: balance mismatch: have %v, want %v", qbftBlock, fixed, balance, reward)
+			}
+		}
+	}
+}
+
+func TestFinalizeUnknownProposer(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.BlockReward = big.NewInt(params.Ether)
+	chain, engine := newBlockChainWithConfig(1, &config)
+
+	// the block is not sealed, so its proposer is unknown
+	block := makeBlockWithoutSeal(chain, engine, chain.Genesis())
+	statedb, _, err := chain.State()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := engine.Finalize(chain, block.Header(), statedb, nil, nil); err == nil {
+		t.Error("expected the unknown proposer to fail finalizing the block")
+	}
+}
+
+func TestBlockRewardActivation(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.BlockReward = big.NewInt(params.Ether)
+	config.BlockRewardBlock = big.NewInt(2)
+	chain, engine := newBlockChainWithConfig(1, &config)
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatal(err)
+	}
+	if block.Root() != chain.Genesis().Root() {
+		t.Errorf("expected no reward before the activation block")
+	}
+}
+
+func TestStatusRoundTimeout(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.Transitions = []params.Transition{
+		{Block: big.NewInt(2), RoundTimeoutStrategy: params.LinearTimeout, MaxRequestTimeout: 35000},
+	}
+	chain, engine := newBlockChainWithConfig(1, &config)
+	defer engine.Stop()
+	api := &API{chain: chain, istanbul: engine}
+
+	status, err := api.Status(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &RoundTimeoutStatus{
+		Strategy:       params.ExponentialTimeout,
+		RequestTimeout: 10000,
+	}
+	if !reflect.DeepEqual(status.RoundTimeout, want) {
+		t.Errorf("round timeout mismatch: have %+v, want %+v", status.RoundTimeout, want)
+	}
+	// the core is running the first round of block 1
+	if len(status.Rounds) != 1 {
+		t.Fatalf("the number of rounds mismatch: have %d, want 1", len(status.Rounds))
+	}
+	if round := status.Rounds[0]; round.Sequence != 1 || round.Round != 0 || round.Start.IsZero() || round.End != nil || round.Timeout != 10000 || round.TimedOut {
+		t.Errorf("round mismatch: have %+v, want the running round 0 of block 1 with a timeout of 10000", round)
+	}
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatal(err)
+	}
+	engine.NewChainHead()
+
+	// the round of block 1 ends as the core moves to block 2
+	deadline := time.Now().Add(5 * time.Second)
+	for len(engine.rounds()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	status, err = api.Status(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &RoundTimeoutStatus{
+		Strategy:          params.LinearTimeout,
+		RequestTimeout:    10000,
+		MaxRequestTimeout: 35000,
+	}
+	if !reflect.DeepEqual(status.RoundTimeout, want) {
+		t.Errorf("round timeout mismatch: have %+v, want %+v", status.RoundTimeout, want)
+	}
+	if len(status.Rounds) != 2 {
+		t.Fatalf("the number of rounds mismatch: have %d, want 2", len(status.Rounds))
+	}
+	if round := status.Rounds[0]; round.Sequence != 1 || round.End == nil || round.End.Before(round.Start) {
+		t.Errorf("round mismatch: have %+v, want the ended round of block 1", round)
+	}
+	if round := status.Rounds[1]; round.Sequence != 2 || round.End != nil {
+		t.Errorf("round mismatch: have %+v, want the running round of block 2", round)
+	}
+}
+
+func TestEmptyBlockPeriod(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.EmptyBlockPeriod = 30
+	chain, engine := newBlockChainWithConfig(1, &config)
+
+	parent := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{parent}); err != nil {
+		t.Fatal(err)
+	}
+
+	assemble := func(txs []*types.Transaction) *types.Block {
+		header := makeHeader(parent, engine.config)
+		if err := engine.Prepare(chain, header); err != nil {
+			t.Fatal(err)
+		}
+		state, _, _ := chain.StateAt(parent.Root())
+		block, err := engine.FinalizeAndAssemble(chain, header, state, txs, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return block
+	}
+	// empty blocks are held back for the empty block period
+	if have, want := assemble(nil).Time(), parent.Time()+config.EmptyBlockPeriod; have != want {
+		t.Errorf("empty block timestamp mismatch: have %d, want %d", have, want)
+	}
+	// blocks with transactions follow the block period
+	tx := types.NewTransaction(0, common.Address{}, big.NewInt(0), params.TxGas, big.NewInt(0), nil)
+	if have := assemble(types.Transactions{tx}).Time(); have >= parent.Time()+config.EmptyBlockPeriod {
+		t.Errorf("block with transactions held back: have timestamp %d, parent %d", have, parent.Time())
+	}
+}
@@ -128,11 +128,30 @@ func (sb *backend) SetBroadcaster(broadcaster consensus.Broadcaster) {
 }
 
 func (sb *backend) NewChainHead() error {
-	sb.coreMu.RLock()
-	defer sb.coreMu.RUnlock()
+	sb.coreLifecycleMu.Lock()
+	defer sb.coreLifecycleMu.Unlock()
+
+	sb.coreMu.Lock()
 	if !sb.coreStarted {
+		sb.coreMu.Unlock()
 		return istanbul.ErrStoppedEngine
 	}
-	go sb.istanbulEventMux.Post(istanbul.FinalCommittedEvent{})
-	return nil
+	// Hand over to QBFT once the next block is to be sealed using it. The new
+	// engine starts from the current head.
+	next := sb.nextBlockNumber()
+	current, core := sb.core, sb.coreFor(next)
+	sb.core = core
+	sb.coreMu.Unlock()
+
+	if core == current {
+		go sb.istanbulEventMux.Post(istanbul.FinalCommittedEvent{})
+		return nil
+	}
+	// The engines are stopped and started without holding coreMu, as stopping
+	// waits for the event handler of the engine which calls into the backend
+	sb.logger.Info("Switching to QBFT consensus", "number", next)
+	if err := current.Stop(); err != nil {
+		return err
+	}
+	return core.Start()
 }
@@ -0,0 +1,324 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	dbKeyParticipationPrefix = "istanbul-participation-"     // participation record of an epoch, by epoch number
+	dbKeyParticipationHead   = "istanbul-participation-head" // number of the last block whose participation is recorded
+)
+
+var errNoParticipation = errors.New("no participation recorded for the epoch")
+
+// ValidatorParticipation is the participation of a validator in the blocks of
+// an epoch
+type ValidatorParticipation struct {
+	Proposed        uint64 `json:"proposed"`        // Blocks proposed
+	MissedProposals uint64 `json:"missedProposals"` // Rounds in which it was the proposer but no block was committed, each triggering a round change
+	Committed       uint64 `json:"committed"`       // Blocks carrying its committed seal
+	MissedCommits   uint64 `json:"missedCommits"`   // Blocks missing its committed seal while it was a validator
+	LastSeenBlock   uint64 `json:"lastSeenBlock"`   // Last block it proposed or committed, 0 if none in the epoch
+	LastSeenTime    uint64 `json:"lastSeenTime"`    // Timestamp of LastSeenBlock
+}
+
+// EpochParticipation is the participation of the validators in the blocks of
+// an epoch recorded so far
+type EpochParticipation struct {
+	Epoch        uint64                                     `json:"epoch"`
+	FirstBlock   uint64                                     `json:"firstBlock"`   // First block recorded
+	LastBlock    uint64                                     `json:"lastBlock"`    // Last block recorded
+	RoundChanges uint64                                     `json:"roundChanges"` // Rounds changed before the blocks were committed
+	Validators   map[common.Address]*ValidatorParticipation `json:"validators"`
+}
+
+// participationIndex keeps the participation record of the epoch of the last
+// indexed block
+type participationIndex struct {
+	mu     sync.Mutex
+	head   *uint64             // last indexed block, nil until read from the database
+	record *EpochParticipation // record of the epoch of head
+}
+
+// ParticipationTracker is implemented by the Istanbul engine to record the
+// participation of the validators in the blocks of the chain as they arrive
+type ParticipationTracker interface {
+	TrackParticipation(chain *core.BlockChain)
+}
+
+// TrackParticipation records the participation of the validators in the new
+// heads of the chain until the chain is stopped
+func (sb *backend) TrackParticipation(chain *core.BlockChain) {
+	heads := make(chan core.ChainHeadEvent, 16)
+	sub := chain.SubscribeChainHeadEvent(heads)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			if err := sb.indexParticipation(chain); err != nil {
+				sb.logger.Warn("Failed to record validator participation", "err", err)
+			}
+			select {
+			case <-heads:
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
+
+// participationEpoch returns the epoch whose participation record covers the
+// block with the given number. Epochs have the length configured in the genesis,
+// regardless of transitions, so that their numbering is stable.
+func (sb *backend) participationEpoch(number uint64) uint64 {
+	if sb.config.Epoch == 0 {
+		return 0
+	}
+	return number / sb.config.Epoch
+}
+
+// indexParticipation records the participation of the validators in the blocks
+// up to the current head. If the node lags more than an epoch behind, recording
+// resumes at the first block of the epoch of the head.
+func (sb *backend) indexParticipation(chain consensus.ChainHeaderReader) error {
+	index := &sb.participation
+	index.mu.Lock()
+	defer index.mu.Unlock()
+
+	if index.head == nil {
+		var head uint64
+		if blob, err := sb.db.Get([]byte(dbKeyParticipationHead)); err == nil && len(blob) == 8 {
+			head = binary.BigEndian.Uint64(blob)
+		}
+		index.head = &head
+	}
+	current := chain.CurrentHeader()
+	if current == nil || current.Number.Uint64() <= *index.head {
+		return nil
+	}
+	end := current.Number.Uint64()
+	next := *index.head + 1
+	if sb.participationEpoch(end) > sb.participationEpoch(next)+1 {
+		next = sb.participationEpoch(end) * sb.config.Epoch
+		if next == 0 {
+			next = 1
+		}
+	}
+
+	for number := next; number <= end; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return errUnknownBlock
+		}
+		epoch := sb.participationEpoch(number)
+		if index.record == nil || index.record.Epoch != epoch {
+			if index.record != nil {
+				if err := sb.storeParticipation(index.record, *index.head); err != nil {
+					return err
+				}
+			}
+			record, err := sb.loadParticipation(epoch)
+			if err != nil {
+				record = &EpochParticipation{Epoch: epoch, FirstBlock: number, Validators: make(map[common.Address]*ValidatorParticipation)}
+			}
+			index.record = record
+		}
+		if err := sb.recordParticipation(chain, index.record, header); err != nil {
+			return err
+		}
+		*index.head = number
+	}
+	if err := sb.storeParticipation(index.record, *index.head); err != nil {
+		return err
+	}
+	sb.updateParticipationMetrics(index.record)
+	return nil
+}
+
+// recordParticipation adds the participation of the validators in the given
+// block to the record of its epoch
+func (sb *backend) recordParticipation(chain consensus.ChainHeaderReader, record *EpochParticipation, header *types.Header) error {
+	number := header.Number.Uint64()
+	valSet, err := sb.headerValidatorSet(chain, header, nil)
+	if err != nil {
+		return err
+	}
+	author, err := sb.Author(header)
+	if err != nil {
+		return err
+	}
+	committers, err := sb.signers(chain, header, nil)
+	if err != nil {
+		return err
+	}
+	var lastProposer common.Address
+	if number > 1 {
+		parent := chain.GetHeader(header.ParentHash, number-1)
+		if parent == nil {
+			return errUnknownBlock
+		}
+		if lastProposer, err = sb.Author(parent); err != nil {
+			return err
+		}
+	}
+
+	participant := func(addr common.Address) *ValidatorParticipation {
+		p, ok := record.Validators[addr]
+		if !ok {
+			p = new(ValidatorParticipation)
+			record.Validators[addr] = p
+		}
+		return p
+	}
+	seen := func(p *ValidatorParticipation) {
+		p.LastSeenBlock, p.LastSeenTime = number, header.Time
+	}
+
+	// The proposers of the rounds before the one in which the block was
+	// committed missed their proposals
+	proposers := valSet.Copy()
+	round := sb.commitRound(header, proposers, lastProposer, author)
+	for r := uint64(0); r < round; r++ {
+		proposers.CalcProposer(lastProposer, r)
+		participant(proposers.GetProposer().Address()).MissedProposals++
+	}
+	record.RoundChanges += round
+
+	proposer := participant(author)
+	proposer.Proposed++
+	seen(proposer)
+
+	committed := make(map[common.Address]bool, len(committers))
+	for _, addr := range committers {
+		committed[addr] = true
+	}
+	for _, val := range valSet.List() {
+		p := participant(val.Address())
+		if committed[val.Address()] {
+			p.Committed++
+			seen(p)
+		} else {
+			p.MissedCommits++
+		}
+	}
+	record.LastBlock = number
+	sb.updateQuorumMetrics(header, valSet, len(committed))
+	return nil
+}
+
+// commitRound returns the round in which the given block was committed. QBFT
+// records it in the header, while for IBFT it is the first round whose proposer
+// is the author of the block.
+func (sb *backend) commitRound(header *types.Header, valSet istanbul.ValidatorSet, lastProposer common.Address, author common.Address) uint64 {
+	if sb.config.IsQBFTConsensusAt(header.Number) {
+		if extra, err := types.ExtractQBFTExtra(header); err == nil {
+			return uint64(extra.Round)
+		}
+		return 0
+	}
+	for round := uint64(0); round < uint64(valSet.Size()); round++ {
+		valSet.CalcProposer(lastProposer, round)
+		if valSet.GetProposer().Address() == author {
+			return round
+		}
+	}
+	return 0
+}
+
+// loadParticipation retrieves the participation record of the given epoch
+func (sb *backend) loadParticipation(epoch uint64) (*EpochParticipation, error) {
+	blob, err := sb.db.Get(participationKey(epoch))
+	if err != nil {
+		return nil, errNoParticipation
+	}
+	record := new(EpochParticipation)
+	if err := json.Unmarshal(blob, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// storeParticipation stores the participation record of an epoch along with the
+// number of the last indexed block
+func (sb *backend) storeParticipation(record *EpochParticipation, head uint64) error {
+	blob, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	batch := sb.db.NewBatch()
+	if err := batch.Put(participationKey(record.Epoch), blob); err != nil {
+		return err
+	}
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], head)
+	if err := batch.Put([]byte(dbKeyParticipationHead), enc[:]); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+func participationKey(epoch uint64) []byte {
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], epoch)
+	return append([]byte(dbKeyParticipationPrefix), enc[:]...)
+}
+
+// updateParticipationMetrics exports the participation of the validators in the
+// current epoch, along with the committed seals of the last block against the
+// quorum of its validators
+func (sb *backend) updateParticipationMetrics(record *EpochParticipation) {
+	if !metrics.Enabled {
+		return
+	}
+	for addr, p := range record.Validators {
+		prefix := "consensus/istanbul/participation/" + strings.ToLower(addr.Hex()) + "/"
+		metrics.GetOrRegisterGauge(prefix+"proposed", nil).Update(int64(p.Proposed))
+		metrics.GetOrRegisterGauge(prefix+"missedproposals", nil).Update(int64(p.MissedProposals))
+		metrics.GetOrRegisterGauge(prefix+"committed", nil).Update(int64(p.Committed))
+		metrics.GetOrRegisterGauge(prefix+"missedcommits", nil).Update(int64(p.MissedCommits))
+		metrics.GetOrRegisterGauge(prefix+"lastseen", nil).Update(int64(p.LastSeenBlock))
+	}
+	metrics.GetOrRegisterGauge("consensus/istanbul/participation/roundchanges", nil).Update(int64(record.RoundChanges))
+}
+
+// updateQuorumMetrics exports the number of committed seals of the given block
+// and the quorum size of its validators
+func (sb *backend) updateQuorumMetrics(header *types.Header, valSet istanbul.ValidatorSet, committers int) {
+	if !metrics.Enabled {
+		return
+	}
+	quorum := 2*valSet.F() + 1
+	if sb.config.IsQBFTConsensusAt(header.Number) || (sb.config.Ceil2Nby3Block != nil && header.Number.Cmp(sb.config.Ceil2Nby3Block) >= 0) {
+		quorum = int(math.Ceil(float64(2*valSet.Size()) / 3))
+	}
+	metrics.GetOrRegisterGauge("consensus/istanbul/participation/validators", nil).Update(int64(valSet.Size()))
+	metrics.GetOrRegisterGauge("consensus/istanbul/participation/committers", nil).Update(int64(committers))
+	metrics.GetOrRegisterGauge("consensus/istanbul/participation/quorum", nil).Update(int64(quorum))
+}
@@ -0,0 +1,126 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestGetParticipation(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	api := &API{chain: chain, istanbul: engine}
+
+	// nothing is recorded before the first block
+	if _, err := api.GetParticipation(nil); err != errNoParticipation {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoParticipation)
+	}
+
+	block := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert the block: %v", err)
+	}
+
+	record, err := api.GetParticipation(nil)
+	if err != nil {
+		t.Fatalf("failed to get the participation: %v", err)
+	}
+	if record.Epoch != 0 || record.FirstBlock != 1 || record.LastBlock != 1 || record.RoundChanges != 0 {
+		t.Errorf("record mismatch: have epoch %d, blocks %d-%d, round changes %d, want epoch 0, blocks 1-1, round changes 0",
+			record.Epoch, record.FirstBlock, record.LastBlock, record.RoundChanges)
+	}
+	want := ValidatorParticipation{Proposed: 1, Committed: 1, LastSeenBlock: 1, LastSeenTime: block.Time()}
+	p, err := api.GetValidatorParticipation(engine.Address(), nil)
+	if err != nil {
+		t.Fatalf("failed to get the validator participation: %v", err)
+	}
+	if *p != want {
+		t.Errorf("participation mismatch: have %+v, want %+v", *p, want)
+	}
+
+	if _, err := api.GetValidatorParticipation(common.HexToAddress("0x1234"), nil); err != errNoParticipation {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoParticipation)
+	}
+}
+
+func TestRecordParticipationRoundChanges(t *testing.T) {
+	const round = 2
+	config := *istanbul.DefaultConfig
+	config.QbftBlock = big.NewInt(1)
+	genesis, keys := getGenesisAndKeys(4)
+	chain, engine := newBlockChainFromGenesis(genesis, keys, &config)
+
+	// the block is proposed in round 2 and committed by all validators but one
+	header := makeBlockWithoutSeal(chain, engine, chain.Genesis()).Header()
+	valSet, err := engine.headerValidatorSet(chain, header, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposers := valSet.Copy()
+	var missed []common.Address
+	for r := uint64(0); r < round; r++ {
+		proposers.CalcProposer(common.Address{}, r)
+		missed = append(missed, proposers.GetProposer().Address())
+	}
+	proposers.CalcProposer(common.Address{}, round)
+	header.Coinbase = proposers.GetProposer().Address()
+
+	seal := crypto.Keccak256(qbft.PrepareCommittedSeal(header.Hash(), big.NewInt(round)))
+	var seals [][]byte
+	for _, key := range keys[1:] {
+		sig, err := crypto.Sign(seal, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seals = append(seals, sig)
+	}
+	if err := writeQBFTCommittedSeals(header, seals, big.NewInt(round)); err != nil {
+		t.Fatal(err)
+	}
+
+	record := &EpochParticipation{FirstBlock: 1, Validators: make(map[common.Address]*ValidatorParticipation)}
+	if err := engine.recordParticipation(chain, record, header); err != nil {
+		t.Fatalf("failed to record the participation: %v", err)
+	}
+	if record.RoundChanges != round {
+		t.Errorf("round changes mismatch: have %d, want %d", record.RoundChanges, round)
+	}
+	for _, addr := range missed {
+		if p := record.Validators[addr]; p.MissedProposals != 1 {
+			t.Errorf("%v: missed proposals mismatch: have %d, want 1", addr, p.MissedProposals)
+		}
+	}
+	if p := record.Validators[header.Coinbase]; p.Proposed != 1 || p.LastSeenBlock != 1 {
+		t.Errorf("proposer participation mismatch: have %+v", *p)
+	}
+	absent := crypto.PubkeyToAddress(keys[0].PublicKey)
+	if p := record.Validators[absent]; p.Committed != 0 || p.MissedCommits != 1 {
+		t.Errorf("absent validator participation mismatch: have %+v", *p)
+	}
+	for _, key := range keys[1:] {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		if p := record.Validators[addr]; p.Committed != 1 || p.MissedCommits != 0 || p.LastSeenBlock != 1 {
+			t.Errorf("%v: participation mismatch: have %+v", addr, *p)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/qbft"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// QBFT blocks carry their extra-data as types.QBFTExtra. The proposer of a
+// block is its coinbase rather than the signer of a proposer seal, and votes on
+// validators are part of the extra-data instead of the coinbase and nonce.
+
+// prepareQBFTExtra returns the QBFT extra-data of the given header with the
+// given validators and vote
+func prepareQBFTExtra(header *types.Header, vals []common.Address, vote *types.ValidatorVote) ([]byte, error) {
+	vanity := make([]byte, types.IstanbulExtraVanity)
+	copy(vanity, header.Extra)
+
+	qbftExtra := &types.QBFTExtra{
+		VanityData:    vanity,
+		Validators:    vals,
+		Vote:          vote,
+		Round:         0,
+		CommittedSeal: [][]byte{},
+	}
+	return rlp.EncodeToBytes(qbftExtra)
+}
+
+// writeQBFTCommittedSeals writes the QBFT extra-data of a block header with the
+// given committed seals and the round in which the block was committed.
+func writeQBFTCommittedSeals(h *types.Header, committedSeals [][]byte, round *big.Int) error {
+	if len(committedSeals) == 0 {
+		return errInvalidCommittedSeals
+	}
+
+	for _, seal := range committedSeals {
+		if len(seal) != types.IstanbulExtraSeal {
+			return errInvalidCommittedSeals
+		}
+	}
+
+	qbftExtra, err := types.ExtractQBFTExtra(h)
+	if err != nil {
+		return err
+	}
+
+	qbftExtra.Round = uint32(round.Uint64())
+	qbftExtra.CommittedSeal = make([][]byte, len(committedSeals))
+	copy(qbftExtra.CommittedSeal, committedSeals)
+
+	payload, err := rlp.EncodeToBytes(&qbftExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = payload
+	return nil
+}
+
+// headerValidators returns the validators in the extra-data of the header
+func headerValidators(config *istanbul.Config, header *types.Header) ([]common.Address, error) {
+	if config.IsQBFTConsensusAt(header.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return nil, err
+		}
+		return qbftExtra.Validators, nil
+	}
+	istanbulExtra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	return istanbulExtra.Validators, nil
+}
+
+// headerVote returns the proposer of the header and the vote it casts on a
+// candidate validator
+func headerVote(config *istanbul.Config, header *types.Header) (proposer common.Address, candidate common.Address, authorize bool, err error) {
+	if config.IsQBFTConsensusAt(header.Number) {
+		qbftExtra, err := types.ExtractQBFTExtra(header)
+		if err != nil {
+			return common.Address{}, common.Address{}, false, err
+		}
+		if qbftExtra.Vote == nil {
+			return header.Coinbase, common.Address{}, false, nil
+		}
+		switch qbftExtra.Vote.VoteType {
+		case types.QBFTAuthVote:
+			authorize = true
+		case types.QBFTDropVote:
+			authorize = false
+		default:
+			return common.Address{}, common.Address{}, false, errInvalidVote
+		}
+		return header.Coinbase, qbftExtra.Vote.RecipientAddress, authorize, nil
+	}
+
+	proposer, err = ecrecover(header)
+	if err != nil {
+		return common.Address{}, common.Address{}, false, err
+	}
+	switch {
+	case bytes.Equal(header.Nonce[:], nonceAuthVote):
+		authorize = true
+	case bytes.Equal(header.Nonce[:], nonceDropVote):
+		authorize = false
+	default:
+		return common.Address{}, common.Address{}, false, errInvalidVote
+	}
+	return proposer, header.Coinbase, authorize, nil
+}
+
+// qbftSigners returns the addresses which signed the committed seals of a
+// QBFT header
+func qbftSigners(header *types.Header) ([]common.Address, error) {
+	qbftExtra, err := types.ExtractQBFTExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []common.Address
+	proposalSeal := qbft.PrepareCommittedSeal(header.Hash(), new(big.Int).SetUint64(uint64(qbftExtra.Round)))
+	for _, seal := range qbftExtra.CommittedSeal {
+		addr, err := istanbul.GetSignatureAddress(proposalSeal, seal)
+		if err != nil {
+			return nil, errInvalidSignature
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
@@ -22,9 +22,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 const (
@@ -34,17 +36,19 @@ const (
 // Vote represents a single vote that an authorized validator made to modify the
 // list of authorizations.
 type Vote struct {
-	Validator common.Address `json:"validator"` // Authorized validator that cast this vote
-	Block     uint64         `json:"block"`     // Block number the vote was cast in (expire old votes)
-	Address   common.Address `json:"address"`   // Account being voted on to change its authorization
-	Authorize bool           `json:"authorize"` // Whether to authorize or deauthorize the voted account
+	Validator common.Address `json:"validator"`        // Authorized validator that cast this vote
+	Block     uint64         `json:"block"`            // Block number the vote was cast in (expire old votes)
+	Address   common.Address `json:"address"`          // Account being voted on to change its authorization
+	Authorize bool           `json:"authorize"`        // Whether to authorize or deauthorize the voted account
+	BLSKey    *params.BLSKey `json:"blsKey,omitempty"` // BLS key registered for the authorized account, if any
 }
 
 // Tally is a simple vote tally to keep the current score of votes. Votes that
 // go against the proposal aren't counted since it's equivalent to not voting.
 type Tally struct {
-	Authorize bool `json:"authorize"` // Whether the vote it about authorizing or kicking someone
-	Votes     int  `json:"votes"`     // Number of votes until now wanting to pass the proposal
+	Authorize bool           `json:"authorize"`        // Whether the vote it about authorizing or kicking someone
+	Votes     int            `json:"votes"`            // Number of votes until now wanting to pass the proposal
+	BLSKey    *params.BLSKey `json:"blsKey,omitempty"` // BLS key registered if the proposal passes, if any
 }
 
 // Snapshot is the state of the authorization voting at a given point in time.
@@ -56,6 +60,8 @@ type Snapshot struct {
 	Votes  []*Vote                  // List of votes cast in chronological order
 	Tally  map[common.Address]Tally // Current vote tally to avoid recalculating
 	ValSet istanbul.ValidatorSet    // Set of authorized validators at this moment
+
+	BLSKeys map[common.Address]params.BLSKey // BLS keys registered with the votes authorizing the validators
 }
 
 // newSnapshot create a new snapshot with the specified startup parameters. This
@@ -68,6 +74,8 @@ func newSnapshot(epoch uint64, number uint64, hash common.Hash, valSet istanbul.
 		Hash:   hash,
 		ValSet: valSet,
 		Tally:  make(map[common.Address]Tally),
+
+		BLSKeys: make(map[common.Address]params.BLSKey),
 	}
 	return snap
 }
@@ -105,47 +113,61 @@ func (s *Snapshot) copy() *Snapshot {
 		ValSet: s.ValSet.Copy(),
 		Votes:  make([]*Vote, len(s.Votes)),
 		Tally:  make(map[common.Address]Tally),
+
+		BLSKeys: make(map[common.Address]params.BLSKey, len(s.BLSKeys)),
 	}
 
 	for address, tally := range s.Tally {
 		cpy.Tally[address] = tally
 	}
+	for address, key := range s.BLSKeys {
+		cpy.BLSKeys[address] = key
+	}
 	copy(cpy.Votes, s.Votes)
 
 	return cpy
 }
 
-// checkVote return whether it's a valid vote
-func (s *Snapshot) checkVote(address common.Address, authorize bool) bool {
+// checkVote return whether it's a valid vote. A validator may be authorized
+// again to register another BLS key.
+func (s *Snapshot) checkVote(address common.Address, authorize bool, blsKey *params.BLSKey) bool {
 	_, validator := s.ValSet.GetByAddress(address)
+	if validator != nil && authorize {
+		registered, ok := s.BLSKeys[address]
+		return blsKey != nil && (!ok || !sameBLSKey(&registered, blsKey))
+	}
 	return (validator != nil && !authorize) || (validator == nil && authorize)
 }
 
 // cast adds a new vote into the tally.
-func (s *Snapshot) cast(address common.Address, authorize bool) bool {
+func (s *Snapshot) cast(address common.Address, authorize bool, blsKey *params.BLSKey) bool {
 	// Ensure the vote is meaningful
-	if !s.checkVote(address, authorize) {
+	if !s.checkVote(address, authorize, blsKey) {
 		return false
 	}
 	// Cast the vote into an existing or new tally
 	if old, ok := s.Tally[address]; ok {
+		// Votes registering another BLS key are for another proposal
+		if !sameBLSKey(old.BLSKey, blsKey) {
+			return false
+		}
 		old.Votes++
 		s.Tally[address] = old
 	} else {
-		s.Tally[address] = Tally{Authorize: authorize, Votes: 1}
+		s.Tally[address] = Tally{Authorize: authorize, Votes: 1, BLSKey: blsKey}
 	}
 	return true
 }
 
 // uncast removes a previously cast vote from the tally.
-func (s *Snapshot) uncast(address common.Address, authorize bool) bool {
+func (s *Snapshot) uncast(address common.Address, authorize bool, blsKey *params.BLSKey) bool {
 	// If there's no tally, it's a dangling vote, just drop
 	tally, ok := s.Tally[address]
 	if !ok {
 		return false
 	}
 	// Ensure we only revert counted votes
-	if tally.Authorize != authorize {
+	if tally.Authorize != authorize || !sameBLSKey(tally.BLSKey, blsKey) {
 		return false
 	}
 	// Otherwise revert the vote
@@ -159,8 +181,8 @@ func (s *Snapshot) uncast(address common.Address, authorize bool) bool {
 }
 
 // apply creates a new authorization snapshot by applying the given headers to
-// the original one.
-func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
+// the original one. The config tells which headers are sealed using QBFT.
+func (s *Snapshot) apply(headers []*types.Header, config *istanbul.Config) (*Snapshot, error) {
 	// Allow passing in no headers for cleaner code
 	if len(headers) == 0 {
 		return s, nil
@@ -178,26 +200,42 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 	snap := s.copy()
 
 	for _, header := range headers {
+		// The epoch may change with the transitions
+		snap.Epoch = config.GetConfig(header.Number).Epoch
+
+		// Votes are neither cast nor tallied once the validator contract is in force
+		if config.UseValidatorContractAt(header.Number) {
+			continue
+		}
 		// Remove any votes on checkpoint blocks
 		number := header.Number.Uint64()
-		if number%s.Epoch == 0 {
+		if number%snap.Epoch == 0 {
 			snap.Votes = nil
 			snap.Tally = make(map[common.Address]Tally)
 		}
 		// Resolve the authorization key and check against validators
-		validator, err := ecrecover(header)
+		validator, candidate, authorize, err := headerVote(config, header)
 		if err != nil {
 			return nil, err
 		}
+		blsKey, err := headerBLSKey(config, header)
+		if err != nil {
+			return nil, err
+		}
+		if blsKey != nil {
+			if _, err := bls.VerifyRegistration(blsKey.PublicKey, blsKey.Proof); err != nil {
+				return nil, errInvalidBLSKey
+			}
+		}
 		if _, v := snap.ValSet.GetByAddress(validator); v == nil {
 			return nil, errUnauthorized
 		}
 
 		// Header authorized, discard any previous votes from the validator
 		for i, vote := range snap.Votes {
-			if vote.Validator == validator && vote.Address == header.Coinbase {
+			if vote.Validator == validator && vote.Address == candidate {
 				// Uncast the vote from the cached tally
-				snap.uncast(vote.Address, vote.Authorize)
+				snap.uncast(vote.Address, vote.Authorize, vote.BLSKey)
 
 				// Uncast the vote from the chronological list
 				snap.Votes = append(snap.Votes[:i], snap.Votes[i+1:]...)
@@ -205,35 +243,31 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 			}
 		}
 		// Tally up the new vote from the validator
-		var authorize bool
-		switch {
-		case bytes.Equal(header.Nonce[:], nonceAuthVote):
-			authorize = true
-		case bytes.Equal(header.Nonce[:], nonceDropVote):
-			authorize = false
-		default:
-			return nil, errInvalidVote
-		}
-		if snap.cast(header.Coinbase, authorize) {
+		if snap.cast(candidate, authorize, blsKey) {
 			snap.Votes = append(snap.Votes, &Vote{
 				Validator: validator,
 				Block:     number,
-				Address:   header.Coinbase,
+				Address:   candidate,
 				Authorize: authorize,
+				BLSKey:    blsKey,
 			})
 		}
 		// If the vote passed, update the list of validators
-		if tally := snap.Tally[header.Coinbase]; tally.Votes > snap.ValSet.Size()/2 {
+		if tally := snap.Tally[candidate]; tally.Votes > snap.ValSet.Size()/2 {
 			if tally.Authorize {
-				snap.ValSet.AddValidator(header.Coinbase)
+				snap.ValSet.AddValidator(candidate)
+				if tally.BLSKey != nil {
+					snap.BLSKeys[candidate] = *tally.BLSKey
+				}
 			} else {
-				snap.ValSet.RemoveValidator(header.Coinbase)
+				snap.ValSet.RemoveValidator(candidate)
+				delete(snap.BLSKeys, candidate)
 
 				// Discard any previous votes the deauthorized validator cast
 				for i := 0; i < len(snap.Votes); i++ {
-					if snap.Votes[i].Validator == header.Coinbase {
+					if snap.Votes[i].Validator == candidate {
 						// Uncast the vote from the cached tally
-						snap.uncast(snap.Votes[i].Address, snap.Votes[i].Authorize)
+						snap.uncast(snap.Votes[i].Address, snap.Votes[i].Authorize, snap.Votes[i].BLSKey)
 
 						// Uncast the vote from the chronological list
 						snap.Votes = append(snap.Votes[:i], snap.Votes[i+1:]...)
@@ -244,12 +278,12 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 			}
 			// Discard any previous votes around the just changed account
 			for i := 0; i < len(snap.Votes); i++ {
-				if snap.Votes[i].Address == header.Coinbase {
+				if snap.Votes[i].Address == candidate {
 					snap.Votes = append(snap.Votes[:i], snap.Votes[i+1:]...)
 					i--
 				}
 			}
-			delete(snap.Tally, header.Coinbase)
+			delete(snap.Tally, candidate)
 		}
 	}
 	snap.Number += uint64(len(headers))
@@ -284,6 +318,8 @@ type snapshotJSON struct {
 	// for validator set
 	Validators []common.Address        `json:"validators"`
 	Policy     istanbul.ProposerPolicy `json:"policy"`
+
+	BLSKeys map[common.Address]params.BLSKey `json:"blsKeys,omitempty"`
 }
 
 func (s *Snapshot) toJSONStruct() *snapshotJSON {
@@ -295,6 +331,7 @@ func (s *Snapshot) toJSONStruct() *snapshotJSON {
 		Tally:      s.Tally,
 		Validators: s.validators(),
 		Policy:     s.ValSet.Policy(),
+		BLSKeys:    s.BLSKeys,
 	}
 }
 
@@ -311,6 +348,10 @@ func (s *Snapshot) UnmarshalJSON(b []byte) error {
 	s.Votes = j.Votes
 	s.Tally = j.Tally
 	s.ValSet = validator.NewSet(j.Validators, j.Policy)
+	s.BLSKeys = j.BLSKeys
+	if s.BLSKeys == nil {
+		s.BLSKeys = make(map[common.Address]params.BLSKey)
+	}
 	return nil
 }
 
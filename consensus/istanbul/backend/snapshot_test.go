@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type testerVote struct {
@@ -403,6 +404,68 @@ func TestVoting(t *testing.T) {
 	}
 }
 
+// Tests that the pending votes are reset at the checkpoints of the epoch in
+// force at each block.
+func TestVotingEpochTransition(t *testing.T) {
+	accounts := newTesterAccountPool()
+	validators := []common.Address{accounts.address("A"), accounts.address("B")}
+
+	genesis := &core.Genesis{
+		Difficulty: defaultDifficulty,
+		Mixhash:    types.IstanbulDigest,
+	}
+	b := genesis.ToBlock(nil)
+	genesis.ExtraData, _ = prepareExtra(b.Header(), validators)
+	db := rawdb.NewMemoryDatabase()
+	genesis.Commit(db)
+
+	config := *istanbul.DefaultConfig
+	config.Transitions = []params.Transition{{Block: big.NewInt(2), Epoch: 3}}
+	engine := New(&config, accounts.accounts["A"], db).(*backend)
+	chain, _ := core.NewBlockChain(db, nil, genesis.Config, engine, vm.Config{}, nil, nil)
+
+	// A votes for C before the checkpoint of block 3 and B after it
+	votes := []testerVote{{validator: "A", voted: "C", auth: true}, {validator: "A"}, {validator: "B", voted: "C", auth: true}}
+	headers := make([]*types.Header, len(votes))
+	for j, vote := range votes {
+		headers[j] = &types.Header{
+			Number:     big.NewInt(int64(j) + 1),
+			Difficulty: defaultDifficulty,
+			MixDigest:  types.IstanbulDigest,
+			Extra:      common.CopyBytes(genesis.ExtraData),
+		}
+		if vote.voted != "" {
+			headers[j].Coinbase = accounts.address(vote.voted)
+		}
+		if j > 0 {
+			headers[j].ParentHash = headers[j-1].Hash()
+		}
+		if vote.auth {
+			copy(headers[j].Nonce[:], nonceAuthVote)
+		}
+		accounts.sign(headers[j], vote.validator)
+	}
+
+	snap, err := engine.snapshot(chain, 1, headers[0].Hash(), headers[:1])
+	if err != nil {
+		t.Fatalf("failed to create voting snapshot: %v", err)
+	}
+	if snap.Epoch != istanbul.DefaultConfig.Epoch {
+		t.Errorf("epoch mismatch: have %v, want %v", snap.Epoch, istanbul.DefaultConfig.Epoch)
+	}
+
+	snap, err = engine.snapshot(chain, 3, headers[2].Hash(), headers)
+	if err != nil {
+		t.Fatalf("failed to create voting snapshot: %v", err)
+	}
+	if snap.Epoch != 3 {
+		t.Errorf("epoch mismatch: have %v, want 3", snap.Epoch)
+	}
+	if result := snap.validators(); len(result) != 2 {
+		t.Errorf("validators mismatch: have %x, want %x", result, validators)
+	}
+}
+
 func TestSaveAndLoad(t *testing.T) {
 	snap := &Snapshot{
 		Epoch:  5,
@@ -0,0 +1,277 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Once the validator contract is in force, the validators of a block are those
+// returned by getValidators() of the contract at the state of the parent block,
+// and votes in the headers are no longer cast nor tallied. The BLS keys of the
+// validators are then those returned by getBLSKey(address), if the contract
+// registers keys.
+
+const (
+	// validatorContractABI is the part of the validator-management contract
+	// interface that the engine relies on
+	validatorContractABI = `[{"constant":true,"inputs":[],"name":"getValidators","outputs":[{"name":"","type":"address[]"}],"payable":false,"stateMutability":"view","type":"function"},{"constant":true,"inputs":[{"name":"validator","type":"address"}],"name":"getBLSKey","outputs":[{"name":"publicKey","type":"bytes"},{"name":"proof","type":"bytes"}],"payable":false,"stateMutability":"view","type":"function"}]`
+
+	// validatorContractGas bounds the gas spent listing the validators
+	validatorContractGas = 10000000
+
+	inmemoryContractValidators = 128 // Number of recent validator sets read from the contract
+)
+
+var (
+	// errNoValidatorState is returned if the validators have to be read from
+	// the validator contract but the chain keeps no state, e.g. a header chain
+	errNoValidatorState = errors.New("validator contract requires the chain state")
+	// errEmptyValidatorContract is returned if the validator contract lists no
+	// validators
+	errEmptyValidatorContract = errors.New("validator contract lists no validators")
+	// errInvalidValidators is returned if the validators in the extra-data of a
+	// block are not those listed by the validator contract
+	errInvalidValidators = errors.New("validators differ from the validator contract")
+
+	parsedValidatorContractABI, _ = abi.JSON(strings.NewReader(validatorContractABI))
+)
+
+// stateReader is implemented by chains which keep the state of their blocks,
+// such as core.BlockChain
+type stateReader interface {
+	StateAt(root common.Hash) (*state.StateDB, *state.StateDB, error)
+	HasState(root common.Hash) bool
+}
+
+// validatorsAt returns the validators of the block following the given block,
+// whether read from the validator contract or tallied from the header votes.
+// The caller may pass in a batch of parents, ending with the given block, to
+// avoid looking those up from the database.
+func (sb *backend) validatorsAt(chain consensus.ChainHeaderReader, number uint64, hash common.Hash, parents []*types.Header) (istanbul.ValidatorSet, error) {
+	next := new(big.Int).SetUint64(number + 1)
+	policy := sb.config.GetConfig(next).ProposerPolicy
+	if !sb.config.UseValidatorContractAt(next) {
+		snap, err := sb.snapshot(chain, number, hash, parents)
+		if err != nil {
+			return nil, err
+		}
+		// The proposer policy may have changed since the snapshot was taken
+		if snap.ValSet.Policy() != policy {
+			return validator.NewSet(snap.validators(), policy), nil
+		}
+		return snap.ValSet, nil
+	}
+
+	var header *types.Header
+	if len(parents) > 0 {
+		header = parents[len(parents)-1]
+	} else {
+		header = chain.GetHeader(hash, number)
+	}
+	if header == nil || header.Hash() != hash {
+		return nil, errUnknownBlock
+	}
+	validators, err := sb.contractValidators(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	return validator.NewSet(validators, policy), nil
+}
+
+// validatorAddresses returns the addresses of the validators in the set
+func validatorAddresses(valSet istanbul.ValidatorSet) []common.Address {
+	addrs := make([]common.Address, 0, valSet.Size())
+	for _, val := range valSet.List() {
+		addrs = append(addrs, val.Address())
+	}
+	return addrs
+}
+
+// contractValidators returns the validators listed by the validator contract
+// at the state of the given block
+func (sb *backend) contractValidators(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	hash := header.Hash()
+	if validators, ok := sb.contractValidatorSets.Get(hash); ok {
+		return validators.([]common.Address), nil
+	}
+
+	evm, err := validatorContractEVM(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	input, err := parsedValidatorContractABI.Pack("getValidators")
+	if err != nil {
+		return nil, err
+	}
+	output, _, err := evm.StaticCall(vm.AccountRef(common.Address{}), sb.config.ValidatorContract, input, validatorContractGas)
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []common.Address
+	if err := parsedValidatorContractABI.Unpack(&validators, "getValidators", output); err != nil {
+		return nil, err
+	}
+	if len(validators) == 0 {
+		return nil, errEmptyValidatorContract
+	}
+	sb.contractValidatorSets.Add(hash, validators)
+	return validators, nil
+}
+
+// contractBLSKeys returns the BLS keys registered for the given validators in
+// the validator contract at the state of the given block. Validators without a
+// key, or listed by a contract which does not register keys, are left out.
+func (sb *backend) contractBLSKeys(chain consensus.ChainHeaderReader, header *types.Header, validators []common.Address) (map[common.Address]params.BLSKey, error) {
+	hash := header.Hash()
+	if keys, ok := sb.contractBLSKeySets.Get(hash); ok {
+		return keys.(map[common.Address]params.BLSKey), nil
+	}
+
+	evm, err := validatorContractEVM(chain, header)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[common.Address]params.BLSKey)
+	for _, addr := range validators {
+		input, err := parsedValidatorContractABI.Pack("getBLSKey", addr)
+		if err != nil {
+			return nil, err
+		}
+		output, _, err := evm.StaticCall(vm.AccountRef(common.Address{}), sb.config.ValidatorContract, input, validatorContractGas)
+		if err != nil {
+			continue
+		}
+		var key struct {
+			PublicKey []byte
+			Proof     []byte
+		}
+		if err := parsedValidatorContractABI.Unpack(&key, "getBLSKey", output); err != nil || len(key.PublicKey) == 0 {
+			continue
+		}
+		keys[addr] = params.BLSKey{PublicKey: key.PublicKey, Proof: key.Proof}
+	}
+	sb.contractBLSKeySets.Add(hash, keys)
+	return keys, nil
+}
+
+// validatorContractEVM returns an EVM calling the validator contract at the
+// state of the given block
+func validatorContractEVM(chain consensus.ChainHeaderReader, header *types.Header) (*vm.EVM, error) {
+	reader, ok := chain.(stateReader)
+	if !ok {
+		return nil, errNoValidatorState
+	}
+	statedb, _, err := reader.StateAt(header.Root)
+	if err != nil {
+		return nil, err
+	}
+	context := vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash: func(n uint64) common.Hash {
+			if h := chain.GetHeaderByNumber(n); h != nil {
+				return h.Hash()
+			}
+			return common.Hash{}
+		},
+		Coinbase:    header.Coinbase,
+		BlockNumber: new(big.Int).Set(header.Number),
+		Time:        new(big.Int).SetUint64(header.Time),
+		Difficulty:  new(big.Int).Set(header.Difficulty),
+		GasLimit:    header.GasLimit,
+		GasPrice:    new(big.Int),
+	}
+	return vm.NewEVM(context, statedb, statedb, chain.Config(), vm.Config{}), nil
+}
+
+// headerValidatorSet returns the validators which may seal the given header.
+// Once the validator contract is in force but the parent state is not
+// available, as for a header chain or when the parent is imported in the same
+// batch, these are the validators read from the contract at the parent if they
+// were read before. Otherwise they are provisionally the validators in the
+// extra-data of the header, which VerifyParentState checks against the
+// contract before the block is processed.
+func (sb *backend) headerValidatorSet(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) (istanbul.ValidatorSet, error) {
+	number := header.Number.Uint64()
+	if sb.config.UseValidatorContractAt(header.Number) && number > 0 {
+		var parent *types.Header
+		if len(parents) > 0 {
+			parent = parents[len(parents)-1]
+		} else {
+			parent = chain.GetHeader(header.ParentHash, number-1)
+		}
+		if parent != nil && !hasState(chain, parent) {
+			if validators, ok := sb.contractValidatorSets.Get(parent.Hash()); ok {
+				return validator.NewSet(validators.([]common.Address), sb.config.ProposerPolicy), nil
+			}
+			validators, err := headerValidators(sb.config, header)
+			if err != nil {
+				return nil, err
+			}
+			return validator.NewSet(validators, sb.config.GetConfig(header.Number).ProposerPolicy), nil
+		}
+	}
+	return sb.validatorsAt(chain, number-1, header.ParentHash, parents)
+}
+
+// hasState reports whether the chain keeps the state of the given block
+func hasState(chain consensus.ChainHeaderReader, header *types.Header) bool {
+	reader, ok := chain.(stateReader)
+	return ok && reader.HasState(header.Root)
+}
+
+// verifyContractValidators checks that the validators in the extra-data of the
+// header are those listed by the validator contract at the parent state
+func (sb *backend) verifyContractValidators(chain consensus.ChainHeaderReader, header *types.Header) error {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+	valSet, err := sb.validatorsAt(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	validators, err := headerValidators(sb.config, header)
+	if err != nil {
+		return err
+	}
+	expected := validatorAddresses(valSet)
+	if len(validators) != len(expected) {
+		return errInvalidValidators
+	}
+	for i := range validators {
+		if validators[i] != expected[i] {
+			return errInvalidValidators
+		}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var testValidatorContract = common.HexToAddress("0x0000000000000000000000000000000000008888")
+
+// validatorContractCode returns the code of a contract whose getValidators()
+// always returns the given validators
+func validatorContractCode(validators []common.Address) []byte {
+	output, err := parsedValidatorContractABI.Methods["getValidators"].Outputs.Pack(validators)
+	if err != nil {
+		panic(err)
+	}
+	size := make([]byte, 2)
+	binary.BigEndian.PutUint16(size, uint16(len(output)))
+
+	// CODECOPY the output following the 14 bytes of code to memory and RETURN it
+	code := []byte{0x61, size[0], size[1], 0x60, 0x0e, 0x60, 0x00, 0x39, 0x61, size[0], size[1], 0x60, 0x00, 0xf3}
+	return append(code, output...)
+}
+
+// newValidatorContractGenesis returns a genesis block whose validator is
+// replaced by the validator listed in the contract from block 1 on, and the
+// keys of both
+func newValidatorContractGenesis() (*core.Genesis, []*ecdsa.PrivateKey, []common.Address) {
+	genesis, genesisKeys := getGenesisAndKeys(1)
+
+	contractKey, _ := crypto.GenerateKey()
+	validators := []common.Address{crypto.PubkeyToAddress(contractKey.PublicKey)}
+	genesis.Alloc[testValidatorContract] = core.GenesisAccount{
+		Code:    validatorContractCode(validators),
+		Balance: big.NewInt(0),
+	}
+	return genesis, append([]*ecdsa.PrivateKey{contractKey}, genesisKeys...), validators
+}
+
+func newValidatorContractChain(t *testing.T, config istanbul.Config) (*core.BlockChain, *backend, []common.Address) {
+	genesis, keys, validators := newValidatorContractGenesis()
+
+	config.ValidatorContract = testValidatorContract
+	config.ValidatorContractBlock = big.NewInt(1)
+	chain, engine := newBlockChainFromGenesis(genesis, keys, &config)
+	if engine.Address() != validators[0] {
+		t.Fatalf("validator mismatch: have %v, want %v", engine.Address(), validators[0])
+	}
+	return chain, engine, validators
+}
+
+func TestValidatorContract(t *testing.T) {
+	chain, engine, validators := newValidatorContractChain(t, *istanbul.DefaultConfig)
+	genesis := chain.Genesis()
+
+	// the genesis block lists other validators than the contract
+	extra, _ := types.ExtractIstanbulExtra(genesis.Header())
+	if extra.Validators[0] == validators[0] {
+		t.Fatal("expected the genesis validators to differ from the contract validators")
+	}
+
+	valSet := engine.ParentValidators(makeBlockWithoutSeal(chain, engine, genesis))
+	if addrs := validatorAddresses(valSet); len(addrs) != 1 || addrs[0] != validators[0] {
+		t.Errorf("parent validators mismatch: have %v, want %v", addrs, validators)
+	}
+
+	api := &API{chain: chain, istanbul: engine}
+	number := rpc.BlockNumber(0)
+	if addrs, err := api.GetValidators(&number); err != nil || len(addrs) != 1 || addrs[0] != validators[0] {
+		t.Errorf("api validators mismatch: have %v %v, want %v", addrs, err, validators)
+	}
+
+	// votes are no longer cast once the contract is in force
+	engine.candidates[common.HexToAddress("0x1")] = true
+	block := makeBlock(chain, engine, genesis)
+	header := block.Header()
+	if header.Coinbase != (common.Address{}) || header.Nonce != emptyNonce {
+		t.Errorf("expected no vote, have coinbase %v and nonce %v", header.Coinbase, header.Nonce)
+	}
+	if extra, _ := types.ExtractIstanbulExtra(header); len(extra.Validators) != 1 || extra.Validators[0] != validators[0] {
+		t.Errorf("extra-data validators mismatch: have %v, want %v", extra.Validators, validators)
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert the block sealed by the contract validator: %v", err)
+	}
+
+	header.Coinbase = common.HexToAddress("0x1")
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidVote {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidVote)
+	}
+
+	// the extra-data lists other validators than the contract
+	header = block.Header()
+	header.Extra, _ = prepareExtra(header, extra.Validators)
+	if err := engine.VerifyParentState(chain, block.WithSeal(header)); err != errInvalidValidators {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidValidators)
+	}
+}
+
+// headerChain hides the state of the chain, as a header chain keeps none
+type headerChain struct {
+	consensus.ChainHeaderReader
+}
+
+func TestValidatorContractHeaderChain(t *testing.T) {
+	chain, engine, validators := newValidatorContractChain(t, *istanbul.DefaultConfig)
+	block := makeBlock(chain, engine, chain.Genesis())
+
+	// the header is verified against the validators in its extra-data, which
+	// are checked against the contract once the parent state is available
+	engine.contractValidatorSets.Purge()
+	if err := engine.VerifyHeader(headerChain{chain}, block.Header(), true); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if err := engine.VerifyParentState(chain, block); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// once the contract was read at the parent, the validators listed in the
+	// extra-data of the header are no longer used
+	header := block.Header()
+	extra, _ := types.ExtractIstanbulExtra(chain.Genesis().Header())
+	header.Extra, _ = prepareExtra(header, extra.Validators)
+	valSet, err := engine.headerValidatorSet(headerChain{chain}, header, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addrs := validatorAddresses(valSet); len(addrs) != 1 || addrs[0] != validators[0] {
+		t.Errorf("validators mismatch: have %v, want %v", addrs, validators)
+	}
+	if err := engine.VerifyParentState(chain, block.WithSeal(header)); err != errInvalidValidators {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidValidators)
+	}
+}
+
+func TestValidatorContractQBFT(t *testing.T) {
+	config := *istanbul.DefaultConfig
+	config.QbftBlock = big.NewInt(1)
+	chain, engine, validators := newValidatorContractChain(t, config)
+
+	engine.candidates[common.HexToAddress("0x1")] = true
+	block := makeBlock(chain, engine, chain.Genesis())
+	extra, err := types.ExtractQBFTExtra(block.Header())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if extra.Vote != nil {
+		t.Errorf("expected no vote, have %v", extra.Vote)
+	}
+	if len(extra.Validators) != 1 || extra.Validators[0] != validators[0] {
+		t.Errorf("extra-data validators mismatch: have %v, want %v", extra.Validators, validators)
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert the block sealed by the contract validator: %v", err)
+	}
+}
+
+// makeBlockWithTransfer returns a sealed block with a transfer from the given
+// key, so that the state of the block differs from the state of its parent
+func makeBlockWithTransfer(t *testing.T, chain *core.BlockChain, engine *backend, parent *types.Block, key *ecdsa.PrivateKey) *types.Block {
+	header := makeHeader(parent, engine.config)
+	if err := engine.Prepare(chain, header); err != nil {
+		t.Fatal(err)
+	}
+	statedb, privateState, err := chain.StateAt(parent.Root())
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	tx, err := types.SignTx(types.NewTransaction(statedb.GetNonce(from), common.HexToAddress("0x1"), big.NewInt(1), params.TxGas, big.NewInt(0), nil), types.HomesteadSigner{}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gp := new(core.GasPool).AddGas(header.GasLimit)
+	receipt, _, err := core.ApplyTransaction(chain.Config(), chain, nil, gp, statedb, privateState, header, tx, &header.GasUsed, vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := engine.FinalizeAndAssemble(chain, header, statedb, types.Transactions{tx}, nil, types.Receipts{receipt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sealBlock(chain, engine, block)
+}
+
+// a batch of blocks is verified against the state of the blocks imported before
+// them in the same batch
+func TestValidatorContractBatchImport(t *testing.T) {
+	genesis, keys, validators := newValidatorContractGenesis()
+	genesis.Alloc[validators[0]] = core.GenesisAccount{Balance: big.NewInt(params.Ether)}
+	genesis.GasLimit = params.GenesisGasLimit * 2000
+	config := *istanbul.DefaultConfig
+	config.BlockPeriod = 0
+	config.ValidatorContract = testValidatorContract
+	config.ValidatorContractBlock = big.NewInt(1)
+
+	// seal the blocks on one chain, one after the other
+	sealer, engine := newBlockChainFromGenesis(genesis, keys, &config)
+	var blocks types.Blocks
+	parent := sealer.Genesis()
+	for i := 0; i < 3; i++ {
+		block := makeBlockWithTransfer(t, sealer, engine, parent, keys[0])
+		if _, err := sealer.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("failed to insert block %d: %v", block.NumberU64(), err)
+		}
+		engine.NewChainHead()
+		blocks = append(blocks, block)
+		parent = block
+	}
+
+	// and import them at once on another
+	chain, _ := newBlockChainFromGenesis(genesis, keys, &config)
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to import the batch of blocks: %v", err)
+	}
+	if head := chain.CurrentBlock().NumberU64(); head != 3 {
+		t.Errorf("head mismatch: have %d, want 3", head)
+	}
+}
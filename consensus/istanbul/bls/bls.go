@@ -0,0 +1,297 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bls implements the BLS signatures aggregated into the committed
+// seals of Istanbul blocks. Signatures are points of G1 and public keys points
+// of G2 of the BLS12-381 curve, so that the aggregated seal of a header is as
+// short as possible. Public keys are registered along with a proof of
+// possession of their secret key, which prevents rogue key attacks on
+// aggregated signatures of the same message.
+package bls
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+const (
+	SecretKeyLength = 32  // Length of a serialized secret key
+	PublicKeyLength = 192 // Length of an uncompressed G2 public key
+	SignatureLength = 96  // Length of an uncompressed G1 signature
+)
+
+// Domain separation tags of the hashes to G1, following the proof of
+// possession scheme of the IETF BLS signature draft
+var (
+	signatureDST  = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+	possessionDST = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+var (
+	errInvalidSecretKey  = errors.New("invalid BLS secret key")
+	errInvalidPublicKey  = errors.New("invalid BLS public key")
+	errInvalidSignature  = errors.New("invalid BLS signature")
+	errInvalidPossession = errors.New("invalid BLS proof of possession")
+)
+
+// fieldModulus is the modulus of the base field of BLS12-381
+var fieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+// SecretKey is a BLS secret key, a non-zero scalar of the curve group.
+type SecretKey struct {
+	scalar *big.Int
+}
+
+// PublicKey is a BLS public key, or an aggregation of public keys.
+type PublicKey struct {
+	point *bls12381.PointG2
+}
+
+// Signature is a BLS signature, or an aggregation of signatures.
+type Signature struct {
+	point *bls12381.PointG1
+}
+
+// GenerateKey generates a new secret key. The key is independent of the node
+// key of the validator, so that a leaked node key does not give away its BLS
+// signatures, nor the other way round.
+func GenerateKey() (*SecretKey, error) {
+	q := bls12381.NewG1().Q()
+	for {
+		// reducing 48 random bytes keeps the bias of the scalar negligible
+		seed := make([]byte, 48)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+		if scalar := new(big.Int).Mod(new(big.Int).SetBytes(seed), q); scalar.Sign() != 0 {
+			return &SecretKey{scalar: scalar}, nil
+		}
+	}
+}
+
+// LoadKey loads a hex-encoded secret key from the given file.
+func LoadKey(file string) (*SecretKey, error) {
+	blob, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(strings.TrimSpace(string(blob)))
+	if err != nil {
+		return nil, errInvalidSecretKey
+	}
+	return SecretKeyFromBytes(b)
+}
+
+// SaveKey saves a secret key to the given file with restrictive permissions.
+// The key is saved hex-encoded.
+func SaveKey(file string, key *SecretKey) error {
+	return ioutil.WriteFile(file, []byte(hex.EncodeToString(key.Bytes())), 0600)
+}
+
+// SecretKeyFromBytes decodes a serialized secret key.
+func SecretKeyFromBytes(b []byte) (*SecretKey, error) {
+	if len(b) != SecretKeyLength {
+		return nil, errInvalidSecretKey
+	}
+	scalar := new(big.Int).SetBytes(b)
+	if scalar.Sign() == 0 || scalar.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, errInvalidSecretKey
+	}
+	return &SecretKey{scalar: scalar}, nil
+}
+
+// Bytes serializes the secret key.
+func (sk *SecretKey) Bytes() []byte {
+	b := make([]byte, SecretKeyLength)
+	return sk.scalar.FillBytes(b)
+}
+
+// PublicKey returns the public key of the secret key.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	g2 := bls12381.NewG2()
+	return &PublicKey{point: g2.MulScalar(g2.New(), g2.One(), sk.scalar)}
+}
+
+// Sign signs the given message.
+func (sk *SecretKey) Sign(msg []byte) *Signature {
+	return sk.sign(msg, signatureDST)
+}
+
+// ProvePossession signs the public key of the secret key, proving that the
+// owner of the public key has its secret key.
+func (sk *SecretKey) ProvePossession() *Signature {
+	return sk.sign(sk.PublicKey().Bytes(), possessionDST)
+}
+
+func (sk *SecretKey) sign(msg []byte, dst []byte) *Signature {
+	g1 := bls12381.NewG1()
+	h := hashToG1(msg, dst)
+	return &Signature{point: g1.MulScalar(h, h, sk.scalar)}
+}
+
+// PublicKeyFromBytes decodes an uncompressed public key, rejecting the points
+// which are not valid public keys.
+func PublicKeyFromBytes(b []byte) (*PublicKey, error) {
+	g2 := bls12381.NewG2()
+	point, err := g2.FromBytes(b)
+	if err != nil || g2.IsZero(point) || !g2.InCorrectSubgroup(point) {
+		return nil, errInvalidPublicKey
+	}
+	return &PublicKey{point: point}, nil
+}
+
+// Bytes serializes the public key uncompressed.
+func (pk *PublicKey) Bytes() []byte {
+	return bls12381.NewG2().ToBytes(pk.point)
+}
+
+// VerifyPossession checks the proof that the owner of the public key has its
+// secret key.
+func (pk *PublicKey) VerifyPossession(proof *Signature) bool {
+	return verify(pk, pk.Bytes(), proof, possessionDST)
+}
+
+// VerifyRegistration decodes a serialized public key registered along with the
+// proof of possession of its secret key, checking the proof.
+func VerifyRegistration(publicKey []byte, proof []byte) (*PublicKey, error) {
+	pk, err := PublicKeyFromBytes(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := SignatureFromBytes(proof)
+	if err != nil || !pk.VerifyPossession(sig) {
+		return nil, errInvalidPossession
+	}
+	return pk, nil
+}
+
+// AggregatePublicKeys adds up the given public keys into the key verifying
+// the aggregation of their signatures of a message.
+func AggregatePublicKeys(pks []*PublicKey) *PublicKey {
+	g2 := bls12381.NewG2()
+	aggregate := g2.Zero()
+	for _, pk := range pks {
+		g2.Add(aggregate, aggregate, pk.point)
+	}
+	return &PublicKey{point: aggregate}
+}
+
+// SignatureFromBytes decodes an uncompressed signature, rejecting the points
+// which are not valid signatures.
+func SignatureFromBytes(b []byte) (*Signature, error) {
+	g1 := bls12381.NewG1()
+	point, err := g1.FromBytes(b)
+	if err != nil || g1.IsZero(point) || !g1.InCorrectSubgroup(point) {
+		return nil, errInvalidSignature
+	}
+	return &Signature{point: point}, nil
+}
+
+// Bytes serializes the signature uncompressed.
+func (sig *Signature) Bytes() []byte {
+	return bls12381.NewG1().ToBytes(sig.point)
+}
+
+// Verify checks that the signature is a signature of the message by the
+// secret key of the given public key. An aggregated signature of a message is
+// verified against the aggregation of the public keys of its signers.
+func (sig *Signature) Verify(pk *PublicKey, msg []byte) bool {
+	return verify(pk, msg, sig, signatureDST)
+}
+
+// AggregateSignatures adds up the given signatures of a message into a single
+// signature.
+func AggregateSignatures(sigs []*Signature) *Signature {
+	g1 := bls12381.NewG1()
+	aggregate := g1.Zero()
+	for _, sig := range sigs {
+		g1.Add(aggregate, aggregate, sig.point)
+	}
+	return &Signature{point: aggregate}
+}
+
+// verify checks that e(H(msg), pk) == e(sig, G2), the pairing engine
+// computing the product e(H(msg), pk) * e(-sig, G2) of the pairs.
+func verify(pk *PublicKey, msg []byte, sig *Signature, dst []byte) bool {
+	engine := bls12381.NewPairingEngine()
+	if engine.G2.IsZero(pk.point) || engine.G1.IsZero(sig.point) {
+		return false
+	}
+	// the engine converts the points to affine coordinates and negates the
+	// signature in place
+	pkPoint := new(bls12381.PointG2).Set(pk.point)
+	sigPoint := new(bls12381.PointG1).Set(sig.point)
+	engine.AddPair(hashToG1(msg, dst), pkPoint)
+	engine.AddPairInv(sigPoint, engine.G2.One())
+	return engine.Check()
+}
+
+// hashToG1 hashes a message to a point of G1, mapping two field elements
+// hashed from the message to the curve and adding them up.
+func hashToG1(msg []byte, dst []byte) *bls12381.PointG1 {
+	g1 := bls12381.NewG1()
+	uniform := expandMessageXMD(msg, dst, 128)
+	point := g1.Zero()
+	for i := 0; i < 2; i++ {
+		u := new(big.Int).Mod(new(big.Int).SetBytes(uniform[i*64:(i+1)*64]), fieldModulus)
+		mapped, err := g1.MapToCurve(u.FillBytes(make([]byte, 48)))
+		if err != nil {
+			// the field element is reduced, so it can always be mapped
+			panic(err)
+		}
+		g1.Add(point, point, mapped)
+	}
+	return point
+}
+
+// expandMessageXMD expands a message into length uniform bytes using SHA-256,
+// as defined by the IETF hash to curve draft.
+func expandMessageXMD(msg []byte, dst []byte, length int) []byte {
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, h.BlockSize()))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	var out, prev []byte
+	for i := 1; len(out) < length; i++ {
+		h.Reset()
+		if prev == nil {
+			h.Write(b0)
+		} else {
+			mixed := make([]byte, len(b0))
+			for j := range mixed {
+				mixed[j] = b0[j] ^ prev[j]
+			}
+			h.Write(mixed)
+		}
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		prev = h.Sum(nil)
+		out = append(out, prev...)
+	}
+	return out[:length]
+}
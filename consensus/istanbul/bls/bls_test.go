@@ -0,0 +1,181 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newTestKey(t *testing.T) *SecretKey {
+	sk, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk
+}
+
+func TestExpandMessageXMD(t *testing.T) {
+	// test vectors of expand_message_xmd with SHA-256 from the IETF hash to curve draft
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	tests := []struct {
+		msg    string
+		length int
+		want   string
+	}{
+		{"", 0x20, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", 0x20, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, test := range tests {
+		if have := hex.EncodeToString(expandMessageXMD([]byte(test.msg), dst, test.length)); have != test.want {
+			t.Errorf("msg %q: expanded message mismatch: have %s, want %s", test.msg, have, test.want)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	sk := newTestKey(t)
+	pk := sk.PublicKey()
+	msg := []byte("committed seal")
+
+	sig := sk.Sign(msg)
+	if !sig.Verify(pk, msg) {
+		t.Errorf("valid signature rejected")
+	}
+	if sig.Verify(pk, []byte("other seal")) {
+		t.Errorf("signature of another message accepted")
+	}
+	if sig.Verify(newTestKey(t).PublicKey(), msg) {
+		t.Errorf("signature of another key accepted")
+	}
+
+	// serialization round trips
+	decodedPK, err := PublicKeyFromBytes(pk.Bytes())
+	if err != nil {
+		t.Fatalf("failed to decode the public key: %v", err)
+	}
+	decodedSig, err := SignatureFromBytes(sig.Bytes())
+	if err != nil {
+		t.Fatalf("failed to decode the signature: %v", err)
+	}
+	if !decodedSig.Verify(decodedPK, msg) {
+		t.Errorf("decoded signature rejected")
+	}
+	decodedSK, err := SecretKeyFromBytes(sk.Bytes())
+	if err != nil {
+		t.Fatalf("failed to decode the secret key: %v", err)
+	}
+	if !bytes.Equal(decodedSK.PublicKey().Bytes(), pk.Bytes()) {
+		t.Errorf("decoded secret key mismatch")
+	}
+
+	// invalid encodings
+	if _, err := PublicKeyFromBytes(make([]byte, PublicKeyLength)); err != errInvalidPublicKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidPublicKey)
+	}
+	if _, err := SignatureFromBytes(sig.Bytes()[1:]); err != errInvalidSignature {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidSignature)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	msg := []byte("committed seal")
+	var (
+		pks  []*PublicKey
+		sigs []*Signature
+	)
+	for i := 0; i < 4; i++ {
+		sk := newTestKey(t)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sk.Sign(msg))
+	}
+
+	aggregate := AggregateSignatures(sigs)
+	if !aggregate.Verify(AggregatePublicKeys(pks), msg) {
+		t.Errorf("valid aggregated signature rejected")
+	}
+	if aggregate.Verify(AggregatePublicKeys(pks[1:]), msg) {
+		t.Errorf("aggregated signature accepted without one of its signers")
+	}
+	if AggregateSignatures(sigs[1:]).Verify(AggregatePublicKeys(pks), msg) {
+		t.Errorf("aggregated signature accepted with a missing signature")
+	}
+}
+
+func TestPossession(t *testing.T) {
+	sk := newTestKey(t)
+	pk := sk.PublicKey()
+	if !pk.VerifyPossession(sk.ProvePossession()) {
+		t.Errorf("valid proof of possession rejected")
+	}
+	if pk.VerifyPossession(newTestKey(t).ProvePossession()) {
+		t.Errorf("proof of possession of another key accepted")
+	}
+	// a signature of the public key is not a proof of possession
+	if pk.VerifyPossession(sk.Sign(pk.Bytes())) {
+		t.Errorf("signature accepted as a proof of possession")
+	}
+}
+
+func TestVerifyRegistration(t *testing.T) {
+	sk := newTestKey(t)
+	publicKey, proof := sk.PublicKey().Bytes(), sk.ProvePossession().Bytes()
+	pk, err := VerifyRegistration(publicKey, proof)
+	if err != nil {
+		t.Fatalf("valid registration rejected: %v", err)
+	}
+	if !bytes.Equal(pk.Bytes(), publicKey) {
+		t.Errorf("public key mismatch: have %x, want %x", pk.Bytes(), publicKey)
+	}
+	if _, err := VerifyRegistration(publicKey, newTestKey(t).ProvePossession().Bytes()); err != errInvalidPossession {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidPossession)
+	}
+	if _, err := VerifyRegistration(publicKey[1:], proof); err != errInvalidPublicKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidPublicKey)
+	}
+}
+
+func TestSaveLoadKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "blskey")
+
+	sk := newTestKey(t)
+	if err := SaveKey(file, sk); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadKey(file)
+	if err != nil {
+		t.Fatalf("failed to load the key: %v", err)
+	}
+	if !bytes.Equal(loaded.Bytes(), sk.Bytes()) {
+		t.Errorf("key mismatch: have %x, want %x", loaded.Bytes(), sk.Bytes())
+	}
+	if err := ioutil.WriteFile(file, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadKey(file); err != errInvalidSecretKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidSecretKey)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
+)
+
+// Backlogs holds the future messages of each validator, ordered by priority.
+// The core serialises the access to the backlogs.
+type Backlogs map[common.Address]*prque.Prque
+
+// Store queues a future message of the validator
+func (b Backlogs) Store(src common.Address, msg *Message, priority float32) {
+	backlog := b[src]
+	if backlog == nil {
+		backlog = prque.New()
+		b[src] = backlog
+	}
+	backlog.Push(msg, priority)
+}
+
+// Process posts the backlogged messages which are no longer future messages
+// and drops the invalid and old ones. The messages of each validator are
+// processed by priority up to the first one which is still a future message.
+// The backlogs of addresses which are not in the validator set are dropped.
+func (b Backlogs) Process(valSet istanbul.ValidatorSet, logger log.Logger, messageView func(*Message) *istanbul.View, checkMessage func(uint64, *istanbul.View) error, post func(BacklogEvent)) {
+	for srcAddress, backlog := range b {
+		if backlog == nil {
+			continue
+		}
+		_, src := valSet.GetByAddress(srcAddress)
+		if src == nil {
+			// validator is not available
+			delete(b, srcAddress)
+			continue
+		}
+		logger := logger.New("from", src)
+		isFuture := false
+
+		// We stop processing if
+		//   1. backlog is empty
+		//   2. The first message in queue is a future message
+		for !(backlog.Empty() || isFuture) {
+			m, prio := backlog.Pop()
+			msg := m.(*Message)
+			view := messageView(msg)
+			if view == nil {
+				logger.Debug("Nil view", "msg", msg)
+				continue
+			}
+			// Push back if it's a future message
+			err := checkMessage(msg.Code, view)
+			if err != nil {
+				if err == ErrFutureMessage {
+					logger.Trace("Stop processing backlog", "msg", msg)
+					backlog.Push(msg, prio)
+					isFuture = true
+					break
+				}
+				logger.Trace("Skip the backlog event", "msg", msg, "err", err)
+				continue
+			}
+			logger.Trace("Post backlog event", "msg", msg)
+
+			post(BacklogEvent{
+				Src: src,
+				Msg: msg,
+			})
+		}
+	}
+}
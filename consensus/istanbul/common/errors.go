@@ -0,0 +1,35 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import "errors"
+
+var (
+	// ErrInconsistentSubject is returned when received subject is different from
+	// current subject.
+	ErrInconsistentSubject = errors.New("inconsistent subjects")
+	// ErrFutureMessage is returned when current view is earlier than the
+	// view of the received message.
+	ErrFutureMessage = errors.New("future message")
+	// ErrOldMessage is returned when the received message's view is earlier
+	// than current view.
+	ErrOldMessage = errors.New("old message")
+	// ErrInvalidMessage is returned when the message is malformed.
+	ErrInvalidMessage = errors.New("invalid message")
+	// ErrInvalidSigner is returned when the message is signed by a validator different than message sender
+	ErrInvalidSigner = errors.New("message not signed by the sender")
+)
@@ -0,0 +1,70 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// SubscribeEvents subscribes to the external and internal events handled by a
+// core: the request, message and backlog events, the round timeouts and the
+// final committed proposals
+func SubscribeEvents(mux *event.TypeMux) (events, timeoutSub, finalCommittedSub *event.TypeMuxSubscription) {
+	events = mux.Subscribe(
+		// external events
+		istanbul.RequestEvent{},
+		istanbul.MessageEvent{},
+		// internal events
+		BacklogEvent{},
+	)
+	timeoutSub = mux.Subscribe(
+		TimeoutEvent{},
+	)
+	finalCommittedSub = mux.Subscribe(
+		istanbul.FinalCommittedEvent{},
+	)
+	return events, timeoutSub, finalCommittedSub
+}
+
+// DecodeMessage decodes a message from its payload, checks its signature and
+// that its sender is in the validator set
+func DecodeMessage(payload []byte, validateFn func([]byte, []byte) (common.Address, error), valSet istanbul.ValidatorSet) (*Message, istanbul.Validator, error) {
+	msg := new(Message)
+	if err := msg.FromPayload(payload, validateFn); err != nil {
+		return nil, nil, err
+	}
+
+	// Only accept message if the address is valid
+	_, src := valSet.GetByAddress(msg.Address)
+	if src == nil {
+		return msg, nil, istanbul.ErrUnauthorizedAddress
+	}
+	return msg, src, nil
+}
+
+// VerifySubject verifies that the subject of a PREPARE or COMMIT message is
+// equivalent to the current subject
+func VerifySubject(expected, got *istanbul.Subject) error {
+	if !reflect.DeepEqual(expected, got) {
+		return ErrInconsistentSubject
+	}
+	return nil
+}
@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Lesser General Public License
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
-package core
+package common
 
 import (
 	"fmt"
@@ -27,32 +27,32 @@ import (
 )
 
 // Construct a new message set to accumulate messages for given sequence/view number.
-func newMessageSet(valSet istanbul.ValidatorSet) *messageSet {
-	return &messageSet{
+func NewMessageSet(valSet istanbul.ValidatorSet) *MessageSet {
+	return &MessageSet{
 		view: &istanbul.View{
 			Round:    new(big.Int),
 			Sequence: new(big.Int),
 		},
 		messagesMu: new(sync.Mutex),
-		messages:   make(map[common.Address]*message),
+		messages:   make(map[common.Address]*Message),
 		valSet:     valSet,
 	}
 }
 
 // ----------------------------------------------------------------------------
 
-type messageSet struct {
+type MessageSet struct {
 	view       *istanbul.View
 	valSet     istanbul.ValidatorSet
 	messagesMu *sync.Mutex
-	messages   map[common.Address]*message
+	messages   map[common.Address]*Message
 }
 
-func (ms *messageSet) View() *istanbul.View {
+func (ms *MessageSet) View() *istanbul.View {
 	return ms.view
 }
 
-func (ms *messageSet) Add(msg *message) error {
+func (ms *MessageSet) Add(msg *Message) error {
 	ms.messagesMu.Lock()
 	defer ms.messagesMu.Unlock()
 
@@ -63,7 +63,7 @@ func (ms *messageSet) Add(msg *message) error {
 	return ms.addVerifiedMessage(msg)
 }
 
-func (ms *messageSet) Values() (result []*message) {
+func (ms *MessageSet) Values() (result []*Message) {
 	ms.messagesMu.Lock()
 	defer ms.messagesMu.Unlock()
 
@@ -74,13 +74,13 @@ func (ms *messageSet) Values() (result []*message) {
 	return result
 }
 
-func (ms *messageSet) Size() int {
+func (ms *MessageSet) Size() int {
 	ms.messagesMu.Lock()
 	defer ms.messagesMu.Unlock()
 	return len(ms.messages)
 }
 
-func (ms *messageSet) Get(addr common.Address) *message {
+func (ms *MessageSet) Get(addr common.Address) *Message {
 	ms.messagesMu.Lock()
 	defer ms.messagesMu.Unlock()
 	return ms.messages[addr]
@@ -88,7 +88,7 @@ func (ms *messageSet) Get(addr common.Address) *message {
 
 // ----------------------------------------------------------------------------
 
-func (ms *messageSet) verify(msg *message) error {
+func (ms *MessageSet) verify(msg *Message) error {
 	// verify if the message comes from one of the validators
 	if _, v := ms.valSet.GetByAddress(msg.Address); v == nil {
 		return istanbul.ErrUnauthorizedAddress
@@ -99,12 +99,12 @@ func (ms *messageSet) verify(msg *message) error {
 	return nil
 }
 
-func (ms *messageSet) addVerifiedMessage(msg *message) error {
+func (ms *MessageSet) addVerifiedMessage(msg *Message) error {
 	ms.messages[msg.Address] = msg
 	return nil
 }
 
-func (ms *messageSet) String() string {
+func (ms *MessageSet) String() string {
 	ms.messagesMu.Lock()
 	defer ms.messagesMu.Unlock()
 	addresses := make([]string, 0, len(ms.messages))
@@ -0,0 +1,77 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/log"
+	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
+)
+
+// CheckRequestMsg checks the request against the current sequence
+// return ErrInvalidMessage if the message is invalid
+// return ErrFutureMessage if the sequence of proposal is larger than current sequence
+// return ErrOldMessage if the sequence of proposal is smaller than current sequence
+func CheckRequestMsg(request *istanbul.Request, sequence *big.Int) error {
+	if request == nil || request.Proposal == nil {
+		return ErrInvalidMessage
+	}
+
+	if c := sequence.Cmp(request.Proposal.Number()); c > 0 {
+		return ErrOldMessage
+	} else if c < 0 {
+		return ErrFutureMessage
+	} else {
+		return nil
+	}
+}
+
+// StoreRequestMsg queues a request for a future sequence, lowest sequence first
+func StoreRequestMsg(pendingRequests *prque.Prque, request *istanbul.Request) {
+	pendingRequests.Push(request, float32(-request.Proposal.Number().Int64()))
+}
+
+// ProcessPendingRequests posts the pending requests for the current sequence
+// and drops the old ones. It stops at the first request for a future sequence.
+func ProcessPendingRequests(pendingRequests *prque.Prque, sequence *big.Int, logger log.Logger, post func(istanbul.RequestEvent)) {
+	for !(pendingRequests.Empty()) {
+		m, prio := pendingRequests.Pop()
+		r, ok := m.(*istanbul.Request)
+		if !ok {
+			logger.Warn("Malformed request, skip", "msg", m)
+			continue
+		}
+		// Push back if it's a future message
+		err := CheckRequestMsg(r, sequence)
+		if err != nil {
+			if err == ErrFutureMessage {
+				logger.Trace("Stop processing request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash())
+				pendingRequests.Push(m, prio)
+				break
+			}
+			logger.Trace("Skip the pending request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash(), "err", err)
+			continue
+		}
+		logger.Trace("Post pending request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash())
+
+		post(istanbul.RequestEvent{
+			Proposal: r.Proposal,
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// RoundHistorySize is the number of latest rounds whose history a core keeps
+const RoundHistorySize = 1024
+
+// RoundHistory records the start, end and timeout of the latest rounds of a
+// consensus core. It is safe for concurrent use.
+type RoundHistory struct {
+	mu     sync.Mutex
+	size   int
+	rounds []istanbul.RoundInfo
+}
+
+// NewRoundHistory creates a history keeping the given number of latest rounds
+func NewRoundHistory(size int) *RoundHistory {
+	return &RoundHistory{size: size}
+}
+
+// Start records the start of the round with the given view, ending the
+// running round. Restarting the timer of the running round only updates its
+// timeout.
+func (h *RoundHistory) Start(view *istanbul.View, timeout time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := time.Now()
+	if n := len(h.rounds); n > 0 {
+		last := &h.rounds[n-1]
+		if last.End.IsZero() && last.View.Cmp(view) == 0 {
+			last.Timeout = timeout
+			return
+		}
+		if last.End.IsZero() {
+			last.End = now
+		}
+	}
+	if len(h.rounds) == h.size {
+		h.rounds = append(h.rounds[:0], h.rounds[1:]...)
+	}
+	h.rounds = append(h.rounds, istanbul.RoundInfo{
+		View: &istanbul.View{
+			Sequence: new(big.Int).Set(view.Sequence),
+			Round:    new(big.Int).Set(view.Round),
+		},
+		Start:   now,
+		Timeout: timeout,
+	})
+}
+
+// TimedOut records that the timeout of the running round expired
+func (h *RoundHistory) TimedOut() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if n := len(h.rounds); n > 0 && h.rounds[n-1].End.IsZero() {
+		h.rounds[n-1].TimedOut = true
+	}
+}
+
+// Stop ends the running round, if any
+func (h *RoundHistory) Stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if n := len(h.rounds); n > 0 && h.rounds[n-1].End.IsZero() {
+		h.rounds[n-1].End = time.Now()
+	}
+}
+
+// Rounds returns the recorded rounds, the oldest first
+func (h *RoundHistory) Rounds() []istanbul.RoundInfo {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]istanbul.RoundInfo(nil), h.rounds...)
+}
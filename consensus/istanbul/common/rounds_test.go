@@ -0,0 +1,65 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func testView(sequence, round int64) *istanbul.View {
+	return &istanbul.View{Sequence: big.NewInt(sequence), Round: big.NewInt(round)}
+}
+
+func TestRoundHistory(t *testing.T) {
+	h := NewRoundHistory(2)
+
+	h.Start(testView(1, 0), time.Second)
+	// restarting the timer of the running round keeps its start
+	start := h.Rounds()[0].Start
+	h.Start(testView(1, 0), 2*time.Second)
+	if rounds := h.Rounds(); len(rounds) != 1 || rounds[0].Start != start || rounds[0].Timeout != 2*time.Second {
+		t.Fatalf("rounds mismatch: have %+v, want round 0 started at %v with a timeout of 2s", rounds, start)
+	}
+
+	h.TimedOut()
+	h.Start(testView(1, 1), 3*time.Second)
+	rounds := h.Rounds()
+	if len(rounds) != 2 {
+		t.Fatalf("the number of rounds mismatch: have %d, want 2", len(rounds))
+	}
+	if !rounds[0].TimedOut || rounds[0].End.IsZero() || rounds[0].End.Before(rounds[0].Start) {
+		t.Errorf("round mismatch: have %+v, want the timed out round 0", rounds[0])
+	}
+	if rounds[1].View.Cmp(testView(1, 1)) != 0 || rounds[1].TimedOut || !rounds[1].End.IsZero() {
+		t.Errorf("round mismatch: have %+v, want the running round 1", rounds[1])
+	}
+
+	// only the latest rounds are kept
+	h.Start(testView(2, 0), time.Second)
+	h.Stop()
+	rounds = h.Rounds()
+	if len(rounds) != 2 || rounds[0].View.Cmp(testView(1, 1)) != 0 || rounds[1].View.Cmp(testView(2, 0)) != 0 {
+		t.Fatalf("rounds mismatch: have %+v, want rounds 1 of block 1 and 0 of block 2", rounds)
+	}
+	if rounds[1].End.IsZero() {
+		t.Errorf("expected the round to be ended once the history is stopped")
+	}
+}
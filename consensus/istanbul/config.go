@@ -16,7 +16,14 @@
 
 package istanbul
 
-import "math/big"
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
 
 type ProposerPolicy uint64
 
@@ -32,6 +39,24 @@ type Config struct {
 	Epoch                  uint64         `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
 	Ceil2Nby3Block         *big.Int       `toml:",omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
 	AllowedFutureBlockTime uint64         `toml:",omitempty"` // Max time (in seconds) from current time allowed for blocks, before they're considered future blocks
+	QbftBlock              *big.Int       `toml:",omitempty"` // Block number from which blocks are sealed using QBFT instead of IBFT, nil if never
+	ValidatorContract      common.Address `toml:",omitempty"` // Validator-management contract listing the validators from ValidatorContractBlock on
+	ValidatorContractBlock *big.Int       `toml:",omitempty"` // Block number from which validators are read from ValidatorContract instead of header votes, nil if never
+
+	Transitions []params.Transition `toml:"-"` // Changes to the configuration from given block numbers on, as set in the genesis
+
+	BlockReward            *big.Int       `toml:",omitempty"` // Reward in wei for sealing a block, nil if blocks are not rewarded
+	BlockRewardBeneficiary common.Address `toml:",omitempty"` // Account receiving the block rewards, the proposer of each block if empty
+	BlockRewardBlock       *big.Int       `toml:",omitempty"` // Block number from which blocks are rewarded, nil if from the genesis
+
+	RoundTimeoutStrategy string `toml:",omitempty"` // How the timeout grows with the round, one of params.ExponentialTimeout, params.LinearTimeout or params.FixedTimeout
+	MaxRequestTimeout    uint64 `toml:",omitempty"` // Maximum timeout of a round in milliseconds, no maximum if 0
+
+	EmptyBlockPeriod uint64 `toml:",omitempty"` // Minimum difference between the timestamps of an empty block and its parent in seconds, unused if not above BlockPeriod
+
+	BLSBlock   *big.Int                         `toml:"-"`          // Block number from which committed seals are aggregated BLS signatures, nil if never
+	BLSKeys    map[common.Address]params.BLSKey `toml:"-"`          // BLS keys registered for the validators by the transitions
+	BLSKeyFile string                           `toml:",omitempty"` // File holding the BLS key of the node, generated if missing, an ephemeral key if empty
 }
 
 var DefaultConfig = &Config{
@@ -42,3 +67,145 @@ var DefaultConfig = &Config{
 	Ceil2Nby3Block:         big.NewInt(0),
 	AllowedFutureBlockTime: 0,
 }
+
+// IsQBFTConsensusAt reports whether the block with the given number is sealed
+// using QBFT rather than IBFT.
+func (c *Config) IsQBFTConsensusAt(number *big.Int) bool {
+	return c.QbftBlock != nil && number != nil && c.QbftBlock.Cmp(number) <= 0
+}
+
+// UseValidatorContractAt reports whether the validators of the block with the
+// given number are read from the validator contract at the parent state rather
+// than tallied from the votes in the headers.
+func (c *Config) UseValidatorContractAt(number *big.Int) bool {
+	config := c.GetConfig(number)
+	return config.ValidatorContractBlock != nil && number != nil && config.ValidatorContractBlock.Cmp(number) <= 0
+}
+
+// UseBLSSealsAt reports whether the committed seals of the block with the given
+// number are aggregated into a BLS signature rather than ECDSA signatures.
+func (c *Config) UseBLSSealsAt(number *big.Int) bool {
+	config := c.GetConfig(number)
+	return config.BLSBlock != nil && number != nil && config.BLSBlock.Cmp(number) <= 0
+}
+
+// BlockRewardAt returns the reward for sealing the block with the given
+// number and the account receiving it, the proposer if empty. The reward is
+// nil if the block is not rewarded.
+func (c *Config) BlockRewardAt(number *big.Int) (*big.Int, common.Address) {
+	if number == nil || (c.BlockRewardBlock != nil && c.BlockRewardBlock.Cmp(number) > 0) {
+		return nil, common.Address{}
+	}
+	config := c.GetConfig(number)
+	if config.BlockReward == nil || config.BlockReward.Sign() <= 0 {
+		return nil, common.Address{}
+	}
+	return config.BlockReward, config.BlockRewardBeneficiary
+}
+
+// RoundChangeTimeout returns how long to wait for a block to be committed in
+// the given round before changing to the next round.
+func (c *Config) RoundChangeTimeout(round uint64) time.Duration {
+	// computed in float nanoseconds, which doesn't overflow on high rounds
+	timeout := float64(c.RequestTimeout) * float64(time.Millisecond)
+	switch c.RoundTimeoutStrategy {
+	case params.FixedTimeout:
+	case params.LinearTimeout:
+		timeout *= float64(round + 1)
+	default:
+		if round > 0 {
+			timeout += math.Pow(2, float64(round)) * float64(time.Second)
+		}
+	}
+	if c.MaxRequestTimeout > 0 {
+		timeout = math.Min(timeout, float64(c.MaxRequestTimeout)*float64(time.Millisecond))
+	}
+	// On top of that, the first round leaves the proposer the time to hold
+	// back an empty block
+	if round == 0 && c.EmptyBlockPeriod > c.BlockPeriod {
+		timeout += float64(c.EmptyBlockPeriod-c.BlockPeriod) * float64(time.Second)
+	}
+	if timeout >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(timeout)
+}
+
+// GetConfig returns the configuration in force at the block with the given
+// number, that is with the transitions up to that block applied.
+func (c *Config) GetConfig(number *big.Int) Config {
+	config := *c
+	if number == nil {
+		return config
+	}
+	for _, transition := range c.Transitions {
+		if transition.Block == nil {
+			continue
+		}
+		if transition.Block.Cmp(number) > 0 {
+			break
+		}
+		if transition.RequestTimeout != 0 {
+			config.RequestTimeout = transition.RequestTimeout
+		}
+		if transition.BlockPeriod != 0 {
+			config.BlockPeriod = transition.BlockPeriod
+		}
+		if transition.ProposerPolicy != nil {
+			config.ProposerPolicy = ProposerPolicy(*transition.ProposerPolicy)
+		}
+		if transition.Epoch != 0 {
+			config.Epoch = transition.Epoch
+		}
+		if transition.RoundTimeoutStrategy != "" {
+			config.RoundTimeoutStrategy = transition.RoundTimeoutStrategy
+		}
+		if transition.MaxRequestTimeout != 0 {
+			config.MaxRequestTimeout = transition.MaxRequestTimeout
+		}
+		if transition.EmptyBlockPeriod != 0 {
+			config.EmptyBlockPeriod = transition.EmptyBlockPeriod
+		}
+		if transition.ValidatorContractAddress != (common.Address{}) {
+			config.ValidatorContract = transition.ValidatorContractAddress
+		}
+		if transition.BlockReward != nil {
+			config.BlockReward = transition.BlockReward
+		}
+		if transition.BlockRewardBeneficiary != (common.Address{}) {
+			config.BlockRewardBeneficiary = transition.BlockRewardBeneficiary
+		}
+		if len(transition.BLSKeys) > 0 {
+			// keys registered by earlier transitions remain unless replaced
+			keys := make(map[common.Address]params.BLSKey, len(config.BLSKeys)+len(transition.BLSKeys))
+			for addr, key := range config.BLSKeys {
+				keys[addr] = key
+			}
+			for addr, key := range transition.BLSKeys {
+				keys[addr] = key
+			}
+			config.BLSKeys = keys
+		}
+		switch transition.CommittedSealScheme {
+		case params.BLSSealScheme:
+			if config.BLSBlock == nil || config.BLSBlock.Cmp(transition.Block) > 0 {
+				config.BLSBlock = transition.Block
+			}
+		case params.ECDSASealScheme:
+			if config.BLSBlock != nil && config.BLSBlock.Cmp(transition.Block) <= 0 {
+				config.BLSBlock = nil
+			}
+		}
+		switch transition.ValidatorSelectionMode {
+		case params.ContractMode:
+			if config.ValidatorContractBlock == nil || config.ValidatorContractBlock.Cmp(transition.Block) > 0 {
+				config.ValidatorContractBlock = transition.Block
+			}
+		case params.BlockHeaderMode:
+			if config.ValidatorContractBlock != nil && config.ValidatorContractBlock.Cmp(transition.Block) <= 0 {
+				config.ValidatorContractBlock = nil
+			}
+		}
+	}
+	return config
+}
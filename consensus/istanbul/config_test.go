@@ -0,0 +1,228 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package istanbul
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestGetConfig(t *testing.T) {
+	sticky := uint64(Sticky)
+	config := *DefaultConfig
+	config.Transitions = []params.Transition{
+		{Block: big.NewInt(10), BlockPeriod: 5, RequestTimeout: 20000},
+		{Block: big.NewInt(20), ProposerPolicy: &sticky, Epoch: 100},
+		{Block: big.NewInt(30), BlockPeriod: 2, EmptyBlockPeriod: 60},
+	}
+
+	tests := []struct {
+		number         int64
+		blockPeriod    uint64
+		requestTimeout uint64
+		policy         ProposerPolicy
+		epoch          uint64
+	}{
+		{0, 1, 10000, RoundRobin, 30000},
+		{9, 1, 10000, RoundRobin, 30000},
+		{10, 5, 20000, RoundRobin, 30000},
+		{20, 5, 20000, Sticky, 100},
+		{35, 2, 20000, Sticky, 100},
+	}
+	for _, test := range tests {
+		c := config.GetConfig(big.NewInt(test.number))
+		if c.BlockPeriod != test.blockPeriod || c.RequestTimeout != test.requestTimeout || c.ProposerPolicy != test.policy || c.Epoch != test.epoch {
+			t.Errorf("block %d: config mismatch: have period %d, timeout %d, policy %d, epoch %d, want %d, %d, %d, %d",
+				test.number, c.BlockPeriod, c.RequestTimeout, c.ProposerPolicy, c.Epoch, test.blockPeriod, test.requestTimeout, test.policy, test.epoch)
+		}
+	}
+
+	if have := config.GetConfig(big.NewInt(29)).EmptyBlockPeriod; have != 0 {
+		t.Errorf("empty block period mismatch before transition: have %d, want 0", have)
+	}
+	if have := config.GetConfig(big.NewInt(30)).EmptyBlockPeriod; have != 60 {
+		t.Errorf("empty block period mismatch after transition: have %d, want 60", have)
+	}
+
+	// transitions without a block number are ignored
+	config.Transitions = append([]params.Transition{{Epoch: 5}}, config.Transitions...)
+	if c := config.GetConfig(big.NewInt(20)); c.Epoch != 100 {
+		t.Errorf("epoch mismatch: have %d, want 100", c.Epoch)
+	}
+
+	// the base configuration is left as is
+	if config.BlockPeriod != 1 || config.ProposerPolicy != RoundRobin {
+		t.Errorf("base config changed: have period %d, policy %d", config.BlockPeriod, config.ProposerPolicy)
+	}
+}
+
+func TestUseValidatorContractAt(t *testing.T) {
+	contract := common.HexToAddress("0x1")
+	config := *DefaultConfig
+	config.Transitions = []params.Transition{
+		{Block: big.NewInt(10), ValidatorSelectionMode: params.ContractMode, ValidatorContractAddress: contract},
+		{Block: big.NewInt(20), ValidatorSelectionMode: params.BlockHeaderMode},
+	}
+
+	for _, test := range []struct {
+		number int64
+		want   bool
+	}{
+		{9, false}, {10, true}, {19, true}, {20, false},
+	} {
+		if have := config.UseValidatorContractAt(big.NewInt(test.number)); have != test.want {
+			t.Errorf("block %d: have %v, want %v", test.number, have, test.want)
+		}
+	}
+	if have := config.GetConfig(big.NewInt(15)).ValidatorContract; have != contract {
+		t.Errorf("validator contract mismatch: have %v, want %v", have, contract)
+	}
+
+	// a transition may bring the contract mode of the base configuration forward
+	config.ValidatorContractBlock = big.NewInt(15)
+	if !config.UseValidatorContractAt(big.NewInt(12)) {
+		t.Error("expected the validator contract to be in force from block 10")
+	}
+}
+
+func TestUseBLSSealsAt(t *testing.T) {
+	validator1, validator2 := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	key1, key2, key3 := params.BLSKey{PublicKey: []byte{1}}, params.BLSKey{PublicKey: []byte{2}}, params.BLSKey{PublicKey: []byte{3}}
+	config := *DefaultConfig
+	config.Transitions = []params.Transition{
+		{Block: big.NewInt(5), BLSKeys: map[common.Address]params.BLSKey{validator1: key1}},
+		{Block: big.NewInt(10), CommittedSealScheme: params.BLSSealScheme, BLSKeys: map[common.Address]params.BLSKey{validator2: key2}},
+		{Block: big.NewInt(15), BLSKeys: map[common.Address]params.BLSKey{validator1: key3}},
+		{Block: big.NewInt(20), CommittedSealScheme: params.ECDSASealScheme},
+	}
+	for _, test := range []struct {
+		number int64
+		want   bool
+	}{
+		{9, false}, {10, true}, {19, true}, {20, false},
+	} {
+		if have := config.UseBLSSealsAt(big.NewInt(test.number)); have != test.want {
+			t.Errorf("block %d: have %v, want %v", test.number, have, test.want)
+		}
+	}
+
+	// keys are registered from their transition on, later keys replacing earlier ones
+	for _, test := range []struct {
+		number int64
+		want   map[common.Address]params.BLSKey
+	}{
+		{4, nil},
+		{5, map[common.Address]params.BLSKey{validator1: key1}},
+		{10, map[common.Address]params.BLSKey{validator1: key1, validator2: key2}},
+		{15, map[common.Address]params.BLSKey{validator1: key3, validator2: key2}},
+	} {
+		if have := config.GetConfig(big.NewInt(test.number)).BLSKeys; !reflect.DeepEqual(have, test.want) {
+			t.Errorf("block %d: keys mismatch: have %v, want %v", test.number, have, test.want)
+		}
+	}
+	if have := config.Transitions[0].BLSKeys; len(have) != 1 || !reflect.DeepEqual(have[validator1], key1) {
+		t.Errorf("transition keys modified: %v", have)
+	}
+}
+
+func TestBlockRewardAt(t *testing.T) {
+	config := *DefaultConfig
+	if reward, _ := config.BlockRewardAt(big.NewInt(1)); reward != nil {
+		t.Errorf("reward mismatch: have %v, want nil", reward)
+	}
+
+	beneficiary := common.HexToAddress("0x1234")
+	config.BlockReward = big.NewInt(100)
+	config.BlockRewardBlock = big.NewInt(10)
+	config.Transitions = []params.Transition{
+		{Block: big.NewInt(20), BlockReward: big.NewInt(50), BlockRewardBeneficiary: beneficiary},
+		{Block: big.NewInt(30), BlockReward: big.NewInt(0)},
+	}
+	for _, test := range []struct {
+		number      int64
+		want        *big.Int
+		beneficiary common.Address
+	}{
+		{9, nil, common.Address{}},
+		{10, big.NewInt(100), common.Address{}},
+		{11, big.NewInt(100), common.Address{}},
+		{20, big.NewInt(50), beneficiary},
+		{30, nil, common.Address{}},
+	} {
+		have, beneficiary := config.BlockRewardAt(big.NewInt(test.number))
+		if (have == nil) != (test.want == nil) || (have != nil && have.Cmp(test.want) != 0) || beneficiary != test.beneficiary {
+			t.Errorf("block %d: reward mismatch: have %v to %v, want %v to %v", test.number, have, beneficiary, test.want, test.beneficiary)
+		}
+	}
+}
+
+func TestRoundChangeTimeout(t *testing.T) {
+	tests := []struct {
+		strategy   string
+		maxTimeout uint64
+		round      uint64
+		want       time.Duration
+	}{
+		{"", 0, 0, 10 * time.Second},
+		{"", 0, 3, 18 * time.Second},
+		{params.ExponentialTimeout, 0, 3, 18 * time.Second},
+		{params.ExponentialTimeout, 60000, 10, time.Minute},
+		{params.ExponentialTimeout, 60000, 1000, time.Minute},
+		{params.ExponentialTimeout, 0, 1000, math.MaxInt64},
+		{params.LinearTimeout, 0, 0, 10 * time.Second},
+		{params.LinearTimeout, 0, 3, 40 * time.Second},
+		{params.LinearTimeout, 25000, 3, 25 * time.Second},
+		{params.FixedTimeout, 0, 0, 10 * time.Second},
+		{params.FixedTimeout, 0, 100, 10 * time.Second},
+	}
+	for _, test := range tests {
+		config := *DefaultConfig
+		config.RoundTimeoutStrategy = test.strategy
+		config.MaxRequestTimeout = test.maxTimeout
+		if have := config.RoundChangeTimeout(test.round); have != test.want {
+			t.Errorf("strategy %q, max %d, round %d: timeout mismatch: have %v, want %v", test.strategy, test.maxTimeout, test.round, have, test.want)
+		}
+	}
+
+	// the first round waits for empty blocks held back by the proposer
+	config := *DefaultConfig
+	config.EmptyBlockPeriod = 30
+	config.MaxRequestTimeout = 10000
+	if have, want := config.RoundChangeTimeout(0), 39*time.Second; have != want {
+		t.Errorf("first round timeout mismatch with empty block period: have %v, want %v", have, want)
+	}
+	if have, want := config.RoundChangeTimeout(1), 10*time.Second; have != want {
+		t.Errorf("second round timeout mismatch with empty block period: have %v, want %v", have, want)
+	}
+
+	// the strategy and maximum may change in transitions
+	config = *DefaultConfig
+	config.Transitions = []params.Transition{{Block: big.NewInt(10), RoundTimeoutStrategy: params.FixedTimeout, MaxRequestTimeout: 5000}}
+	before, after := config.GetConfig(big.NewInt(9)), config.GetConfig(big.NewInt(10))
+	if have, want := before.RoundChangeTimeout(2), 14*time.Second; have != want {
+		t.Errorf("timeout mismatch before transition: have %v, want %v", have, want)
+	}
+	if have, want := after.RoundChangeTimeout(2), 5*time.Second; have != want {
+		t.Errorf("timeout mismatch after transition: have %v, want %v", have, want)
+	}
+}
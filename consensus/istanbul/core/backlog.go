@@ -18,7 +18,6 @@ package core
 
 import (
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
-	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
 )
 
 var (
@@ -75,6 +74,24 @@ func (c *core) checkMessage(msgCode uint64, view *istanbul.View) error {
 	return nil
 }
 
+// messageView decodes the view of the message
+func messageView(msg *message) *istanbul.View {
+	switch msg.Code {
+	case msgPreprepare:
+		var p *istanbul.Preprepare
+		if err := msg.Decode(&p); err == nil {
+			return p.View
+		}
+	// for msgRoundChange, msgPrepare and msgCommit cases
+	default:
+		var sub *istanbul.Subject
+		if err := msg.Decode(&sub); err == nil {
+			return sub.View
+		}
+	}
+	return nil
+}
+
 func (c *core) storeBacklog(msg *message, src istanbul.Validator) {
 	logger := c.logger.New("from", src, "state", c.state)
 
@@ -89,91 +106,18 @@ func (c *core) storeBacklog(msg *message, src istanbul.Validator) {
 	defer c.backlogsMu.Unlock()
 
 	logger.Debug("Retrieving backlog queue", "for", src.Address(), "backlogs_size", len(c.backlogs))
-	backlog := c.backlogs[src.Address()]
-	if backlog == nil {
-		backlog = prque.New()
+	if view := messageView(msg); view != nil {
+		c.backlogs.Store(src.Address(), msg, toPriority(msg.Code, view))
 	}
-	switch msg.Code {
-	case msgPreprepare:
-		var p *istanbul.Preprepare
-		err := msg.Decode(&p)
-		if err == nil {
-			backlog.Push(msg, toPriority(msg.Code, p.View))
-		}
-		// for msgRoundChange, msgPrepare and msgCommit cases
-	default:
-		var p *istanbul.Subject
-		err := msg.Decode(&p)
-		if err == nil {
-			backlog.Push(msg, toPriority(msg.Code, p.View))
-		}
-	}
-	c.backlogs[src.Address()] = backlog
 }
 
 func (c *core) processBacklog() {
 	c.backlogsMu.Lock()
 	defer c.backlogsMu.Unlock()
 
-	for srcAddress, backlog := range c.backlogs {
-		if backlog == nil {
-			continue
-		}
-		_, src := c.valSet.GetByAddress(srcAddress)
-		if src == nil {
-			// validator is not available
-			delete(c.backlogs, srcAddress)
-			continue
-		}
-		logger := c.logger.New("from", src, "state", c.state)
-		isFuture := false
-
-		// We stop processing if
-		//   1. backlog is empty
-		//   2. The first message in queue is a future message
-		for !(backlog.Empty() || isFuture) {
-			m, prio := backlog.Pop()
-			msg := m.(*message)
-			var view *istanbul.View
-			switch msg.Code {
-			case msgPreprepare:
-				var m *istanbul.Preprepare
-				err := msg.Decode(&m)
-				if err == nil {
-					view = m.View
-				}
-				// for msgRoundChange, msgPrepare and msgCommit cases
-			default:
-				var sub *istanbul.Subject
-				err := msg.Decode(&sub)
-				if err == nil {
-					view = sub.View
-				}
-			}
-			if view == nil {
-				logger.Debug("Nil view", "msg", msg)
-				continue
-			}
-			// Push back if it's a future message
-			err := c.checkMessage(msg.Code, view)
-			if err != nil {
-				if err == errFutureMessage {
-					logger.Trace("Stop processing backlog", "msg", msg)
-					backlog.Push(msg, prio)
-					isFuture = true
-					break
-				}
-				logger.Trace("Skip the backlog event", "msg", msg, "err", err)
-				continue
-			}
-			logger.Trace("Post backlog event", "msg", msg)
-
-			go c.sendEvent(backlogEvent{
-				src: src,
-				msg: msg,
-			})
-		}
-	}
+	c.backlogs.Process(c.valSet, c.logger.New("state", c.state), messageView, c.checkMessage, func(ev backlogEvent) {
+		go c.sendEvent(ev)
+	})
 }
 
 func toPriority(msgCode uint64, view *istanbul.View) float32 {
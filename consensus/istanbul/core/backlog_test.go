@@ -354,8 +354,8 @@ func testProcessBacklog(t *testing.T, msg *message) {
 		if !ok {
 			t.Errorf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
 		}
-		if e.msg.Code != msg.Code {
-			t.Errorf("message code mismatch: have %v, want %v", e.msg.Code, msg.Code)
+		if e.Msg.Code != msg.Code {
+			t.Errorf("message code mismatch: have %v, want %v", e.Msg.Code, msg.Code)
 		}
 		// success
 	case <-timeout.C:
@@ -17,10 +17,9 @@
 package core
 
 import (
-	"reflect"
-
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 )
 
 func (c *core) sendCommit() {
@@ -86,9 +85,9 @@ func (c *core) verifyCommit(commit *istanbul.Subject, src istanbul.Validator) er
 	logger := c.logger.New("from", src, "state", c.state)
 
 	sub := c.current.Subject()
-	if !reflect.DeepEqual(commit, sub) {
+	if err := istanbulcommon.VerifySubject(sub, commit); err != nil {
 		logger.Warn("Inconsistent subjects between commit and proposal", "expected", sub, "got", commit)
-		return errInconsistentSubject
+		return err
 	}
 
 	return nil
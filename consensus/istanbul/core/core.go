@@ -25,7 +25,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
-	"github.com/ethereum/go-ethereum/core/types"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	metrics "github.com/ethereum/go-ethereum/metrics"
@@ -42,10 +42,11 @@ func New(backend istanbul.Backend, config *istanbul.Config) Engine {
 		handlerWg:          new(sync.WaitGroup),
 		logger:             log.New("address", backend.Address()),
 		backend:            backend,
-		backlogs:           make(map[common.Address]*prque.Prque),
+		backlogs:           make(istanbulcommon.Backlogs),
 		backlogsMu:         new(sync.Mutex),
 		pendingRequests:    prque.New(),
 		pendingRequestsMu:  new(sync.Mutex),
+		rounds:             istanbulcommon.NewRoundHistory(istanbulcommon.RoundHistorySize),
 		consensusTimestamp: time.Time{},
 		roundMeter:         metrics.NewMeter(),
 		sequenceMeter:      metrics.NewMeter(),
@@ -78,7 +79,7 @@ type core struct {
 	waitingForRoundChange bool
 	validateFn            func([]byte, []byte) (common.Address, error)
 
-	backlogs   map[common.Address]*prque.Prque
+	backlogs   istanbulcommon.Backlogs
 	backlogsMu *sync.Mutex
 
 	current   *roundState
@@ -90,6 +91,11 @@ type core struct {
 	pendingRequests   *prque.Prque
 	pendingRequestsMu *sync.Mutex
 
+	// the start, end and timeout of the latest rounds
+	rounds *istanbulcommon.RoundHistory
+
+	sentMessages [][]byte // payloads of the messages sent at the current sequence
+
 	consensusTimestamp time.Time
 	// the meter to record the round change rate
 	roundMeter metrics.Meter
@@ -109,7 +115,7 @@ func (c *core) finalizeMessage(msg *message) ([]byte, error) {
 	// Assign the CommittedSeal if it's a COMMIT message and proposal is not nil
 	if msg.Code == msgCommit && c.current.Proposal() != nil {
 		seal := PrepareCommittedSeal(c.current.Proposal().Hash())
-		msg.CommittedSeal, err = c.backend.Sign(seal)
+		msg.CommittedSeal, err = c.backend.SignCommittedSeal(c.current.Proposal().Number(), seal)
 		if err != nil {
 			return nil, err
 		}
@@ -142,6 +148,7 @@ func (c *core) broadcast(msg *message) {
 		logger.Error("Failed to finalize message", "msg", msg, "err", err)
 		return
 	}
+	c.recordSentMessage(payload)
 
 	// Broadcast payload
 	if err = c.backend.Broadcast(c.valSet, payload); err != nil {
@@ -175,12 +182,13 @@ func (c *core) commit() {
 	proposal := c.current.Proposal()
 	if proposal != nil {
 		committedSeals := make([][]byte, c.current.Commits.Size())
+		committers := make([]common.Address, c.current.Commits.Size())
 		for i, v := range c.current.Commits.Values() {
-			committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
-			copy(committedSeals[i][:], v.CommittedSeal[:])
+			committedSeals[i] = common.CopyBytes(v.CommittedSeal)
+			committers[i] = v.Address
 		}
 
-		if err := c.backend.Commit(proposal, committedSeals); err != nil {
+		if err := c.backend.Commit(proposal, committedSeals, committers, c.current.Round()); err != nil {
 			c.current.UnlockHash() //Unlock block when insertion fails
 			c.sendNextRoundChange()
 			return
@@ -237,6 +245,7 @@ func (c *core) startNewRound(round *big.Int) {
 			Round:    new(big.Int),
 		}
 		c.valSet = c.backend.Validators(lastProposal)
+		c.sentMessages = nil
 	}
 
 	// Update logger
@@ -306,6 +315,11 @@ func (c *core) setState(state State) {
 	c.processBacklog()
 }
 
+// Rounds returns the start, end and timeout of the latest rounds
+func (c *core) Rounds() []istanbul.RoundInfo {
+	return c.rounds.Rounds()
+}
+
 func (c *core) Address() common.Address {
 	return c.address
 }
@@ -327,11 +341,9 @@ func (c *core) newRoundChangeTimer() {
 	c.stopTimer()
 
 	// set timeout based on the round number
-	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
-	round := c.current.Round().Uint64()
-	if round > 0 {
-		timeout += time.Duration(math.Pow(2, float64(round))) * time.Second
-	}
+	config := c.config.GetConfig(c.current.Sequence())
+	timeout := config.RoundChangeTimeout(c.current.Round().Uint64())
+	c.rounds.Start(c.currentView(), timeout)
 	c.roundChangeTimer = time.AfterFunc(timeout, func() {
 		c.sendEvent(timeoutEvent{})
 	})
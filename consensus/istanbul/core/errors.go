@@ -16,12 +16,16 @@
 
 package core
 
-import "errors"
+import (
+	"errors"
+
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+)
 
 var (
 	// errInconsistentSubject is returned when received subject is different from
 	// current subject.
-	errInconsistentSubject = errors.New("inconsistent subjects")
+	errInconsistentSubject = istanbulcommon.ErrInconsistentSubject
 	// errNotFromProposer is returned when received message is supposed to be from
 	// proposer.
 	errNotFromProposer = errors.New("message does not come from proposer")
@@ -29,12 +33,12 @@ var (
 	errIgnored = errors.New("message is ignored")
 	// errFutureMessage is returned when current view is earlier than the
 	// view of the received message.
-	errFutureMessage = errors.New("future message")
+	errFutureMessage = istanbulcommon.ErrFutureMessage
 	// errOldMessage is returned when the received message's view is earlier
 	// than current view.
-	errOldMessage = errors.New("old message")
+	errOldMessage = istanbulcommon.ErrOldMessage
 	// errInvalidMessage is returned when the message is malformed.
-	errInvalidMessage = errors.New("invalid message")
+	errInvalidMessage = istanbulcommon.ErrInvalidMessage
 	// errFailedDecodePreprepare is returned when the PRE-PREPARE message is malformed.
 	errFailedDecodePreprepare = errors.New("failed to decode PRE-PREPARE")
 	// errFailedDecodePrepare is returned when the PREPARE message is malformed.
@@ -44,5 +48,5 @@ var (
 	// errFailedDecodeMessageSet is returned when the message set is malformed.
 	// errFailedDecodeMessageSet = errors.New("failed to decode message set")
 	// errInvalidSigner is returned when the message is signed by a validator different than message sender
-	errInvalidSigner = errors.New("message not signed by the sender")
+	errInvalidSigner = istanbulcommon.ErrInvalidSigner
 )
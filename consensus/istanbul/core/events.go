@@ -17,12 +17,10 @@
 package core
 
 import (
-	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 )
 
-type backlogEvent struct {
-	src istanbul.Validator
-	msg *message
-}
-
-type timeoutEvent struct{}
+type (
+	backlogEvent = istanbulcommon.BacklogEvent
+	timeoutEvent = istanbulcommon.TimeoutEvent
+)
@@ -19,12 +19,15 @@ package core
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 )
 
 // Start implements core.Engine.Start
 func (c *core) Start() error {
 	// Start a new round from last sequence + 1
 	c.startNewRound(common.Big0)
+	// Resume the sequence if it was interrupted by a restart
+	c.restoreState()
 
 	// Tests will handle events itself, so we have to make subscribeEvents()
 	// be able to call in test.
@@ -41,6 +44,7 @@ func (c *core) Stop() error {
 
 	// Make sure the handler goroutine exits
 	c.handlerWg.Wait()
+	c.rounds.Stop()
 	return nil
 }
 
@@ -48,19 +52,7 @@ func (c *core) Stop() error {
 
 // Subscribe both internal and external events
 func (c *core) subscribeEvents() {
-	c.events = c.backend.EventMux().Subscribe(
-		// external events
-		istanbul.RequestEvent{},
-		istanbul.MessageEvent{},
-		// internal events
-		backlogEvent{},
-	)
-	c.timeoutSub = c.backend.EventMux().Subscribe(
-		timeoutEvent{},
-	)
-	c.finalCommittedSub = c.backend.EventMux().Subscribe(
-		istanbul.FinalCommittedEvent{},
-	)
+	c.events, c.timeoutSub, c.finalCommittedSub = istanbulcommon.SubscribeEvents(c.backend.EventMux())
 }
 
 // Unsubscribe all events
@@ -100,8 +92,8 @@ func (c *core) handleEvents() {
 				}
 			case backlogEvent:
 				// No need to check signature for internal messages
-				if err := c.handleCheckedMsg(ev.msg, ev.src); err == nil {
-					p, err := ev.msg.Payload()
+				if err := c.handleCheckedMsg(ev.Msg, ev.Src); err == nil {
+					p, err := ev.Msg.Payload()
 					if err != nil {
 						c.logger.Warn("Get message payload failed", "err", err)
 						continue
@@ -134,20 +126,13 @@ func (c *core) sendEvent(ev interface{}) {
 func (c *core) handleMsg(payload []byte) error {
 	logger := c.logger.New()
 
-	// Decode message and check its signature
-	msg := new(message)
-	if err := msg.FromPayload(payload, c.validateFn); err != nil {
-		logger.Error("Failed to decode message from payload", "err", err)
+	// Decode message and check its signature and sender
+	msg, src, err := istanbulcommon.DecodeMessage(payload, c.validateFn, c.valSet)
+	if err != nil {
+		logger.Error("Failed to decode message from payload", "msg", msg, "err", err)
 		return err
 	}
 
-	// Only accept message if the address is valid
-	_, src := c.valSet.GetByAddress(msg.Address)
-	if src == nil {
-		logger.Error("Invalid address in message", "msg", msg)
-		return istanbul.ErrUnauthorizedAddress
-	}
-
 	return c.handleCheckedMsg(msg, src)
 }
 
@@ -180,6 +165,8 @@ func (c *core) handleCheckedMsg(msg *message, src istanbul.Validator) error {
 }
 
 func (c *core) handleTimeoutMsg() {
+	c.rounds.TimedOut()
+
 	// If we're not waiting for round change yet, we can try to catch up
 	// the max round with F+1 round change message. We only need to catch up
 	// if the max round is larger than current round.
@@ -0,0 +1,82 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// restartCore replaces the core of the backend by a new one, started as after
+// a restart without handling events
+func restartCore(backend *testSystemBackend) *core {
+	c := New(backend, istanbul.DefaultConfig).(*core)
+	c.logger = testLogger
+	c.validateFn = backend.CheckValidatorSignature
+	backend.engine = c
+
+	c.startNewRound(common.Big0)
+	c.restoreState()
+	c.stopTimer()
+	return c
+}
+
+func TestRestoreState(t *testing.T) {
+	sys := NewTestSystemWithBackend(4, 1)
+	go sys.listen()
+	defer close(sys.quit)
+
+	backend := sys.backends[0]
+	c := backend.engine.(*core)
+	view := &istanbul.View{Sequence: big.NewInt(1), Round: big.NewInt(1)}
+	c.current = newTestRoundState(view, c.valSet)
+	c.current.LockHash()
+	c.state = StatePrepared
+	c.sendCommit()
+
+	c = restartCore(backend)
+	if c.current.Sequence().Cmp(view.Sequence) != 0 || c.current.Round().Cmp(view.Round) != 0 {
+		t.Errorf("view mismatch: have %v, want %v", c.currentView(), view)
+	}
+	if c.state != StatePrepared {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StatePrepared)
+	}
+	proposal := newTestProposal()
+	if !c.current.IsHashLocked() || c.current.GetLockedHash() != proposal.Hash() {
+		t.Errorf("locked hash mismatch: have %v, want %v", c.current.GetLockedHash(), proposal.Hash())
+	}
+	if c.current.Proposal() == nil || c.current.Proposal().Hash() != proposal.Hash() {
+		t.Errorf("proposal mismatch: have %v, want %v", c.current.Proposal(), proposal)
+	}
+	// the COMMIT sent before the restart is taken into account again
+	if len(c.sentMessages) != 1 || c.current.Commits.Get(backend.Address()) == nil {
+		t.Errorf("sent COMMIT not restored: have %d sent messages, %d commits", len(c.sentMessages), c.current.Commits.Size())
+	}
+
+	// once the sequence is committed, there is nothing left to resume
+	backend.committedMsgs = append(backend.committedMsgs, testCommittedMsgs{commitProposal: proposal})
+	c = restartCore(backend)
+	if c.current.Sequence().Cmp(big.NewInt(2)) != 0 || c.current.Round().Sign() != 0 {
+		t.Errorf("view mismatch: have %v, want sequence 2, round 0", c.currentView())
+	}
+	if c.state != StateAcceptRequest || c.current.IsHashLocked() || len(c.sentMessages) != 0 {
+		t.Errorf("state of the committed sequence restored: state %v, locked %v, %d sent messages", c.state, c.current.IsHashLocked(), len(c.sentMessages))
+	}
+}
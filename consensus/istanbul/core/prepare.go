@@ -17,9 +17,8 @@
 package core
 
 import (
-	"reflect"
-
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 )
 
 func (c *core) sendPrepare() {
@@ -74,9 +73,9 @@ func (c *core) verifyPrepare(prepare *istanbul.Subject, src istanbul.Validator)
 	logger := c.logger.New("from", src, "state", c.state)
 
 	sub := c.current.Subject()
-	if !reflect.DeepEqual(prepare, sub) {
+	if err := istanbulcommon.VerifySubject(sub, prepare); err != nil {
 		logger.Warn("Inconsistent subjects between PREPARE and proposal", "expected", sub, "got", prepare)
-		return errInconsistentSubject
+		return err
 	}
 
 	return nil
@@ -86,8 +86,8 @@ func (c *core) handlePreprepare(msg *message, src istanbul.Validator) error {
 			c.stopFuturePreprepareTimer()
 			c.futurePreprepareTimer = time.AfterFunc(duration, func() {
 				c.sendEvent(backlogEvent{
-					src: src,
-					msg: msg,
+					Src: src,
+					Msg: msg,
 				})
 			})
 		} else {
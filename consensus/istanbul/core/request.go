@@ -18,6 +18,7 @@ package core
 
 import (
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
 )
 
 func (c *core) handleRequest(request *istanbul.Request) error {
@@ -39,22 +40,9 @@ func (c *core) handleRequest(request *istanbul.Request) error {
 	return nil
 }
 
-// check request state
-// return errInvalidMessage if the message is invalid
-// return errFutureMessage if the sequence of proposal is larger than current sequence
-// return errOldMessage if the sequence of proposal is smaller than current sequence
+// check request state, see istanbulcommon.CheckRequestMsg
 func (c *core) checkRequestMsg(request *istanbul.Request) error {
-	if request == nil || request.Proposal == nil {
-		return errInvalidMessage
-	}
-
-	if c := c.current.sequence.Cmp(request.Proposal.Number()); c > 0 {
-		return errOldMessage
-	} else if c < 0 {
-		return errFutureMessage
-	} else {
-		return nil
-	}
+	return istanbulcommon.CheckRequestMsg(request, c.current.sequence)
 }
 
 func (c *core) storeRequestMsg(request *istanbul.Request) {
@@ -65,35 +53,14 @@ func (c *core) storeRequestMsg(request *istanbul.Request) {
 	c.pendingRequestsMu.Lock()
 	defer c.pendingRequestsMu.Unlock()
 
-	c.pendingRequests.Push(request, float32(-request.Proposal.Number().Int64()))
+	istanbulcommon.StoreRequestMsg(c.pendingRequests, request)
 }
 
 func (c *core) processPendingRequests() {
 	c.pendingRequestsMu.Lock()
 	defer c.pendingRequestsMu.Unlock()
 
-	for !(c.pendingRequests.Empty()) {
-		m, prio := c.pendingRequests.Pop()
-		r, ok := m.(*istanbul.Request)
-		if !ok {
-			c.logger.Warn("Malformed request, skip", "msg", m)
-			continue
-		}
-		// Push back if it's a future message
-		err := c.checkRequestMsg(r)
-		if err != nil {
-			if err == errFutureMessage {
-				c.logger.Trace("Stop processing request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash())
-				c.pendingRequests.Push(m, prio)
-				break
-			}
-			c.logger.Trace("Skip the pending request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash(), "err", err)
-			continue
-		}
-		c.logger.Trace("Post pending request", "number", r.Proposal.Number(), "hash", r.Proposal.Hash())
-
-		go c.sendEvent(istanbul.RequestEvent{
-			Proposal: r.Proposal,
-		})
-	}
+	istanbulcommon.ProcessPendingRequests(c.pendingRequests, c.current.sequence, c.logger, func(ev istanbul.RequestEvent) {
+		go c.sendEvent(ev)
+	})
 }
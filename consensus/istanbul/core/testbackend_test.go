@@ -93,7 +93,7 @@ func (self *testSystemBackend) Gossip(valSet istanbul.ValidatorSet, message []by
 	return nil
 }
 
-func (self *testSystemBackend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
+func (self *testSystemBackend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address, round *big.Int) error {
 	testLogger.Info("commit message", "address", self.Address())
 	self.committedMsgs = append(self.committedMsgs, testCommittedMsgs{
 		commitProposal: proposal,
@@ -114,6 +114,11 @@ func (self *testSystemBackend) Sign(data []byte) ([]byte, error) {
 	return self.address.Bytes(), nil
 }
 
+// SignCommittedSeal returns the address of the backend like Sign
+func (self *testSystemBackend) SignCommittedSeal(number *big.Int, seal []byte) ([]byte, error) {
+	return self.Sign(seal)
+}
+
 func (self *testSystemBackend) CheckSignature([]byte, common.Address, []byte) error {
 	return nil
 }
@@ -157,6 +162,17 @@ func (self *testSystemBackend) ParentValidators(proposal istanbul.Proposal) ista
 	return self.peers
 }
 
+func (self *testSystemBackend) WriteCoreState(name string, state []byte) error {
+	return self.db.Put([]byte(name), state)
+}
+
+func (self *testSystemBackend) ReadCoreState(name string) ([]byte, error) {
+	if has, err := self.db.Has([]byte(name)); err != nil || !has {
+		return nil, err
+	}
+	return self.db.Get([]byte(name))
+}
+
 func (sb *testSystemBackend) Close() error {
 	return nil
 }
@@ -0,0 +1,135 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+var (
+	// msgPriority is defined for calculating processing priority to speedup consensus
+	// msgPreprepare > msgCommit > msgPrepare
+	msgPriority = map[uint64]int{
+		msgPreprepare: 1,
+		msgCommit:     2,
+		msgPrepare:    3,
+	}
+)
+
+// checkMessage checks the message state
+// return errInvalidMessage if the message is invalid
+// return errFutureMessage if the message view is larger than current view
+// return errOldMessage if the message view is smaller than current view
+func (c *core) checkMessage(msgCode uint64, view *istanbul.View) error {
+	if view == nil || view.Sequence == nil || view.Round == nil {
+		return errInvalidMessage
+	}
+
+	if msgCode == msgRoundChange {
+		if view.Sequence.Cmp(c.currentView().Sequence) > 0 {
+			return errFutureMessage
+		} else if view.Cmp(c.currentView()) < 0 {
+			return errOldMessage
+		}
+		return nil
+	}
+
+	if view.Cmp(c.currentView()) > 0 {
+		return errFutureMessage
+	}
+
+	if view.Cmp(c.currentView()) < 0 {
+		return errOldMessage
+	}
+
+	// While waiting for round change, only a PRE-PREPARE justified by a quorum
+	// of ROUND CHANGE messages is accepted
+	if c.waitingForRoundChange && msgCode != msgPreprepare {
+		return errFutureMessage
+	}
+
+	// StateAcceptRequest only accepts msgPreprepare
+	// other messages are future messages
+	if c.state == StateAcceptRequest && msgCode != msgPreprepare {
+		return errFutureMessage
+	}
+
+	// For states(StatePreprepared, StatePrepared, StateCommitted),
+	// can accept all message types if processing with same view
+	return nil
+}
+
+// messageView decodes the view of the message
+func messageView(msg *message) *istanbul.View {
+	switch msg.Code {
+	case msgPreprepare:
+		var p *Preprepare
+		if err := msg.Decode(&p); err == nil {
+			return p.View
+		}
+	case msgRoundChange:
+		var rc *RoundChange
+		if err := msg.Decode(&rc); err == nil {
+			return rc.View
+		}
+	default:
+		var sub *istanbul.Subject
+		if err := msg.Decode(&sub); err == nil {
+			return sub.View
+		}
+	}
+	return nil
+}
+
+func (c *core) storeBacklog(msg *message, src istanbul.Validator) {
+	logger := c.logger.New("from", src, "state", c.state)
+
+	if src.Address() == c.Address() {
+		logger.Warn("Backlog from self")
+		return
+	}
+
+	logger.Trace("Store future message")
+
+	c.backlogsMu.Lock()
+	defer c.backlogsMu.Unlock()
+
+	logger.Debug("Retrieving backlog queue", "for", src.Address(), "backlogs_size", len(c.backlogs))
+	if view := messageView(msg); view != nil {
+		c.backlogs.Store(src.Address(), msg, toPriority(msg.Code, view))
+	}
+}
+
+func (c *core) processBacklog() {
+	c.backlogsMu.Lock()
+	defer c.backlogsMu.Unlock()
+
+	c.backlogs.Process(c.valSet, c.logger.New("state", c.state), messageView, c.checkMessage, func(ev backlogEvent) {
+		go c.sendEvent(ev)
+	})
+}
+
+func toPriority(msgCode uint64, view *istanbul.View) float32 {
+	if msgCode == msgRoundChange {
+		// For msgRoundChange, set the message priority based on its sequence
+		return -float32(view.Sequence.Uint64() * 1000)
+	}
+	// FIXME: round will be reset as 0 while new sequence
+	// 10 * Round limits the range of message code is from 0 to 9
+	// 1000 * Sequence limits the range of round is from 0 to 99
+	return -float32(view.Sequence.Uint64()*1000 + view.Round.Uint64()*10 + uint64(msgPriority[msgCode]))
+}
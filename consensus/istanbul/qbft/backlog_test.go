@@ -0,0 +1,171 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+package qbft
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func TestCheckMessage(t *testing.T) {
+	c, _ := newTestCore(4, testView(1, 1))
+
+	// invalid view format
+	if err := c.checkMessage(msgPreprepare, nil); err != errInvalidMessage {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidMessage)
+	}
+
+	testStates := []State{StateAcceptRequest, StatePreprepared, StatePrepared, StateCommitted}
+	testCode := []uint64{msgPreprepare, msgPrepare, msgCommit, msgRoundChange}
+
+	for _, state := range testStates {
+		c.state = state
+		for _, code := range testCode {
+			// future sequence
+			if err := c.checkMessage(code, testView(2, 0)); err != errFutureMessage {
+				t.Errorf("state %v, code %d: error mismatch: have %v, want %v", state, code, err, errFutureMessage)
+			}
+			// old sequence
+			if err := c.checkMessage(code, testView(0, 1)); err != errOldMessage {
+				t.Errorf("state %v, code %d: error mismatch: have %v, want %v", state, code, err, errOldMessage)
+			}
+			// old round
+			if err := c.checkMessage(code, testView(1, 0)); err != errOldMessage {
+				t.Errorf("state %v, code %d: error mismatch: have %v, want %v", state, code, err, errOldMessage)
+			}
+			// future round, ROUND CHANGE messages of later rounds are accepted
+			err := c.checkMessage(code, testView(1, 2))
+			if code == msgRoundChange {
+				if err != nil {
+					t.Errorf("state %v, code %d: error mismatch: have %v, want nil", state, code, err)
+				}
+			} else if err != errFutureMessage {
+				t.Errorf("state %v, code %d: error mismatch: have %v, want %v", state, code, err, errFutureMessage)
+			}
+			// current view
+			err = c.checkMessage(code, testView(1, 1))
+			if state == StateAcceptRequest && code != msgPreprepare && code != msgRoundChange {
+				if err != errFutureMessage {
+					t.Errorf("state %v, code %d: error mismatch: have %v, want %v", state, code, err, errFutureMessage)
+				}
+			} else if err != nil {
+				t.Errorf("state %v, code %d: error mismatch: have %v, want nil", state, code, err)
+			}
+		}
+	}
+
+	// only a PRE-PREPARE is accepted while waiting for round change
+	c.state = StatePrepared
+	c.waitingForRoundChange = true
+	for _, code := range []uint64{msgPrepare, msgCommit} {
+		if err := c.checkMessage(code, testView(1, 1)); err != errFutureMessage {
+			t.Errorf("code %d: error mismatch: have %v, want %v", code, err, errFutureMessage)
+		}
+	}
+	if err := c.checkMessage(msgPreprepare, testView(1, 1)); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+}
+
+func TestStoreBacklog(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	block := makeBlock(1)
+
+	preprepare := testMessage(msgPreprepare, &Preprepare{View: testView(1, 1), Proposal: block}, addrs[1])
+	c.storeBacklog(preprepare, c.valSet.GetByIndex(1))
+	if msg, _ := c.backlogs[addrs[1]].Pop(); !reflect.DeepEqual(msg, preprepare) {
+		t.Errorf("message mismatch: have %v, want %v", msg, preprepare)
+	}
+
+	roundChange := testMessage(msgRoundChange, &RoundChange{View: testView(2, 0)}, addrs[1])
+	c.storeBacklog(roundChange, c.valSet.GetByIndex(1))
+	if msg, _ := c.backlogs[addrs[1]].Pop(); !reflect.DeepEqual(msg, roundChange) {
+		t.Errorf("message mismatch: have %v, want %v", msg, roundChange)
+	}
+
+	// messages from self are not stored
+	prepare := testMessage(msgPrepare, &istanbul.Subject{View: testView(1, 1), Digest: block.Hash()}, addrs[0])
+	c.storeBacklog(prepare, c.valSet.GetByIndex(0))
+	if c.backlogs[addrs[0]] != nil {
+		t.Errorf("expected no backlog for self")
+	}
+}
+
+func TestProcessBacklog(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	block := makeBlock(1)
+
+	current := testMessage(msgPrepare, &istanbul.Subject{View: testView(1, 0), Digest: block.Hash()}, addrs[1])
+	future := testMessage(msgPrepare, &istanbul.Subject{View: testView(2, 0), Digest: block.Hash()}, addrs[2])
+	c.storeBacklog(current, c.valSet.GetByIndex(1))
+	c.storeBacklog(future, c.valSet.GetByIndex(2))
+
+	c.subscribeEvents()
+	defer c.unsubscribeEvents()
+
+	// PREPARE messages are future messages until a PRE-PREPARE is accepted
+	c.processBacklog()
+	c.state = StatePreprepared
+	c.processBacklog()
+
+	select {
+	case ev := <-c.events.Chan():
+		e, ok := ev.Data.(backlogEvent)
+		if !ok {
+			t.Fatalf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
+		}
+		if e.Src.Address() != addrs[1] || !reflect.DeepEqual(e.Msg, current) {
+			t.Errorf("backlog event mismatch: have %v from %v, want %v from %v", e.Msg, e.Src, current, addrs[1])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("unexpected timeout occurs")
+	}
+
+	if size := c.backlogs[addrs[1]].Size(); size != 0 {
+		t.Errorf("the size of the backlog mismatch: have %v, want 0", size)
+	}
+	if size := c.backlogs[addrs[2]].Size(); size != 1 {
+		t.Errorf("the size of the backlog mismatch: have %v, want 1", size)
+	}
+}
+
+func TestToPriority(t *testing.T) {
+	// messages of earlier views come first, and within a view the
+	// PRE-PREPARE comes before the COMMIT and the PREPARE
+	ordered := []struct {
+		code uint64
+		view *istanbul.View
+	}{
+		{msgPreprepare, testView(1, 0)},
+		{msgCommit, testView(1, 0)},
+		{msgPrepare, testView(1, 0)},
+		{msgPreprepare, testView(1, 1)},
+		{msgRoundChange, testView(2, 5)},
+		{msgPreprepare, testView(2, 0)},
+	}
+	for i := 1; i < len(ordered); i++ {
+		prev, next := ordered[i-1], ordered[i]
+		if toPriority(prev.code, prev.view) <= toPriority(next.code, next.view) {
+			t.Errorf("expected code %d at %v to come before code %d at %v", prev.code, prev.view, next.code, next.view)
+		}
+	}
+	if p := toPriority(msgRoundChange, testView(2, 5)); p != -2000 {
+		t.Errorf("priority mismatch: have %v, want -2000", p)
+	}
+}
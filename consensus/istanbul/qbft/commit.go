@@ -0,0 +1,91 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+)
+
+func (c *core) sendCommit() {
+	logger := c.logger.New("state", c.state)
+
+	sub := c.current.Subject()
+	encodedSubject, err := Encode(sub)
+	if err != nil {
+		logger.Error("Failed to encode", "subject", sub)
+		return
+	}
+	c.broadcast(&message{
+		Code: msgCommit,
+		Msg:  encodedSubject,
+	})
+}
+
+func (c *core) handleCommit(msg *message, src istanbul.Validator) error {
+	// Decode COMMIT message
+	var commit *istanbul.Subject
+	err := msg.Decode(&commit)
+	if err != nil {
+		return errFailedDecodeCommit
+	}
+
+	if err := c.checkMessage(msgCommit, commit.View); err != nil {
+		return err
+	}
+
+	if err := c.verifyCommit(commit, src); err != nil {
+		return err
+	}
+
+	c.acceptCommit(msg, src)
+
+	// Commit the proposal once we have enough COMMIT messages and we are not in the Committed state.
+	//
+	// If we already have a proposal, we may have chance to speed up the consensus process
+	// by committing the proposal without PREPARE messages.
+	if c.current.Commits.Size() >= c.QuorumSize() && c.state.Cmp(StateCommitted) < 0 {
+		c.commit()
+	}
+
+	return nil
+}
+
+// verifyCommit verifies if the received COMMIT message is equivalent to our subject
+func (c *core) verifyCommit(commit *istanbul.Subject, src istanbul.Validator) error {
+	logger := c.logger.New("from", src, "state", c.state)
+
+	sub := c.current.Subject()
+	if err := istanbulcommon.VerifySubject(sub, commit); err != nil {
+		logger.Warn("Inconsistent subjects between commit and proposal", "expected", sub, "got", commit)
+		return err
+	}
+
+	return nil
+}
+
+func (c *core) acceptCommit(msg *message, src istanbul.Validator) error {
+	logger := c.logger.New("from", src, "state", c.state)
+
+	// Add the COMMIT message to current round state
+	if err := c.current.Commits.Add(msg); err != nil {
+		logger.Error("Failed to record commit message", "msg", msg, "err", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+package qbft
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func TestHandleCommit(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 0), block)
+	c.state = StatePrepared
+
+	for i, b := range backends[1:] {
+		msg := testMessage(msgCommit, &istanbul.Subject{View: testView(1, 0), Digest: block.Hash()}, b.address)
+		msg.CommittedSeal = b.address.Bytes()
+		_, src := c.valSet.GetByAddress(b.address)
+		if err := c.handleCommit(msg, src); err != nil {
+			t.Fatalf("error mismatch: have %v, want nil", err)
+		}
+		if i == 0 && c.state != StatePrepared {
+			t.Errorf("state mismatch: have %v, want %v", c.state, StatePrepared)
+		}
+	}
+
+	if c.state != StateCommitted {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StateCommitted)
+	}
+	committed := backends[0].Committed()
+	if len(committed) != 1 {
+		t.Fatalf("the number of committed proposals mismatch: have %v, want 1", len(committed))
+	}
+	if committed[0].commitProposal.Hash() != block.Hash() {
+		t.Errorf("committed proposal mismatch: have %v, want %v", committed[0].commitProposal.Hash(), block.Hash())
+	}
+	if len(committed[0].committedSeals) != c.QuorumSize() {
+		t.Errorf("the number of committed seals mismatch: have %v, want %v", len(committed[0].committedSeals), c.QuorumSize())
+	}
+}
+
+// A quorum of COMMIT messages commits the block even before it is prepared
+func TestHandleCommit_beforePrepared(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 0), block)
+
+	for _, b := range backends[1:] {
+		msg := testMessage(msgCommit, &istanbul.Subject{View: testView(1, 0), Digest: block.Hash()}, b.address)
+		_, src := c.valSet.GetByAddress(b.address)
+		if err := c.handleCommit(msg, src); err != nil {
+			t.Fatalf("error mismatch: have %v, want nil", err)
+		}
+	}
+	if c.state != StateCommitted {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StateCommitted)
+	}
+}
+
+func TestHandleCommit_invalid(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 1), block)
+	_, src := c.valSet.GetByAddress(backends[1].address)
+
+	testCases := []struct {
+		name    string
+		subject *istanbul.Subject
+		err     error
+	}{
+		{
+			"another digest",
+			&istanbul.Subject{View: testView(1, 1), Digest: makeBlockWithTime(1, 1).Hash()},
+			errInconsistentSubject,
+		},
+		{
+			"an old round",
+			&istanbul.Subject{View: testView(1, 0), Digest: block.Hash()},
+			errOldMessage,
+		},
+		{
+			"a future round",
+			&istanbul.Subject{View: testView(1, 2), Digest: block.Hash()},
+			errFutureMessage,
+		},
+	}
+	for _, test := range testCases {
+		msg := testMessage(msgCommit, test.subject, backends[1].address)
+		if err := c.handleCommit(msg, src); err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+	if size := c.current.Commits.Size(); size != 0 {
+		t.Errorf("the number of commits mismatch: have %v, want 0", size)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func testConfig() *istanbul.Config {
+	config := *istanbul.DefaultConfig
+	config.RequestTimeout = 300
+	return &config
+}
+
+func TestCommitProposal(t *testing.T) {
+	sys := newTestSystemWithBackend(4, testConfig())
+
+	stop := sys.Run()
+	defer stop()
+
+	for _, b := range sys.backends {
+		b.NewRequest(makeBlock(1))
+	}
+	if !sys.waitForCommit(1, 5*time.Second) {
+		t.Fatal("the proposal was not committed")
+	}
+
+	for _, b := range sys.backends {
+		committed := b.Committed()[0]
+		if committed.commitProposal.Number().Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("block number mismatch: have %v, want 1", committed.commitProposal.Number())
+		}
+		if committed.round.Sign() != 0 {
+			t.Errorf("round mismatch: have %v, want 0", committed.round)
+		}
+		if len(committed.committedSeals) < 3 {
+			t.Errorf("the number of committed seals mismatch: have %v, want at least 3", len(committed.committedSeals))
+		}
+	}
+}
+
+func TestRoundChangeAfterSilentProposer(t *testing.T) {
+	sys := newTestSystemWithBackend(4, testConfig())
+	// the proposer of the first round sends no messages
+	for _, b := range sys.backends {
+		if b.peers.IsProposer(b.address) {
+			b.silent = true
+		}
+	}
+
+	stop := sys.Run()
+	defer stop()
+
+	for _, b := range sys.backends {
+		b.NewRequest(makeBlock(1))
+	}
+	if !sys.waitForCommit(1, 10*time.Second) {
+		t.Fatal("the proposal was not committed after the round change")
+	}
+
+	for _, b := range sys.backends {
+		if b.silent {
+			continue
+		}
+		committed := b.Committed()[0]
+		if committed.round.Sign() == 0 {
+			t.Errorf("round mismatch: have %v, want a later round than 0", committed.round)
+		}
+	}
+}
+
+func TestQuorumSize(t *testing.T) {
+	sys := newTestSystemWithBackend(4, testConfig())
+	c := sys.backends[0].engine.(*core)
+	c.valSet = sys.backends[0].peers
+
+	for _, test := range []struct {
+		size   int
+		quorum int
+	}{
+		{4, 3}, {5, 4}, {6, 4}, {7, 5}, {10, 7},
+	} {
+		for c.valSet.Size() < test.size {
+			c.valSet.AddValidator(generateValidators(1)[0])
+		}
+		if q := c.QuorumSize(); q != test.quorum {
+			t.Errorf("quorum size mismatch for %d validators: have %v, want %v", test.size, q, test.quorum)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"errors"
+
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+)
+
+var (
+	// errInconsistentSubject is returned when received subject is different from
+	// current subject.
+	errInconsistentSubject = istanbulcommon.ErrInconsistentSubject
+	// errNotFromProposer is returned when received message is supposed to be from
+	// proposer.
+	errNotFromProposer = errors.New("message does not come from proposer")
+	// errIgnored is returned when a message was ignored.
+	errIgnored = errors.New("message is ignored")
+	// errFutureMessage is returned when current view is earlier than the
+	// view of the received message.
+	errFutureMessage = istanbulcommon.ErrFutureMessage
+	// errOldMessage is returned when the received message's view is earlier
+	// than current view.
+	errOldMessage = istanbulcommon.ErrOldMessage
+	// errInvalidMessage is returned when the message is malformed.
+	errInvalidMessage = istanbulcommon.ErrInvalidMessage
+	// errFailedDecodePreprepare is returned when the PRE-PREPARE message is malformed.
+	errFailedDecodePreprepare = errors.New("failed to decode PRE-PREPARE")
+	// errFailedDecodePrepare is returned when the PREPARE message is malformed.
+	errFailedDecodePrepare = errors.New("failed to decode PREPARE")
+	// errFailedDecodeCommit is returned when the COMMIT message is malformed.
+	errFailedDecodeCommit = errors.New("failed to decode COMMIT")
+	// errFailedDecodeRoundChange is returned when the ROUND CHANGE message is malformed.
+	errFailedDecodeRoundChange = errors.New("failed to decode ROUND CHANGE")
+	// errInvalidSigner is returned when the message is signed by a validator different than message sender
+	errInvalidSigner = istanbulcommon.ErrInvalidSigner
+	// errInvalidJustification is returned when the messages justifying a
+	// PRE-PREPARE or a ROUND CHANGE do not form a valid certificate.
+	errInvalidJustification = errors.New("invalid justification")
+)
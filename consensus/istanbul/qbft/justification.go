@@ -0,0 +1,107 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// justifyPreprepare checks that the PRE-PREPARE of a round after the first is
+// justified by a quorum of valid ROUND CHANGE messages for its view, and that
+// it proposes the block prepared in the highest round if any of those messages
+// carries a prepared block. This is what keeps a block which may have been
+// committed by some validators from being replaced in a later round.
+func (c *core) justifyPreprepare(preprepare *Preprepare) error {
+	if preprepare.View.Round.Sign() == 0 {
+		return nil
+	}
+
+	var highest *RoundChange
+	senders := make(map[common.Address]bool)
+	for _, msg := range preprepare.Justification {
+		if msg.Code != msgRoundChange {
+			return errInvalidJustification
+		}
+		if err := msg.Verify(c.validateFn); err != nil {
+			return err
+		}
+		var rc *RoundChange
+		if err := msg.Decode(&rc); err != nil {
+			return errFailedDecodeRoundChange
+		}
+		if rc.View == nil || rc.View.Round == nil || rc.View.Sequence == nil || rc.View.Cmp(preprepare.View) != 0 {
+			return errInvalidJustification
+		}
+		if err := c.verifyRoundChange(rc); err != nil {
+			return err
+		}
+		senders[msg.Address] = true
+
+		if rc.IsPrepared() && (highest == nil || highest.PreparedRound.Cmp(rc.PreparedRound) < 0) {
+			highest = rc
+		}
+	}
+	if len(senders) < c.QuorumSize() {
+		return errInvalidJustification
+	}
+
+	if highest != nil && highest.PreparedBlock.Hash() != preprepare.Proposal.Hash() {
+		return errInvalidJustification
+	}
+	return nil
+}
+
+// verifyRoundChange checks that the block carried by a ROUND CHANGE message, if
+// any, was prepared at the sequence in an earlier round by a quorum of PREPARE
+// messages
+func (c *core) verifyRoundChange(rc *RoundChange) error {
+	if !rc.IsPrepared() {
+		return nil
+	}
+	if rc.PreparedRound.Cmp(rc.View.Round) >= 0 || rc.PreparedBlock.Number().Cmp(rc.View.Sequence) != 0 {
+		return errInvalidJustification
+	}
+
+	view := &istanbul.View{
+		Round:    rc.PreparedRound,
+		Sequence: rc.View.Sequence,
+	}
+	digest := rc.PreparedBlock.Hash()
+
+	senders := make(map[common.Address]bool)
+	for _, msg := range rc.Justification {
+		if msg.Code != msgPrepare {
+			return errInvalidJustification
+		}
+		if err := msg.Verify(c.validateFn); err != nil {
+			return err
+		}
+		var prepare *istanbul.Subject
+		if err := msg.Decode(&prepare); err != nil {
+			return errFailedDecodePrepare
+		}
+		if prepare.View == nil || prepare.View.Round == nil || prepare.View.Sequence == nil || prepare.View.Cmp(view) != 0 || prepare.Digest != digest {
+			return errInvalidJustification
+		}
+		senders[msg.Address] = true
+	}
+	if len(senders) < c.QuorumSize() {
+		return errInvalidJustification
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// newTestCore returns the core of the first of n validators at the given view
+func newTestCore(n uint64, view *istanbul.View) (*core, []common.Address) {
+	sys := newTestSystemWithBackend(n, testConfig())
+	backend := sys.backends[0]
+	c := backend.engine.(*core)
+	c.valSet = backend.peers
+	c.current = newRoundState(view, c.valSet, nil, nil)
+	c.roundChangeSet = newRoundChangeSet(c.valSet)
+
+	var addrs []common.Address
+	for _, val := range c.valSet.List() {
+		addrs = append(addrs, val.Address())
+	}
+	return c, addrs
+}
+
+// testMessage returns a message signed by the given validator, as the test
+// backends sign with their address
+func testMessage(code uint64, payload interface{}, from common.Address) *message {
+	encoded, _ := Encode(payload)
+	return &message{
+		Code:          code,
+		Msg:           encoded,
+		Address:       from,
+		Signature:     from.Bytes(),
+		CommittedSeal: []byte{},
+	}
+}
+
+func testPrepares(from []common.Address, view *istanbul.View, digest common.Hash) []*message {
+	var prepares []*message
+	for _, addr := range from {
+		prepares = append(prepares, testMessage(msgPrepare, &istanbul.Subject{View: view, Digest: digest}, addr))
+	}
+	return prepares
+}
+
+func testView(sequence, round int64) *istanbul.View {
+	return &istanbul.View{Sequence: big.NewInt(sequence), Round: big.NewInt(round)}
+}
+
+func TestJustifyPreprepare(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 2))
+
+	prepared := makeBlockWithTime(1, 1)
+	other := makeBlockWithTime(1, 2)
+	// a quorum of validators prepared the block in round 0 and round 1
+	preparedRC := func(from common.Address, round int64, prepares []*message) *message {
+		return testMessage(msgRoundChange, &RoundChange{
+			View:          testView(1, 2),
+			PreparedRound: big.NewInt(round),
+			PreparedBlock: prepared,
+			Justification: prepares,
+		}, from)
+	}
+	notPreparedRC := func(from common.Address) *message {
+		return testMessage(msgRoundChange, &RoundChange{View: testView(1, 2)}, from)
+	}
+	prepares := testPrepares(addrs[:3], testView(1, 1), prepared.Hash())
+
+	testCases := []struct {
+		name          string
+		proposal      istanbul.Proposal
+		justification []*message
+		err           error
+	}{
+		{
+			"no justification",
+			other,
+			nil,
+			errInvalidJustification,
+		},
+		{
+			"less than a quorum of ROUND CHANGE messages",
+			other,
+			[]*message{notPreparedRC(addrs[0]), notPreparedRC(addrs[1])},
+			errInvalidJustification,
+		},
+		{
+			"the same validator twice",
+			other,
+			[]*message{notPreparedRC(addrs[0]), notPreparedRC(addrs[1]), notPreparedRC(addrs[1])},
+			errInvalidJustification,
+		},
+		{
+			"no prepared block",
+			other,
+			[]*message{notPreparedRC(addrs[0]), notPreparedRC(addrs[1]), notPreparedRC(addrs[2])},
+			nil,
+		},
+		{
+			"the prepared block is proposed again",
+			prepared,
+			[]*message{notPreparedRC(addrs[0]), preparedRC(addrs[1], 1, prepares), notPreparedRC(addrs[2])},
+			nil,
+		},
+		{
+			"another block is proposed instead of the prepared one",
+			other,
+			[]*message{notPreparedRC(addrs[0]), preparedRC(addrs[1], 1, prepares), notPreparedRC(addrs[2])},
+			errInvalidJustification,
+		},
+		{
+			"the prepared block lacks a quorum of PREPARE messages",
+			prepared,
+			[]*message{notPreparedRC(addrs[0]), preparedRC(addrs[1], 1, prepares[:2]), notPreparedRC(addrs[2])},
+			errInvalidJustification,
+		},
+		{
+			"the PREPARE messages are for another round",
+			prepared,
+			[]*message{notPreparedRC(addrs[0]), preparedRC(addrs[1], 0, prepares), notPreparedRC(addrs[2])},
+			errInvalidJustification,
+		},
+		{
+			"a ROUND CHANGE message for another round",
+			other,
+			[]*message{notPreparedRC(addrs[0]), notPreparedRC(addrs[1]), testMessage(msgRoundChange, &RoundChange{View: testView(1, 1)}, addrs[2])},
+			errInvalidJustification,
+		},
+		{
+			"a message which is not a ROUND CHANGE",
+			other,
+			[]*message{notPreparedRC(addrs[0]), notPreparedRC(addrs[1]), prepares[2]},
+			errInvalidJustification,
+		},
+	}
+	for _, test := range testCases {
+		err := c.justifyPreprepare(&Preprepare{
+			View:          testView(1, 2),
+			Proposal:      test.proposal,
+			Justification: test.justification,
+		})
+		if err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+
+	// the first round needs no justification
+	if err := c.justifyPreprepare(&Preprepare{View: testView(1, 0), Proposal: other}); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+}
+
+func TestJustifyPreprepare_highestPreparedRound(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 3))
+
+	older := makeBlockWithTime(1, 1)
+	newer := makeBlockWithTime(1, 2)
+	rcs := []*message{
+		testMessage(msgRoundChange, &RoundChange{
+			View:          testView(1, 3),
+			PreparedRound: big.NewInt(0),
+			PreparedBlock: older,
+			Justification: testPrepares(addrs[:3], testView(1, 0), older.Hash()),
+		}, addrs[0]),
+		testMessage(msgRoundChange, &RoundChange{
+			View:          testView(1, 3),
+			PreparedRound: big.NewInt(2),
+			PreparedBlock: newer,
+			Justification: testPrepares(addrs[1:], testView(1, 2), newer.Hash()),
+		}, addrs[1]),
+		testMessage(msgRoundChange, &RoundChange{View: testView(1, 3)}, addrs[2]),
+	}
+	for _, msg := range rcs {
+		if _, err := c.roundChangeSet.Add(big.NewInt(3), msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if highest := c.roundChangeSet.HighestPrepared(big.NewInt(3)); highest == nil || highest.PreparedBlock.Hash() != newer.Hash() {
+		t.Errorf("expected the block prepared in round 2 to be the highest prepared block")
+	}
+	if err := c.justifyPreprepare(&Preprepare{View: testView(1, 3), Proposal: newer, Justification: rcs}); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if err := c.justifyPreprepare(&Preprepare{View: testView(1, 3), Proposal: older, Justification: rcs}); err != errInvalidJustification {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidJustification)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// name under which the core state is stored by the backend
+const coreStateName = "qbft"
+
+// persistedState is the consensus state of the current sequence stored by the
+// backend, from which a restarted validator resumes the sequence
+type persistedState struct {
+	Sequence   *big.Int
+	Round      *big.Int
+	State      State
+	Preprepare []byte   // RLP encoded preprepare of the round, empty if none
+	Prepared   []byte   // RLP encoded prepared certificate, empty if none
+	Messages   [][]byte // payloads of the messages sent at the sequence
+}
+
+// persistedPrepared is the RLP representation of a prepared certificate
+type persistedPrepared struct {
+	Round    *big.Int
+	Proposal *types.Block
+	Prepares []*message
+}
+
+// recordSentMessage adds the payload of a message about to be sent to the
+// messages sent at the current sequence, and stores the consensus state so that
+// the message is not contradicted after a restart
+func (c *core) recordSentMessage(payload []byte) {
+	for _, sent := range c.sentMessages {
+		if bytes.Equal(sent, payload) {
+			return
+		}
+	}
+	c.sentMessages = append(c.sentMessages, payload)
+	c.persistState()
+}
+
+// persistState stores the consensus state of the current sequence
+func (c *core) persistState() {
+	state := &persistedState{
+		Sequence: c.current.Sequence(),
+		Round:    c.current.Round(),
+		State:    c.state,
+		Messages: c.sentMessages,
+	}
+	var err error
+	if preprepare := c.current.Preprepare; preprepare != nil {
+		if state.Preprepare, err = rlp.EncodeToBytes(preprepare); err != nil {
+			c.logger.Error("Failed to encode preprepare", "err", err)
+			return
+		}
+	}
+	if prepared := c.current.Prepared(); prepared != nil {
+		state.Prepared, err = rlp.EncodeToBytes([]interface{}{prepared.round, prepared.proposal, prepared.prepares})
+		if err != nil {
+			c.logger.Error("Failed to encode prepared certificate", "err", err)
+			return
+		}
+	}
+	blob, err := rlp.EncodeToBytes(state)
+	if err != nil {
+		c.logger.Error("Failed to encode consensus state", "err", err)
+		return
+	}
+	if err := c.backend.WriteCoreState(coreStateName, blob); err != nil {
+		c.logger.Error("Failed to store consensus state", "err", err)
+	}
+}
+
+// restoreState resumes the current sequence from the state stored before a
+// restart. The messages sent at the sequence are sent again and handled as if
+// just received, so that the votes of this validator are neither lost nor
+// contradicted.
+func (c *core) restoreState() {
+	blob, err := c.backend.ReadCoreState(coreStateName)
+	if err != nil {
+		c.logger.Error("Failed to read consensus state", "err", err)
+		return
+	}
+	if blob == nil {
+		return
+	}
+	state := new(persistedState)
+	if err := rlp.DecodeBytes(blob, state); err != nil {
+		c.logger.Error("Failed to decode consensus state", "err", err)
+		return
+	}
+	// Nothing to resume if the sequence was committed before the restart
+	if state.Sequence.Cmp(c.current.Sequence()) != 0 {
+		return
+	}
+	var preprepare *Preprepare
+	if len(state.Preprepare) > 0 {
+		preprepare = new(Preprepare)
+		if err := rlp.DecodeBytes(state.Preprepare, preprepare); err != nil {
+			c.logger.Error("Failed to decode preprepare", "err", err)
+			return
+		}
+	}
+	var prepared *preparedCertificate
+	if len(state.Prepared) > 0 {
+		var p persistedPrepared
+		if err := rlp.DecodeBytes(state.Prepared, &p); err != nil {
+			c.logger.Error("Failed to decode prepared certificate", "err", err)
+			return
+		}
+		prepared = &preparedCertificate{round: p.Round, proposal: p.Proposal, prepares: p.Prepares}
+	}
+
+	_, lastProposer := c.backend.LastProposal()
+	view := &istanbul.View{Sequence: state.Sequence, Round: state.Round}
+	c.current = newRoundState(view, c.valSet, prepared, nil)
+	c.current.SetPreprepare(preprepare)
+	c.valSet.CalcProposer(lastProposer, state.Round.Uint64())
+	c.sentMessages = state.Messages
+	c.setState(state.State)
+	c.newRoundChangeTimer()
+	c.logger.Info("Resume consensus state", "seq", state.Sequence, "round", state.Round, "state", state.State, "prepared", prepared != nil, "messages", len(state.Messages))
+
+	for _, payload := range state.Messages {
+		if err := c.backend.Gossip(c.valSet, payload); err != nil {
+			c.logger.Error("Failed to gossip message", "err", err)
+		}
+		if err := c.handleMsg(payload); err != nil {
+			c.logger.Trace("Failed to handle sent message", "err", err)
+		}
+	}
+}
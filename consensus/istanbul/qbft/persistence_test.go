@@ -0,0 +1,81 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// restartCore replaces the core of the backend by a new one, started as after
+// a restart without handling events
+func restartCore(backend *testSystemBackend) *core {
+	c := New(backend, testConfig()).(*core)
+	c.logger = testLogger
+	c.validateFn = backend.CheckValidatorSignature
+	backend.engine = c
+
+	c.startNewRound(common.Big0)
+	c.restoreState()
+	c.stopTimer()
+	return c
+}
+
+func TestRestoreState(t *testing.T) {
+	view := testView(1, 1)
+	c, addrs := newTestCore(4, view)
+	backend := c.backend.(*testSystemBackend)
+	go backend.sys.listen()
+	defer close(backend.sys.quit)
+
+	proposal := makeBlock(1)
+	c.current.SetPreprepare(&Preprepare{View: view, Proposal: proposal})
+	c.current.SetPrepared(testPrepares(addrs[:3], view, proposal.Hash()))
+	c.state = StatePrepared
+	c.sendCommit()
+
+	c = restartCore(backend)
+	if c.current.Sequence().Cmp(view.Sequence) != 0 || c.current.Round().Cmp(view.Round) != 0 {
+		t.Errorf("view mismatch: have %v, want %v", c.currentView(), view)
+	}
+	if c.state != StatePrepared {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StatePrepared)
+	}
+	if c.current.Proposal() == nil || c.current.Proposal().Hash() != proposal.Hash() {
+		t.Errorf("proposal mismatch: have %v, want %v", c.current.Proposal(), proposal)
+	}
+	prepared := c.current.Prepared()
+	if prepared == nil || prepared.round.Cmp(view.Round) != 0 || prepared.proposal.Hash() != proposal.Hash() || len(prepared.prepares) != 3 {
+		t.Errorf("prepared certificate mismatch: have %+v", prepared)
+	}
+	// the COMMIT sent before the restart is taken into account again
+	if len(c.sentMessages) != 1 || c.current.Commits.Get(backend.Address()) == nil {
+		t.Errorf("sent COMMIT not restored: have %d sent messages, %d commits", len(c.sentMessages), c.current.Commits.Size())
+	}
+
+	// once the sequence is committed, there is nothing left to resume
+	backend.committedMsgs = append(backend.committedMsgs, testCommittedMsgs{commitProposal: proposal, round: view.Round})
+	c = restartCore(backend)
+	if c.current.Sequence().Cmp(big.NewInt(2)) != 0 || c.current.Round().Sign() != 0 {
+		t.Errorf("view mismatch: have %v, want sequence 2, round 0", c.currentView())
+	}
+	if c.state != StateAcceptRequest || c.current.Prepared() != nil || len(c.sentMessages) != 0 {
+		t.Errorf("state of the committed sequence restored: state %v, prepared %v, %d sent messages", c.state, c.current.Prepared() != nil, len(c.sentMessages))
+	}
+}
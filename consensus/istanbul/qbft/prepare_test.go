@@ -0,0 +1,105 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+package qbft
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// newPreparingCore returns a core which accepted the PRE-PREPARE of the block
+// at the given view and doesn't broadcast its own messages
+func newPreparingCore(view *istanbul.View, proposal istanbul.Proposal) (*core, []*testSystemBackend) {
+	sys := newTestSystemWithBackend(4, testConfig())
+	for _, b := range sys.backends {
+		b.silent = true
+	}
+	c := sys.backends[0].engine.(*core)
+	c.valSet = sys.backends[0].peers
+	c.current = newRoundState(view, c.valSet, nil, nil)
+	c.roundChangeSet = newRoundChangeSet(c.valSet)
+	c.current.SetPreprepare(&Preprepare{View: view, Proposal: proposal})
+	c.state = StatePreprepared
+	return c, sys.backends
+}
+
+func TestHandlePrepare(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 0), block)
+
+	for i, b := range backends[1:] {
+		msg := testMessage(msgPrepare, &istanbul.Subject{View: testView(1, 0), Digest: block.Hash()}, b.address)
+		_, src := c.valSet.GetByAddress(b.address)
+		if err := c.handlePrepare(msg, src); err != nil {
+			t.Fatalf("error mismatch: have %v, want nil", err)
+		}
+		if i == 0 && c.state != StatePreprepared {
+			t.Errorf("state mismatch: have %v, want %v", c.state, StatePreprepared)
+		}
+	}
+
+	if c.state != StatePrepared {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StatePrepared)
+	}
+	prepared := c.current.Prepared()
+	if prepared == nil {
+		t.Fatal("expected the block to be prepared")
+	}
+	if prepared.proposal.Hash() != block.Hash() || prepared.round.Sign() != 0 {
+		t.Errorf("prepared certificate mismatch: have %v in round %v, want %v in round 0", prepared.proposal.Hash(), prepared.round, block.Hash())
+	}
+	if len(prepared.prepares) != c.QuorumSize() {
+		t.Errorf("the number of prepares mismatch: have %v, want %v", len(prepared.prepares), c.QuorumSize())
+	}
+}
+
+func TestHandlePrepare_invalid(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 1), block)
+	_, src := c.valSet.GetByAddress(backends[1].address)
+
+	testCases := []struct {
+		name    string
+		subject *istanbul.Subject
+		err     error
+	}{
+		{
+			"another digest",
+			&istanbul.Subject{View: testView(1, 1), Digest: makeBlockWithTime(1, 1).Hash()},
+			errInconsistentSubject,
+		},
+		{
+			"an old round",
+			&istanbul.Subject{View: testView(1, 0), Digest: block.Hash()},
+			errOldMessage,
+		},
+		{
+			"a future sequence",
+			&istanbul.Subject{View: testView(2, 0), Digest: block.Hash()},
+			errFutureMessage,
+		},
+	}
+	for _, test := range testCases {
+		msg := testMessage(msgPrepare, test.subject, backends[1].address)
+		if err := c.handlePrepare(msg, src); err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+	if size := c.current.Prepares.Size(); size != 0 {
+		t.Errorf("the number of prepares mismatch: have %v, want 0", size)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func (c *core) sendPreprepare(request *istanbul.Request) {
+	logger := c.logger.New("state", c.state)
+	// If I'm the proposer and I have the same sequence with the proposal
+	if c.current.Sequence().Cmp(request.Proposal.Number()) == 0 && c.IsProposer() {
+		curView := c.currentView()
+		preprepare := &Preprepare{
+			View:     curView,
+			Proposal: request.Proposal,
+		}
+		// After the first round, the proposal is justified by a quorum of ROUND CHANGE
+		// messages, and must be the block prepared in the highest round if any
+		if curView.Round.Sign() > 0 {
+			preprepare.Justification = c.roundChangeSet.Messages(curView.Round)
+			if len(preprepare.Justification) < c.QuorumSize() {
+				logger.Trace("Not enough ROUND CHANGE messages to justify a proposal", "view", curView)
+				return
+			}
+			if prepared := c.roundChangeSet.HighestPrepared(curView.Round); prepared != nil {
+				preprepare.Proposal = prepared.PreparedBlock
+			}
+		}
+		encoded, err := Encode(preprepare)
+		if err != nil {
+			logger.Error("Failed to encode", "view", curView)
+			return
+		}
+		c.broadcast(&message{
+			Code: msgPreprepare,
+			Msg:  encoded,
+		})
+	}
+}
+
+func (c *core) handlePreprepare(msg *message, src istanbul.Validator) error {
+	logger := c.logger.New("from", src, "state", c.state)
+
+	// Decode PRE-PREPARE
+	var preprepare *Preprepare
+	err := msg.Decode(&preprepare)
+	if err != nil {
+		return errFailedDecodePreprepare
+	}
+
+	// Ensure we have the same view with the PRE-PREPARE message. A PRE-PREPARE of
+	// a later round at the current sequence moves us to its round once it is
+	// justified, as does one for the round we are waiting to change to.
+	roundChange := c.waitingForRoundChange
+	if err := c.checkMessage(msgPreprepare, preprepare.View); err == errFutureMessage && c.isFutureRoundOfSequence(preprepare.View) {
+		roundChange = true
+	} else if err != nil {
+		return err
+	}
+
+	// Check if the message comes from the proposer of its round
+	if roundChange {
+		if err := c.checkPreprepareFromProposer(preprepare, src); err != nil {
+			logger.Warn("Ignore preprepare messages from non-proposer")
+			return err
+		}
+	} else if !c.valSet.IsProposer(src.Address()) {
+		logger.Warn("Ignore preprepare messages from non-proposer")
+		return errNotFromProposer
+	}
+
+	// Ensure the proposal of a later round is justified
+	if err := c.justifyPreprepare(preprepare); err != nil {
+		logger.Warn("Ignore unjustified PRE-PREPARE", "view", preprepare.View, "err", err)
+		return err
+	}
+	if roundChange {
+		c.startNewRound(preprepare.View.Round)
+	}
+
+	// Verify the proposal we received
+	if duration, err := c.backend.Verify(preprepare.Proposal); err != nil {
+		// if it's a future block, we will handle it again after the duration
+		if err == consensus.ErrFutureBlock {
+			logger.Info("Proposed block will be handled in the future", "err", err, "duration", duration)
+			c.stopFuturePreprepareTimer()
+			c.futurePreprepareTimer = time.AfterFunc(duration, func() {
+				c.sendEvent(backlogEvent{
+					Src: src,
+					Msg: msg,
+				})
+			})
+		} else {
+			logger.Warn("Failed to verify proposal", "err", err, "duration", duration)
+			c.sendNextRoundChange()
+		}
+		return err
+	}
+
+	// Here is about to accept the PRE-PREPARE
+	if c.state == StateAcceptRequest {
+		c.acceptPreprepare(preprepare)
+		c.setState(StatePreprepared)
+		c.sendPrepare()
+	}
+
+	return nil
+}
+
+// isFutureRoundOfSequence reports whether the view is a later round of the
+// current sequence
+func (c *core) isFutureRoundOfSequence(view *istanbul.View) bool {
+	return view.Sequence.Cmp(c.current.Sequence()) == 0 && view.Round.Cmp(c.current.Round()) > 0
+}
+
+// checkPreprepareFromProposer checks that a PRE-PREPARE comes from the proposer
+// of its round, which may not be the current proposer while changing round
+func (c *core) checkPreprepareFromProposer(preprepare *Preprepare, src istanbul.Validator) error {
+	_, lastProposer := c.backend.LastProposal()
+	valSet := c.valSet.Copy()
+	valSet.CalcProposer(lastProposer, preprepare.View.Round.Uint64())
+	if !valSet.IsProposer(src.Address()) {
+		return errNotFromProposer
+	}
+	return nil
+}
+
+func (c *core) acceptPreprepare(preprepare *Preprepare) {
+	c.consensusTimestamp = time.Now()
+	c.current.SetPreprepare(preprepare)
+}
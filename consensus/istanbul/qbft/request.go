@@ -0,0 +1,66 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+)
+
+func (c *core) handleRequest(request *istanbul.Request) error {
+	logger := c.logger.New("state", c.state, "seq", c.current.sequence)
+	if err := c.checkRequestMsg(request); err != nil {
+		if err == errInvalidMessage {
+			logger.Warn("invalid request")
+			return err
+		}
+		logger.Warn("unexpected request", "err", err, "number", request.Proposal.Number(), "hash", request.Proposal.Hash())
+		return err
+	}
+	logger.Trace("handleRequest", "number", request.Proposal.Number(), "hash", request.Proposal.Hash())
+
+	c.current.pendingRequest = request
+	if c.state == StateAcceptRequest {
+		c.sendPreprepare(request)
+	}
+	return nil
+}
+
+// check request state, see istanbulcommon.CheckRequestMsg
+func (c *core) checkRequestMsg(request *istanbul.Request) error {
+	return istanbulcommon.CheckRequestMsg(request, c.current.sequence)
+}
+
+func (c *core) storeRequestMsg(request *istanbul.Request) {
+	logger := c.logger.New("state", c.state)
+
+	logger.Trace("Store future request", "number", request.Proposal.Number(), "hash", request.Proposal.Hash())
+
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+
+	istanbulcommon.StoreRequestMsg(c.pendingRequests, request)
+}
+
+func (c *core) processPendingRequests() {
+	c.pendingRequestsMu.Lock()
+	defer c.pendingRequestsMu.Unlock()
+
+	istanbulcommon.ProcessPendingRequests(c.pendingRequests, c.current.sequence, c.logger, func(ev istanbul.RequestEvent) {
+		go c.sendEvent(ev)
+	})
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+package qbft
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+func TestCheckRequestMsg(t *testing.T) {
+	c, _ := newTestCore(4, testView(1, 0))
+
+	testCases := []struct {
+		name    string
+		request *istanbul.Request
+		err     error
+	}{
+		{"no request", nil, errInvalidMessage},
+		{"no proposal", &istanbul.Request{}, errInvalidMessage},
+		{"old request", &istanbul.Request{Proposal: makeBlock(0)}, errOldMessage},
+		{"future request", &istanbul.Request{Proposal: makeBlock(2)}, errFutureMessage},
+		{"current request", &istanbul.Request{Proposal: makeBlock(1)}, nil},
+	}
+	for _, test := range testCases {
+		if err := c.checkRequestMsg(test.request); err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestHandleRequest(t *testing.T) {
+	c, _ := newTestCore(4, testView(1, 0))
+	// keep the core from proposing the block
+	c.state = StatePreprepared
+
+	request := &istanbul.Request{Proposal: makeBlock(1)}
+	if err := c.handleRequest(request); err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if c.current.pendingRequest != request {
+		t.Errorf("pending request mismatch: have %v, want %v", c.current.pendingRequest, request)
+	}
+	if !c.IsCurrentProposal(request.Proposal.Hash()) {
+		t.Error("expected the request to be the current proposal")
+	}
+
+	if err := c.handleRequest(&istanbul.Request{Proposal: makeBlock(2)}); err != errFutureMessage {
+		t.Errorf("error mismatch: have %v, want %v", err, errFutureMessage)
+	}
+	if c.current.pendingRequest != request {
+		t.Errorf("pending request mismatch: have %v, want %v", c.current.pendingRequest, request)
+	}
+}
+
+func TestStoreRequestMsg(t *testing.T) {
+	c, _ := newTestCore(4, testView(0, 0))
+	requests := []istanbul.Request{
+		{Proposal: makeBlock(1)},
+		{Proposal: makeBlock(2)},
+		{Proposal: makeBlock(3)},
+	}
+
+	c.storeRequestMsg(&requests[1])
+	c.storeRequestMsg(&requests[0])
+	c.storeRequestMsg(&requests[2])
+	if c.pendingRequests.Size() != len(requests) {
+		t.Errorf("the size of pending requests mismatch: have %v, want %v", c.pendingRequests.Size(), len(requests))
+	}
+
+	c.current.sequence = big.NewInt(3)
+
+	c.subscribeEvents()
+	defer c.unsubscribeEvents()
+
+	c.processPendingRequests()
+
+	select {
+	case ev := <-c.events.Chan():
+		e, ok := ev.Data.(istanbul.RequestEvent)
+		if !ok {
+			t.Fatalf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
+		}
+		if e.Proposal.Number().Cmp(requests[2].Proposal.Number()) != 0 {
+			t.Errorf("the number of proposal mismatch: have %v, want %v", e.Proposal.Number(), requests[2].Proposal.Number())
+		}
+	case <-time.After(2 * time.Second):
+		t.Error("unexpected timeout occurs")
+	}
+	// the old requests are dropped
+	if c.pendingRequests.Size() != 0 {
+		t.Errorf("the size of pending requests mismatch: have %v, want 0", c.pendingRequests.Size())
+	}
+}
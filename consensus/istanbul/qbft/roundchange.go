@@ -0,0 +1,217 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// sendNextRoundChange sends the ROUND CHANGE message with current round + 1
+func (c *core) sendNextRoundChange() {
+	cv := c.currentView()
+	c.sendRoundChange(new(big.Int).Add(cv.Round, common.Big1))
+}
+
+// sendRoundChange sends the ROUND CHANGE message with the given round, along
+// with the prepared certificate of the sequence if we have one
+func (c *core) sendRoundChange(round *big.Int) {
+	logger := c.logger.New("state", c.state)
+
+	cv := c.currentView()
+	if cv.Round.Cmp(round) >= 0 {
+		logger.Error("Cannot send out the round change", "current round", cv.Round, "target round", round)
+		return
+	}
+
+	c.catchUpRound(&istanbul.View{
+		// The round number we'd like to transfer to.
+		Round:    new(big.Int).Set(round),
+		Sequence: new(big.Int).Set(cv.Sequence),
+	})
+
+	// Now we have the new round number and sequence number
+	rc := &RoundChange{
+		View: c.currentView(),
+	}
+	if prepared := c.current.Prepared(); prepared != nil {
+		rc.PreparedRound = prepared.round
+		rc.PreparedBlock = prepared.proposal
+		rc.Justification = prepared.prepares
+	}
+
+	payload, err := Encode(rc)
+	if err != nil {
+		logger.Error("Failed to encode ROUND CHANGE", "rc", rc, "err", err)
+		return
+	}
+
+	c.broadcast(&message{
+		Code: msgRoundChange,
+		Msg:  payload,
+	})
+}
+
+func (c *core) handleRoundChange(msg *message, src istanbul.Validator) error {
+	logger := c.logger.New("state", c.state, "from", src.Address().Hex())
+
+	// Decode ROUND CHANGE message
+	var rc *RoundChange
+	if err := msg.Decode(&rc); err != nil {
+		logger.Error("Failed to decode ROUND CHANGE", "err", err)
+		return errFailedDecodeRoundChange
+	}
+
+	if err := c.checkMessage(msgRoundChange, rc.View); err != nil {
+		return err
+	}
+
+	// Ensure the prepared block, if any, is justified
+	if err := c.verifyRoundChange(rc); err != nil {
+		logger.Warn("Ignore unjustified ROUND CHANGE", "view", rc.View, "err", err)
+		return err
+	}
+
+	roundView := rc.View
+
+	// Add the ROUND CHANGE message to its message set and return how many
+	// messages we've got with the same round number and sequence number.
+	num, err := c.roundChangeSet.Add(roundView.Round, msg)
+	if err != nil {
+		logger.Warn("Failed to add round change message", "from", src, "msg", msg, "err", err)
+		return err
+	}
+
+	// Once f+1 validators asked to move to rounds above ours, at least one
+	// honest validator did, so we move to the lowest of those rounds.
+	if minRound := c.roundChangeSet.MinRoundAbove(c.current.Round(), c.valSet.F()+1); minRound != nil {
+		c.sendRoundChange(minRound)
+	}
+
+	cv := c.currentView()
+	if num >= c.QuorumSize() && (c.waitingForRoundChange || cv.Round.Cmp(roundView.Round) < 0) {
+		// We've received Ceil(2N/3) ROUND CHANGE messages, start a new round immediately.
+		c.startNewRound(roundView.Round)
+		return nil
+	} else if cv.Round.Cmp(roundView.Round) < 0 {
+		// Only gossip the message with current round to other validators.
+		return errIgnored
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+func newRoundChangeSet(valSet istanbul.ValidatorSet) *roundChangeSet {
+	return &roundChangeSet{
+		validatorSet: valSet,
+		roundChanges: make(map[uint64]*messageSet),
+		mu:           new(sync.Mutex),
+	}
+}
+
+type roundChangeSet struct {
+	validatorSet istanbul.ValidatorSet
+	roundChanges map[uint64]*messageSet
+	mu           *sync.Mutex
+}
+
+// Add adds the round and message into round change set
+func (rcs *roundChangeSet) Add(r *big.Int, msg *message) (int, error) {
+	rcs.mu.Lock()
+	defer rcs.mu.Unlock()
+
+	round := r.Uint64()
+	if rcs.roundChanges[round] == nil {
+		rcs.roundChanges[round] = newMessageSet(rcs.validatorSet)
+	}
+	err := rcs.roundChanges[round].Add(msg)
+	if err != nil {
+		return 0, err
+	}
+	return rcs.roundChanges[round].Size(), nil
+}
+
+// Clear deletes the messages with smaller round
+func (rcs *roundChangeSet) Clear(round *big.Int) {
+	rcs.mu.Lock()
+	defer rcs.mu.Unlock()
+
+	for k, rms := range rcs.roundChanges {
+		if len(rms.Values()) == 0 || k < round.Uint64() {
+			delete(rcs.roundChanges, k)
+		}
+	}
+}
+
+// Messages returns the ROUND CHANGE messages for the given round
+func (rcs *roundChangeSet) Messages(round *big.Int) []*message {
+	rcs.mu.Lock()
+	defer rcs.mu.Unlock()
+
+	if rms := rcs.roundChanges[round.Uint64()]; rms != nil {
+		return rms.Values()
+	}
+	return nil
+}
+
+// HighestPrepared returns the ROUND CHANGE message for the given round which
+// carries the block prepared in the highest round, or nil if none carries a
+// prepared block
+func (rcs *roundChangeSet) HighestPrepared(round *big.Int) *RoundChange {
+	var highest *RoundChange
+	for _, msg := range rcs.Messages(round) {
+		var rc *RoundChange
+		if err := msg.Decode(&rc); err != nil || !rc.IsPrepared() {
+			continue
+		}
+		if highest == nil || highest.PreparedRound.Cmp(rc.PreparedRound) < 0 {
+			highest = rc
+		}
+	}
+	return highest
+}
+
+// MinRoundAbove returns the lowest round above the given one for which there
+// are ROUND CHANGE messages, provided that at least num validators sent ROUND
+// CHANGE messages for rounds above it
+func (rcs *roundChangeSet) MinRoundAbove(round *big.Int, num int) *big.Int {
+	rcs.mu.Lock()
+	defer rcs.mu.Unlock()
+
+	var minRound *big.Int
+	senders := make(map[common.Address]bool)
+	for k, rms := range rcs.roundChanges {
+		r := new(big.Int).SetUint64(k)
+		if r.Cmp(round) <= 0 || rms.Size() == 0 {
+			continue
+		}
+		for _, msg := range rms.Values() {
+			senders[msg.Address] = true
+		}
+		if minRound == nil || minRound.Cmp(r) > 0 {
+			minRound = r
+		}
+	}
+	if len(senders) < num {
+		return nil
+	}
+	return minRound
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+package qbft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestHandleRoundChange(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	c.backend.(*testSystemBackend).silent = true
+	defer c.stopTimer()
+
+	roundChange := func(from int, round int64) error {
+		msg := testMessage(msgRoundChange, &RoundChange{View: testView(1, round)}, addrs[from])
+		_, src := c.valSet.GetByAddress(addrs[from])
+		return c.handleRoundChange(msg, src)
+	}
+
+	// a single validator can't move us to a later round
+	if err := roundChange(1, 2); err != errIgnored {
+		t.Errorf("error mismatch: have %v, want %v", err, errIgnored)
+	}
+	if round := c.current.Round(); round.Sign() != 0 {
+		t.Errorf("round mismatch: have %v, want 0", round)
+	}
+
+	// f+1 validators move us to the round they asked for
+	if err := roundChange(2, 2); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if round := c.current.Round(); round.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("round mismatch: have %v, want 2", round)
+	}
+	if !c.waitingForRoundChange {
+		t.Error("expected to wait for round change")
+	}
+
+	// a quorum starts the round
+	if err := roundChange(3, 2); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if c.waitingForRoundChange {
+		t.Error("expected the new round to be started")
+	}
+	if c.state != StateAcceptRequest {
+		t.Errorf("state mismatch: have %v, want %v", c.state, StateAcceptRequest)
+	}
+	if round := c.current.Round(); round.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("round mismatch: have %v, want 2", round)
+	}
+
+	// ROUND CHANGE messages of earlier rounds are old
+	if err := roundChange(1, 1); err != errOldMessage {
+		t.Errorf("error mismatch: have %v, want %v", err, errOldMessage)
+	}
+}
+
+func TestHandleRoundChange_unjustified(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	c.backend.(*testSystemBackend).silent = true
+	defer c.stopTimer()
+
+	// the block was prepared by less than a quorum
+	prepared := makeBlock(1)
+	msg := testMessage(msgRoundChange, &RoundChange{
+		View:          testView(1, 1),
+		PreparedRound: big.NewInt(0),
+		PreparedBlock: prepared,
+		Justification: testPrepares(addrs[:2], testView(1, 0), prepared.Hash()),
+	}, addrs[1])
+	_, src := c.valSet.GetByAddress(addrs[1])
+	if err := c.handleRoundChange(msg, src); err != errInvalidJustification {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidJustification)
+	}
+	if msgs := c.roundChangeSet.Messages(big.NewInt(1)); len(msgs) != 0 {
+		t.Errorf("the number of round change messages mismatch: have %v, want 0", len(msgs))
+	}
+}
+
+func TestSendRoundChange_prepared(t *testing.T) {
+	block := makeBlock(1)
+	c, backends := newPreparingCore(testView(1, 0), block)
+	defer c.stopTimer()
+
+	prepares := testPrepares([]common.Address{backends[1].address, backends[2].address, backends[3].address}, testView(1, 0), block.Hash())
+	c.current.SetPrepared(prepares)
+	c.sendRoundChange(big.NewInt(1))
+
+	if round := c.current.Round(); round.Cmp(common.Big1) != 0 {
+		t.Errorf("round mismatch: have %v, want 1", round)
+	}
+	// the prepared certificate is kept for the ROUND CHANGE messages of the
+	// later rounds
+	prepared := c.current.Prepared()
+	if prepared == nil || prepared.proposal.Hash() != block.Hash() || prepared.round.Sign() != 0 {
+		t.Errorf("expected the block prepared in round 0 to be kept")
+	}
+}
+
+func TestRoundChangeSet_Clear(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	rcs := c.roundChangeSet
+
+	for round := int64(1); round <= 3; round++ {
+		if _, err := rcs.Add(big.NewInt(round), testMessage(msgRoundChange, &RoundChange{View: testView(1, round)}, addrs[0])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if num, err := rcs.Add(big.NewInt(2), testMessage(msgRoundChange, &RoundChange{View: testView(1, 2)}, addrs[1])); err != nil || num != 2 {
+		t.Errorf("the number of round change messages mismatch: have %v (%v), want 2", num, err)
+	}
+
+	rcs.Clear(big.NewInt(2))
+	if msgs := rcs.Messages(big.NewInt(1)); len(msgs) != 0 {
+		t.Errorf("expected the messages of round 1 to be cleared, got %v", len(msgs))
+	}
+	if msgs := rcs.Messages(big.NewInt(2)); len(msgs) != 2 {
+		t.Errorf("the number of messages of round 2 mismatch: have %v, want 2", len(msgs))
+	}
+	if msgs := rcs.Messages(big.NewInt(3)); len(msgs) != 1 {
+		t.Errorf("the number of messages of round 3 mismatch: have %v, want 1", len(msgs))
+	}
+}
+
+func TestRoundChangeSet_MinRoundAbove(t *testing.T) {
+	c, addrs := newTestCore(4, testView(1, 0))
+	rcs := c.roundChangeSet
+
+	rcs.Add(big.NewInt(3), testMessage(msgRoundChange, &RoundChange{View: testView(1, 3)}, addrs[0]))
+	if round := rcs.MinRoundAbove(big.NewInt(0), 2); round != nil {
+		t.Errorf("expected no round with a single sender, got %v", round)
+	}
+	rcs.Add(big.NewInt(2), testMessage(msgRoundChange, &RoundChange{View: testView(1, 2)}, addrs[1]))
+	if round := rcs.MinRoundAbove(big.NewInt(0), 2); round == nil || round.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("round mismatch: have %v, want 2", round)
+	}
+	if round := rcs.MinRoundAbove(big.NewInt(2), 2); round != nil {
+		t.Errorf("expected no round above round 2, got %v", round)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+// newRoundState creates a new roundState instance with the given view and validatorSet.
+// The prepared certificate and pending request are carried over when the round
+// changes at the same sequence.
+func newRoundState(view *istanbul.View, validatorSet istanbul.ValidatorSet, prepared *preparedCertificate, pendingRequest *istanbul.Request) *roundState {
+	return &roundState{
+		round:          view.Round,
+		sequence:       view.Sequence,
+		Prepares:       newMessageSet(validatorSet),
+		Commits:        newMessageSet(validatorSet),
+		prepared:       prepared,
+		pendingRequest: pendingRequest,
+		mu:             new(sync.RWMutex),
+	}
+}
+
+// preparedCertificate is a block prepared at the current sequence, with the
+// quorum of PREPARE messages which prepared it
+type preparedCertificate struct {
+	round    *big.Int
+	proposal istanbul.Proposal
+	prepares []*message
+}
+
+// roundState stores the consensus state
+type roundState struct {
+	round          *big.Int
+	sequence       *big.Int
+	Preprepare     *Preprepare
+	Prepares       *messageSet
+	Commits        *messageSet
+	prepared       *preparedCertificate // block prepared in the highest round at the sequence, nil if none
+	pendingRequest *istanbul.Request
+
+	mu *sync.RWMutex
+}
+
+func (s *roundState) Subject() *istanbul.Subject {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.Preprepare == nil {
+		return nil
+	}
+
+	return &istanbul.Subject{
+		View: &istanbul.View{
+			Round:    new(big.Int).Set(s.round),
+			Sequence: new(big.Int).Set(s.sequence),
+		},
+		Digest: s.Preprepare.Proposal.Hash(),
+	}
+}
+
+func (s *roundState) SetPreprepare(preprepare *Preprepare) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Preprepare = preprepare
+}
+
+func (s *roundState) Proposal() istanbul.Proposal {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.Preprepare != nil {
+		return s.Preprepare.Proposal
+	}
+
+	return nil
+}
+
+func (s *roundState) Round() *big.Int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.round
+}
+
+func (s *roundState) Sequence() *big.Int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sequence
+}
+
+// SetPrepared records the proposal of the round as prepared by the given
+// PREPARE messages
+func (s *roundState) SetPrepared(prepares []*message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Preprepare != nil {
+		s.prepared = &preparedCertificate{
+			round:    new(big.Int).Set(s.round),
+			proposal: s.Preprepare.Proposal,
+			prepares: prepares,
+		}
+	}
+}
+
+func (s *roundState) Prepared() *preparedCertificate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.prepared
+}
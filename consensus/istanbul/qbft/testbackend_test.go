@@ -0,0 +1,311 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	elog "github.com/ethereum/go-ethereum/log"
+)
+
+var testLogger = elog.New()
+
+type testSystemBackend struct {
+	id  uint64
+	sys *testSystem
+
+	engine Engine
+	peers  istanbul.ValidatorSet
+	events *event.TypeMux
+
+	mu            sync.Mutex
+	committedMsgs []testCommittedMsgs
+	silent        bool              // drops the messages sent by this backend
+	coreStates    map[string][]byte // states stored by the cores
+
+	address common.Address
+}
+
+type testCommittedMsgs struct {
+	commitProposal istanbul.Proposal
+	committedSeals [][]byte
+	round          *big.Int
+}
+
+// ==============================================
+//
+// define the functions that needs to be provided for Istanbul.
+
+func (self *testSystemBackend) Address() common.Address {
+	return self.address
+}
+
+// Peers returns all connected peers
+func (self *testSystemBackend) Validators(proposal istanbul.Proposal) istanbul.ValidatorSet {
+	return self.peers
+}
+
+func (self *testSystemBackend) EventMux() *event.TypeMux {
+	return self.events
+}
+
+func (self *testSystemBackend) Broadcast(valSet istanbul.ValidatorSet, message []byte) error {
+	if self.silent {
+		return nil
+	}
+	self.sys.queuedMessage <- istanbul.MessageEvent{
+		Payload: message,
+	}
+	return nil
+}
+
+func (self *testSystemBackend) Gossip(valSet istanbul.ValidatorSet, message []byte) error {
+	return nil
+}
+
+func (self *testSystemBackend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address, round *big.Int) error {
+	self.mu.Lock()
+	self.committedMsgs = append(self.committedMsgs, testCommittedMsgs{
+		commitProposal: proposal,
+		committedSeals: seals,
+		round:          new(big.Int).Set(round),
+	})
+	self.mu.Unlock()
+
+	// fake new head events
+	go self.events.Post(istanbul.FinalCommittedEvent{})
+	return nil
+}
+
+func (self *testSystemBackend) Committed() []testCommittedMsgs {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return append([]testCommittedMsgs(nil), self.committedMsgs...)
+}
+
+func (self *testSystemBackend) Verify(proposal istanbul.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+// Sign returns the address of the backend, so that CheckValidatorSignature
+// recovers it
+func (self *testSystemBackend) Sign(data []byte) ([]byte, error) {
+	return self.address.Bytes(), nil
+}
+
+// SignCommittedSeal returns the address of the backend like Sign
+func (self *testSystemBackend) SignCommittedSeal(number *big.Int, seal []byte) ([]byte, error) {
+	return self.Sign(seal)
+}
+
+func (self *testSystemBackend) CheckSignature([]byte, common.Address, []byte) error {
+	return nil
+}
+
+func (self *testSystemBackend) CheckValidatorSignature(data []byte, sig []byte) (common.Address, error) {
+	return common.BytesToAddress(sig), nil
+}
+
+func (self *testSystemBackend) NewRequest(request istanbul.Proposal) {
+	go self.events.Post(istanbul.RequestEvent{
+		Proposal: request,
+	})
+}
+
+func (self *testSystemBackend) HasBadProposal(hash common.Hash) bool {
+	return false
+}
+
+func (self *testSystemBackend) LastProposal() (istanbul.Proposal, common.Address) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	l := len(self.committedMsgs)
+	if l > 0 {
+		return self.committedMsgs[l-1].commitProposal, common.Address{}
+	}
+	return makeBlock(0), common.Address{}
+}
+
+func (self *testSystemBackend) HasPropsal(hash common.Hash, number *big.Int) bool {
+	return false
+}
+
+func (self *testSystemBackend) GetProposer(number uint64) common.Address {
+	return common.Address{}
+}
+
+func (self *testSystemBackend) ParentValidators(proposal istanbul.Proposal) istanbul.ValidatorSet {
+	return self.peers
+}
+
+func (self *testSystemBackend) WriteCoreState(name string, state []byte) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.coreStates == nil {
+		self.coreStates = make(map[string][]byte)
+	}
+	self.coreStates[name] = state
+	return nil
+}
+
+func (self *testSystemBackend) ReadCoreState(name string) ([]byte, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.coreStates[name], nil
+}
+
+func (self *testSystemBackend) Close() error {
+	return nil
+}
+
+// ==============================================
+//
+// define the struct that need to be provided for integration tests.
+
+type testSystem struct {
+	backends []*testSystemBackend
+
+	queuedMessage chan istanbul.MessageEvent
+	quit          chan struct{}
+}
+
+func generateValidators(n int) []common.Address {
+	vals := make([]common.Address, 0)
+	for i := 0; i < n; i++ {
+		privateKey, _ := crypto.GenerateKey()
+		vals = append(vals, crypto.PubkeyToAddress(privateKey.PublicKey))
+	}
+	return vals
+}
+
+// newTestSystemWithBackend creates n validators. Each starts a round of sequence
+// 1 with the given config once the system runs.
+func newTestSystemWithBackend(n uint64, config *istanbul.Config) *testSystem {
+	addrs := generateValidators(int(n))
+	sys := &testSystem{
+		backends:      make([]*testSystemBackend, n),
+		queuedMessage: make(chan istanbul.MessageEvent),
+		quit:          make(chan struct{}),
+	}
+
+	for i := uint64(0); i < n; i++ {
+		vset := validator.NewSet(addrs, istanbul.RoundRobin)
+		backend := &testSystemBackend{
+			id:      i,
+			sys:     sys,
+			events:  new(event.TypeMux),
+			peers:   vset,
+			address: vset.GetByIndex(i).Address(),
+		}
+		sys.backends[i] = backend
+
+		core := New(backend, config).(*core)
+		core.logger = testLogger
+		core.validateFn = backend.CheckValidatorSignature
+
+		backend.engine = core
+	}
+
+	return sys
+}
+
+// listen will consume messages from queue and deliver a message to core
+func (t *testSystem) listen() {
+	for {
+		select {
+		case <-t.quit:
+			return
+		case queuedMessage := <-t.queuedMessage:
+			for _, backend := range t.backends {
+				go backend.EventMux().Post(queuedMessage)
+			}
+		}
+	}
+}
+
+// Run starts the cores and delivers their messages, returning a function
+// which stops them
+func (t *testSystem) Run() func() {
+	for _, b := range t.backends {
+		b.engine.Start()
+	}
+
+	go t.listen()
+	return func() {
+		close(t.quit)
+		for _, b := range t.backends {
+			b.engine.Stop()
+		}
+	}
+}
+
+// waitForCommit waits until every backend which is not silent has committed
+// the given number of blocks
+func (t *testSystem) waitForCommit(count int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		done := true
+		for _, b := range t.backends {
+			if !b.silent && len(b.Committed()) < count {
+				done = false
+			}
+		}
+		if done {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+// ==============================================
+//
+// helper functions.
+
+func makeBlock(number int64) *types.Block {
+	header := &types.Header{
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(number),
+		GasLimit:   0,
+		GasUsed:    0,
+		Time:       0,
+	}
+	block := &types.Block{}
+	return block.WithSeal(header)
+}
+
+func makeBlockWithTime(number int64, time uint64) *types.Block {
+	header := &types.Header{
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(number),
+		Time:       time,
+	}
+	block := &types.Block{}
+	return block.WithSeal(header)
+}
@@ -0,0 +1,163 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulcommon "github.com/ethereum/go-ethereum/consensus/istanbul/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+type Engine interface {
+	Start() error
+	Stop() error
+
+	IsProposer() bool
+
+	// verify if a hash is the same as the proposed block in the current pending request
+	IsCurrentProposal(blockHash common.Hash) bool
+
+	// Rounds returns the start, end and timeout of the latest rounds
+	Rounds() []istanbul.RoundInfo
+}
+
+// State, message and message set are shared with the other Istanbul core
+type State = istanbulcommon.State
+
+const (
+	StateAcceptRequest = istanbulcommon.StateAcceptRequest
+	StatePreprepared   = istanbulcommon.StatePreprepared
+	StatePrepared      = istanbulcommon.StatePrepared
+	StateCommitted     = istanbulcommon.StateCommitted
+)
+
+type (
+	message    = istanbulcommon.Message
+	messageSet = istanbulcommon.MessageSet
+)
+
+type (
+	backlogEvent = istanbulcommon.BacklogEvent
+	timeoutEvent = istanbulcommon.TimeoutEvent
+)
+
+func newMessageSet(valSet istanbul.ValidatorSet) *messageSet {
+	return istanbulcommon.NewMessageSet(valSet)
+}
+
+// The QBFT message codes do not overlap with the IBFT ones, so that messages
+// of the other engine are rejected while a network moves from IBFT to QBFT.
+const (
+	msgPreprepare uint64 = iota + 0x12
+	msgPrepare
+	msgCommit
+	msgRoundChange
+)
+
+// ==============================================
+//
+// helper functions
+
+func Encode(val interface{}) ([]byte, error) {
+	return rlp.EncodeToBytes(val)
+}
+
+// ==============================================
+//
+// QBFT message payloads. PREPARE and COMMIT messages carry an istanbul.Subject.
+
+// Preprepare proposes a block for a view. In rounds after the first, the
+// proposal is justified by a quorum of ROUND CHANGE messages for the round: if
+// any of them carries a prepared block, the block prepared in the highest
+// round must be proposed again.
+type Preprepare struct {
+	View          *istanbul.View
+	Proposal      istanbul.Proposal
+	Justification []*message
+}
+
+// EncodeRLP serializes b into the Ethereum RLP format.
+func (b *Preprepare) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{b.View, b.Proposal, b.Justification})
+}
+
+// DecodeRLP implements rlp.Decoder, and load the consensus fields from a RLP stream.
+func (b *Preprepare) DecodeRLP(s *rlp.Stream) error {
+	var preprepare struct {
+		View          *istanbul.View
+		Proposal      *types.Block
+		Justification []*message
+	}
+
+	if err := s.Decode(&preprepare); err != nil {
+		return err
+	}
+	b.View, b.Proposal, b.Justification = preprepare.View, preprepare.Proposal, preprepare.Justification
+	return nil
+}
+
+// RoundChange asks to move to the round of its view. If the sender prepared a
+// block at the sequence, it carries the block prepared in the highest round
+// along with the PREPARE messages which justify it.
+type RoundChange struct {
+	View          *istanbul.View
+	PreparedRound *big.Int
+	PreparedBlock istanbul.Proposal
+	Justification []*message
+}
+
+// EncodeRLP serializes b into the Ethereum RLP format.
+func (b *RoundChange) EncodeRLP(w io.Writer) error {
+	var preparedBlock interface{}
+	if b.PreparedBlock != nil {
+		preparedBlock = b.PreparedBlock
+	}
+	preparedRound := b.PreparedRound
+	if preparedRound == nil {
+		preparedRound = new(big.Int)
+	}
+	return rlp.Encode(w, []interface{}{b.View, preparedRound, preparedBlock, b.Justification})
+}
+
+// DecodeRLP implements rlp.Decoder, and load the consensus fields from a RLP stream.
+func (b *RoundChange) DecodeRLP(s *rlp.Stream) error {
+	var rc struct {
+		View          *istanbul.View
+		PreparedRound *big.Int
+		PreparedBlock *types.Block `rlp:"nil"`
+		Justification []*message
+	}
+
+	if err := s.Decode(&rc); err != nil {
+		return err
+	}
+	b.View, b.Justification = rc.View, rc.Justification
+	if rc.PreparedBlock != nil {
+		b.PreparedRound, b.PreparedBlock = rc.PreparedRound, rc.PreparedBlock
+	}
+	return nil
+}
+
+// IsPrepared reports whether the sender prepared a block at the sequence
+func (b *RoundChange) IsPrepared() bool {
+	return b.PreparedBlock != nil
+}
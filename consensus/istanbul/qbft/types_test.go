@@ -0,0 +1,114 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package qbft
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestPreprepareRLP(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	rc := testMessage(msgRoundChange, &RoundChange{View: testView(1, 1)}, addr)
+	pp := &Preprepare{
+		View:          testView(1, 1),
+		Proposal:      makeBlock(1),
+		Justification: []*message{rc},
+	}
+	encoded, err := rlp.EncodeToBytes(pp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded *Preprepare
+	if err := rlp.DecodeBytes(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.View, pp.View) || decoded.Proposal.Hash() != pp.Proposal.Hash() {
+		t.Errorf("preprepare mismatch: have %v, want %v", decoded, pp)
+	}
+	if len(decoded.Justification) != 1 || !reflect.DeepEqual(decoded.Justification[0], rc) {
+		t.Errorf("justification mismatch: have %v, want %v", decoded.Justification, pp.Justification)
+	}
+}
+
+func TestRoundChangeRLP(t *testing.T) {
+	view := testView(1, 2)
+	prepares := testPrepares([]common.Address{common.HexToAddress("0x1")}, testView(1, 1), common.HexToHash("0x2"))
+
+	testCases := []*RoundChange{
+		{View: view},
+		{View: view, PreparedRound: big.NewInt(0), PreparedBlock: makeBlock(1), Justification: prepares},
+		{View: view, PreparedRound: big.NewInt(1), PreparedBlock: makeBlock(1), Justification: prepares},
+	}
+	for _, rc := range testCases {
+		encoded, err := rlp.EncodeToBytes(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded *RoundChange
+		if err := rlp.DecodeBytes(encoded, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded.View, rc.View) {
+			t.Errorf("view mismatch: have %v, want %v", decoded.View, rc.View)
+		}
+		if decoded.IsPrepared() != rc.IsPrepared() {
+			t.Fatalf("prepared mismatch: have %v, want %v", decoded.IsPrepared(), rc.IsPrepared())
+		}
+		if !rc.IsPrepared() {
+			continue
+		}
+		if decoded.PreparedRound.Cmp(rc.PreparedRound) != 0 || decoded.PreparedBlock.Hash() != rc.PreparedBlock.Hash() {
+			t.Errorf("prepared block mismatch: have %v in round %v, want %v in round %v", decoded.PreparedBlock.Hash(), decoded.PreparedRound, rc.PreparedBlock.Hash(), rc.PreparedRound)
+		}
+		if !reflect.DeepEqual(decoded.Justification, rc.Justification) {
+			t.Errorf("justification mismatch: have %v, want %v", decoded.Justification, rc.Justification)
+		}
+	}
+}
+
+func TestMessageFromPayload(t *testing.T) {
+	addr := common.HexToAddress("0x1")
+	msg := testMessage(msgPrepare, &istanbul.Subject{View: testView(1, 0), Digest: common.HexToHash("0x2")}, addr)
+	payload, err := msg.Payload()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validateFn := func(data []byte, sig []byte) (common.Address, error) {
+		return common.BytesToAddress(sig), nil
+	}
+	decoded := new(message)
+	if err := decoded.FromPayload(payload, validateFn); err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	if !reflect.DeepEqual(decoded, msg) {
+		t.Errorf("message mismatch: have %v, want %v", decoded, msg)
+	}
+
+	// signed by another validator
+	msg.Signature = common.HexToAddress("0x3").Bytes()
+	payload, _ = msg.Payload()
+	if err := new(message).FromPayload(payload, validateFn); err != errInvalidSigner {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidSigner)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -45,6 +46,16 @@ type Request struct {
 	Proposal Proposal
 }
 
+// RoundInfo records when a consensus round started and ended, and the timeout
+// it was given
+type RoundInfo struct {
+	View     *View
+	Start    time.Time
+	End      time.Time     // zero while the round is running
+	Timeout  time.Duration // the timeout of the round in force when it ended
+	TimedOut bool          // whether the round ended because the timeout expired
+}
+
 // View includes a round number and a sequence number.
 // Sequence is the block number we'd like to commit.
 // Each round has a number and is composed by 3 steps: preprepare, prepare and commit.
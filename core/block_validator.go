@@ -71,6 +71,11 @@ func (v *BlockValidator) ValidateBody(block *types.Block) error {
 		}
 		return consensus.ErrPrunedAncestor
 	}
+	// Quorum
+	if verifier, ok := v.engine.(consensus.ParentStateVerifier); ok {
+		return verifier.VerifyParentState(v.bc, block)
+	}
+	// End Quorum
 	return nil
 }
 
@@ -90,7 +90,9 @@ func (p *StateProcessor) Process(block *types.Block, statedb, privateState *stat
 		}
 	}
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
-	p.engine.Finalize(p.bc, header, statedb, block.Transactions(), block.Uncles())
+	if err := p.engine.Finalize(p.bc, header, statedb, block.Transactions(), block.Uncles()); err != nil {
+		return nil, nil, nil, 0, err
+	}
 
 	return receipts, privateReceipts, allLogs, *usedGas, nil
 }
@@ -117,7 +119,7 @@ func ApplyTransaction(config *params.ChainConfig, bc *BlockChain, author *common
 
 	// Quorum - check for account permissions to execute the transaction
 	if core.IsV2Permission() {
-		if err := core.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
+		if err := core.CheckAccountPermissionForBlock(header.Number, tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -104,8 +104,13 @@ func (h *Header) Hash() common.Hash {
 	// If the mix digest is equivalent to the predefined Istanbul digest, use Istanbul
 	// specific hash calculation.
 	if h.MixDigest == IstanbulDigest {
-		// Seal is reserved in extra-data. To prove block is signed by the proposer.
-		if istanbulHeader := IstanbulFilteredHeader(h, true); istanbulHeader != nil {
+		if IsQBFTHeader(h) {
+			// QBFT blocks are hashed without the committed seals and round.
+			if qbftHeader := QBFTFilteredHeader(h); qbftHeader != nil {
+				return rlpHash(qbftHeader)
+			}
+		} else if istanbulHeader := IstanbulFilteredHeader(h, true); istanbulHeader != nil {
+			// Seal is reserved in extra-data. To prove block is signed by the proposer.
 			return rlpHash(istanbulHeader)
 		}
 	}
@@ -19,6 +19,8 @@ package types
 import (
 	"errors"
 	"io"
+	"math/big"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -34,21 +36,41 @@ var (
 
 	// ErrInvalidIstanbulHeaderExtra is returned if the length of extra-data is less than 32 bytes
 	ErrInvalidIstanbulHeaderExtra = errors.New("invalid istanbul header extra-data")
+
+	// errInvalidBLSKeyRegistration is returned if the extra-data registers more than one BLS key
+	errInvalidBLSKeyRegistration = errors.New("invalid BLS key registration in istanbul header extra-data")
+
+	QBFTAuthVote = byte(0xFF) // Vote type to add a validator in QBFT extra-data
+	QBFTDropVote = byte(0x00) // Vote type to remove a validator in QBFT extra-data
 )
 
+// BLSKeyRegistration registers the BLS public key of the validator voted on by
+// a header, along with the proof of possession of its secret key. It follows
+// the other fields of the extra-data, and only if present, so that headers
+// without registration keep their encoding.
+type BLSKeyRegistration struct {
+	PublicKey []byte
+	Proof     []byte
+}
+
 type IstanbulExtra struct {
 	Validators    []common.Address
 	Seal          []byte
 	CommittedSeal [][]byte
+	BLSKey        *BLSKeyRegistration
 }
 
 // EncodeRLP serializes ist into the Ethereum RLP format.
 func (ist *IstanbulExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		ist.Validators,
 		ist.Seal,
 		ist.CommittedSeal,
-	})
+	}
+	if ist.BLSKey != nil {
+		fields = append(fields, ist.BLSKey)
+	}
+	return rlp.Encode(w, fields)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
@@ -57,14 +79,32 @@ func (ist *IstanbulExtra) DecodeRLP(s *rlp.Stream) error {
 		Validators    []common.Address
 		Seal          []byte
 		CommittedSeal [][]byte
+		BLSKey        []*BLSKeyRegistration `rlp:"tail"`
 	}
 	if err := s.Decode(&istanbulExtra); err != nil {
 		return err
 	}
-	ist.Validators, ist.Seal, ist.CommittedSeal = istanbulExtra.Validators, istanbulExtra.Seal, istanbulExtra.CommittedSeal
+	blsKey, err := blsKeyRegistration(istanbulExtra.BLSKey)
+	if err != nil {
+		return err
+	}
+	ist.Validators, ist.Seal, ist.CommittedSeal, ist.BLSKey = istanbulExtra.Validators, istanbulExtra.Seal, istanbulExtra.CommittedSeal, blsKey
 	return nil
 }
 
+// blsKeyRegistration returns the BLS key registered by the optional trailing
+// field of the extra-data, if any
+func blsKeyRegistration(tail []*BLSKeyRegistration) (*BLSKeyRegistration, error) {
+	switch len(tail) {
+	case 0:
+		return nil, nil
+	case 1:
+		return tail[0], nil
+	default:
+		return nil, errInvalidBLSKeyRegistration
+	}
+}
+
 // ExtractIstanbulExtra extracts all values of the IstanbulExtra from the header. It returns an
 // error if the length of the given extra-data is less than 32 bytes or the extra-data can not
 // be decoded.
@@ -105,3 +145,119 @@ func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
 
 	return newHeader
 }
+
+// ValidatorVote is a vote to add or remove a validator, carried in the QBFT
+// extra-data instead of the coinbase and nonce of the header.
+type ValidatorVote struct {
+	RecipientAddress common.Address
+	VoteType         byte
+}
+
+// QBFTExtra is the extra-data of blocks sealed by QBFT. Unlike IstanbulExtra,
+// the whole extra-data is RLP encoded, the proposer is the coinbase of the
+// header rather than the signer of a seal, and the round in which the block
+// was committed is recorded.
+type QBFTExtra struct {
+	VanityData    []byte
+	Validators    []common.Address
+	Vote          *ValidatorVote
+	Round         uint32
+	CommittedSeal [][]byte
+	BLSKey        *BLSKeyRegistration
+}
+
+// EncodeRLP serializes qst into the Ethereum RLP format.
+func (qst *QBFTExtra) EncodeRLP(w io.Writer) error {
+	var vote interface{}
+	if qst.Vote != nil {
+		vote = qst.Vote
+	}
+	fields := []interface{}{
+		qst.VanityData,
+		qst.Validators,
+		vote,
+		qst.Round,
+		qst.CommittedSeal,
+	}
+	if qst.BLSKey != nil {
+		fields = append(fields, qst.BLSKey)
+	}
+	return rlp.Encode(w, fields)
+}
+
+// DecodeRLP implements rlp.Decoder, and load the QBFT fields from a RLP stream.
+func (qst *QBFTExtra) DecodeRLP(s *rlp.Stream) error {
+	var qbftExtra struct {
+		VanityData    []byte
+		Validators    []common.Address
+		Vote          *ValidatorVote `rlp:"nil"`
+		Round         uint32
+		CommittedSeal [][]byte
+		BLSKey        []*BLSKeyRegistration `rlp:"tail"`
+	}
+	if err := s.Decode(&qbftExtra); err != nil {
+		return err
+	}
+	blsKey, err := blsKeyRegistration(qbftExtra.BLSKey)
+	if err != nil {
+		return err
+	}
+	qst.VanityData, qst.Validators, qst.Vote, qst.Round, qst.CommittedSeal, qst.BLSKey = qbftExtra.VanityData, qbftExtra.Validators, qbftExtra.Vote, qbftExtra.Round, qbftExtra.CommittedSeal, blsKey
+	return nil
+}
+
+// qbftBlock holds the number of the first block whose header carries QBFT
+// extra-data as *big.Int, nil if the chain does not move to QBFT
+var qbftBlock atomic.Value
+
+// SetQBFTBlock sets the number of the first block whose header carries QBFT
+// extra-data, which determines how Istanbul headers are hashed. It is set
+// from the consensus configuration when the Istanbul engine is created; nil
+// means that all Istanbul headers carry IBFT extra-data.
+func SetQBFTBlock(number *big.Int) {
+	if number != nil {
+		number = new(big.Int).Set(number)
+	}
+	qbftBlock.Store(number)
+}
+
+// IsQBFTHeader reports whether the Istanbul header carries QBFT extra-data
+// according to the consensus configuration.
+func IsQBFTHeader(h *Header) bool {
+	number, _ := qbftBlock.Load().(*big.Int)
+	return number != nil && h.Number != nil && number.Cmp(h.Number) <= 0
+}
+
+// ExtractQBFTExtra extracts all values of the QBFTExtra from the header. It returns an
+// error if the extra-data can not be decoded.
+func ExtractQBFTExtra(h *Header) (*QBFTExtra, error) {
+	var qbftExtra *QBFTExtra
+	err := rlp.DecodeBytes(h.Extra, &qbftExtra)
+	if err != nil {
+		return nil, err
+	}
+	return qbftExtra, nil
+}
+
+// QBFTFilteredHeader returns a filtered header without the committed seals and
+// round, so that the hash of a block does not depend on the round in which it
+// was committed. It returns nil if the extra-data cannot be decoded/encoded by rlp.
+func QBFTFilteredHeader(h *Header) *Header {
+	newHeader := CopyHeader(h)
+	qbftExtra, err := ExtractQBFTExtra(newHeader)
+	if err != nil {
+		return nil
+	}
+
+	qbftExtra.Round = 0
+	qbftExtra.CommittedSeal = [][]byte{}
+
+	payload, err := rlp.EncodeToBytes(&qbftExtra)
+	if err != nil {
+		return nil
+	}
+
+	newHeader.Extra = payload
+
+	return newHeader
+}
@@ -18,11 +18,13 @@ package types
 
 import (
 	"bytes"
+	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestHeaderHash(t *testing.T) {
@@ -86,3 +88,126 @@ func TestExtractToIstanbul(t *testing.T) {
 		}
 	}
 }
+
+func TestExtractToQBFT(t *testing.T) {
+	expected := &QBFTExtra{
+		VanityData: bytes.Repeat([]byte{0x01}, IstanbulExtraVanity),
+		Validators: []common.Address{
+			common.BytesToAddress(hexutil.MustDecode("0x44add0ec310f115a0e603b2d7db9f067778eaf8a")),
+			common.BytesToAddress(hexutil.MustDecode("0x294fc7e8f22b3bcdcf955dd7ff3ba2ed833f8212")),
+		},
+		Vote: &ValidatorVote{
+			RecipientAddress: common.BytesToAddress(hexutil.MustDecode("0x6beaaed781d2d2ab6350f5c4566a2c6eaac407a6")),
+			VoteType:         QBFTAuthVote,
+		},
+		Round:         2,
+		CommittedSeal: [][]byte{bytes.Repeat([]byte{0x02}, IstanbulExtraSeal)},
+	}
+	extra, err := rlp.EncodeToBytes(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	qbftExtra, err := ExtractQBFTExtra(&Header{Extra: extra})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(qbftExtra, expected) {
+		t.Errorf("expected: %v, but got: %v", expected, qbftExtra)
+	}
+
+	// without a vote
+	expected.Vote = nil
+	extra, _ = rlp.EncodeToBytes(expected)
+	qbftExtra, err = ExtractQBFTExtra(&Header{Extra: extra})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qbftExtra.Vote != nil {
+		t.Errorf("expected no vote, but got: %v", qbftExtra.Vote)
+	}
+
+	// istanbul extra-data is not QBFT extra-data
+	istanbulExtra := common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000000f858f8549444add0ec310f115a0e603b2d7db9f067778eaf8a94294fc7e8f22b3bcdcf955dd7ff3ba2ed833f8212946beaaed781d2d2ab6350f5c4566a2c6eaac407a6948be76812f765c24641ec63dc2852b378aba2b44080c0")
+	if _, err := ExtractQBFTExtra(&Header{Extra: istanbulExtra}); err == nil {
+		t.Errorf("expected an error for istanbul extra-data")
+	}
+}
+
+func TestExtractBLSKeyRegistration(t *testing.T) {
+	blsKey := &BLSKeyRegistration{PublicKey: bytes.Repeat([]byte{0x03}, 192), Proof: bytes.Repeat([]byte{0x04}, 96)}
+	validators := []common.Address{common.BytesToAddress(hexutil.MustDecode("0x44add0ec310f115a0e603b2d7db9f067778eaf8a"))}
+
+	istanbulExtra := &IstanbulExtra{Validators: validators, Seal: []byte{}, CommittedSeal: [][]byte{}, BLSKey: blsKey}
+	payload, err := rlp.EncodeToBytes(istanbulExtra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ExtractIstanbulExtra(&Header{Extra: append(bytes.Repeat([]byte{0x00}, IstanbulExtraVanity), payload...)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, istanbulExtra) {
+		t.Errorf("expected: %v, but got: %v", istanbulExtra, decoded)
+	}
+
+	qbftExtra := &QBFTExtra{
+		VanityData:    bytes.Repeat([]byte{0x01}, IstanbulExtraVanity),
+		Validators:    validators,
+		Vote:          &ValidatorVote{RecipientAddress: validators[0], VoteType: QBFTAuthVote},
+		CommittedSeal: [][]byte{},
+		BLSKey:        blsKey,
+	}
+	extra, err := rlp.EncodeToBytes(qbftExtra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedQBFT, err := ExtractQBFTExtra(&Header{Extra: extra})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decodedQBFT, qbftExtra) {
+		t.Errorf("expected: %v, but got: %v", qbftExtra, decodedQBFT)
+	}
+
+	// a single key may be registered
+	extra, _ = rlp.EncodeToBytes([]interface{}{qbftExtra.VanityData, qbftExtra.Validators, qbftExtra.Vote, qbftExtra.Round, qbftExtra.CommittedSeal, blsKey, blsKey})
+	if _, err := ExtractQBFTExtra(&Header{Extra: extra}); err != errInvalidBLSKeyRegistration {
+		t.Errorf("expected: %v, but got: %v", errInvalidBLSKeyRegistration, err)
+	}
+}
+
+func TestQBFTHeaderHash(t *testing.T) {
+	qbftExtra := &QBFTExtra{
+		VanityData:    bytes.Repeat([]byte{0x00}, IstanbulExtraVanity),
+		Validators:    []common.Address{common.BytesToAddress(hexutil.MustDecode("0x44add0ec310f115a0e603b2d7db9f067778eaf8a"))},
+		CommittedSeal: [][]byte{},
+	}
+	extra, _ := rlp.EncodeToBytes(qbftExtra)
+	header := &Header{MixDigest: IstanbulDigest, Number: big.NewInt(1), Extra: extra}
+
+	SetQBFTBlock(big.NewInt(1))
+	defer SetQBFTBlock(nil)
+	hash := header.Hash()
+
+	// the round and committed seals are not part of the hash
+	qbftExtra.Round = 3
+	qbftExtra.CommittedSeal = [][]byte{bytes.Repeat([]byte{0x01}, IstanbulExtraSeal)}
+	header.Extra, _ = rlp.EncodeToBytes(qbftExtra)
+	if header.Hash() != hash {
+		t.Errorf("expected: %v, but got: %v", hash.Hex(), header.Hash().Hex())
+	}
+
+	// but the rest of the extra-data is
+	qbftExtra.Vote = &ValidatorVote{VoteType: QBFTDropVote}
+	header.Extra, _ = rlp.EncodeToBytes(qbftExtra)
+	if header.Hash() == hash {
+		t.Errorf("expected the vote to change the hash")
+	}
+
+	// headers before the QBFT block are not hashed as QBFT headers, whatever
+	// their extra-data
+	SetQBFTBlock(big.NewInt(2))
+	if header.Hash() != rlpHash(header) {
+		t.Errorf("expected the header before the QBFT block to be hashed as a whole")
+	}
+}
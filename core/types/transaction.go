@@ -230,6 +230,9 @@ func (tx *Transaction) Nonce() uint64                     { return tx.data.Accou
 func (tx *Transaction) CheckNonce() bool                  { return true }
 func (tx *Transaction) PrivacyMetadata() *PrivacyMetadata { return tx.privacyMetadata }
 
+// Time returns the time the transaction was first seen locally.
+func (tx *Transaction) Time() time.Time { return tx.time }
+
 // To returns the recipient address of the transaction.
 // It returns nil if the transaction is a contract creation.
 func (tx *Transaction) To() *common.Address {
@@ -238,6 +238,11 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 	}
 	eth.bloomIndexer.Start(eth.blockchain)
 
+	// Quorum: record the participation of the Istanbul validators in the blocks
+	if tracker, ok := eth.engine.(istanbulBackend.ParticipationTracker); ok {
+		tracker.TrackParticipation(eth.blockchain)
+	}
+
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
@@ -309,7 +314,20 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 		}
 		config.Istanbul.ProposerPolicy = istanbul.ProposerPolicy(chainConfig.Istanbul.ProposerPolicy)
 		config.Istanbul.Ceil2Nby3Block = chainConfig.Istanbul.Ceil2Nby3Block
+		config.Istanbul.QbftBlock = chainConfig.Istanbul.QbftBlock
+		config.Istanbul.ValidatorContract = chainConfig.Istanbul.ValidatorContractAddress
+		config.Istanbul.ValidatorContractBlock = chainConfig.Istanbul.ValidatorContractBlock
+		config.Istanbul.Transitions = chainConfig.Transitions
+		config.Istanbul.BlockReward = chainConfig.Istanbul.BlockReward
+		config.Istanbul.BlockRewardBeneficiary = chainConfig.Istanbul.BlockRewardBeneficiary
+		config.Istanbul.BlockRewardBlock = chainConfig.Istanbul.BlockRewardBlock
+		config.Istanbul.RoundTimeoutStrategy = chainConfig.Istanbul.RoundTimeoutStrategy
+		config.Istanbul.MaxRequestTimeout = chainConfig.Istanbul.MaxRequestTimeout
+		config.Istanbul.EmptyBlockPeriod = chainConfig.Istanbul.EmptyBlockPeriod
 		config.Istanbul.AllowedFutureBlockTime = config.Miner.AllowedFutureBlockTime //Quorum
+		if config.Istanbul.BLSKeyFile == "" {
+			config.Istanbul.BLSKeyFile = stack.ResolvePath(istanbulBackend.DatadirBLSKey)
+		}
 
 		return istanbulBackend.New(&config.Istanbul, stack.GetNodeKey(), db)
 	}
@@ -80,6 +80,7 @@ var DefaultConfig = Config{
 	RPCTxFeeCap: 1, // 1 ether
 
 	Istanbul: *istanbul.DefaultConfig, // Quorum
+	Raft:     DefaultRaftConfig,       // Quorum
 }
 
 func init() {
@@ -168,6 +169,8 @@ type Config struct {
 
 	RaftMode             bool
 	EnableNodePermission bool
+	// Raft options
+	Raft RaftConfig
 	// Istanbul options
 	Istanbul istanbul.Config
 
@@ -2,6 +2,7 @@ package eth
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -19,3 +20,29 @@ func TestQuorumDefautConfig(t *testing.T) {
 		assert.Equal(t, v.expected, v.actual, k+" value mismatch")
 	}
 }
+
+func TestRaftConfig_Validate(t *testing.T) {
+	valid := DefaultRaftConfig
+	assert.NoError(t, valid.Validate())
+
+	valid.PreVote, valid.CheckQuorum = true, true
+	valid.ElectionTick = 50
+	assert.NoError(t, valid.Validate())
+	assert.Equal(t, 5*time.Second, valid.ElectionTimeout())
+
+	valid.TxOrdering, valid.TxQuotaPerSender = RaftTxOrderingRoundRobin, 10
+	assert.NoError(t, valid.Validate())
+
+	for name, mutate := range map[string]func(c *RaftConfig){
+		"zero tick interval":       func(c *RaftConfig) { c.TickInterval = 0 },
+		"zero heartbeat tick":      func(c *RaftConfig) { c.HeartbeatTick = 0 },
+		"election below heartbeat": func(c *RaftConfig) { c.ElectionTick, c.HeartbeatTick = 5, 5 },
+		"zero snapshot period":     func(c *RaftConfig) { c.SnapshotPeriod = 0 },
+		"unknown tx ordering":      func(c *RaftConfig) { c.TxOrdering = "random" },
+		"negative tx quota":        func(c *RaftConfig) { c.TxQuotaPerOrg = -1 },
+	} {
+		c := DefaultRaftConfig
+		mutate(&c)
+		assert.Error(t, c.Validate(), name)
+	}
+}
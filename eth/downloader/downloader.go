@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -93,6 +94,11 @@ var (
 	errCanceled                = errors.New("syncing canceled (requested)")
 	errNoSyncActive            = errors.New("no sync active")
 	errTooOld                  = errors.New("peer doesn't speak recent enough protocol version (need version >= 63)")
+
+	// ErrPrivateState is returned by a bounded fast sync when the node is party to
+	// private transactions of the synced blocks, whose private state can only be
+	// rebuilt by executing the blocks
+	ErrPrivateState = errors.New("node is party to private transactions, its private state can't be fast synced")
 )
 
 type Downloader struct {
@@ -268,9 +274,9 @@ func (d *Downloader) Progress() ethereum.SyncProgress {
 	current := uint64(0)
 	mode := d.getMode()
 	switch {
-	case d.blockchain != nil && mode == FullSync:
+	case d.blockchain != nil && (mode == FullSync || mode == BoundedFullSync):
 		current = d.blockchain.CurrentBlock().NumberU64()
-	case d.blockchain != nil && mode == FastSync:
+	case d.blockchain != nil && (mode == FastSync || mode == BoundedFastSync):
 		current = d.blockchain.CurrentFastBlock().NumberU64()
 	case d.lightchain != nil:
 		current = d.lightchain.CurrentHeader().Number.Uint64()
@@ -436,6 +442,9 @@ func (d *Downloader) synchronise(id string, hash common.Hash, td *big.Int, mode
 	if mode == BoundedFullSync {
 		return d.syncWithPeerUntil(p, hash, td)
 	}
+	if mode == BoundedFastSync {
+		return d.fastSyncWithPeerUntil(p, hash, td)
+	}
 	return d.syncWithPeer(p, hash, td)
 }
 
@@ -552,7 +561,7 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		func() error { return d.processHeaders(origin+1, pivot, td) },
 	}
 	if mode == FastSync {
-		fetchers = append(fetchers, func() error { return d.processFastSyncContent(latest) })
+		fetchers = append(fetchers, func() error { return d.processFastSyncContent(latest, pivot, true) })
 	} else if mode == FullSync {
 		fetchers = append(fetchers, d.processFullSyncContent)
 	}
@@ -1479,7 +1488,7 @@ func (d *Downloader) processHeaders(origin uint64, pivot uint64, td *big.Int) er
 				// This check cannot be executed "as is" for full imports, since blocks may still be
 				// queued for processing when the header download completes. However, as long as the
 				// peer gave us something useful, we're already happy/progressed (above check).
-				if mode == FastSync || mode == LightSync {
+				if mode == FastSync || mode == BoundedFastSync || mode == LightSync {
 					head := d.lightchain.CurrentHeader()
 					if td.Cmp(d.lightchain.GetTd(head.Hash(), head.Number.Uint64())) > 0 {
 						return errStallingPeer
@@ -1507,7 +1516,7 @@ func (d *Downloader) processHeaders(origin uint64, pivot uint64, td *big.Int) er
 				chunk := headers[:limit]
 
 				// In case of header only syncing, validate the chunk immediately
-				if mode == FastSync || mode == LightSync {
+				if mode == FastSync || mode == BoundedFastSync || mode == LightSync {
 					// If we're importing pure headers, verify based on their recentness
 					frequency := fsHeaderCheckFrequency
 					if chunk[len(chunk)-1].Number.Uint64()+uint64(fsHeaderForceVerify) > pivot {
@@ -1532,7 +1541,7 @@ func (d *Downloader) processHeaders(origin uint64, pivot uint64, td *big.Int) er
 					}
 				}
 				// Unless we're doing light chains, schedule the headers for associated content retrieval
-				if mode == FullSync || mode == FastSync || mode == BoundedFullSync {
+				if mode == FullSync || mode == FastSync || mode == BoundedFullSync || mode == BoundedFastSync {
 					// If we've reached the allowed number of pending headers, stall a bit
 					for d.queue.PendingBlocks() >= maxQueuedHeaders || d.queue.PendingReceipts() >= maxQueuedHeaders {
 						select {
@@ -1623,7 +1632,8 @@ func (d *Downloader) importBlockResults(results []*fetchResult) error {
 
 // processFastSyncContent takes fetch results from the queue and writes them to the
 // database. It also controls the synchronisation of state nodes of the pivot block.
-func (d *Downloader) processFastSyncContent(latest *types.Header) error {
+// If movable, the pivot is moved when it becomes stale.
+func (d *Downloader) processFastSyncContent(latest *types.Header, pivot uint64, movable bool) error {
 	// Start syncing state of the reported head block. This should get us most of
 	// the state of the pivot block.
 	sync := d.syncState(latest.Root)
@@ -1635,12 +1645,9 @@ func (d *Downloader) processFastSyncContent(latest *types.Header) error {
 	}
 	go closeOnErr(sync)
 
-	// Figure out the ideal pivot block. Note, that this goalpost may move if the
-	// sync takes long enough for the chain head to move significantly.
-	pivot := uint64(0)
-	if height := latest.Number.Uint64(); height > uint64(fsMinFullBlocks) {
-		pivot = height - uint64(fsMinFullBlocks)
-	}
+	// Note, that the pivot may move if the sync takes long enough for the
+	// chain head to move significantly.
+	//
 	// To cater for moving pivot points, track the pivot block and subsequently
 	// accumulated download results separately.
 	var (
@@ -1667,11 +1674,16 @@ func (d *Downloader) processFastSyncContent(latest *types.Header) error {
 		if d.chainInsertHook != nil {
 			d.chainInsertHook(results)
 		}
+		if d.getMode() == BoundedFastSync {
+			if err := checkNoPrivateTransactions(results); err != nil {
+				return err
+			}
+		}
 		if oldPivot != nil {
 			results = append(append([]*fetchResult{oldPivot}, oldTail...), results...)
 		}
 		// Split around the pivot block and process the two sides via fast/full sync
-		if atomic.LoadInt32(&d.committed) == 0 {
+		if movable && atomic.LoadInt32(&d.committed) == 0 {
 			latest = results[len(results)-1].Header
 			if height := latest.Number.Uint64(); height > pivot+2*uint64(fsMinFullBlocks) {
 				log.Warn("Pivot became stale, moving", "old", pivot, "new", height-uint64(fsMinFullBlocks))
@@ -1719,6 +1731,30 @@ func (d *Downloader) processFastSyncContent(latest *types.Header) error {
 	}
 }
 
+// checks that the node is not party to any private transaction of the results,
+// as fast sync only downloads the public state and the private state would be
+// left empty
+func checkNoPrivateTransactions(results []*fetchResult) error {
+	if private.P == nil {
+		return nil
+	}
+	for _, result := range results {
+		for _, tx := range result.Transactions {
+			if !tx.IsPrivate() {
+				continue
+			}
+			_, _, payload, _, err := private.P.Receive(common.BytesToEncryptedPayloadHash(tx.Data()))
+			if err != nil {
+				return fmt.Errorf("%w: checking private transaction %x: %v", ErrPrivateState, tx.Hash(), err)
+			}
+			if payload != nil {
+				return fmt.Errorf("%w: private transaction %x in block %d", ErrPrivateState, tx.Hash(), result.Header.Number)
+			}
+		}
+	}
+	return nil
+}
+
 func splitAroundPivot(pivot uint64, results []*fetchResult) (p *fetchResult, before, after []*fetchResult) {
 	if len(results) == 0 {
 		return nil, nil, nil
@@ -1987,6 +2023,68 @@ func (d *Downloader) syncWithPeerUntil(p *peerConnection, hash common.Hash, td *
 	return d.spawnSync(fetchers)
 }
 
+// Fast synchronizes with a peer up to the provided hash: the blocks up to the
+// hash are downloaded with their receipts and the state of the block with the
+// hash is downloaded instead of being rebuilt by executing all blocks. Only
+// the public state is downloaded, so the sync fails with ErrPrivateState if
+// the node is party to any private transaction of the blocks.
+func (d *Downloader) fastSyncWithPeerUntil(p *peerConnection, hash common.Hash, td *big.Int) (err error) {
+	d.mux.Post(StartEvent{})
+	defer func() {
+		// reset on error
+		if err != nil {
+			d.mux.Post(FailedEvent{err})
+		} else {
+			d.mux.Post(DoneEvent{})
+		}
+	}()
+	if p.version < 63 {
+		return errTooOld
+	}
+
+	log.Info("Fast synchronising with the network", "id", p.id, "version", p.version, "hash", hash)
+	defer func(start time.Time) {
+		log.Info("Synchronisation terminated", "duration", time.Since(start))
+	}(time.Now())
+
+	localHeight := d.blockchain.CurrentBlock().NumberU64()
+	remoteHeader, err := d.fetchHeader(p, hash)
+	if err != nil {
+		return err
+	}
+	remoteHeight := remoteHeader.Number.Uint64()
+	if remoteHeight <= localHeight {
+		return fmt.Errorf("%w: target block %d is not above the local head %d", errInvalidChain, remoteHeight, localHeight)
+	}
+
+	d.syncStatsLock.Lock()
+	if d.syncStatsChainHeight <= localHeight || d.syncStatsChainOrigin > localHeight {
+		d.syncStatsChainOrigin = localHeight
+	}
+	d.syncStatsChainHeight = remoteHeight
+	d.syncStatsLock.Unlock()
+
+	// The requested block is the pivot, its state is downloaded and the sync
+	// stops there
+	pivot := remoteHeight
+	rawdb.WriteLastPivotNumber(d.stateDB, pivot)
+	d.committed = 0
+	d.ancientLimit = 0
+
+	d.queue.Prepare(localHeight+1, FastSync)
+	if d.syncInitHook != nil {
+		d.syncInitHook(localHeight, remoteHeight)
+	}
+	fetchers := []func() error{
+		func() error { return d.fetchBoundedHeaders(p, localHeight+1, remoteHeight) },
+		func() error { return d.fetchBodies(localHeight + 1) },
+		func() error { return d.fetchReceipts(localHeight + 1) },
+		func() error { return d.processHeaders(localHeight+1, pivot, td) },
+		func() error { return d.processFastSyncContent(remoteHeader, pivot, false) },
+	}
+	return d.spawnSync(fetchers)
+}
+
 // Fetches a single header from a peer
 func (d *Downloader) fetchHeader(p *peerConnection, hash common.Hash) (*types.Header, error) {
 	log.Info("retrieving remote chain height", "peer", p)
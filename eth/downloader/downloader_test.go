@@ -33,6 +33,9 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/ethereum/go-ethereum/private/engine/notinuse"
 	"github.com/ethereum/go-ethereum/trie"
 )
 
@@ -558,6 +561,63 @@ func testCanonicalSynchronisation(t *testing.T, protocol int, mode SyncMode) {
 	assertOwnChain(t, tester, chain.len())
 }
 
+// Tests that a bounded fast sync downloads the chain up to the requested block
+// and the state of that block, which becomes the head.
+func TestBoundedFastSynchronisation(t *testing.T) {
+	t.Parallel()
+
+	tester := newTester()
+	defer tester.terminate()
+
+	chain := testChainBase.shorten(blockCacheItems - 15)
+	tester.newPeer("peer", 64, chain)
+	target := chain.shorten(chain.len() - 10).headBlock()
+
+	if err := tester.downloader.synchronise("peer", target.Hash(), big.NewInt(0), BoundedFastSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	assertOwnChain(t, tester, chain.len()-10)
+	if head := tester.CurrentBlock(); head.Hash() != target.Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.NumberU64(), target.NumberU64())
+	}
+	if mode := tester.downloader.getMode(); mode != BoundedFastSync {
+		t.Fatalf("sync mode mismatch: have %v, want %v", mode, BoundedFastSync)
+	}
+}
+
+type stubPrivateTransactionManager struct {
+	notinuse.PrivateTransactionManager
+	payloads map[common.EncryptedPayloadHash][]byte
+}
+
+func (ptm *stubPrivateTransactionManager) Receive(hash common.EncryptedPayloadHash) (string, []string, []byte, *engine.ExtraMetadata, error) {
+	return "", nil, ptm.payloads[hash], nil, nil
+}
+
+// Tests that a bounded fast sync is refused for blocks with private
+// transactions the node is party to.
+func TestBoundedFastSyncPrivateTransactions(t *testing.T) {
+	originalP := private.P
+	defer func() { private.P = originalP }()
+
+	hash := common.BytesToEncryptedPayloadHash([]byte("payload"))
+	tx, err := types.SignTx(types.NewTransaction(0, common.Address{}, big.NewInt(0), params.TxGas, nil, hash.Bytes()), types.HomesteadSigner{}, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.SetPrivate()
+	results := []*fetchResult{{Header: &types.Header{Number: big.NewInt(1)}, Transactions: types.Transactions{tx}}}
+
+	private.P = &stubPrivateTransactionManager{}
+	if err := checkNoPrivateTransactions(results); err != nil {
+		t.Fatalf("unexpected error for private transactions of other parties: %v", err)
+	}
+	private.P = &stubPrivateTransactionManager{payloads: map[common.EncryptedPayloadHash][]byte{hash: []byte("data")}}
+	if err := checkNoPrivateTransactions(results); !errors.Is(err, ErrPrivateState) {
+		t.Fatalf("error mismatch: have %v, want %v", err, ErrPrivateState)
+	}
+}
+
 // Tests that if a large batch of blocks are being downloaded, it is throttled
 // until the cached blocks are retrieved.
 func TestThrottling63Full(t *testing.T) { testThrottling(t, 63, FullSync) }
@@ -28,6 +28,7 @@ const (
 	LightSync                 // Download only the headers and terminate afterwards
 	// Used by raft:
 	BoundedFullSync SyncMode = 100 // Perform a full sync until the requested hash, and no further
+	BoundedFastSync SyncMode = 101 // Perform a fast sync until the requested hash, downloading the state of that block
 )
 
 func (mode SyncMode) IsValid() bool {
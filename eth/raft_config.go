@@ -0,0 +1,126 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Quorum
+//
+// RaftConfig holds the tuning options of the raft consensus.
+//
+// The election timeout of a node is ElectionTick * TickInterval and a leader
+// sends heartbeats every HeartbeatTick * TickInterval. Safe combinations:
+//
+//   - ElectionTick must be greater than HeartbeatTick. An election timeout of
+//     at least 10 heartbeats tolerates a few lost heartbeats without
+//     triggering an election.
+//   - The heartbeat interval should be well above the round trip time between
+//     the nodes. Across regions a tick of 100ms with an election tick of 50 or
+//     more avoids spurious elections.
+//   - PreVote stops a partitioned node from disrupting the cluster with a
+//     higher term when it rejoins. CheckQuorum makes a leader which has lost
+//     contact with the majority step down, so that it stops minting blocks
+//     which can never be committed. Enabling both is recommended; they must be
+//     enabled on all nodes of the cluster.
+//   - All nodes of a cluster should use the same tick interval and election
+//     tick.
+//
+// A learner is considered caught up when the raft log replicated to it is at
+// most LearnerMaxLag entries behind the commit index of the leader. With
+// AutoPromoteLearners, the leader promotes a learner to a peer once it has
+// been caught up for LearnerPromotionPeriod.
+//
+// TxOrdering selects the order in which the minter includes pending
+// transactions in a block, see the RaftTxOrdering constants. TxQuotaPerSender
+// and TxQuotaPerOrg bound the number of transactions per block of a sender
+// and of the permission org of the sender; zero means no bound.
+//
+// With StateSync, a node which joins the cluster with an empty chain fast
+// syncs up to the head block of the raft snapshot it receives: the blocks are
+// downloaded with their receipts and the public state of the head block is
+// downloaded from the peers instead of being rebuilt by executing every
+// block. The private state can't be downloaded, so if the node is party to
+// private transactions of those blocks it falls back to executing them.
+type RaftConfig struct {
+	TickInterval   time.Duration // interval of the raft logical clock
+	ElectionTick   int           // number of ticks without leader contact before a follower starts an election
+	HeartbeatTick  int           // number of ticks between heartbeats sent by the leader
+	SnapshotPeriod uint64        // number of applied raft entries after which a snapshot is taken
+	PreVote        bool          // enables the pre-vote phase of the election
+	CheckQuorum    bool          // makes the leader step down if it loses contact with the majority
+
+	AutoPromoteLearners    bool          // promote caught up learners automatically
+	LearnerMaxLag          uint64        // max number of entries a learner may lag behind to be promoted
+	LearnerPromotionPeriod time.Duration // time a learner must be caught up before it is promoted automatically
+
+	TxOrdering       string // ordering policy of pending transactions in minted blocks, price if empty
+	TxQuotaPerSender int    // max number of transactions of a sender per block
+	TxQuotaPerOrg    int    // max number of transactions of the senders of an org per block
+
+	StateSync bool // download the state of the snapshot head instead of executing all blocks when joining with an empty chain
+}
+
+// transaction ordering policies of the raft minter
+const (
+	RaftTxOrderingPrice      = "price"      // by gas price, then by arrival time of the next transaction of each sender
+	RaftTxOrderingFIFO       = "fifo"       // by arrival time
+	RaftTxOrderingRoundRobin = "roundrobin" // one transaction of each sender in turn
+)
+
+// DefaultRaftConfig contains the raft settings used when none are given
+var DefaultRaftConfig = RaftConfig{
+	TickInterval:   100 * time.Millisecond,
+	ElectionTick:   10,
+	HeartbeatTick:  1,
+	SnapshotPeriod: 250,
+
+	LearnerMaxLag:          100,
+	LearnerPromotionPeriod: 30 * time.Second,
+
+	TxOrdering: RaftTxOrderingPrice,
+}
+
+// ElectionTimeout returns the time without contact from the leader after
+// which a follower starts an election
+func (c *RaftConfig) ElectionTimeout() time.Duration {
+	return time.Duration(c.ElectionTick) * c.TickInterval
+}
+
+// Validate checks the raft settings, returning an error for invalid settings
+// and logging a warning for settings which are valid but not recommended
+func (c *RaftConfig) Validate() error {
+	if c.TickInterval < time.Millisecond {
+		return fmt.Errorf("raft tick interval must be at least 1ms, got %v", c.TickInterval)
+	}
+	if c.HeartbeatTick <= 0 {
+		return errors.New("raft heartbeat tick must be greater than 0")
+	}
+	if c.ElectionTick <= c.HeartbeatTick {
+		return fmt.Errorf("raft election tick (%d) must be greater than heartbeat tick (%d)", c.ElectionTick, c.HeartbeatTick)
+	}
+	if c.SnapshotPeriod == 0 {
+		return errors.New("raft snapshot period must be greater than 0")
+	}
+	if c.AutoPromoteLearners && c.LearnerPromotionPeriod <= 0 {
+		return errors.New("raft learner promotion period must be greater than 0")
+	}
+	switch c.TxOrdering {
+	case "", RaftTxOrderingPrice, RaftTxOrderingFIFO, RaftTxOrderingRoundRobin:
+	default:
+		return fmt.Errorf("unknown raft transaction ordering %q, expected one of %s, %s or %s", c.TxOrdering, RaftTxOrderingPrice, RaftTxOrderingFIFO, RaftTxOrderingRoundRobin)
+	}
+	if c.TxQuotaPerSender < 0 || c.TxQuotaPerOrg < 0 {
+		return errors.New("raft transaction quotas must not be negative")
+	}
+	if c.ElectionTick < 10*c.HeartbeatTick {
+		log.Warn("raft election tick is less than 10 heartbeats, lost heartbeats may trigger elections", "electionTick", c.ElectionTick, "heartbeatTick", c.HeartbeatTick)
+	}
+	if !c.PreVote || !c.CheckQuorum {
+		log.Info("raft PreVote and CheckQuorum are recommended to avoid disruptive elections", "preVote", c.PreVote, "checkQuorum", c.CheckQuorum)
+	}
+	return nil
+}
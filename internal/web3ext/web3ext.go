@@ -217,6 +217,10 @@ web3._extend({
 			name: 'stopWS',
 			call: 'admin_stopWS'
 		}),
+		new web3._extend.Method({
+			name: 'checkPermissionCache',
+			call: 'admin_checkPermissionCache'
+		}),
 	],
 	properties: [
 		new web3._extend.Property({
@@ -843,11 +847,21 @@ web3._extend({
                        call: 'raft_promoteToPeer',
                        params: 1
                }),
+               new web3._extend.Method({
+                       name: 'forcePromoteToPeer',
+                       call: 'raft_forcePromoteToPeer',
+                       params: 1
+               }),
                new web3._extend.Method({
                        name: 'removePeer',
                        call: 'raft_removePeer',
                        params: 1
                }),
+               new web3._extend.Method({
+                       name: 'transferLeadership',
+                       call: 'raft_transferLeadership',
+                       params: 1
+               }),
                new web3._extend.Property({
                        name: 'leader',
                        getter: 'raft_leader'
@@ -856,6 +870,10 @@ web3._extend({
                        name: 'cluster',
                        getter: 'raft_cluster'
                }),
+               new web3._extend.Property({
+                       name: 'syncStatus',
+                       getter: 'raft_syncStatus'
+               }),
        ]
 })
 `
@@ -886,8 +904,8 @@ web3._extend({
                new web3._extend.Method({
                        name: 'updateOrgStatus',
                        call: 'quorumPermission_updateOrgStatus',
-                       params: 3,
-                       inputFormatter: [null,null,web3._extend.formatters.inputTransactionFormatter]
+                       params: 4,
+                       inputFormatter: [null,null,web3._extend.formatters.inputTransactionFormatter,null]
                }),
                new web3._extend.Method({
                        name: 'approveOrgStatus',
@@ -910,8 +928,8 @@ web3._extend({
                new web3._extend.Method({
                        name: 'assignAdminRole',
                        call: 'quorumPermission_assignAdminRole',
-                       params: 4,
-                       inputFormatter: [null,web3._extend.formatters.inputAddressFormatter,null, web3._extend.formatters.inputTransactionFormatter]
+                       params: 5,
+                       inputFormatter: [null,web3._extend.formatters.inputAddressFormatter,null, web3._extend.formatters.inputTransactionFormatter,null]
                }),
                new web3._extend.Method({
                        name: 'approveAdminRole',
@@ -934,8 +952,8 @@ web3._extend({
                new web3._extend.Method({
                        name: 'addAccountToOrg',
                        call: 'quorumPermission_addAccountToOrg',
-                       params: 4,
-                       inputFormatter: [web3._extend.formatters.inputAddressFormatter,null,null,web3._extend.formatters.inputTransactionFormatter]
+                       params: 5,
+                       inputFormatter: [web3._extend.formatters.inputAddressFormatter,null,null,web3._extend.formatters.inputTransactionFormatter,null]
                }),
                new web3._extend.Method({
                        name: 'changeAccountRole',
@@ -991,6 +1009,24 @@ web3._extend({
                        params: 4,
                        inputFormatter: [null, null, null, null]
                }),
+               new web3._extend.Method({
+                       name: 'setVoteThreshold',
+                       call: 'quorumPermission_setVoteThreshold',
+                       params: 3,
+                       inputFormatter: [null, null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'approveVoteThreshold',
+                       call: 'quorumPermission_approveVoteThreshold',
+                       params: 3,
+                       inputFormatter: [null, null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'getVoteThreshold',
+                       call: 'quorumPermission_getVoteThreshold',
+                       params: 1,
+                       inputFormatter: [null]
+               }),
 
        ],
        properties:
@@ -1011,6 +1047,10 @@ web3._extend({
 					   name: 'acctList',
 				       getter: 'quorumPermission_acctList'
 			  }), 
+              new web3._extend.Property({
+					   name: 'pendingOps',
+				       getter: 'quorumPermission_pendingOps'
+			  }),
        ]
 })
 `
@@ -1047,6 +1087,11 @@ web3._extend({
 			call: 'istanbul_propose',
 			params: 2
 		}),
+		new web3._extend.Method({
+			name: 'proposeBLSKey',
+			call: 'istanbul_proposeBLSKey',
+			params: 2
+		}),
 		new web3._extend.Method({
 			name: 'discard',
 			call: 'istanbul_discard',
@@ -1076,6 +1121,18 @@ web3._extend({
 			params: 1,
             inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getParticipation',
+			call: 'istanbul_getParticipation',
+			params: 1,
+            inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorParticipation',
+			call: 'istanbul_getValidatorParticipation',
+			params: 2,
+            inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 
 	],
 	properties:
@@ -1088,6 +1145,10 @@ web3._extend({
 			name: 'nodeAddress',
 			getter: 'istanbul_nodeAddress'
 		}),
+		new web3._extend.Property({
+			name: 'blsKey',
+			getter: 'istanbul_blsKey'
+		}),
 	]
 });
 `
@@ -21,8 +21,11 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -240,19 +243,19 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, false, 32, 35, big.NewInt(0), big.NewInt(0), nil, nil}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, false, 32, 35, big.NewInt(0), big.NewInt(0), nil, nil, nil}
 
 	// AllCliqueProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Clique consensus.
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil}
+	AllCliqueProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, &CliqueConfig{Period: 0, Epoch: 30000}, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, nil}
 
-	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil}
+	TestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, false, 32, 32, big.NewInt(0), big.NewInt(0), nil, nil, nil}
 	TestRules       = TestChainConfig.Rules(new(big.Int))
 
-	QuorumTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0)}
+	QuorumTestChainConfig = &ChainConfig{big.NewInt(10), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, new(EthashConfig), nil, nil, true, 64, 32, big.NewInt(0), big.NewInt(0), nil, big.NewInt(0), nil}
 )
 
 // TrustedCheckpoint represents a set of post-processed trie roots (CHT and
@@ -302,6 +305,53 @@ type MaxCodeConfigStruct struct {
 	Size  uint64   `json:"size,omitempty"`
 }
 
+// Validator selection modes of Istanbul transitions
+const (
+	BlockHeaderMode = "blockheader" // validators are voted in and out in the block headers
+	ContractMode    = "contract"    // validators are listed by the validator contract
+)
+
+// Round-change timeout strategies of Istanbul, deciding how the timeout of a
+// round grows with the round number
+const (
+	ExponentialTimeout = "exponential" // 2^round seconds are added to the request timeout, the default
+	LinearTimeout      = "linear"      // the request timeout is multiplied by round+1
+	FixedTimeout       = "fixed"       // every round times out after the request timeout
+)
+
+// Committed seal schemes of Istanbul transitions
+const (
+	ECDSASealScheme = "ecdsa" // every committer adds its ECDSA committed seal to the header
+	BLSSealScheme   = "bls"   // the BLS committed seals of the committers are aggregated into a single signature
+)
+
+// BLSKey is the BLS public key of an Istanbul validator, signing its committed
+// seals under BLSSealScheme
+type BLSKey struct {
+	PublicKey hexutil.Bytes `json:"publicKey"` // Uncompressed G2 public key
+	Proof     hexutil.Bytes `json:"proof"`     // Signature of the public key proving the possession of its secret key
+}
+
+// Transition changes the Istanbul configuration from the given block on. Zero
+// values leave the configuration in force unchanged.
+type Transition struct {
+	Block                    *big.Int       `json:"block"`
+	RequestTimeout           uint64         `json:"requestTimeout,omitempty"`           // The timeout for each Istanbul round in milliseconds
+	BlockPeriod              uint64         `json:"blockPeriod,omitempty"`              // Minimum difference between two consecutive block's timestamps in seconds
+	ProposerPolicy           *uint64        `json:"policy,omitempty"`                   // The policy for proposer selection
+	Epoch                    uint64         `json:"epoch,omitempty"`                    // Epoch length to reset votes and checkpoint
+	ValidatorSelectionMode   string         `json:"validatorSelectionMode,omitempty"`   // Either BlockHeaderMode or ContractMode
+	ValidatorContractAddress common.Address `json:"validatorContractAddress,omitempty"` // Validator-management contract used in ContractMode
+	BlockReward              *big.Int       `json:"blockReward,omitempty"`              // Reward in wei for sealing a block, zero to stop rewarding blocks
+	BlockRewardBeneficiary   common.Address `json:"blockRewardBeneficiary,omitempty"`   // Account receiving the block rewards
+	RoundTimeoutStrategy     string         `json:"roundTimeoutStrategy,omitempty"`     // One of ExponentialTimeout, LinearTimeout or FixedTimeout
+	MaxRequestTimeout        uint64         `json:"maxRequestTimeout,omitempty"`        // Maximum timeout of a round in milliseconds
+	EmptyBlockPeriod         uint64         `json:"emptyBlockPeriod,omitempty"`         // Minimum difference between the timestamps of an empty block and its parent in seconds
+
+	CommittedSealScheme string                    `json:"committedSealScheme,omitempty"` // Either ECDSASealScheme or BLSSealScheme
+	BLSKeys             map[common.Address]BLSKey `json:"blsKeys,omitempty"`             // BLS keys of validators, registered from the block on
+}
+
 // ChainConfig is the core config which determines the blockchain settings.
 //
 // ChainConfig is stored in the database on a per block basis. This means
@@ -348,6 +398,8 @@ type ChainConfig struct {
 	MaxCodeSizeConfig []MaxCodeConfigStruct `json:"maxCodeSizeConfig,omitempty"`
 	// Quorum
 	PrivacyEnhancementsBlock *big.Int `json:"privacyEnhancementsBlock,omitempty"`
+	// to change the Istanbul configuration at given blocks
+	Transitions []Transition `json:"transitions,omitempty"`
 }
 
 // EthashConfig is the consensus engine configs for proof-of-work based sealing.
@@ -375,6 +427,31 @@ type IstanbulConfig struct {
 	Epoch          uint64   `json:"epoch"`                    // Epoch length to reset votes and checkpoint
 	ProposerPolicy uint64   `json:"policy"`                   // The policy for proposer selection
 	Ceil2Nby3Block *big.Int `json:"ceil2Nby3Block,omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+	QbftBlock      *big.Int `json:"qbftBlock,omitempty"`      // Block number from which blocks are sealed using QBFT instead of IBFT
+
+	ValidatorContractAddress common.Address `json:"validatorContractAddress,omitempty"` // Validator-management contract listing the validators once ValidatorContractBlock is reached
+	ValidatorContractBlock   *big.Int       `json:"validatorContractBlock,omitempty"`   // Block number from which validators are read from the contract instead of header votes
+
+	BlockReward            *big.Int       `json:"blockReward,omitempty"`            // Reward in wei for sealing a block, nil if blocks are not rewarded
+	BlockRewardBeneficiary common.Address `json:"blockRewardBeneficiary,omitempty"` // Account receiving the block rewards, the proposer of each block if empty
+	BlockRewardBlock       *big.Int       `json:"blockRewardBlock,omitempty"`       // Block number from which blocks are rewarded
+
+	RoundTimeoutStrategy string `json:"roundTimeoutStrategy,omitempty"` // One of ExponentialTimeout, LinearTimeout or FixedTimeout, ExponentialTimeout if empty
+	MaxRequestTimeout    uint64 `json:"maxRequestTimeout,omitempty"`    // Maximum timeout of a round in milliseconds, no maximum if 0
+
+	EmptyBlockPeriod uint64 `json:"emptyBlockPeriod,omitempty"` // Minimum difference between the timestamps of an empty block and its parent in seconds, empty blocks follow the block period if not above it
+}
+
+// blockRewardBlock returns the number of the first rewarded block, nil if no
+// block is rewarded
+func (c *IstanbulConfig) blockRewardBlock() *big.Int {
+	if c.BlockReward == nil || c.BlockReward.Sign() <= 0 {
+		return nil
+	}
+	if c.BlockRewardBlock == nil {
+		return big.NewInt(0)
+	}
+	return c.BlockRewardBlock
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -609,6 +686,86 @@ func isMaxCodeSizeConfigCompatible(c1, c2 *ChainConfig, head *big.Int) (error, *
 	return nil, big.NewInt(0), big.NewInt(0)
 }
 
+// validates the transitions passed in config
+func (c *ChainConfig) CheckTransitionsData() error {
+	// 1. block entries are given in ascending order
+	// 2. validator selection modes are known, and the contract mode has a contract
+	// 3. round-change timeout strategies are known
+	// 4. committed seal schemes are known
+	// 5. registered BLS keys come with a valid proof of possession
+	if c.Istanbul != nil && !isRoundTimeoutStrategy(c.Istanbul.RoundTimeoutStrategy) {
+		return fmt.Errorf("invalid round timeout strategy %q in istanbul config", c.Istanbul.RoundTimeoutStrategy)
+	}
+	var prevBlock *big.Int
+	for _, transition := range c.Transitions {
+		if transition.Block == nil {
+			return errors.New("Block number not given in transitions data")
+		}
+		if prevBlock != nil && transition.Block.Cmp(prevBlock) <= 0 {
+			return errors.New("invalid transitions detail, block order has to be ascending")
+		}
+		prevBlock = transition.Block
+
+		switch transition.ValidatorSelectionMode {
+		case "", BlockHeaderMode:
+		case ContractMode:
+			if transition.ValidatorContractAddress == (common.Address{}) && (c.Istanbul == nil || c.Istanbul.ValidatorContractAddress == (common.Address{})) {
+				return fmt.Errorf("transition at block %v selects validators by contract without a validator contract address", transition.Block)
+			}
+		default:
+			return fmt.Errorf("invalid validator selection mode %q in transitions data", transition.ValidatorSelectionMode)
+		}
+		if !isRoundTimeoutStrategy(transition.RoundTimeoutStrategy) {
+			return fmt.Errorf("invalid round timeout strategy %q in transitions data", transition.RoundTimeoutStrategy)
+		}
+		switch transition.CommittedSealScheme {
+		case "", ECDSASealScheme, BLSSealScheme:
+		default:
+			return fmt.Errorf("invalid committed seal scheme %q in transitions data", transition.CommittedSealScheme)
+		}
+		for addr, key := range transition.BLSKeys {
+			if _, err := bls.VerifyRegistration(key.PublicKey, key.Proof); err != nil {
+				return fmt.Errorf("invalid BLS key of %v in transitions data: %v", addr.Hex(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func isRoundTimeoutStrategy(strategy string) bool {
+	switch strategy {
+	case "", ExponentialTimeout, LinearTimeout, FixedTimeout:
+		return true
+	}
+	return false
+}
+
+// checks if changes to transitions proposed are compatible with already
+// existing genesis data, i.e. transitions up to the head are unchanged
+func isTransitionsConfigCompatible(c1, c2 *ChainConfig, head *big.Int) (error, *big.Int, *big.Int) {
+	var c1BelowHead, c2BelowHead []Transition
+	for _, transition := range c1.Transitions {
+		if transition.Block != nil && transition.Block.Cmp(head) <= 0 {
+			c1BelowHead = append(c1BelowHead, transition)
+		}
+	}
+	for _, transition := range c2.Transitions {
+		if transition.Block != nil && transition.Block.Cmp(head) <= 0 {
+			c2BelowHead = append(c2BelowHead, transition)
+		}
+	}
+
+	if len(c1BelowHead) != len(c2BelowHead) {
+		return errors.New("transitions data incompatible. updating transitions for past"), head, head
+	}
+	for i := range c1BelowHead {
+		if !reflect.DeepEqual(c1BelowHead[i], c2BelowHead[i]) {
+			return errors.New("transitions data incompatible. transitions historical data does not match"), head, head
+		}
+	}
+	return nil, big.NewInt(0), big.NewInt(0)
+}
+
 // IsPrivacyEnhancementsEnabled returns whether num represents a block number after the PrivacyEnhancementsEnabled fork
 func (c *ChainConfig) IsPrivacyEnhancementsEnabled(num *big.Int) bool {
 	return isForked(c.PrivacyEnhancementsBlock, num)
@@ -632,6 +789,12 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64, isQuor
 		return newCompatError(err.Error(), cBlock, newCfgBlock)
 	}
 
+	// likewise for the transitions
+	err, cBlock, newCfgBlock = isTransitionsConfigCompatible(c, newcfg, bhead)
+	if err != nil {
+		return newCompatError(err.Error(), cBlock, newCfgBlock)
+	}
+
 	// Iterate checkCompatible to find the lowest conflict.
 	var lasterr *ConfigCompatError
 	for {
@@ -737,6 +900,19 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int, isQuor
 	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block, head) {
 		return newCompatError("Ceil 2N/3 fork block", c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block)
 	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.ValidatorContractBlock, newcfg.Istanbul.ValidatorContractBlock, head) {
+		return newCompatError("validator contract fork block", c.Istanbul.ValidatorContractBlock, newcfg.Istanbul.ValidatorContractBlock)
+	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil {
+		stored, updated := c.Istanbul.blockRewardBlock(), newcfg.Istanbul.blockRewardBlock()
+		if isForkIncompatible(stored, updated, head) {
+			return newCompatError("block reward fork block", stored, updated)
+		}
+		// Once blocks are rewarded, the reward changes through the transitions
+		if isForked(stored, head) && (c.Istanbul.BlockReward.Cmp(newcfg.Istanbul.BlockReward) != 0 || c.Istanbul.BlockRewardBeneficiary != newcfg.Istanbul.BlockRewardBeneficiary) {
+			return newCompatError("block reward", stored, updated)
+		}
+	}
 	if isForkIncompatible(c.QIP714Block, newcfg.QIP714Block, head) {
 		return newCompatError("permissions fork block", c.QIP714Block, newcfg.QIP714Block)
 	}
@@ -20,6 +20,9 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/bls"
 )
 
 // Quorum - test code size and transaction size limit in chain config
@@ -287,3 +290,118 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckTransitionsData(t *testing.T) {
+	contract := common.HexToAddress("0x1")
+	sk, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := bls.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	blsKey := BLSKey{PublicKey: sk.PublicKey().Bytes(), Proof: sk.ProvePossession().Bytes()}
+	stolenKey := BLSKey{PublicKey: sk.PublicKey().Bytes(), Proof: other.ProvePossession().Bytes()}
+	tests := []struct {
+		config  *ChainConfig
+		wantErr bool
+	}{
+		{&ChainConfig{}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(0), BlockPeriod: 2}, {Block: big.NewInt(10), RequestTimeout: 5000}}}, false},
+		{&ChainConfig{Transitions: []Transition{{BlockPeriod: 2}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10)}, {Block: big.NewInt(5)}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10)}, {Block: big.NewInt(10)}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), ValidatorSelectionMode: "vote"}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), ValidatorSelectionMode: ContractMode}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), ValidatorSelectionMode: ContractMode, ValidatorContractAddress: contract}}}, false},
+		{&ChainConfig{Istanbul: &IstanbulConfig{ValidatorContractAddress: contract}, Transitions: []Transition{{Block: big.NewInt(10), ValidatorSelectionMode: ContractMode}}}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), ValidatorSelectionMode: BlockHeaderMode}}}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), RoundTimeoutStrategy: LinearTimeout, MaxRequestTimeout: 60000}}}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), RoundTimeoutStrategy: "quadratic"}}}, true},
+		{&ChainConfig{Istanbul: &IstanbulConfig{RoundTimeoutStrategy: FixedTimeout}}, false},
+		{&ChainConfig{Istanbul: &IstanbulConfig{RoundTimeoutStrategy: "quadratic"}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), CommittedSealScheme: BLSSealScheme}}}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), CommittedSealScheme: "schnorr"}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSKeys: map[common.Address]BLSKey{contract: blsKey}}}}, false},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSKeys: map[common.Address]BLSKey{contract: stolenKey}}}}, true},
+		{&ChainConfig{Transitions: []Transition{{Block: big.NewInt(10), BLSKeys: map[common.Address]BLSKey{contract: {PublicKey: blsKey.PublicKey}}}}}, true},
+	}
+	for i, test := range tests {
+		if err := test.config.CheckTransitionsData(); (err != nil) != test.wantErr {
+			t.Errorf("test %d: error mismatch: have %v, want error %v", i, err, test.wantErr)
+		}
+	}
+}
+
+func TestTransitionsCompatible(t *testing.T) {
+	stored := &ChainConfig{Transitions: []Transition{{Block: big.NewInt(5), BlockPeriod: 2}, {Block: big.NewInt(10), BlockPeriod: 3}}}
+
+	// transitions after the head may change
+	later := &ChainConfig{Transitions: []Transition{{Block: big.NewInt(5), BlockPeriod: 2}, {Block: big.NewInt(12), BlockPeriod: 4}}}
+	if err := stored.CheckCompatible(later, 8, false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// but not those up to the head
+	want := &ConfigCompatError{
+		What:         "transitions data incompatible. transitions historical data does not match",
+		StoredConfig: big.NewInt(8),
+		NewConfig:    big.NewInt(8),
+		RewindTo:     7,
+	}
+	changed := &ChainConfig{Transitions: []Transition{{Block: big.NewInt(5), BlockPeriod: 1}, {Block: big.NewInt(10), BlockPeriod: 3}}}
+	if err := stored.CheckCompatible(changed, 8, false); !reflect.DeepEqual(err, want) {
+		t.Errorf("error mismatch: have %v, want %v", err, want)
+	}
+
+	// transitions without a block number are not in force at any block
+	unnumbered := &ChainConfig{Transitions: append([]Transition{{BlockPeriod: 1}}, stored.Transitions...)}
+	if err := stored.CheckCompatible(unnumbered, 8, false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	want.What = "transitions data incompatible. updating transitions for past"
+	added := &ChainConfig{Transitions: []Transition{{Block: big.NewInt(5), BlockPeriod: 2}, {Block: big.NewInt(7), BlockPeriod: 4}, {Block: big.NewInt(10), BlockPeriod: 3}}}
+	if err := stored.CheckCompatible(added, 8, false); !reflect.DeepEqual(err, want) {
+		t.Errorf("error mismatch: have %v, want %v", err, want)
+	}
+}
+
+func TestBlockRewardCompatible(t *testing.T) {
+	beneficiary := common.HexToAddress("0x1234")
+	stored := &ChainConfig{Istanbul: &IstanbulConfig{BlockReward: big.NewInt(100), BlockRewardBlock: big.NewInt(10)}}
+
+	// the reward may change before blocks are rewarded
+	later := &ChainConfig{Istanbul: &IstanbulConfig{BlockReward: big.NewInt(50), BlockRewardBeneficiary: beneficiary, BlockRewardBlock: big.NewInt(10)}}
+	if err := stored.CheckCompatible(later, 9, false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// but only through the transitions afterwards
+	want := &ConfigCompatError{
+		What:         "block reward",
+		StoredConfig: big.NewInt(10),
+		NewConfig:    big.NewInt(10),
+		RewindTo:     9,
+	}
+	if err := stored.CheckCompatible(later, 10, false); !reflect.DeepEqual(err, want) {
+		t.Errorf("error mismatch: have %v, want %v", err, want)
+	}
+	beneficiaryOnly := &ChainConfig{Istanbul: &IstanbulConfig{BlockReward: big.NewInt(100), BlockRewardBeneficiary: beneficiary, BlockRewardBlock: big.NewInt(10)}}
+	if err := stored.CheckCompatible(beneficiaryOnly, 10, false); !reflect.DeepEqual(err, want) {
+		t.Errorf("error mismatch: have %v, want %v", err, want)
+	}
+
+	// blocks rewarded from the genesis can't be left unrewarded
+	want = &ConfigCompatError{
+		What:         "block reward fork block",
+		StoredConfig: big.NewInt(0),
+		NewConfig:    big.NewInt(20),
+		RewindTo:     0,
+	}
+	fromGenesis := &ChainConfig{Istanbul: &IstanbulConfig{BlockReward: big.NewInt(100)}}
+	if err := fromGenesis.CheckCompatible(&ChainConfig{Istanbul: &IstanbulConfig{BlockReward: big.NewInt(100), BlockRewardBlock: big.NewInt(20)}}, 10, false); !reflect.DeepEqual(err, want) {
+		t.Errorf("error mismatch: have %v, want %v", err, want)
+	}
+}
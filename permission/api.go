@@ -1,6 +1,7 @@
 package permission
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/permission/core"
 	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
 var isStringAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9_-]*$`).MatchString
@@ -44,6 +46,8 @@ const (
 	InitiateAccountRecovery
 	ApproveNodeRecovery
 	ApproveAccountRecovery
+	SetVoteThreshold
+	ApproveVoteThreshold
 )
 
 type AccountUpdateAction int
@@ -144,6 +148,43 @@ func (q *QuorumControlsAPI) GetOrgDetails(orgId string) (core.OrgDetailInfo, err
 	return core.OrgDetailInfo{NodeList: nodeList, RoleList: roleList, AcctList: acctList, SubOrgList: orgRec.SubOrgList}, nil
 }
 
+// PermissionEvents creates a subscription which is notified of every change
+// applied to the org, node, role and account caches from the permission
+// contract events.
+func (q *QuorumControlsAPI) PermissionEvents(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		events := make(chan ptype.PermissionEvent, 16)
+		sub := q.permCtrl.events.Subscribe(events)
+		defer func() { sub.Unsubscribe() }()
+
+		for {
+			select {
+			case evt := <-events:
+				notifier.Notify(rpcSub.ID, evt)
+			case err := <-sub.Err():
+				if err != ptype.ErrPermissionEventsDropped {
+					return
+				}
+				// tell the client that it missed events and keep going
+				notifier.Notify(rpcSub.ID, ptype.PermissionEvent{Type: ptype.EventsDropped})
+				sub = q.permCtrl.events.Subscribe(events)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
 func reportExecError(action PermAction, err error) (string, error) {
 	log.Error("Failed to execute permission action", "action", action, "err", err)
 	msg := fmt.Sprintf("failed to execute permissions action: %v", err)
@@ -204,12 +245,15 @@ func (q *QuorumControlsAPI) ApproveOrg(orgId string, url string, acct common.Add
 	return actionSuccess, nil
 }
 
-func (q *QuorumControlsAPI) UpdateOrgStatus(orgId string, status uint8, txa ethapi.SendTxArgs) (string, error) {
+func (q *QuorumControlsAPI) UpdateOrgStatus(orgId string, status uint8, txa ethapi.SendTxArgs, expiry *core.AccessExpiry) (string, error) {
 	orgService, err := q.permCtrl.NewPermissionOrgService(txa)
 	if err != nil {
 		return "", err
 	}
 	args := ptype.TxArgs{OrgId: orgId, Action: status, Txa: txa}
+	if expiry != nil {
+		args.Expiry = *expiry
+	}
 	if err := q.valUpdateOrgStatus(args); err != nil {
 		return "", err
 	}
@@ -276,12 +320,15 @@ func (q *QuorumControlsAPI) ApproveOrgStatus(orgId string, status uint8, txa eth
 	return actionSuccess, nil
 }
 
-func (q *QuorumControlsAPI) AssignAdminRole(orgId string, acct common.Address, roleId string, txa ethapi.SendTxArgs) (string, error) {
+func (q *QuorumControlsAPI) AssignAdminRole(orgId string, acct common.Address, roleId string, txa ethapi.SendTxArgs, expiry *core.AccessExpiry) (string, error) {
 	accountService, err := q.permCtrl.NewPermissionAccountService(txa)
 	if err != nil {
 		return "", err
 	}
 	args := ptype.TxArgs{OrgId: orgId, AcctId: acct, RoleId: roleId, Txa: txa}
+	if expiry != nil {
+		args.Expiry = *expiry
+	}
 	if err := q.valAssignAdminRole(args); err != nil {
 		return "", err
 	}
@@ -348,12 +395,15 @@ func (q *QuorumControlsAPI) RemoveRole(orgId string, roleId string, txa ethapi.S
 	return actionSuccess, nil
 }
 
-func (q *QuorumControlsAPI) AddAccountToOrg(acct common.Address, orgId string, roleId string, txa ethapi.SendTxArgs) (string, error) {
+func (q *QuorumControlsAPI) AddAccountToOrg(acct common.Address, orgId string, roleId string, txa ethapi.SendTxArgs, expiry *core.AccessExpiry) (string, error) {
 	accountService, err := q.permCtrl.NewPermissionAccountService(txa)
 	if err != nil {
 		return "", err
 	}
 	args := ptype.TxArgs{OrgId: orgId, RoleId: roleId, AcctId: acct, Txa: txa}
+	if expiry != nil {
+		args.Expiry = *expiry
+	}
 
 	if err := q.valAssignRole(args); err != nil {
 		return "", err
@@ -473,6 +523,89 @@ func (q *QuorumControlsAPI) ApproveBlackListedAccountRecovery(orgId string, acct
 	return actionSuccess, nil
 }
 
+// PendingOps returns the operations pending approval by the network admin
+// accounts along with the votes received so far
+func (q *QuorumControlsAPI) PendingOps() ([]ptype.PendingOp, error) {
+	votingService, err := q.newVotingService()
+	if err != nil {
+		return nil, err
+	}
+	return votingService.GetPendingOps(q.permCtrl.permConfig.NwAdminOrg)
+}
+
+// GetVoteThreshold returns the approval threshold configured for the
+// operation type as a percentage of voters. 0 indicates simple majority
+func (q *QuorumControlsAPI) GetVoteThreshold(opType uint8) (uint8, error) {
+	votingService, err := q.newVotingService()
+	if err != nil {
+		return 0, err
+	}
+	return votingService.GetVoteThreshold(opType)
+}
+
+// SetVoteThreshold proposes the approval threshold for the operation type as
+// a percentage of voters. passing 0 restores simple majority. the threshold
+// applies once approved by the network admin accounts
+func (q *QuorumControlsAPI) SetVoteThreshold(opType uint8, threshold uint8, txa ethapi.SendTxArgs) (string, error) {
+	thresholdService, err := q.newVoteThresholdService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{Action: opType, Threshold: threshold, Txa: txa}
+	if err := q.valSetVoteThreshold(args); err != nil {
+		return "", err
+	}
+	tx, err := thresholdService.SetVoteThreshold(args)
+	if err != nil {
+		return reportExecError(SetVoteThreshold, err)
+	}
+	log.Debug("executed permission action", "action", SetVoteThreshold, "tx", tx)
+	return actionSuccess, nil
+}
+
+// ApproveVoteThreshold approves the proposed approval threshold for the
+// operation type
+func (q *QuorumControlsAPI) ApproveVoteThreshold(opType uint8, threshold uint8, txa ethapi.SendTxArgs) (string, error) {
+	thresholdService, err := q.newVoteThresholdService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{Action: opType, Threshold: threshold, Txa: txa}
+	if err := q.valApproveVoteThreshold(args); err != nil {
+		return "", err
+	}
+	tx, err := thresholdService.ApproveVoteThreshold(args)
+	if err != nil {
+		return reportExecError(ApproveVoteThreshold, err)
+	}
+	log.Debug("executed permission action", "action", ApproveVoteThreshold, "tx", tx)
+	return actionSuccess, nil
+}
+
+func (q *QuorumControlsAPI) newVoteThresholdService(txa ethapi.SendTxArgs) (ptype.VoteThresholdService, error) {
+	orgService, err := q.permCtrl.NewPermissionOrgService(txa)
+	if err != nil {
+		return nil, err
+	}
+	thresholdService, ok := orgService.(ptype.VoteThresholdService)
+	if !ok {
+		return nil, ptype.ErrVotingNotSupported
+	}
+	return thresholdService, nil
+}
+
+func (q *QuorumControlsAPI) newVotingService() (ptype.VotingService, error) {
+	auditService, err := q.permCtrl.NewPermissionAuditService()
+	if err != nil {
+		return nil, err
+	}
+	votingService, ok := auditService.(ptype.VotingService)
+	if !ok {
+		return nil, ptype.ErrVotingNotSupported
+	}
+	return votingService, nil
+}
+
 func (q *QuorumControlsAPI) TransactionAllowed(txa ethapi.SendTxArgs) bool {
 	var value, gasPrice, gasLimit *big.Int
 	var payload []byte
@@ -582,12 +715,12 @@ func (q *QuorumControlsAPI) validatePendingOp(authOrg, orgId, url string, accoun
 	return auditService.ValidatePendingOp(authOrg, orgId, url, account, pendingOp)
 }
 
-func (q *QuorumControlsAPI) checkPendingOp(orgId string) bool {
+func (q *QuorumControlsAPI) checkPendingOp(authOrg, orgId, url string, account common.Address, pendingOp int64) bool {
 	auditService, err := q.permCtrl.NewPermissionAuditService()
 	if err != nil {
 		return false
 	}
-	return auditService.CheckPendingOp(orgId)
+	return auditService.CheckPendingOp(authOrg, orgId, url, account, pendingOp)
 }
 
 func (q *QuorumControlsAPI) checkOrgStatus(orgId string, op uint8) error {
@@ -781,7 +914,7 @@ func (q *QuorumControlsAPI) valAddOrg(args ptype.TxArgs) error {
 	}
 
 	// check if any previous op is pending approval for network admin
-	if q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg) {
+	if q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, args.AcctId, ptype.PendingOpAddOrg) {
 		return ptype.ErrPendingApprovals
 	}
 	// check if org already exists
@@ -801,13 +934,57 @@ func (q *QuorumControlsAPI) valAddOrg(args ptype.TxArgs) error {
 	return nil
 }
 
+// validations for proposing the approval threshold of an operation type
+func (q *QuorumControlsAPI) valSetVoteThreshold(args ptype.TxArgs) error {
+	opType := int64(args.Action)
+	if opType < ptype.PendingOpAddOrg || opType > ptype.PendingOpVoteThreshold || args.Threshold > 100 {
+		return ptype.ErrInvalidThreshold
+	}
+	if !q.isNetworkAdmin(args.Txa.From) {
+		return ptype.ErrNotNetworkAdmin
+	}
+	if q.isThresholdPending(args) {
+		return ptype.ErrPendingApprovals
+	}
+	return nil
+}
+
+// validations for approving the approval threshold of an operation type
+func (q *QuorumControlsAPI) valApproveVoteThreshold(args ptype.TxArgs) error {
+	if !q.isNetworkAdmin(args.Txa.From) {
+		return ptype.ErrNotNetworkAdmin
+	}
+	if !q.isThresholdPending(args) {
+		return ptype.ErrNothingToApprove
+	}
+	return nil
+}
+
+// checks if the threshold change is pending approval
+func (q *QuorumControlsAPI) isThresholdPending(args ptype.TxArgs) bool {
+	votingService, err := q.newVotingService()
+	if err != nil {
+		return false
+	}
+	ops, err := votingService.GetPendingOps(q.permCtrl.permConfig.NwAdminOrg)
+	if err != nil {
+		return false
+	}
+	for _, op := range ops {
+		if op.OpType == ptype.PendingOpVoteThreshold && op.TargetOpType == int64(args.Action) && op.Threshold == args.Threshold {
+			return true
+		}
+	}
+	return false
+}
+
 func (q *QuorumControlsAPI) valApproveOrg(args ptype.TxArgs) error {
 	// check caller is network admin
 	if !q.isNetworkAdmin(args.Txa.From) {
 		return ptype.ErrNotNetworkAdmin
 	}
 	// check if anything pending approval
-	if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, args.AcctId, 1) {
+	if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, args.AcctId, ptype.PendingOpAddOrg) {
 		return ptype.ErrNothingToApprove
 	}
 	return nil
@@ -860,7 +1037,11 @@ func (q *QuorumControlsAPI) valUpdateOrgStatus(args ptype.TxArgs) error {
 	if er := q.checkOrgStatus(args.OrgId, args.Action); er != nil {
 		return er
 	}
-	return nil
+	// expiry is applicable for suspension only
+	if !args.Expiry.IsZero() && OrgUpdateAction(args.Action) != SuspendOrg {
+		return ptype.ErrOpNotAllowed
+	}
+	return q.valExpiry(args.Expiry)
 }
 
 func (q *QuorumControlsAPI) valApproveOrgStatus(args ptype.TxArgs) error {
@@ -871,9 +1052,9 @@ func (q *QuorumControlsAPI) valApproveOrgStatus(args ptype.TxArgs) error {
 	// check if anything is pending approval
 	var pendingOp int64
 	if args.Action == 1 {
-		pendingOp = 2
+		pendingOp = ptype.PendingOpSuspendOrg
 	} else if args.Action == 2 {
-		pendingOp = 3
+		pendingOp = ptype.PendingOpRevokeOrgSuspension
 	} else {
 		return ptype.ErrOpNotAllowed
 	}
@@ -933,7 +1114,7 @@ func (q *QuorumControlsAPI) valAssignAdminRole(args ptype.TxArgs) error {
 	if er := q.checkOrgAdminExists(args.OrgId, args.RoleId, args.AcctId); er != nil && er.Error() != ptype.ErrOrgAdminExists.Error() {
 		return er
 	}
-	return nil
+	return q.valExpiry(args.Expiry)
 }
 
 func (q *QuorumControlsAPI) valApproveAdminRole(args ptype.TxArgs) error {
@@ -949,7 +1130,7 @@ func (q *QuorumControlsAPI) valApproveAdminRole(args ptype.TxArgs) error {
 		return ptype.ErrInvalidAccount
 	}
 	// validate pending op
-	if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, ac.OrgId, "", args.AcctId, 4) {
+	if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, ac.OrgId, "", args.AcctId, ptype.PendingOpAssignAdminRole) {
 		return ptype.ErrNothingToApprove
 	}
 	return nil
@@ -1019,6 +1200,21 @@ func (q *QuorumControlsAPI) valAssignRole(args ptype.TxArgs) error {
 			return ptype.ErrAccountInUse
 		}
 	}
+	return q.valExpiry(args.Expiry)
+}
+
+// validates the expiry passed for an account role assignment or org
+// suspension. expiry is supported in v2 model only and should be in future
+func (q *QuorumControlsAPI) valExpiry(expiry core.AccessExpiry) error {
+	if expiry.IsZero() {
+		return nil
+	}
+	if !q.permCtrl.IsV2Permission() {
+		return ptype.ErrExpiryNotSupported
+	}
+	if expiry.HasExpired() {
+		return ptype.ErrInvalidExpiry
+	}
 	return nil
 }
 
@@ -1049,7 +1245,7 @@ func (q *QuorumControlsAPI) valRecoverNode(args ptype.TxArgs, action PermAction)
 			return err
 		}
 		// check no pending approval items
-		if q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg) {
+		if q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, common.Address{}, ptype.PendingOpNodeRecovery) {
 			return ptype.ErrPendingApprovals
 		}
 	} else {
@@ -1057,7 +1253,7 @@ func (q *QuorumControlsAPI) valRecoverNode(args ptype.TxArgs, action PermAction)
 		if err := q.valNodeStatusChange(args.OrgId, args.Url, 5, ApproveNodeRecovery); err != nil {
 			return err
 		}
-		if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, common.Address{}, 5) {
+		if !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, args.Url, common.Address{}, ptype.PendingOpNodeRecovery) {
 			return ptype.ErrNothingToApprove
 		}
 	}
@@ -1084,11 +1280,11 @@ func (q *QuorumControlsAPI) valRecoverAccount(args ptype.TxArgs, action PermActi
 		return err
 	}
 
-	if action == InitiateAccountRecovery && q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg) {
+	if action == InitiateAccountRecovery && q.checkPendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, "", args.AcctId, ptype.PendingOpAccountRecovery) {
 		return ptype.ErrPendingApprovals
 	}
 
-	if action == ApproveAccountRecovery && !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, "", args.AcctId, 6) {
+	if action == ApproveAccountRecovery && !q.validatePendingOp(q.permCtrl.permConfig.NwAdminOrg, args.OrgId, "", args.AcctId, ptype.PendingOpAccountRecovery) {
 		return ptype.ErrNothingToApprove
 	}
 	return nil
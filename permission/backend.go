@@ -36,6 +36,8 @@ type PermissionCtrl struct {
 	errorChan          chan error      // channel to capture error when starting aysnc
 	networkInitialized bool
 	controlService     ptype.ControlService
+	events             *ptype.PermissionEventFeed // permission model changes for quorumPermission subscribers
+	cacheCheckMux      sync.Mutex                 // serializes cache consistency checks
 }
 
 var permissionService *PermissionCtrl
@@ -65,6 +67,7 @@ func NewQuorumPermissionCtrl(stack *node.Node, pconfig *ptype.PermissionConfig,
 		errorChan:      make(chan error),
 		useDns:         useDns,
 		isRaft:         false,
+		events:         ptype.NewPermissionEventFeed(),
 	}
 
 	err := p.populateBackEnd()
@@ -112,12 +115,19 @@ func (p *PermissionCtrl) apis() []rpc.API {
 			Service:   NewQuorumControlsAPI(p),
 			Public:    true,
 		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   NewPermissionAdminAPI(p),
+			Public:    false,
+		},
 	}
 }
 
 func (p *PermissionCtrl) Stop() error {
 	log.Info("permission service: stopping")
 	ptype.StopFeed.Send(ptype.StopEvent{})
+	p.events.Stop()
 	log.Info("permission service: stopped")
 	return nil
 }
@@ -199,7 +209,7 @@ func (p *PermissionCtrl) ConnectionAllowed(_enodeId, _ip string, _port, _raftPor
 	return cs.ConnectionAllowed(_enodeId, _ip, _port, _raftPort)
 }
 
-func (p *PermissionCtrl) IsTransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, transactionType core.TransactionType) error {
+func (p *PermissionCtrl) IsTransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, transactionType core.TransactionType, _block *big.Int) error {
 	// If permissions model is not in use return nil
 	if core.PermissionModel == core.Default {
 		return nil
@@ -210,11 +220,11 @@ func (p *PermissionCtrl) IsTransactionAllowed(_sender common.Address, _target co
 		return err
 	}
 
-	return cs.TransactionAllowed(_sender, _target, _value, _gasPrice, _gasLimit, _payload, transactionType)
+	return cs.TransactionAllowed(_sender, _target, _value, _gasPrice, _gasLimit, _payload, transactionType, _block)
 }
 
 func (p *PermissionCtrl) populateBackEnd() error {
-	backend := ptype.NewInterfaceBackend(p.node, false, p.dataDir)
+	backend := ptype.NewInterfaceBackend(p.node, false, p.dataDir, p.events)
 
 	switch p.permConfig.PermissionsModel {
 	case ptype.PERMISSION_V2:
@@ -0,0 +1,309 @@
+package permission
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+)
+
+// interval at which the permission caches are checked against the contract
+// state in the background
+var cacheCheckInterval = 10 * time.Minute
+
+var (
+	orgDriftCounter     = metrics.NewRegisteredCounter("permission/cache/drift/org", nil)
+	roleDriftCounter    = metrics.NewRegisteredCounter("permission/cache/drift/role", nil)
+	nodeDriftCounter    = metrics.NewRegisteredCounter("permission/cache/drift/node", nil)
+	accountDriftCounter = metrics.NewRegisteredCounter("permission/cache/drift/account", nil)
+	cacheCheckTimer     = metrics.NewRegisteredTimer("permission/cache/check", nil)
+)
+
+const (
+	orgCache     = "org"
+	roleCache    = "role"
+	nodeCache    = "node"
+	accountCache = "account"
+)
+
+// CacheDrift describes a cached permission entry which did not match the
+// contract state. Contract is nil if the entry does not exist in the contract
+type CacheDrift struct {
+	Cache    string      `json:"cache"`
+	Key      string      `json:"key"`
+	Cached   interface{} `json:"cached"`
+	Contract interface{} `json:"contract"`
+}
+
+// CacheCheckResult is the outcome of a permission cache consistency check
+type CacheCheckResult struct {
+	BlockNumber uint64       `json:"blockNumber"`
+	Checked     int          `json:"checked"`
+	Drift       []CacheDrift `json:"drift"`
+}
+
+// PermissionAdminAPI provides administrative functions for the permission
+// service
+type PermissionAdminAPI struct {
+	permCtrl *PermissionCtrl
+}
+
+// NewPermissionAdminAPI creates a new PermissionAdminAPI
+func NewPermissionAdminAPI(p *PermissionCtrl) *PermissionAdminAPI {
+	return &PermissionAdminAPI{p}
+}
+
+// CheckPermissionCache compares every cached permission entry against the
+// contract state at the head block, repopulates drifted entries and reports
+// the drift
+func (api *PermissionAdminAPI) CheckPermissionCache() (*CacheCheckResult, error) {
+	return api.permCtrl.checkCacheConsistency()
+}
+
+// periodically checks the permission caches against the contract state
+func (p *PermissionCtrl) monitorCacheConsistency() error {
+	go func() {
+		ticker := time.NewTicker(cacheCheckInterval)
+		defer ticker.Stop()
+		stopChan, stopSubscription := ptype.SubscribeStopEvent()
+		defer stopSubscription.Unsubscribe()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := p.checkCacheConsistency(); err != nil {
+					log.Warn("permission cache consistency check failed", "err", err)
+				}
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// state of a cache consistency check run
+type cacheCheck struct {
+	result   *CacheCheckResult
+	snapshot pcore.CacheSnapshot
+	// nil if the permission model or the deployed contracts do not
+	// support expiry
+	expiry ptype.ExpiryService
+	// number of cache updates made by the check
+	updates uint64
+}
+
+// checks all cached org, role, node and account entries against the
+// contract. the entries are taken from a snapshot of the caches. drifted
+// entries are replaced with the contract state and entries which do not
+// exist in the contract are removed from the cache
+func (p *PermissionCtrl) checkCacheConsistency() (*CacheCheckResult, error) {
+	p.cacheCheckMux.Lock()
+	defer p.cacheCheckMux.Unlock()
+	defer func(start time.Time) { cacheCheckTimer.UpdateSince(start) }(time.Now())
+
+	number, _ := p.currentBlock()
+	c := &cacheCheck{
+		result:   &CacheCheckResult{BlockNumber: number, Drift: []CacheDrift{}},
+		snapshot: pcore.SnapshotCaches(),
+	}
+	c.expiry, _ = p.contract.(ptype.ExpiryService)
+	for _, f := range []func(*cacheCheck) error{
+		p.checkOrgCache,
+		p.checkRoleCache,
+		p.checkNodeCache,
+		p.checkAccountCache,
+	} {
+		if err := f(c); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.result.Drift) > 0 {
+		log.Warn("permission cache drift detected and repopulated", "block", number, "checked", c.result.Checked, "drift", len(c.result.Drift))
+	}
+	return c.result, nil
+}
+
+func (r *CacheCheckResult) addDrift(counter metrics.Counter, cache, key string, cached, contract interface{}) {
+	counter.Inc(1)
+	r.Drift = append(r.Drift, CacheDrift{Cache: cache, Key: key, Cached: cached, Contract: contract})
+}
+
+// reports if an entry missing from the contract can be removed from the
+// cache. the entry may have been updated by an event for a block later than
+// the contract state read, so removal is left to the next check if the
+// caches have been updated by anyone else since the snapshot
+func (c *cacheCheck) canRemove() bool {
+	return pcore.CacheVersion() == c.snapshot.Version+c.updates
+}
+
+// applies a single cache update
+func (c *cacheCheck) update(f func()) {
+	f()
+	c.updates++
+}
+
+// fetches an expiry from the contract. ok is false if the expiry is not
+// available, in which case the check skips the expiry of all entries
+func (c *cacheCheck) fetchExpiry(fetch func(ptype.ExpiryService) (pcore.AccessExpiry, error)) (expiry pcore.AccessExpiry, ok bool, err error) {
+	if c.expiry == nil {
+		return pcore.AccessExpiry{}, false, nil
+	}
+	expiry, err = fetch(c.expiry)
+	if isMissingMethod(err) {
+		log.Info("permission contracts do not support expiry, skipping expiry check")
+		c.expiry = nil
+		return pcore.AccessExpiry{}, false, nil
+	}
+	return expiry, err == nil, err
+}
+
+func (p *PermissionCtrl) checkOrgCache(c *cacheCheck) error {
+	for _, cached := range c.snapshot.Orgs {
+		c.result.Checked++
+		rec, err := p.populateOrgToCache(cached.FullOrgId)
+		if err == ptype.ErrOrgDoesNotExists {
+			if c.canRemove() {
+				c.update(func() { pcore.OrgInfoMap.RemoveOrg(cached.FullOrgId) })
+			}
+			c.result.addDrift(orgDriftCounter, orgCache, cached.FullOrgId, cached, nil)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch org %s: %v", cached.FullOrgId, err)
+		}
+		if cached.ParentOrgId != rec.ParentOrgId || cached.UltimateParent != rec.UltimateParent ||
+			cached.Status != rec.Status || !equalLevel(cached.Level, rec.Level) {
+			c.update(func() { pcore.OrgInfoMap.UpsertOrgWithSubOrgList(rec) })
+			c.result.addDrift(orgDriftCounter, orgCache, cached.FullOrgId, cached, *rec)
+		}
+		expiry, ok, err := c.fetchExpiry(func(es ptype.ExpiryService) (pcore.AccessExpiry, error) {
+			return es.GetSuspensionExpiry(cached.FullOrgId)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to fetch suspension expiry of org %s: %v", cached.FullOrgId, err)
+		}
+		if ok && cached.SuspensionExpiry != expiry {
+			c.update(func() { pcore.OrgInfoMap.SetSuspensionExpiry(cached.FullOrgId, expiry) })
+			c.result.addDrift(orgDriftCounter, orgCache, cached.FullOrgId, cached.SuspensionExpiry, expiry)
+		}
+	}
+	return nil
+}
+
+func (p *PermissionCtrl) checkRoleCache(c *cacheCheck) error {
+	for _, cached := range c.snapshot.Roles {
+		c.result.Checked++
+		key := cached.OrgId + ":" + cached.RoleId
+		rec, err := p.populateRoleToCache(&pcore.RoleKey{OrgId: cached.OrgId, RoleId: cached.RoleId})
+		if err == ptype.ErrInvalidRole {
+			if c.canRemove() {
+				c.update(func() { pcore.RoleInfoMap.RemoveRole(cached.OrgId, cached.RoleId) })
+			}
+			c.result.addDrift(roleDriftCounter, roleCache, key, cached, nil)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch role %s: %v", key, err)
+		}
+		if cached != *rec {
+			c.update(func() {
+				pcore.RoleInfoMap.UpsertRole(rec.OrgId, rec.RoleId, rec.IsVoter, rec.IsAdmin, rec.Access, rec.Active)
+			})
+			c.result.addDrift(roleDriftCounter, roleCache, key, cached, *rec)
+		}
+	}
+	return nil
+}
+
+func (p *PermissionCtrl) checkNodeCache(c *cacheCheck) error {
+	// nodes are looked up by url which is not the key of the node records
+	// in the contract, hence the full list is read from the contract
+	numberOfNodes, err := p.contract.GetNumberOfNodes()
+	if err != nil {
+		return fmt.Errorf("failed to fetch number of nodes: %v", err)
+	}
+	nodes := make(map[string]pcore.NodeInfo)
+	for k := uint64(0); k < numberOfNodes.Uint64(); k++ {
+		orgId, url, status, err := p.contract.GetNodeDetailsFromIndex(new(big.Int).SetUint64(k))
+		if err != nil {
+			return fmt.Errorf("failed to fetch node at index %d: %v", k, err)
+		}
+		nodes[url] = pcore.NodeInfo{OrgId: orgId, Url: url, Status: pcore.NodeStatus(status.Int64())}
+	}
+	for _, cached := range c.snapshot.Nodes {
+		c.result.Checked++
+		rec, ok := nodes[cached.Url]
+		if !ok {
+			if c.canRemove() {
+				c.update(func() { pcore.NodeInfoMap.RemoveNode(cached.OrgId, cached.Url) })
+			}
+			c.result.addDrift(nodeDriftCounter, nodeCache, cached.Url, cached, nil)
+			continue
+		}
+		if cached != rec {
+			if cached.OrgId != rec.OrgId {
+				c.update(func() { pcore.NodeInfoMap.RemoveNode(cached.OrgId, cached.Url) })
+			}
+			c.update(func() { pcore.NodeInfoMap.UpsertNode(rec.OrgId, rec.Url, rec.Status) })
+			c.result.addDrift(nodeDriftCounter, nodeCache, cached.Url, cached, rec)
+		}
+	}
+	return nil
+}
+
+func (p *PermissionCtrl) checkAccountCache(c *cacheCheck) error {
+	for _, cached := range c.snapshot.Accounts {
+		c.result.Checked++
+		key := cached.AcctId.Hex()
+		// validity is derived from the expiry and the expiry is checked
+		// separately, neither is part of the contract record
+		cachedExpiry := cached.Expiry
+		cached.Validity, cached.Expiry = nil, pcore.AccessExpiry{}
+		rec, err := p.populateAccountToCache(cached.AcctId)
+		if err == ptype.ErrAccountNotThere {
+			if c.canRemove() {
+				c.update(func() { pcore.AcctInfoMap.RemoveAccount(cached.AcctId) })
+			}
+			c.result.addDrift(accountDriftCounter, accountCache, key, cached, nil)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch account %s: %v", key, err)
+		}
+		rec.Expiry = pcore.AccessExpiry{}
+		if cached != *rec {
+			c.update(func() { pcore.AcctInfoMap.UpsertAccount(rec.OrgId, rec.RoleId, rec.AcctId, rec.IsOrgAdmin, rec.Status) })
+			c.result.addDrift(accountDriftCounter, accountCache, key, cached, *rec)
+		}
+		if err := c.checkAccountExpiry(cached.AcctId, cachedExpiry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *cacheCheck) checkAccountExpiry(acct common.Address, cachedExpiry pcore.AccessExpiry) error {
+	expiry, ok, err := c.fetchExpiry(func(es ptype.ExpiryService) (pcore.AccessExpiry, error) {
+		return es.GetAccountExpiry(acct)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch expiry of account %s: %v", acct.Hex(), err)
+	}
+	if ok && cachedExpiry != expiry {
+		c.update(func() { pcore.AcctInfoMap.SetAccountExpiry(acct, expiry) })
+		c.result.addDrift(accountDriftCounter, accountCache, acct.Hex(), cachedExpiry, expiry)
+	}
+	return nil
+}
+
+func equalLevel(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
@@ -44,7 +44,13 @@ func isNodePermissionedV2(node *enode.Node, nodename string, currentNode string,
 
 }
 
+// checks if the node is allowed to connect. the decision of the permission
+// plugin is combined if the plugin is set
 func IsNodePermissioned(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
+	return core.CheckNodeWithPlugin(isNodePermissioned(node, nodename, currentNode, datadir, direction), node.String(), direction)
+}
+
+func isNodePermissioned(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
 
 	//if we have not reached QIP714 block return full access
 	if !core.PermissionsEnabled() {
@@ -1,11 +1,13 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -62,6 +64,9 @@ type OrgInfo struct {
 	Level          *big.Int  `json:"level"`
 	SubOrgList     []string  `json:"subOrgList"`
 	Status         OrgStatus `json:"status"`
+
+	// expiry of the suspension, set if the org is suspended
+	SuspensionExpiry AccessExpiry `json:"-"`
 }
 
 type NodeStatus uint8
@@ -108,6 +113,40 @@ type AccountInfo struct {
 	AcctId     common.Address `json:"acctId"`
 	IsOrgAdmin bool           `json:"isOrgAdmin"`
 	Status     AcctStatus     `json:"status"`
+	Validity   *AcctValidity  `json:"validity,omitempty"`
+	Expiry     AccessExpiry   `json:"-"`
+}
+
+// AccessExpiry bounds an account role assignment or an org suspension by
+// block number and/or block timestamp. A zero value leaves the respective
+// bound open.
+type AccessExpiry struct {
+	Block     uint64 `json:"expiryBlock"`
+	Timestamp uint64 `json:"expiryTime"`
+}
+
+// returns true if neither an expiry block nor an expiry timestamp is set
+func (e AccessExpiry) IsZero() bool {
+	return e.Block == 0 && e.Timestamp == 0
+}
+
+// returns true if the expiry has passed for the given block number and time
+func (e AccessExpiry) Expired(number, time uint64) bool {
+	return (e.Block != 0 && number > e.Block) || (e.Timestamp != 0 && time > e.Timestamp)
+}
+
+// returns true if the expiry has passed as of the current block
+func (e AccessExpiry) HasExpired() bool {
+	return e.Expired(currentBlock())
+}
+
+// AcctValidity reports the remaining validity of a time bounded account
+// role assignment as of the current block
+type AcctValidity struct {
+	AccessExpiry
+	RemainingBlocks uint64 `json:"remainingBlocks"`
+	RemainingTime   uint64 `json:"remainingTime"`
+	Expired         bool   `json:"expired"`
 }
 
 type OrgDetailInfo struct {
@@ -125,7 +164,11 @@ var networkBootUpCompleted = false
 var networkAdminRole string
 var orgAdminRole string
 var PermissionModel = Default
-var PermissionTransactionAllowedFunc func(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType TransactionType) error
+
+// returns the number and timestamp of the current block. used for
+// evaluating expiry of account roles and org suspensions
+var CurrentBlockFunc func() (uint64, uint64)
+var PermissionTransactionAllowedFunc func(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType TransactionType, _block *big.Int) error
 var (
 	OrgInfoMap  *OrgCache
 	NodeInfoMap *NodeCache
@@ -133,6 +176,50 @@ var (
 	AcctInfoMap *AcctCache
 )
 
+// cacheUpdateMu orders cache updates against snapshots. updates hold it
+// shared as each cache synchronizes itself, a snapshot holds it exclusively
+var cacheUpdateMu sync.RWMutex
+
+// cacheVersion is incremented on every cache update
+var cacheVersion uint64
+
+// CacheSnapshot is a copy of the permission caches taken with no update in
+// progress
+type CacheSnapshot struct {
+	Version  uint64
+	Orgs     []OrgInfo
+	Roles    []RoleInfo
+	Nodes    []NodeInfo
+	Accounts []AccountInfo
+}
+
+// SnapshotCaches returns a consistent copy of the org, role, node and
+// account caches
+func SnapshotCaches() CacheSnapshot {
+	cacheUpdateMu.Lock()
+	defer cacheUpdateMu.Unlock()
+	return CacheSnapshot{
+		Version:  cacheVersion,
+		Orgs:     OrgInfoMap.GetOrgList(),
+		Roles:    RoleInfoMap.GetRoleList(),
+		Nodes:    NodeInfoMap.GetNodeList(),
+		Accounts: AcctInfoMap.GetAcctList(),
+	}
+}
+
+// CacheVersion returns the number of cache updates applied so far. caches
+// are unchanged since a snapshot as long as the version is the same
+func CacheVersion() uint64 {
+	return atomic.LoadUint64(&cacheVersion)
+}
+
+// marks the start of a cache update, the returned function marks the end
+func beginCacheUpdate() func() {
+	cacheUpdateMu.RLock()
+	atomic.AddUint64(&cacheVersion, 1)
+	return cacheUpdateMu.RUnlock
+}
+
 type OrgKey struct {
 	OrgId string
 }
@@ -295,6 +382,7 @@ func GetNodeUrl(enodeId string, ip string, port uint16, raftport uint16, isRaft
 }
 
 func (o *OrgCache) UpsertOrg(orgId, parentOrg, ultimateParent string, level *big.Int, status OrgStatus) {
+	defer beginCacheUpdate()()
 	defer o.mux.Unlock()
 	o.mux.Lock()
 	var key OrgKey
@@ -312,11 +400,40 @@ func (o *OrgCache) UpsertOrg(orgId, parentOrg, ultimateParent string, level *big
 		}
 	}
 
-	norg := &OrgInfo{orgId, key.OrgId, parentOrg, ultimateParent, level, nil, status}
+	norg := &OrgInfo{orgId, key.OrgId, parentOrg, ultimateParent, level, nil, status, AccessExpiry{}}
+	// the suspension expiry is set by a separate event, keep it till the
+	// suspension is revoked
+	if ent, ok := o.c.Get(key); ok && status == OrgSuspended {
+		norg.SuspensionExpiry = ent.(*OrgInfo).SuspensionExpiry
+	}
 	o.c.Add(key, norg)
 }
 
+// sets the expiry of the suspension for the given org. a zero expiry
+// removes the bound and the suspension stays till it is revoked
+func (o *OrgCache) SetSuspensionExpiry(orgId string, expiry AccessExpiry) {
+	defer beginCacheUpdate()()
+	defer o.mux.Unlock()
+	o.mux.Lock()
+	key := OrgKey{OrgId: orgId}
+	if ent, ok := o.c.Get(key); ok {
+		org := *ent.(*OrgInfo)
+		org.SuspensionExpiry = expiry
+		o.c.Add(key, &org)
+	}
+}
+
+// returns the suspension expiry of the given org
+func (o *OrgCache) GetSuspensionExpiry(orgId string) (AccessExpiry, bool) {
+	org, _ := o.GetOrg(orgId)
+	if org == nil || org.SuspensionExpiry.IsZero() {
+		return AccessExpiry{}, false
+	}
+	return org.SuspensionExpiry, true
+}
+
 func (o *OrgCache) UpsertOrgWithSubOrgList(orgRec *OrgInfo) {
+	defer beginCacheUpdate()()
 	var key OrgKey
 	if orgRec.ParentOrgId == "" {
 		key = OrgKey{OrgId: orgRec.OrgId}
@@ -357,6 +474,12 @@ func (o *OrgCache) GetOrg(orgId string) (*OrgInfo, error) {
 	return nil, errors.New("Org does not exist")
 }
 
+// removes the org from the cache. orgId is the full org id
+func (o *OrgCache) RemoveOrg(orgId string) {
+	defer beginCacheUpdate()()
+	o.c.Remove(OrgKey{OrgId: orgId})
+}
+
 func (o *OrgCache) GetOrgList() []OrgInfo {
 	olist := make([]OrgInfo, len(o.c.Keys()))
 	for i, k := range o.c.Keys() {
@@ -368,6 +491,7 @@ func (o *OrgCache) GetOrgList() []OrgInfo {
 }
 
 func (n *NodeCache) UpsertNode(orgId string, url string, status NodeStatus) {
+	defer beginCacheUpdate()()
 	key := NodeKey{OrgId: orgId, Url: url}
 	n.c.Add(key, &NodeInfo{orgId, url, status})
 }
@@ -398,6 +522,34 @@ func (n *NodeCache) GetNodeByUrl(url string) (*NodeInfo, error) {
 	return nil, errors.New("Node does not exist")
 }
 
+// returns the node record whose enode ID matches the given enode URL. Unlike
+// GetNodeByUrl, the host and ports of the URL are not compared, as they can
+// be written differently, e.g. with or without the raftport.
+func (n *NodeCache) GetNodeByEnodeUrl(url string) (*NodeInfo, error) {
+	node, err := enode.ParseV4(url)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range n.c.Keys() {
+		ent := k.(NodeKey)
+		recNode, err := enode.ParseV4(ent.Url)
+		if err != nil || recNode.ID() != node.ID() {
+			continue
+		}
+		v, _ := n.c.Get(ent)
+		return v.(*NodeInfo), nil
+	}
+	if n.evicted {
+		return n.GetNodeByUrl(url)
+	}
+	return nil, errors.New("Node does not exist")
+}
+
+func (n *NodeCache) RemoveNode(orgId string, url string) {
+	defer beginCacheUpdate()()
+	n.c.Remove(NodeKey{OrgId: orgId, Url: url})
+}
+
 func (n *NodeCache) GetNodeList() []NodeInfo {
 	olist := make([]NodeInfo, len(n.c.Keys()))
 	for i, k := range n.c.Keys() {
@@ -409,8 +561,56 @@ func (n *NodeCache) GetNodeList() []NodeInfo {
 }
 
 func (a *AcctCache) UpsertAccount(orgId string, role string, acct common.Address, orgAdmin bool, status AcctStatus) {
+	defer beginCacheUpdate()()
 	key := AccountKey{acct}
-	a.c.Add(key, &AccountInfo{orgId, role, acct, orgAdmin, status})
+	nacct := &AccountInfo{orgId, role, acct, orgAdmin, status, nil, AccessExpiry{}}
+	// the expiry is set by a separate event following the role assignment
+	if ent, ok := a.c.Get(key); ok {
+		nacct.Expiry = ent.(*AccountInfo).Expiry
+	}
+	a.c.Add(key, nacct)
+}
+
+// sets the expiry of the role assigned to the account. a zero expiry
+// makes the role assignment valid till it is changed
+func (a *AcctCache) SetAccountExpiry(acct common.Address, expiry AccessExpiry) {
+	defer beginCacheUpdate()()
+	key := AccountKey{acct}
+	if ent, ok := a.c.Get(key); ok {
+		ac := *ent.(*AccountInfo)
+		ac.Expiry = expiry
+		a.c.Add(key, &ac)
+	}
+}
+
+// returns the expiry of the role assigned to the account
+func (a *AcctCache) GetAccountExpiry(acct common.Address) (AccessExpiry, bool) {
+	ac, _ := a.GetAccount(acct)
+	if ac == nil || ac.Expiry.IsZero() {
+		return AccessExpiry{}, false
+	}
+	return ac.Expiry, true
+}
+
+// returns a copy of the account record with the remaining validity of a
+// time bounded role assignment filled in
+func (a *AcctCache) withValidity(ac *AccountInfo) AccountInfo {
+	r := *ac
+	e := ac.Expiry
+	if e.IsZero() {
+		return r
+	}
+	v := &AcctValidity{AccessExpiry: e}
+	number, time := currentBlock()
+	v.Expired = e.Expired(number, time)
+	if e.Block > number {
+		v.RemainingBlocks = e.Block - number
+	}
+	if e.Timestamp > time {
+		v.RemainingTime = e.Timestamp - time
+	}
+	r.Validity = v
+	return r
 }
 
 func (a *AcctCache) GetAccount(acct common.Address) (*AccountInfo, error) {
@@ -428,18 +628,24 @@ func (a *AcctCache) GetAccount(acct common.Address) (*AccountInfo, error) {
 			return nil, err
 		}
 		a.UpsertAccount(acctRec.OrgId, acctRec.RoleId, acctRec.AcctId, acctRec.IsOrgAdmin, acctRec.Status)
+		a.SetAccountExpiry(acctRec.AcctId, acctRec.Expiry)
 		//return the record
 		return acctRec, nil
 	}
 	return nil, nil
 }
 
+func (a *AcctCache) RemoveAccount(acct common.Address) {
+	defer beginCacheUpdate()()
+	a.c.Remove(AccountKey{acct})
+}
+
 func (a *AcctCache) GetAcctList() []AccountInfo {
 	alist := make([]AccountInfo, len(a.c.Keys()))
 	for i, k := range a.c.Keys() {
 		v, _ := a.c.Get(k)
 		vp := v.(*AccountInfo)
-		alist[i] = *vp
+		alist[i] = a.withValidity(vp)
 	}
 	return alist
 }
@@ -450,7 +656,7 @@ func (a *AcctCache) GetAcctListOrg(orgId string) []AccountInfo {
 		v, _ := a.c.Get(k)
 		vp := v.(*AccountInfo)
 		if vp.OrgId == orgId {
-			alist = append(alist, *vp)
+			alist = append(alist, a.withValidity(vp))
 		}
 	}
 	return alist
@@ -468,13 +674,14 @@ func (a *AcctCache) GetAcctListRole(orgId, roleId string) []AccountInfo {
 		}
 
 		if vp.RoleId == roleId && (vp.OrgId == orgId || (orgRec != nil && orgRec.UltimateParent == orgId)) {
-			alist = append(alist, *vp)
+			alist = append(alist, a.withValidity(vp))
 		}
 	}
 	return alist
 }
 
 func (r *RoleCache) UpsertRole(orgId string, role string, voter bool, admin bool, access AccessType, active bool) {
+	defer beginCacheUpdate()()
 	key := RoleKey{orgId, role}
 	r.c.Add(key, &RoleInfo{orgId, role, voter, admin, access, active})
 
@@ -502,6 +709,11 @@ func (r *RoleCache) GetRole(orgId string, roleId string) (*RoleInfo, error) {
 	return nil, errors.New("Invalid role")
 }
 
+func (r *RoleCache) RemoveRole(orgId string, roleId string) {
+	defer beginCacheUpdate()()
+	r.c.Remove(RoleKey{OrgId: orgId, RoleId: roleId})
+}
+
 func (r *RoleCache) GetRoleList() []RoleInfo {
 	rlist := make([]RoleInfo, len(r.c.Keys()))
 	for i, k := range r.c.Keys() {
@@ -518,7 +730,7 @@ func GetAcctAccess(acctId common.Address) AccessType {
 
 	// check if the org status is fine to do the transaction
 	a, _ := AcctInfoMap.GetAccount(acctId)
-	if a != nil && a.Status == AcctActive {
+	if a != nil && a.Status == AcctActive && !isAccountExpired(acctId) {
 		// get the org details and ultimate org details. check org status
 		// if the org is not approved or pending suspension
 		if checkIfOrgActive(a.OrgId) {
@@ -538,15 +750,39 @@ func GetAcctAccess(acctId common.Address) AccessType {
 	return defaultAccess
 }
 
+// returns the number and timestamp of the current block. returns zeros
+// if the block source is not set yet
+func currentBlock() (uint64, uint64) {
+	if CurrentBlockFunc == nil {
+		return 0, 0
+	}
+	return CurrentBlockFunc()
+}
+
+// checks if the role assigned to the account has expired
+func isAccountExpired(acctId common.Address) bool {
+	e, ok := AcctInfoMap.GetAccountExpiry(acctId)
+	return ok && e.HasExpired()
+}
+
+// checks if the org is suspended and the suspension has not lapsed
+func isOrgSuspended(o *OrgInfo) bool {
+	if o.Status != OrgSuspended {
+		return false
+	}
+	e, ok := OrgInfoMap.GetSuspensionExpiry(o.FullOrgId)
+	return !ok || !e.HasExpired()
+}
+
 //checks if the given org is active in the network
 func checkIfOrgActive(orgId string) bool {
 	o, _ := OrgInfoMap.GetOrg(orgId)
-	if o != nil && o.Status != OrgSuspended {
+	if o != nil && !isOrgSuspended(o) {
 		u, _ := OrgInfoMap.GetOrg(o.UltimateParent)
 		if u == nil {
 			return true
 		}
-		if u != nil && u.Status != OrgSuspended {
+		if u != nil && !isOrgSuspended(u) {
 			return true
 		}
 	}
@@ -560,7 +796,7 @@ func CheckIfAdminAccount(acctId common.Address) bool {
 		return true
 	}
 	a, _ := AcctInfoMap.GetAccount(acctId)
-	if a != nil && a.Status == AcctActive {
+	if a != nil && a.Status == AcctActive && !isAccountExpired(acctId) {
 		if checkIfOrgActive(a.OrgId) {
 			if a.RoleId == networkAdminRole || a.RoleId == orgAdminRole {
 				return true
@@ -578,8 +814,16 @@ func CheckIfAdminAccount(acctId common.Address) bool {
 	return false
 }
 
-// validates if the account can transact from the current node
+// validates if the account can transact from the current node. this is
+// checked on transaction submission only, so the decision of the permission
+// plugin is combined if the plugin is set
 func ValidateNodeForTxn(hexnodeId string, from common.Address) bool {
+	return combineWithPlugin(validateNodeForTxn(hexnodeId, from), func(ctx context.Context, p PermissionPlugin) (bool, error) {
+		return p.IsNodeAllowedForTransaction(ctx, hexnodeId, from)
+	})
+}
+
+func validateNodeForTxn(hexnodeId string, from common.Address) bool {
 	if !PermissionsEnabled() || hexnodeId == "" {
 		return true
 	}
@@ -623,12 +867,33 @@ func IsV2Permission() bool {
 	return PermissionModel == V2
 }
 
-//  checks if the account permission allows the transaction to be executed
+// IsTransactionAllowed checks if the account permission allows the transaction to be submitted.
+// the decision of the permission plugin is combined if the plugin is set.
+// blocks are never checked against the plugin as its decision is local to
+// this node and would break consensus
 func IsTransactionAllowed(from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType) error {
+	err := isTransactionAllowed(from, to, value, gasPrice, gasLimit, payload, transactionType, nil)
+	if permissionPlugin == nil {
+		return err
+	}
+	if combineWithPlugin(err == nil, func(ctx context.Context, p PermissionPlugin) (bool, error) {
+		return p.IsTransactionAllowed(ctx, from, to, value, gasPrice, gasLimit, payload, transactionType)
+	}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return ErrPluginDenied
+}
+
+// checks if the account permission as of the given block allows the
+// transaction to be executed. a nil block checks against the latest block
+func isTransactionAllowed(from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType, block *big.Int) error {
 	//if we have not reached QIP714 block return full access
 	if !PermissionsEnabled() {
 		return nil
 	}
 
-	return PermissionTransactionAllowedFunc(from, to, value, gasPrice, gasLimit, payload, transactionType)
+	return PermissionTransactionAllowedFunc(from, to, value, gasPrice, gasLimit, payload, transactionType, block)
 }
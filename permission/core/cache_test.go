@@ -113,6 +113,26 @@ func TestNodeCache_UpsertNode(t *testing.T) {
 	assert.True(nodeInfo.Status == NodeDeactivated, fmt.Sprintf("Expected node status %v, got %v", NodeDeactivated, nodeInfo.Status))
 }
 
+func TestNodeCache_GetNodeByEnodeUrl(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	defer func(nodeInfoMap *NodeCache) { NodeInfoMap = nodeInfoMap }(NodeInfoMap)
+	NodeInfoMap = NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	NodeInfoMap.UpsertNode(NETWORKADMIN, NODE1, NodeBlackListed)
+
+	// same enode ID with a different host and without raftport
+	nodeInfo, err := NodeInfoMap.GetNodeByEnodeUrl("enode://ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef@10.0.0.1:30303")
+	assert.NoError(err)
+	assert.Equal(NODE1, nodeInfo.Url)
+	assert.Equal(NodeBlackListed, nodeInfo.Status)
+
+	_, err = NodeInfoMap.GetNodeByEnodeUrl(NODE2)
+	assert.Error(err, "unknown node must not be found")
+
+	_, err = NodeInfoMap.GetNodeByEnodeUrl("enode://invalid")
+	assert.Error(err)
+}
+
 func TestRoleCache_UpsertRole(t *testing.T) {
 	assert := testifyassert.New(t)
 
@@ -172,6 +192,42 @@ func TestAcctCache_UpsertAccount(t *testing.T) {
 	assert.True(len(acctList) == 1, fmt.Sprintf("Expected number of accounts for the role to be 1, got %v", len(acctList)))
 }
 
+func TestSnapshotCaches(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	defer func(o *OrgCache, r *RoleCache, n *NodeCache, a *AcctCache) {
+		OrgInfoMap, RoleInfoMap, NodeInfoMap, AcctInfoMap = o, r, n, a
+	}(OrgInfoMap, RoleInfoMap, NodeInfoMap, AcctInfoMap)
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	RoleInfoMap = NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	NodeInfoMap = NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+
+	OrgInfoMap.UpsertOrg(NETWORKADMIN, "", NETWORKADMIN, big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole(NETWORKADMIN, NETWORKADMIN, true, true, FullAccess, true)
+	NodeInfoMap.UpsertNode(NETWORKADMIN, NODE1, NodeApproved)
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct1, true, AcctActive)
+
+	snapshot := SnapshotCaches()
+	assert.Len(snapshot.Orgs, 1)
+	assert.Len(snapshot.Roles, 1)
+	assert.Len(snapshot.Nodes, 1)
+	assert.Len(snapshot.Accounts, 1)
+	assert.Equal(snapshot.Version, CacheVersion())
+
+	// reads leave the version as is, every update moves it
+	_, _ = AcctInfoMap.GetAccount(Acct1)
+	assert.Equal(snapshot.Version, CacheVersion())
+	AcctInfoMap.SetAccountExpiry(Acct1, AccessExpiry{Block: 10})
+	assert.Equal(snapshot.Version+1, CacheVersion())
+	AcctInfoMap.RemoveAccount(Acct1)
+	assert.Equal(snapshot.Version+2, CacheVersion())
+
+	// the snapshot is a copy
+	assert.Equal(AcctActive, snapshot.Accounts[0].Status)
+	assert.Empty(SnapshotCaches().Accounts)
+}
+
 func TestGetAcctAccess(t *testing.T) {
 	assert := testifyassert.New(t)
 
@@ -209,6 +265,67 @@ func TestGetAcctAccess(t *testing.T) {
 	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
 }
 
+func TestGetAcctAccess_WithExpiry(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	var number, time uint64 = 10, 1000
+	CurrentBlockFunc = func() (uint64, uint64) { return number, time }
+	orgInfoMap, roleInfoMap, acctInfoMap := OrgInfoMap, RoleInfoMap, AcctInfoMap
+	defer func() {
+		CurrentBlockFunc = nil
+		OrgInfoMap, RoleInfoMap, AcctInfoMap = orgInfoMap, roleInfoMap, acctInfoMap
+		SetDefaults(NETWORKADMIN, ORGADMIN, false)
+	}()
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, true)
+	SetQIP714BlockReached()
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	RoleInfoMap = NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	OrgInfoMap.UpsertOrg("ORG1", "", "ORG1", big.NewInt(1), OrgApproved)
+	RoleInfoMap.UpsertRole("ORG1", "ROLE1", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount("ORG1", "ROLE1", Acct1, false, AcctActive)
+
+	// role assignment valid till block 20
+	AcctInfoMap.SetAccountExpiry(Acct1, AccessExpiry{Block: 20})
+	access := GetAcctAccess(Acct1)
+	assert.True(access == Transact, fmt.Sprintf("Expected account access to be %v, got %v", Transact, access))
+	acctList := AcctInfoMap.GetAcctList()
+	assert.Equal(1, len(acctList))
+	assert.NotNil(acctList[0].Validity)
+	assert.Equal(uint64(10), acctList[0].Validity.RemainingBlocks)
+	assert.False(acctList[0].Validity.Expired)
+
+	// move past the expiry block and the account should fall back to default access
+	number = 21
+	access = GetAcctAccess(Acct1)
+	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
+	assert.True(AcctInfoMap.GetAcctList()[0].Validity.Expired)
+
+	// timestamp bound expiry
+	AcctInfoMap.SetAccountExpiry(Acct1, AccessExpiry{Timestamp: 2000})
+	access = GetAcctAccess(Acct1)
+	assert.True(access == Transact, fmt.Sprintf("Expected account access to be %v, got %v", Transact, access))
+	time = 2001
+	access = GetAcctAccess(Acct1)
+	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
+
+	// clearing the expiry restores the access
+	AcctInfoMap.SetAccountExpiry(Acct1, AccessExpiry{})
+	access = GetAcctAccess(Acct1)
+	assert.True(access == Transact, fmt.Sprintf("Expected account access to be %v, got %v", Transact, access))
+	assert.Nil(AcctInfoMap.GetAcctList()[0].Validity)
+
+	// suspend the org till block 30. access is restored once the suspension lapses
+	OrgInfoMap.UpsertOrg("ORG1", "", "ORG1", big.NewInt(1), OrgSuspended)
+	OrgInfoMap.SetSuspensionExpiry("ORG1", AccessExpiry{Block: 30})
+	access = GetAcctAccess(Acct1)
+	assert.True(access == ReadOnly, fmt.Sprintf("Expected account access to be %v, got %v", ReadOnly, access))
+	number = 31
+	access = GetAcctAccess(Acct1)
+	assert.True(access == Transact, fmt.Sprintf("Expected account access to be %v, got %v", Transact, access))
+}
+
 func TestValidateNodeForTxn(t *testing.T) {
 	assert := testifyassert.New(t)
 	// pass the enode as null and the response should be true
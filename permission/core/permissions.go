@@ -105,8 +105,22 @@ func isNodeBlackListed(nodeName, dataDir string) bool {
 	return false
 }
 
-// function checks for account access to execute the transaction
+// function checks for account access to submit the transaction
 func CheckAccountPermission(from common.Address, to *common.Address, value *big.Int, data []byte, gas uint64, gasPrice *big.Int) error {
+	toAcct, transactionType := accountTxnType(to, data)
+	return IsTransactionAllowed(from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType)
+}
+
+// function checks for account access to execute the transaction as part of
+// the block with the given number. the permissions are evaluated as of the
+// parent block so that every node reaches the same verdict irrespective of
+// its current head. this is the check to use when processing blocks
+func CheckAccountPermissionForBlock(number *big.Int, from common.Address, to *common.Address, value *big.Int, data []byte, gas uint64, gasPrice *big.Int) error {
+	toAcct, transactionType := accountTxnType(to, data)
+	return isTransactionAllowed(from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType, new(big.Int).Sub(number, big.NewInt(1)))
+}
+
+func accountTxnType(to *common.Address, data []byte) (common.Address, TransactionType) {
 	transactionType := ValueTransferTxn
 
 	if to == nil {
@@ -123,5 +137,5 @@ func CheckAccountPermission(from common.Address, to *common.Address, value *big.
 		toAcct = *to
 	}
 
-	return IsTransactionAllowed(from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType)
+	return toAcct, transactionType
 }
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// PermissionPlugin delegates account and node permission decisions to an
+// external system. It is implemented by the permission plugin gateway.
+type PermissionPlugin interface {
+	IsTransactionAllowed(ctx context.Context, from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType) (bool, error)
+	IsNodeAllowedForTransaction(ctx context.Context, hexNodeId string, from common.Address) (bool, error)
+	IsNodePermissioned(ctx context.Context, url string, direction string) (bool, error)
+}
+
+// PluginMode defines how the decision of the permission plugin is combined
+// with the built-in permission checks
+type PluginMode string
+
+const (
+	// both built-in checks and the plugin should allow
+	PluginModeAll PluginMode = "all"
+	// either built-in checks or the plugin should allow
+	PluginModeAny PluginMode = "any"
+	// decision is made by the plugin only
+	PluginModeOnly PluginMode = "plugin"
+)
+
+// upper bound on a single call to the permission plugin. calls are made
+// from transaction submission and peer connection handling, which should
+// not hang on an unresponsive plugin
+var pluginCallTimeout = 5 * time.Second
+
+var ErrPluginDenied = errors.New("permission denied by the permission plugin")
+
+var permissionPlugin PermissionPlugin
+var permissionPluginMode = PluginModeAll
+
+// sets the permission plugin and the mode in which its decision is
+// combined with the built-in checks. passing nil removes the plugin
+func SetPermissionPlugin(p PermissionPlugin, mode PluginMode) error {
+	switch mode {
+	case PluginModeAll, PluginModeAny, PluginModeOnly:
+	default:
+		return fmt.Errorf("invalid permission plugin mode %q", mode)
+	}
+	permissionPlugin = p
+	permissionPluginMode = mode
+	return nil
+}
+
+// combines the built-in decision with the plugin decision as per the
+// plugin mode. the plugin is not invoked if its decision cannot change the
+// outcome. any error from the plugin, including a timeout, is treated as a
+// deny
+func combineWithPlugin(builtIn bool, pluginFunc func(ctx context.Context, p PermissionPlugin) (bool, error)) bool {
+	p := permissionPlugin
+	if p == nil {
+		return builtIn
+	}
+	switch permissionPluginMode {
+	case PluginModeAll:
+		if !builtIn {
+			return false
+		}
+	case PluginModeAny:
+		if builtIn {
+			return true
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pluginCallTimeout)
+	defer cancel()
+	allowed, err := pluginFunc(ctx, p)
+	if err != nil {
+		log.Error("permission plugin failed, denying", "err", err)
+		return false
+	}
+	return allowed
+}
+
+// checks the node connection permission with the plugin, combining it
+// with the built-in decision
+func CheckNodeWithPlugin(allowed bool, url string, direction string) bool {
+	return combineWithPlugin(allowed, func(ctx context.Context, p PermissionPlugin) (bool, error) {
+		return p.IsNodePermissioned(ctx, url, direction)
+	})
+}
@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+type stubPermissionPlugin struct {
+	allowed bool
+	err     error
+	calls   int
+}
+
+func (s *stubPermissionPlugin) IsTransactionAllowed(_ context.Context, _ common.Address, _ common.Address, _ *big.Int, _ *big.Int, _ *big.Int, _ []byte, _ TransactionType) (bool, error) {
+	s.calls++
+	return s.allowed, s.err
+}
+
+func (s *stubPermissionPlugin) IsNodeAllowedForTransaction(_ context.Context, _ string, _ common.Address) (bool, error) {
+	s.calls++
+	return s.allowed, s.err
+}
+
+func (s *stubPermissionPlugin) IsNodePermissioned(_ context.Context, _ string, _ string) (bool, error) {
+	s.calls++
+	return s.allowed, s.err
+}
+
+// resets the package-global plugin state once the test completes
+func cleanupPermissionPlugin(t *testing.T) {
+	t.Cleanup(func() {
+		permissionPlugin = nil
+		permissionPluginMode = PluginModeAll
+	})
+}
+
+func TestSetPermissionPlugin_whenInvalidMode(t *testing.T) {
+	assert := testifyassert.New(t)
+	cleanupPermissionPlugin(t)
+
+	err := SetPermissionPlugin(&stubPermissionPlugin{}, PluginMode("arbitrary"))
+
+	assert.Error(err)
+	assert.Nil(permissionPlugin)
+}
+
+func TestCheckNodeWithPlugin(t *testing.T) {
+	assert := testifyassert.New(t)
+	cleanupPermissionPlugin(t)
+
+	tests := []struct {
+		name    string
+		mode    PluginMode
+		builtIn bool
+		plugin  *stubPermissionPlugin
+		want    bool
+		calls   int
+	}{
+		{"all, both allow", PluginModeAll, true, &stubPermissionPlugin{allowed: true}, true, 1},
+		{"all, plugin denies", PluginModeAll, true, &stubPermissionPlugin{allowed: false}, false, 1},
+		{"all, built-in denies", PluginModeAll, false, &stubPermissionPlugin{allowed: true}, false, 0},
+		{"any, built-in allows", PluginModeAny, true, &stubPermissionPlugin{allowed: false}, true, 0},
+		{"any, plugin allows", PluginModeAny, false, &stubPermissionPlugin{allowed: true}, true, 1},
+		{"plugin, overrides built-in deny", PluginModeOnly, false, &stubPermissionPlugin{allowed: true}, true, 1},
+		{"plugin, overrides built-in allow", PluginModeOnly, true, &stubPermissionPlugin{allowed: false}, false, 1},
+		{"plugin error denies", PluginModeOnly, true, &stubPermissionPlugin{allowed: true, err: errors.New("arbitrary error")}, false, 1},
+	}
+	for _, tt := range tests {
+		assert.NoError(SetPermissionPlugin(tt.plugin, tt.mode))
+		assert.Equal(tt.want, CheckNodeWithPlugin(tt.builtIn, NODE1, "INCOMING"), tt.name)
+		assert.Equal(tt.calls, tt.plugin.calls, tt.name)
+	}
+}
+
+func TestIsTransactionAllowed_withPlugin(t *testing.T) {
+	assert := testifyassert.New(t)
+	cleanupPermissionPlugin(t)
+
+	// permissions are not enabled so the built-in checks allow the transaction
+	reached := qip714BlockReached
+	t.Cleanup(func() { qip714BlockReached = reached })
+	qip714BlockReached = false
+	p := &stubPermissionPlugin{allowed: false}
+	assert.NoError(SetPermissionPlugin(p, PluginModeAll))
+
+	err := IsTransactionAllowed(Acct1, Acct2, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, ValueTransferTxn)
+	assert.Equal(ErrPluginDenied, err)
+
+	p.allowed = true
+	err = IsTransactionAllowed(Acct1, Acct2, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, ValueTransferTxn)
+	assert.NoError(err)
+}
+
+func TestCheckAccountPermission_pluginOnSubmissionOnly(t *testing.T) {
+	assert := testifyassert.New(t)
+	cleanupPermissionPlugin(t)
+
+	reached := qip714BlockReached
+	t.Cleanup(func() { qip714BlockReached = reached })
+	qip714BlockReached = false
+	p := &stubPermissionPlugin{allowed: false}
+	assert.NoError(SetPermissionPlugin(p, PluginModeOnly))
+
+	assert.Equal(ErrPluginDenied, CheckAccountPermission(Acct1, &Acct2, big.NewInt(0), nil, 0, big.NewInt(0)))
+	assert.Equal(1, p.calls)
+
+	// the plugin decision is local to the node, so it must not affect
+	// block processing
+	assert.NoError(CheckAccountPermissionForBlock(big.NewInt(1), Acct1, &Acct2, big.NewInt(0), nil, 0, big.NewInt(0)))
+	assert.Equal(1, p.calls)
+}
+
+type blockingPermissionPlugin struct {
+	stubPermissionPlugin
+}
+
+func (b *blockingPermissionPlugin) IsNodePermissioned(ctx context.Context, _ string, _ string) (bool, error) {
+	<-ctx.Done()
+	return true, ctx.Err()
+}
+
+func TestCheckNodeWithPlugin_whenPluginHangs(t *testing.T) {
+	assert := testifyassert.New(t)
+	cleanupPermissionPlugin(t)
+
+	timeout := pluginCallTimeout
+	t.Cleanup(func() { pluginCallTimeout = timeout })
+	pluginCallTimeout = 10 * time.Millisecond
+	assert.NoError(SetPermissionPlugin(&blockingPermissionPlugin{}, PluginModeOnly))
+
+	// the call is bounded by pluginCallTimeout and the timeout denies
+	assert.False(CheckNodeWithPlugin(true, NODE1, "INCOMING"))
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
@@ -82,6 +83,10 @@ var (
 	ErrNotMasterOrg         = errors.New("Org is not a master org")
 	ErrHostNameNotSupported = errors.New("Hostname not supported in the network")
 	ErrNoPermissionForTxn   = errors.New("account does not have permission for the transaction")
+	ErrExpiryNotSupported   = errors.New("Expiry is supported in permissions v2 model only")
+	ErrInvalidExpiry        = errors.New("Expiry block or time has already passed")
+	ErrVotingNotSupported   = errors.New("Voting thresholds and pending operation listing are supported in permissions v2 model only")
+	ErrInvalidThreshold     = errors.New("Invalid operation type or threshold")
 )
 
 // backend struct for interfaces
@@ -89,14 +94,15 @@ type InterfaceBackend struct {
 	node    *node.Node
 	isRaft  bool
 	dataDir string
+	events  *PermissionEventFeed
 }
 
 func (i *InterfaceBackend) SetIsRaft(isRaft bool) {
 	i.isRaft = isRaft
 }
 
-func NewInterfaceBackend(node *node.Node, isRaft bool, dataDir string) *InterfaceBackend {
-	return &InterfaceBackend{node: node, isRaft: isRaft, dataDir: dataDir}
+func NewInterfaceBackend(node *node.Node, isRaft bool, dataDir string, events *PermissionEventFeed) *InterfaceBackend {
+	return &InterfaceBackend{node: node, isRaft: isRaft, dataDir: dataDir, events: events}
 }
 
 func (i InterfaceBackend) Node() *node.Node {
@@ -111,6 +117,13 @@ func (i InterfaceBackend) DataDir() string {
 	return i.dataDir
 }
 
+// sends the permission event to the subscribers of the permission service
+func (i InterfaceBackend) PostPermissionEvent(evt PermissionEvent, raw types.Log) {
+	if i.events != nil {
+		i.events.Post(evt, raw)
+	}
+}
+
 // to signal all watches when service is stopped
 type StopEvent struct {
 }
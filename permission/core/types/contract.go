@@ -23,6 +23,8 @@ type TxArgs struct {
 	AcctId     common.Address
 	AccessType uint8
 	Action     uint8
+	Expiry     core.AccessExpiry
+	Threshold  uint8
 	Txa        ethapi.SendTxArgs
 }
 
@@ -69,13 +71,15 @@ type AccountService interface {
 // Control services
 type ControlService interface {
 	ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint16) (bool, error)
-	TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType core.TransactionType) error
+	// checks the transaction against the permissions as of the given block,
+	// nil for the latest block
+	TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType core.TransactionType, _block *big.Int) error
 }
 
 // Audit services
 type AuditService interface {
 	ValidatePendingOp(authOrg, orgId, url string, account common.Address, pendingOp int64) bool
-	CheckPendingOp(_orgId string) bool
+	CheckPendingOp(authOrg, orgId, url string, account common.Address, pendingOp int64) bool
 }
 
 type InitService interface {
@@ -119,6 +123,55 @@ type InitService interface {
 	GetNodeDetails(enodeId string) (string, string, *big.Int, error)
 }
 
+// ExpiryService is implemented by the permission models which support
+// time bounded account roles and org suspensions
+type ExpiryService interface {
+	GetAccountExpiry(_account common.Address) (core.AccessExpiry, error)
+	GetSuspensionExpiry(_orgId string) (core.AccessExpiry, error)
+}
+
+// types of the operations subject to voting by the network admin accounts.
+// the values match the OP_* constants of the v2 VoterManager contract
+const (
+	PendingOpNone                int64 = iota
+	PendingOpAddOrg                    // new org add activity
+	PendingOpSuspendOrg                // org suspension activity
+	PendingOpRevokeOrgSuspension       // revoke of org suspension
+	PendingOpAssignAdminRole           // assigning admin role for a new account
+	PendingOpNodeRecovery              // blacklisted node recovery
+	PendingOpAccountRecovery           // blacklisted account recovery
+	PendingOpVoteThreshold             // change of the approval threshold of an op type, v2 only
+)
+
+// PendingOp holds the details of an operation pending approval along with
+// the votes received so far and the votes required for approval. For a
+// threshold change TargetOpType and Threshold carry the proposed values
+type PendingOp struct {
+	OrgId         string         `json:"orgId"`
+	EnodeId       string         `json:"enodeId"`
+	Account       common.Address `json:"account"`
+	OpType        int64          `json:"opType"`
+	VoteCount     int64          `json:"voteCount"`
+	RequiredVotes int64          `json:"requiredVotes"`
+	TargetOpType  int64          `json:"targetOpType,omitempty"`
+	Threshold     uint8          `json:"threshold,omitempty"`
+}
+
+// VotingService is implemented by the audit service of the permission
+// models which support concurrent pending operations and approval
+// thresholds per operation type
+type VotingService interface {
+	GetVoteThreshold(_opType uint8) (uint8, error)
+	GetPendingOps(_authOrg string) ([]PendingOp, error)
+}
+
+// VoteThresholdService is implemented by the org service of the permission
+// models which support approval thresholds per operation type
+type VoteThresholdService interface {
+	SetVoteThreshold(_args TxArgs) (*types.Transaction, error)
+	ApproveVoteThreshold(_args TxArgs) (*types.Transaction, error)
+}
+
 func BindContract(contractInstance interface{}, bindFunc func() (interface{}, error)) error {
 	element := reflect.ValueOf(contractInstance).Elem()
 	instance, err := bindFunc()
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// number of permission events which can be queued for a subscriber before
+// it is considered too slow and unsubscribed
+const permissionEventQueueSize = 256
+
+// PermissionEventType identifies the change to the permission model which
+// is carried by a PermissionEvent
+type PermissionEventType string
+
+const (
+	OrgProposed           PermissionEventType = "OrgProposed"
+	OrgApproved           PermissionEventType = "OrgApproved"
+	OrgSuspended          PermissionEventType = "OrgSuspended"
+	OrgSuspensionRevoked  PermissionEventType = "OrgSuspensionRevoked"
+	NodeProposed          PermissionEventType = "NodeProposed"
+	NodeAdded             PermissionEventType = "NodeAdded"
+	NodeDeactivated       PermissionEventType = "NodeDeactivated"
+	NodeActivated         PermissionEventType = "NodeActivated"
+	NodeBlacklisted       PermissionEventType = "NodeBlacklisted"
+	NodeRecoveryInitiated PermissionEventType = "NodeRecoveryInitiated"
+	NodeRecoveryCompleted PermissionEventType = "NodeRecoveryCompleted"
+	AccountRoleChanged    PermissionEventType = "AccountRoleChanged"
+	AccountAccessRevoked  PermissionEventType = "AccountAccessRevoked"
+	AccountStatusChanged  PermissionEventType = "AccountStatusChanged"
+	RoleCreated           PermissionEventType = "RoleCreated"
+	RoleRemoved           PermissionEventType = "RoleRemoved"
+
+	// EventsDropped is sent to a quorumPermission subscriber which fell
+	// behind. Events before it were lost, the caches should be re-read.
+	EventsDropped PermissionEventType = "EventsDropped"
+)
+
+// PermissionEvent is emitted whenever a permission contract event has been
+// applied to the org, node, role or account cache. Only the fields relevant
+// to the event type are populated.
+type PermissionEvent struct {
+	Type        PermissionEventType `json:"type"`
+	OrgId       string              `json:"orgId,omitempty"`
+	Url         string              `json:"url,omitempty"`
+	RoleId      string              `json:"roleId,omitempty"`
+	AcctId      *common.Address     `json:"acctId,omitempty"`
+	Status      uint8               `json:"status"`
+	BlockNumber uint64              `json:"blockNumber"`
+	TxHash      common.Hash         `json:"txHash"`
+}
+
+// ErrPermissionEventsDropped is returned on the Err channel of a permission
+// event subscription which fell too far behind. Events were lost and the
+// subscriber has to resynchronise with the permission caches.
+var ErrPermissionEventsDropped = errors.New("permission events dropped, subscriber too slow")
+
+// PermissionEventFeed broadcasts permission model changes to subscribers.
+// Every subscriber has its own bounded queue which is drained by a separate
+// goroutine, so the permission contract watch loops are never blocked by a
+// slow subscriber. A subscriber whose queue overflows is unsubscribed with
+// ErrPermissionEventsDropped rather than silently missing events.
+type PermissionEventFeed struct {
+	mu   sync.Mutex
+	subs map[chan PermissionEvent]chan struct{} // queue -> closed on overflow
+	quit chan struct{}
+	once sync.Once
+}
+
+func NewPermissionEventFeed() *PermissionEventFeed {
+	return &PermissionEventFeed{
+		subs: make(map[chan PermissionEvent]chan struct{}),
+		quit: make(chan struct{}),
+	}
+}
+
+// function to subscribe to the permission model change events
+func (f *PermissionEventFeed) Subscribe(ch chan<- PermissionEvent) event.Subscription {
+	queue := make(chan PermissionEvent, permissionEventQueueSize)
+	overflow := make(chan struct{})
+
+	f.mu.Lock()
+	f.subs[queue] = overflow
+	f.mu.Unlock()
+
+	return event.NewSubscription(func(unsub <-chan struct{}) error {
+		defer f.unsubscribe(queue)
+		for {
+			select {
+			case evt := <-queue:
+				select {
+				case ch <- evt:
+				case <-overflow:
+					return ErrPermissionEventsDropped
+				case <-unsub:
+					return nil
+				case <-f.quit:
+					return nil
+				}
+			case <-overflow:
+				return ErrPermissionEventsDropped
+			case <-unsub:
+				return nil
+			case <-f.quit:
+				return nil
+			}
+		}
+	})
+}
+
+func (f *PermissionEventFeed) unsubscribe(queue chan PermissionEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.subs, queue)
+}
+
+// queues the permission event for all subscribers, stamping it with the
+// block number and transaction hash of the contract log it originates from.
+// Subscribers whose queue is full are dropped with ErrPermissionEventsDropped.
+func (f *PermissionEventFeed) Post(evt PermissionEvent, raw types.Log) {
+	evt.BlockNumber = raw.BlockNumber
+	evt.TxHash = raw.TxHash
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for queue, overflow := range f.subs {
+		select {
+		case queue <- evt:
+		default:
+			log.Warn("permission event subscriber too slow, dropping subscription", "type", evt.Type, "block", evt.BlockNumber)
+			close(overflow)
+			delete(f.subs, queue)
+		}
+	}
+}
+
+// stops delivering events to subscribers
+func (f *PermissionEventFeed) Stop() {
+	f.once.Do(func() { close(f.quit) })
+}
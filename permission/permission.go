@@ -8,6 +8,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
@@ -38,6 +39,7 @@ func (p *PermissionCtrl) AfterStart() error {
 
 	// set the function point for transaction allowed check
 	pcore.PermissionTransactionAllowedFunc = p.IsTransactionAllowed
+	pcore.CurrentBlockFunc = p.currentBlock
 	setPermissionService(p)
 
 	// set the default access to ReadOnly
@@ -48,6 +50,7 @@ func (p *PermissionCtrl) AfterStart() error {
 		p.backend.ManageNodePermissions,    // monitor org  level Node management events
 		p.backend.ManageRolePermissions,    // monitor org level role management events
 		p.backend.ManageAccountPermissions, // monitor org level account management events
+		p.monitorCacheConsistency,          // periodically check caches against the contract state
 	} {
 		if err := f(); err != nil {
 			return err
@@ -225,6 +228,11 @@ func (p *PermissionCtrl) populateAccountsFromContract() error {
 		for k := uint64(0); k < iOrgNum; k++ {
 			if addr, org, role, status, orgAdmin, err := p.contract.GetAccountDetailsFromIndex(big.NewInt(int64(k))); err == nil {
 				pcore.AcctInfoMap.UpsertAccount(org, role, addr, orgAdmin, pcore.AcctStatus(int(status.Int64())))
+				if es, ok := p.contract.(ptype.ExpiryService); ok {
+					if expiry, err := es.GetAccountExpiry(addr); err == nil {
+						pcore.AcctInfoMap.SetAccountExpiry(addr, expiry)
+					}
+				}
 			}
 		}
 	} else {
@@ -272,6 +280,15 @@ func (p *PermissionCtrl) populateOrgsFromContract() error {
 		for k := uint64(0); k < iOrgNum; k++ {
 			if orgId, porgId, ultParent, level, status, err := p.contract.GetOrgInfo(big.NewInt(int64(k))); err == nil {
 				pcore.OrgInfoMap.UpsertOrg(orgId, porgId, ultParent, level, pcore.OrgStatus(int(status.Int64())))
+				if es, ok := p.contract.(ptype.ExpiryService); ok && pcore.OrgStatus(status.Int64()) == pcore.OrgSuspended {
+					fullOrgId := orgId
+					if porgId != "" {
+						fullOrgId = porgId + "." + orgId
+					}
+					if expiry, err := es.GetSuspensionExpiry(fullOrgId); err == nil {
+						pcore.OrgInfoMap.SetSuspensionExpiry(fullOrgId, expiry)
+					}
+				}
 			}
 		}
 	} else {
@@ -319,6 +336,12 @@ func (p *PermissionCtrl) updateNetworkStatus() error {
 	return nil
 }
 
+// returns the number and timestamp of the current block
+func (p *PermissionCtrl) currentBlock() (uint64, uint64) {
+	b := p.eth.BlockChain().CurrentBlock()
+	return b.NumberU64(), b.Time()
+}
+
 // getter to get an account record from the contract
 func (p *PermissionCtrl) populateAccountToCache(acctId common.Address) (*pcore.AccountInfo, error) {
 	account, orgId, roleId, status, isAdmin, err := p.contract.GetAccountDetails(acctId)
@@ -329,7 +352,20 @@ func (p *PermissionCtrl) populateAccountToCache(acctId common.Address) (*pcore.A
 	if status.Int64() == 0 {
 		return nil, ptype.ErrAccountNotThere
 	}
-	return &pcore.AccountInfo{AcctId: account, OrgId: orgId, RoleId: roleId, Status: pcore.AcctStatus(status.Int64()), IsOrgAdmin: isAdmin}, nil
+	acct := &pcore.AccountInfo{AcctId: account, OrgId: orgId, RoleId: roleId, Status: pcore.AcctStatus(status.Int64()), IsOrgAdmin: isAdmin}
+	if es, ok := p.contract.(ptype.ExpiryService); ok {
+		if acct.Expiry, err = es.GetAccountExpiry(account); err != nil && !isMissingMethod(err) {
+			return nil, err
+		}
+	}
+	return acct, nil
+}
+
+// reports if a contract call failed because the deployed contract does not
+// have the method. contracts deployed before the method was added revert
+// without a reason
+func isMissingMethod(err error) bool {
+	return err != nil && err.Error() == vm.ErrExecutionReverted.Error()
 }
 
 // getter to get a org record from the contract
@@ -342,6 +378,11 @@ func (p *PermissionCtrl) populateOrgToCache(orgId string) (*pcore.OrgInfo, error
 		return nil, ptype.ErrOrgDoesNotExists
 	}
 	orgInfo := pcore.OrgInfo{OrgId: org, ParentOrgId: parentOrgId, UltimateParent: ultimateParentId, Status: pcore.OrgStatus(orgStatus.Int64()), Level: orgLevel}
+	if es, ok := p.contract.(ptype.ExpiryService); ok && orgInfo.Status == pcore.OrgSuspended {
+		if orgInfo.SuspensionExpiry, err = es.GetSuspensionExpiry(orgId); err != nil && !isMissingMethod(err) {
+			return nil, err
+		}
+	}
 	// now need to build the list of sub orgs for this org
 	subOrgIndexes, err := p.contract.GetSubOrgIndexes(orgId)
 	if err != nil {
@@ -1,6 +1,7 @@
 package permission
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -20,6 +22,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
@@ -33,6 +37,7 @@ import (
 	v1bind "github.com/ethereum/go-ethereum/permission/v1/bind"
 	v2 "github.com/ethereum/go-ethereum/permission/v2"
 	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -306,6 +311,71 @@ func typicalQuorumControlsAPI(t *testing.T) *QuorumControlsAPI {
 	return NewQuorumControlsAPI(pc)
 }
 
+func TestPermissionCtrl_CheckCacheConsistency(t *testing.T) {
+	for _, v2Model := range []bool{false, true} {
+		t.Run(fmt.Sprintf("v2=%v", v2Model), func(t *testing.T) {
+			// the permission model is global, later tests expect the
+			// model of the test run
+			defer func(m pcore.PermissionModelType) { pcore.PermissionModel = m }(pcore.PermissionModel)
+			testObject := typicalPermissionCtrl(t, v2Model)
+			assert.NoError(t, testObject.AfterStart())
+			assert.NoError(t, testObject.populateInitPermissions(orgCacheSize, roleCacheSize, nodeCacheSize, accountCacheSize))
+
+			result, err := NewPermissionAdminAPI(testObject).CheckPermissionCache()
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(result.Drift))
+			assert.Equal(t, 3, result.Checked)
+
+			// simulate missed events
+			pcore.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, guardianAddress, true, pcore.AcctSuspended)
+			pcore.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, true, true, pcore.ReadOnly, true)
+			unknown := getArbitraryAccount()
+			pcore.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, unknown, false, pcore.AcctActive)
+
+			result, err = testObject.checkCacheConsistency()
+			assert.NoError(t, err)
+			assert.Equal(t, 3, len(result.Drift))
+
+			acct, err := pcore.AcctInfoMap.GetAccount(guardianAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, pcore.AcctActive, acct.Status)
+			role, err := pcore.RoleInfoMap.GetRole(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole)
+			assert.NoError(t, err)
+			assert.Equal(t, pcore.FullAccess, role.Access)
+			acct, _ = pcore.AcctInfoMap.GetAccount(unknown)
+			assert.Nil(t, acct)
+
+			// caches are consistent after repopulation
+			result, err = testObject.checkCacheConsistency()
+			assert.NoError(t, err)
+			assert.Equal(t, 0, len(result.Drift))
+
+			if v2Model {
+				// contracts deployed before expiry was added
+				testObject.contract = &noExpiryContract{testObject.contract}
+				result, err = testObject.checkCacheConsistency()
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(result.Drift))
+				assert.Equal(t, 3, result.Checked)
+			}
+		})
+	}
+}
+
+// noExpiryContract fails the expiry calls the way contracts without the
+// methods do
+type noExpiryContract struct {
+	ptype.InitService
+}
+
+func (c *noExpiryContract) GetAccountExpiry(common.Address) (pcore.AccessExpiry, error) {
+	return pcore.AccessExpiry{}, vm.ErrExecutionReverted
+}
+
+func (c *noExpiryContract) GetSuspensionExpiry(string) (pcore.AccessExpiry, error) {
+	return pcore.AccessExpiry{}, vm.ErrExecutionReverted
+}
+
 func TestQuorumControlsAPI_ListAPIs(t *testing.T) {
 	testObject := typicalQuorumControlsAPI(t)
 
@@ -323,6 +393,9 @@ func TestQuorumControlsAPI_ListAPIs(t *testing.T) {
 	assert.True(t, len(testObject.AcctList()) > 0, "expected non zero account list")
 	// test OrgList
 	assert.True(t, len(testObject.OrgList()) > 0, "expected non zero org list")
+	// pending ops listing is not supported in v1 model
+	_, err = testObject.PendingOps()
+	assert.Equal(t, err, ptype.ErrVotingNotSupported)
 	// test RoleList
 	assert.True(t, len(testObject.RoleList()) > 0, "expected non zero org list")
 }
@@ -355,10 +428,10 @@ func TestQuorumControlsAPI_OrgAPIs(t *testing.T) {
 	assert.NoError(t, err)
 
 	pcore.OrgInfoMap.UpsertOrg(arbitraryOrgToAdd, "", arbitraryOrgToAdd, big.NewInt(1), pcore.OrgApproved)
-	_, err = testObject.UpdateOrgStatus(arbitraryOrgToAdd, uint8(SuspendOrg), invalidTxa)
+	_, err = testObject.UpdateOrgStatus(arbitraryOrgToAdd, uint8(SuspendOrg), invalidTxa, nil)
 	assert.Equal(t, err, errors.New("Invalid account id"))
 
-	_, err = testObject.UpdateOrgStatus(arbitraryOrgToAdd, uint8(SuspendOrg), txa)
+	_, err = testObject.UpdateOrgStatus(arbitraryOrgToAdd, uint8(SuspendOrg), txa, nil)
 	assert.NoError(t, err)
 
 	pcore.OrgInfoMap.UpsertOrg(arbitraryOrgToAdd, "", arbitraryOrgToAdd, big.NewInt(1), pcore.OrgSuspended)
@@ -505,7 +578,7 @@ func TestQuorumControlsAPI_TransactionAllowed(t *testing.T) {
 			pcore.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, roleId, false, false, pcore.AccessType(uint8(i)), true)
 
 			if i == 0 {
-				_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, roleId, txa)
+				_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, roleId, txa, nil)
 				assert.NoError(t, err)
 			} else {
 				_, err = testObject.ChangeAccountRole(acct, arbitraryNetworkAdminOrg, roleId, txa)
@@ -568,10 +641,10 @@ func TestQuorumControlsAPI_RoleAndAccountsAPIs(t *testing.T) {
 	pcore.SetNetworkBootUpCompleted()
 	pcore.SetQIP714BlockReached()
 
-	_, err := testObject.AssignAdminRole(arbitraryNetworkAdminOrg, acct, arbitraryNetworkAdminRole, invalidTxa)
+	_, err := testObject.AssignAdminRole(arbitraryNetworkAdminOrg, acct, arbitraryNetworkAdminRole, invalidTxa, nil)
 	assert.Equal(t, err, errors.New("Invalid account id"))
 
-	_, _ = testObject.AssignAdminRole(arbitraryNetworkAdminOrg, acct, arbitraryNetworkAdminRole, txa)
+	_, _ = testObject.AssignAdminRole(arbitraryNetworkAdminOrg, acct, arbitraryNetworkAdminRole, txa, nil)
 	pcore.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitraryNetworkAdminRole, acct, true, pcore.AcctPendingApproval)
 
 	_, err = testObject.ApproveAdminRole(arbitraryNetworkAdminOrg, acct, invalidTxa)
@@ -594,10 +667,16 @@ func TestQuorumControlsAPI_RoleAndAccountsAPIs(t *testing.T) {
 	pcore.RoleInfoMap.UpsertRole(arbitraryNetworkAdminOrg, arbitrartNewRole1, false, false, pcore.FullAccess, true)
 
 	acct = getArbitraryAccount()
-	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, arbitrartNewRole1, invalidTxa)
+	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, arbitrartNewRole1, invalidTxa, nil)
 	assert.Equal(t, err, errors.New("Invalid account id"))
 
-	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, arbitrartNewRole1, txa)
+	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, arbitrartNewRole1, txa, &pcore.AccessExpiry{Block: 100})
+	assert.Equal(t, err, ptype.ErrExpiryNotSupported)
+
+	_, err = testObject.SetVoteThreshold(1, 60, txa)
+	assert.Equal(t, err, ptype.ErrVotingNotSupported)
+
+	_, err = testObject.AddAccountToOrg(acct, arbitraryNetworkAdminOrg, arbitrartNewRole1, txa, nil)
 	assert.NoError(t, err)
 	pcore.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitrartNewRole1, acct, true, pcore.AcctActive)
 
@@ -673,7 +752,7 @@ func TestQuorumControlsAPI_RoleAndAccountsAPIs(t *testing.T) {
 	AccountArray[3] = common.StringToAddress("ae9bc6cd5145e67fbd1887a5145271fd182f0ee7")
 
 	for i := 0; i < accountCacheSize; i++ {
-		_, err = testObject.AddAccountToOrg(AccountArray[i], arbitraryNetworkAdminOrg, arbitrartNewRole1, txa)
+		_, err = testObject.AddAccountToOrg(AccountArray[i], arbitraryNetworkAdminOrg, arbitrartNewRole1, txa, nil)
 		assert.NoError(t, err)
 		pcore.AcctInfoMap.UpsertAccount(arbitraryNetworkAdminOrg, arbitrartNewRole1, AccountArray[i], false, pcore.AcctActive)
 	}
@@ -999,3 +1078,72 @@ func TestIsTransactionAllowed_V1(t *testing.T) {
 		})
 	}
 }
+
+func TestQuorumControlsAPI_PermissionEvents(t *testing.T) {
+	server := rpc.NewServer()
+	defer server.Stop()
+	// a zero status is meaningful and must not be dropped from the payload
+	blob, err := json.Marshal(ptype.PermissionEvent{Type: ptype.OrgProposed})
+	assert.NoError(t, err)
+	assert.Contains(t, string(blob), `"status":0`)
+
+	events := ptype.NewPermissionEventFeed()
+	defer events.Stop()
+	if err := server.RegisterName("quorumPermission", NewQuorumControlsAPI(&PermissionCtrl{events: events})); err != nil {
+		t.Fatal(err)
+	}
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	ch := make(chan ptype.PermissionEvent, 1)
+	sub, err := client.Subscribe(context.Background(), "quorumPermission", ch, "permissionEvents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	acct := getArbitraryAccount()
+	raw := types.Log{BlockNumber: 10, TxHash: common.HexToHash("0x1234")}
+	// the subscription goroutine registers with the feed asynchronously,
+	// so keep posting until the first event is delivered
+	deadline := time.After(5 * time.Second)
+	for {
+		events.Post(ptype.PermissionEvent{Type: ptype.AccountRoleChanged, OrgId: arbitraryOrgToAdd, RoleId: arbitrartNewRole1, AcctId: &acct, Status: uint8(pcore.AcctActive)}, raw)
+		select {
+		case evt := <-ch:
+			assert.Equal(t, ptype.AccountRoleChanged, evt.Type)
+			assert.Equal(t, arbitraryOrgToAdd, evt.OrgId)
+			assert.Equal(t, arbitrartNewRole1, evt.RoleId)
+			assert.Equal(t, acct, *evt.AcctId)
+			assert.Equal(t, uint8(pcore.AcctActive), evt.Status)
+			assert.Equal(t, uint64(10), evt.BlockNumber)
+			assert.Equal(t, raw.TxHash, evt.TxHash)
+			return
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-deadline:
+			t.Fatal("timed out waiting for permission event")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestPermissionEventFeed_SlowSubscriber(t *testing.T) {
+	events := ptype.NewPermissionEventFeed()
+	defer events.Stop()
+
+	// nobody reads from ch, so the subscription queue overflows
+	ch := make(chan ptype.PermissionEvent)
+	sub := events.Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	for i := 0; i < 1024; i++ {
+		events.Post(ptype.PermissionEvent{Type: ptype.RoleCreated}, types.Log{BlockNumber: uint64(i)})
+	}
+	select {
+	case err := <-sub.Err():
+		assert.Equal(t, ptype.ErrPermissionEventsDropped, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow subscriber was not dropped")
+	}
+}
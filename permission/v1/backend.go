@@ -44,13 +44,16 @@ func (b *Backend) ManageAccountPermissions() error {
 			select {
 			case evtAccessModified := <-chAccessModified:
 				core.AcctInfoMap.UpsertAccount(evtAccessModified.OrgId, evtAccessModified.RoleId, evtAccessModified.Account, evtAccessModified.OrgAdmin, core.AcctStatus(int(evtAccessModified.Status.Uint64())))
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountRoleChanged, OrgId: evtAccessModified.OrgId, RoleId: evtAccessModified.RoleId, AcctId: &evtAccessModified.Account, Status: uint8(evtAccessModified.Status.Uint64())}, evtAccessModified.Raw)
 
 			case evtAccessRevoked := <-chAccessRevoked:
 				core.AcctInfoMap.UpsertAccount(evtAccessRevoked.OrgId, evtAccessRevoked.RoleId, evtAccessRevoked.Account, evtAccessRevoked.OrgAdmin, core.AcctActive)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountAccessRevoked, OrgId: evtAccessRevoked.OrgId, RoleId: evtAccessRevoked.RoleId, AcctId: &evtAccessRevoked.Account, Status: uint8(core.AcctActive)}, evtAccessRevoked.Raw)
 
 			case evtStatusChanged := <-chStatusChanged:
 				if ac, err := core.AcctInfoMap.GetAccount(evtStatusChanged.Account); ac != nil {
 					core.AcctInfoMap.UpsertAccount(evtStatusChanged.OrgId, ac.RoleId, evtStatusChanged.Account, ac.IsOrgAdmin, core.AcctStatus(int(evtStatusChanged.Status.Uint64())))
+					b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountStatusChanged, OrgId: evtStatusChanged.OrgId, RoleId: ac.RoleId, AcctId: &evtStatusChanged.Account, Status: uint8(evtStatusChanged.Status.Uint64())}, evtStatusChanged.Raw)
 				} else {
 					log.Info("error fetching account information", "err", err)
 				}
@@ -87,10 +90,12 @@ func (b *Backend) ManageRolePermissions() error {
 			select {
 			case evtRoleCreated := <-chRoleCreated:
 				core.RoleInfoMap.UpsertRole(evtRoleCreated.OrgId, evtRoleCreated.RoleId, evtRoleCreated.IsVoter, evtRoleCreated.IsAdmin, core.AccessType(int(evtRoleCreated.BaseAccess.Uint64())), true)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.RoleCreated, OrgId: evtRoleCreated.OrgId, RoleId: evtRoleCreated.RoleId}, evtRoleCreated.Raw)
 
 			case evtRoleRevoked := <-chRoleRevoked:
 				if r, _ := core.RoleInfoMap.GetRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId); r != nil {
 					core.RoleInfoMap.UpsertRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId, r.IsVoter, r.IsAdmin, r.Access, false)
+					b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.RoleRemoved, OrgId: evtRoleRevoked.OrgId, RoleId: evtRoleRevoked.RoleId}, evtRoleRevoked.Raw)
 				} else {
 					log.Error("Revoke role - cache is missing role", "org", evtRoleRevoked.OrgId, "role", evtRoleRevoked.RoleId)
 				}
@@ -137,15 +142,19 @@ func (b *Backend) ManageOrgPermissions() error {
 			select {
 			case evtPendingApproval := <-chPendingApproval:
 				core.OrgInfoMap.UpsertOrg(evtPendingApproval.OrgId, evtPendingApproval.PorgId, evtPendingApproval.UltParent, evtPendingApproval.Level, core.OrgStatus(evtPendingApproval.Status.Uint64()))
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgProposed, OrgId: evtPendingApproval.OrgId, Status: uint8(evtPendingApproval.Status.Uint64())}, evtPendingApproval.Raw)
 
 			case evtOrgApproved := <-chOrgApproved:
 				core.OrgInfoMap.UpsertOrg(evtOrgApproved.OrgId, evtOrgApproved.PorgId, evtOrgApproved.UltParent, evtOrgApproved.Level, core.OrgApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgApproved, OrgId: evtOrgApproved.OrgId, Status: uint8(core.OrgApproved)}, evtOrgApproved.Raw)
 
 			case evtOrgSuspended := <-chOrgSuspended:
 				core.OrgInfoMap.UpsertOrg(evtOrgSuspended.OrgId, evtOrgSuspended.PorgId, evtOrgSuspended.UltParent, evtOrgSuspended.Level, core.OrgSuspended)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgSuspended, OrgId: evtOrgSuspended.OrgId, Status: uint8(core.OrgSuspended)}, evtOrgSuspended.Raw)
 
 			case evtOrgReactivated := <-chOrgReactivated:
 				core.OrgInfoMap.UpsertOrg(evtOrgReactivated.OrgId, evtOrgReactivated.PorgId, evtOrgReactivated.UltParent, evtOrgReactivated.Level, core.OrgApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgSuspensionRevoked, OrgId: evtOrgReactivated.OrgId, Status: uint8(core.OrgApproved)}, evtOrgReactivated.Raw)
 			case <-stopChan:
 				log.Info("quit org Contr watch")
 				return
@@ -207,9 +216,11 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeApproved.OrgId, evtNodeApproved.EnodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeAdded, OrgId: evtNodeApproved.OrgId, Url: evtNodeApproved.EnodeId, Status: uint8(core.NodeApproved)}, evtNodeApproved.Raw)
 
 			case evtNodeProposed := <-chNodeProposed:
 				core.NodeInfoMap.UpsertNode(evtNodeProposed.OrgId, evtNodeProposed.EnodeId, core.NodePendingApproval)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeProposed, OrgId: evtNodeProposed.OrgId, Url: evtNodeProposed.EnodeId, Status: uint8(core.NodePendingApproval)}, evtNodeProposed.Raw)
 
 			case evtNodeDeactivated := <-chNodeDeactivated:
 				err := ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), evtNodeDeactivated.EnodeId, ptype.NodeDelete, b.Ib.IsRaft())
@@ -217,6 +228,7 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeDeactivated.OrgId, evtNodeDeactivated.EnodeId, core.NodeDeactivated)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeDeactivated, OrgId: evtNodeDeactivated.OrgId, Url: evtNodeDeactivated.EnodeId, Status: uint8(core.NodeDeactivated)}, evtNodeDeactivated.Raw)
 
 			case evtNodeActivated := <-chNodeActivated:
 				err := ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), evtNodeActivated.EnodeId, ptype.NodeAdd, b.Ib.IsRaft())
@@ -224,9 +236,11 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeActivated.OrgId, evtNodeActivated.EnodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeActivated, OrgId: evtNodeActivated.OrgId, Url: evtNodeActivated.EnodeId, Status: uint8(core.NodeApproved)}, evtNodeActivated.Raw)
 
 			case evtNodeBlacklisted := <-chNodeBlacklisted:
 				core.NodeInfoMap.UpsertNode(evtNodeBlacklisted.OrgId, evtNodeBlacklisted.EnodeId, core.NodeBlackListed)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeBlacklisted, OrgId: evtNodeBlacklisted.OrgId, Url: evtNodeBlacklisted.EnodeId, Status: uint8(core.NodeBlackListed)}, evtNodeBlacklisted.Raw)
 				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), evtNodeBlacklisted.EnodeId, ptype.NodeAdd)
 				log.Error("error updating disallowed-nodes.json", "err", err)
 				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), evtNodeBlacklisted.EnodeId, ptype.NodeDelete, b.Ib.IsRaft())
@@ -236,9 +250,11 @@ func (b *Backend) ManageNodePermissions() error {
 
 			case evtNodeRecoveryInit := <-chNodeRecoveryInit:
 				core.NodeInfoMap.UpsertNode(evtNodeRecoveryInit.OrgId, evtNodeRecoveryInit.EnodeId, core.NodeRecoveryInitiated)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeRecoveryInitiated, OrgId: evtNodeRecoveryInit.OrgId, Url: evtNodeRecoveryInit.EnodeId, Status: uint8(core.NodeRecoveryInitiated)}, evtNodeRecoveryInit.Raw)
 
 			case evtNodeRecoveryDone := <-chNodeRecoveryDone:
 				core.NodeInfoMap.UpsertNode(evtNodeRecoveryDone.OrgId, evtNodeRecoveryDone.EnodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeRecoveryCompleted, OrgId: evtNodeRecoveryDone.OrgId, Url: evtNodeRecoveryDone.EnodeId, Status: uint8(core.NodeApproved)}, evtNodeRecoveryDone.Raw)
 				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), evtNodeRecoveryDone.EnodeId, ptype.NodeDelete)
 				log.Error("error updating disallowed-nodes.json", "err", err)
 				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), evtNodeRecoveryDone.EnodeId, ptype.NodeAdd, b.Ib.IsRaft())
@@ -160,8 +160,10 @@ func (a *Audit) ValidatePendingOp(_authOrg, _orgId, _url string, _account common
 	return err == nil && (op.Int64() == _pendingOp && pOrg == _orgId && pUrl == _url && pAcct == _account)
 }
 
-func (a *Audit) CheckPendingOp(_orgId string) bool {
-	_, _, _, op, err := a.Backend.PermInterfSession.GetPendingOp(_orgId)
+// v1 model allows only one pending operation at a time. any pending
+// operation blocks a new one
+func (a *Audit) CheckPendingOp(_authOrg, _orgId, _url string, _account common.Address, _pendingOp int64) bool {
+	_, _, _, op, err := a.Backend.PermInterfSession.GetPendingOp(_authOrg)
 	return err == nil && op.Int64() != 0
 }
 
@@ -180,7 +182,7 @@ func (c *Control) ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint1
 	return false, nil
 }
 
-func (c *Control) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, transactionType core.TransactionType) error {
+func (c *Control) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, transactionType core.TransactionType, _block *big.Int) error {
 	accessType := core.GetAcctAccess(_sender)
 	switch accessType {
 	case core.ReadOnly:
@@ -217,6 +219,9 @@ func (o *Org) ApproveOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 }
 
 func (o *Org) UpdateOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return nil, ptype.ErrExpiryNotSupported
+	}
 	return o.Backend.PermInterfSession.UpdateOrgStatus(_args.OrgId, big.NewInt(int64(_args.Action)))
 }
 
@@ -249,6 +254,9 @@ func (n *Node) UpdateNodeStatus(_args ptype.TxArgs) (*types.Transaction, error)
 }
 
 func (a *Account) AssignAccountRole(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return nil, ptype.ErrExpiryNotSupported
+	}
 	return a.Backend.PermInterfSession.AssignAccountRole(_args.AcctId, _args.OrgId, _args.RoleId)
 }
 
@@ -269,6 +277,9 @@ func (a *Account) ApproveAdminRole(_args ptype.TxArgs) (*types.Transaction, erro
 }
 
 func (a *Account) AssignAdminRole(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return nil, ptype.ErrExpiryNotSupported
+	}
 	return a.Backend.PermInterfSession.AssignAdminRole(_args.OrgId, _args.AcctId, _args.RoleId)
 }
 
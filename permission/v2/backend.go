@@ -20,6 +20,7 @@ func (b *Backend) ManageAccountPermissions() error {
 	chAccessModified := make(chan *eb.AcctManagerAccountAccessModified)
 	chAccessRevoked := make(chan *eb.AcctManagerAccountAccessRevoked)
 	chStatusChanged := make(chan *eb.AcctManagerAccountStatusChanged)
+	chExpirySet := make(chan *eb.AcctManagerAccountAccessExpirySet)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
@@ -37,6 +38,10 @@ func (b *Backend) ManageAccountPermissions() error {
 		return fmt.Errorf("failed AccountStatusChanged: %v", err)
 	}
 
+	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessExpirySet(opts, chExpirySet); err != nil {
+		return fmt.Errorf("failed AccountAccessExpirySet: %v", err)
+	}
+
 	go func() {
 		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
@@ -44,16 +49,22 @@ func (b *Backend) ManageAccountPermissions() error {
 			select {
 			case evtAccessModified := <-chAccessModified:
 				core.AcctInfoMap.UpsertAccount(evtAccessModified.OrgId, evtAccessModified.RoleId, evtAccessModified.Account, evtAccessModified.OrgAdmin, core.AcctStatus(int(evtAccessModified.Status.Uint64())))
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountRoleChanged, OrgId: evtAccessModified.OrgId, RoleId: evtAccessModified.RoleId, AcctId: &evtAccessModified.Account, Status: uint8(evtAccessModified.Status.Uint64())}, evtAccessModified.Raw)
 
 			case evtAccessRevoked := <-chAccessRevoked:
 				core.AcctInfoMap.UpsertAccount(evtAccessRevoked.OrgId, evtAccessRevoked.RoleId, evtAccessRevoked.Account, evtAccessRevoked.OrgAdmin, core.AcctActive)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountAccessRevoked, OrgId: evtAccessRevoked.OrgId, RoleId: evtAccessRevoked.RoleId, AcctId: &evtAccessRevoked.Account, Status: uint8(core.AcctActive)}, evtAccessRevoked.Raw)
 
 			case evtStatusChanged := <-chStatusChanged:
 				if ac, err := core.AcctInfoMap.GetAccount(evtStatusChanged.Account); ac != nil {
 					core.AcctInfoMap.UpsertAccount(evtStatusChanged.OrgId, ac.RoleId, evtStatusChanged.Account, ac.IsOrgAdmin, core.AcctStatus(int(evtStatusChanged.Status.Uint64())))
+					b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.AccountStatusChanged, OrgId: evtStatusChanged.OrgId, RoleId: ac.RoleId, AcctId: &evtStatusChanged.Account, Status: uint8(evtStatusChanged.Status.Uint64())}, evtStatusChanged.Raw)
 				} else {
 					log.Info("error fetching account information", "err", err)
 				}
+
+			case evtExpirySet := <-chExpirySet:
+				core.AcctInfoMap.SetAccountExpiry(evtExpirySet.Account, core.AccessExpiry{Block: evtExpirySet.ExpiryBlock.Uint64(), Timestamp: evtExpirySet.ExpiryTime.Uint64()})
 			case <-stopChan:
 				log.Info("quit account contract watch")
 				return
@@ -86,10 +97,12 @@ func (b *Backend) ManageRolePermissions() error {
 			select {
 			case evtRoleCreated := <-chRoleCreated:
 				core.RoleInfoMap.UpsertRole(evtRoleCreated.OrgId, evtRoleCreated.RoleId, evtRoleCreated.IsVoter, evtRoleCreated.IsAdmin, core.AccessType(int(evtRoleCreated.BaseAccess.Uint64())), true)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.RoleCreated, OrgId: evtRoleCreated.OrgId, RoleId: evtRoleCreated.RoleId}, evtRoleCreated.Raw)
 
 			case evtRoleRevoked := <-chRoleRevoked:
 				if r, _ := core.RoleInfoMap.GetRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId); r != nil {
 					core.RoleInfoMap.UpsertRole(evtRoleRevoked.OrgId, evtRoleRevoked.RoleId, r.IsVoter, r.IsAdmin, r.Access, false)
+					b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.RoleRemoved, OrgId: evtRoleRevoked.OrgId, RoleId: evtRoleRevoked.RoleId}, evtRoleRevoked.Raw)
 				} else {
 					log.Error("Revoke role - cache is missing role", "org", evtRoleRevoked.OrgId, "role", evtRoleRevoked.RoleId)
 				}
@@ -107,6 +120,7 @@ func (b *Backend) ManageOrgPermissions() error {
 	chOrgApproved := make(chan *eb.OrgManagerOrgApproved, 1)
 	chOrgSuspended := make(chan *eb.OrgManagerOrgSuspended, 1)
 	chOrgReactivated := make(chan *eb.OrgManagerOrgSuspensionRevoked, 1)
+	chSuspensionExpirySet := make(chan *eb.OrgManagerOrgSuspensionExpirySet, 1)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
@@ -128,6 +142,10 @@ func (b *Backend) ManageOrgPermissions() error {
 		return fmt.Errorf("failed WatchOrgSuspensionRevoked: %v", err)
 	}
 
+	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspensionExpirySet(opts, chSuspensionExpirySet); err != nil {
+		return fmt.Errorf("failed WatchOrgSuspensionExpirySet: %v", err)
+	}
+
 	go func() {
 		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
@@ -135,15 +153,27 @@ func (b *Backend) ManageOrgPermissions() error {
 			select {
 			case evtPendingApproval := <-chPendingApproval:
 				core.OrgInfoMap.UpsertOrg(evtPendingApproval.OrgId, evtPendingApproval.PorgId, evtPendingApproval.UltParent, evtPendingApproval.Level, core.OrgStatus(evtPendingApproval.Status.Uint64()))
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgProposed, OrgId: evtPendingApproval.OrgId, Status: uint8(evtPendingApproval.Status.Uint64())}, evtPendingApproval.Raw)
 
 			case evtOrgApproved := <-chOrgApproved:
 				core.OrgInfoMap.UpsertOrg(evtOrgApproved.OrgId, evtOrgApproved.PorgId, evtOrgApproved.UltParent, evtOrgApproved.Level, core.OrgApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgApproved, OrgId: evtOrgApproved.OrgId, Status: uint8(core.OrgApproved)}, evtOrgApproved.Raw)
 
 			case evtOrgSuspended := <-chOrgSuspended:
 				core.OrgInfoMap.UpsertOrg(evtOrgSuspended.OrgId, evtOrgSuspended.PorgId, evtOrgSuspended.UltParent, evtOrgSuspended.Level, core.OrgSuspended)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgSuspended, OrgId: evtOrgSuspended.OrgId, Status: uint8(core.OrgSuspended)}, evtOrgSuspended.Raw)
 
 			case evtOrgReactivated := <-chOrgReactivated:
 				core.OrgInfoMap.UpsertOrg(evtOrgReactivated.OrgId, evtOrgReactivated.PorgId, evtOrgReactivated.UltParent, evtOrgReactivated.Level, core.OrgApproved)
+				fullOrgId := evtOrgReactivated.OrgId
+				if evtOrgReactivated.PorgId != "" {
+					fullOrgId = evtOrgReactivated.PorgId + "." + evtOrgReactivated.OrgId
+				}
+				core.OrgInfoMap.SetSuspensionExpiry(fullOrgId, core.AccessExpiry{})
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.OrgSuspensionRevoked, OrgId: evtOrgReactivated.OrgId, Status: uint8(core.OrgApproved)}, evtOrgReactivated.Raw)
+
+			case evtSuspensionExpirySet := <-chSuspensionExpirySet:
+				core.OrgInfoMap.SetSuspensionExpiry(evtSuspensionExpirySet.OrgId, core.AccessExpiry{Block: evtSuspensionExpirySet.ExpiryBlock.Uint64(), Timestamp: evtSuspensionExpirySet.ExpiryTime.Uint64()})
 			case <-stopChan:
 				log.Info("quit org contract watch")
 				return
@@ -205,10 +235,12 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeApproved.OrgId, enodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeAdded, OrgId: evtNodeApproved.OrgId, Url: enodeId, Status: uint8(core.NodeApproved)}, evtNodeApproved.Raw)
 
 			case evtNodeProposed := <-chNodeProposed:
 				enodeId := core.GetNodeUrl(evtNodeProposed.EnodeId, evtNodeProposed.Ip[:], evtNodeProposed.Port, evtNodeProposed.Raftport, b.Ib.IsRaft())
 				core.NodeInfoMap.UpsertNode(evtNodeProposed.OrgId, enodeId, core.NodePendingApproval)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeProposed, OrgId: evtNodeProposed.OrgId, Url: enodeId, Status: uint8(core.NodePendingApproval)}, evtNodeProposed.Raw)
 
 			case evtNodeDeactivated := <-chNodeDeactivated:
 				enodeId := core.GetNodeUrl(evtNodeDeactivated.EnodeId, evtNodeDeactivated.Ip[:], evtNodeDeactivated.Port, evtNodeDeactivated.Raftport, b.Ib.IsRaft())
@@ -217,6 +249,7 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeDeactivated.OrgId, enodeId, core.NodeDeactivated)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeDeactivated, OrgId: evtNodeDeactivated.OrgId, Url: enodeId, Status: uint8(core.NodeDeactivated)}, evtNodeDeactivated.Raw)
 
 			case evtNodeActivated := <-chNodeActivated:
 				enodeId := core.GetNodeUrl(evtNodeActivated.EnodeId, evtNodeActivated.Ip[:], evtNodeActivated.Port, evtNodeActivated.Raftport, b.Ib.IsRaft())
@@ -225,10 +258,12 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 				core.NodeInfoMap.UpsertNode(evtNodeActivated.OrgId, enodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeActivated, OrgId: evtNodeActivated.OrgId, Url: enodeId, Status: uint8(core.NodeApproved)}, evtNodeActivated.Raw)
 
 			case evtNodeBlacklisted := <-chNodeBlacklisted:
 				enodeId := core.GetNodeUrl(evtNodeBlacklisted.EnodeId, evtNodeBlacklisted.Ip[:], evtNodeBlacklisted.Port, evtNodeBlacklisted.Raftport, b.Ib.IsRaft())
 				core.NodeInfoMap.UpsertNode(evtNodeBlacklisted.OrgId, enodeId, core.NodeBlackListed)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeBlacklisted, OrgId: evtNodeBlacklisted.OrgId, Url: enodeId, Status: uint8(core.NodeBlackListed)}, evtNodeBlacklisted.Raw)
 				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), enodeId, ptype.NodeAdd)
 				log.Error("error updating disallowed-nodes.json", "err", err)
 				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeDelete, b.Ib.IsRaft())
@@ -239,10 +274,12 @@ func (b *Backend) ManageNodePermissions() error {
 			case evtNodeRecoveryInit := <-chNodeRecoveryInit:
 				enodeId := core.GetNodeUrl(evtNodeRecoveryInit.EnodeId, evtNodeRecoveryInit.Ip[:], evtNodeRecoveryInit.Port, evtNodeRecoveryInit.Raftport, b.Ib.IsRaft())
 				core.NodeInfoMap.UpsertNode(evtNodeRecoveryInit.OrgId, enodeId, core.NodeRecoveryInitiated)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeRecoveryInitiated, OrgId: evtNodeRecoveryInit.OrgId, Url: enodeId, Status: uint8(core.NodeRecoveryInitiated)}, evtNodeRecoveryInit.Raw)
 
 			case evtNodeRecoveryDone := <-chNodeRecoveryDone:
 				enodeId := core.GetNodeUrl(evtNodeRecoveryDone.EnodeId, evtNodeRecoveryDone.Ip[:], evtNodeRecoveryDone.Port, evtNodeRecoveryDone.Raftport, b.Ib.IsRaft())
 				core.NodeInfoMap.UpsertNode(evtNodeRecoveryDone.OrgId, enodeId, core.NodeApproved)
+				b.Ib.PostPermissionEvent(ptype.PermissionEvent{Type: ptype.NodeRecoveryCompleted, OrgId: evtNodeRecoveryDone.OrgId, Url: enodeId, Status: uint8(core.NodeApproved)}, evtNodeRecoveryDone.Raw)
 				err := ptype.UpdateDisallowedNodes(b.Ib.DataDir(), enodeId, ptype.NodeDelete)
 				log.Error("error updating disallowed-nodes.json", "err", err)
 				err = ptype.UpdatePermissionedNodes(b.Ib.Node(), b.Ib.DataDir(), enodeId, ptype.NodeAdd, b.Ib.IsRaft())
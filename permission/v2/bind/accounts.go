@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // AcctManagerABI is the input ABI used to generate the binding from.
-const AcctManagerABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_adminRole\",\"type\":\"bool\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeExistingAdmin\",\"outputs\":[{\"name\":\"voterUpdate\",\"type\":\"bool\"},{\"name\":\"account\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfAccounts\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountOrgRole\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountRole\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"orgAdminExists\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_aIndex\",\"type\":\"uint256\"}],\"name\":\"getAccountDetailsFromIndex\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addNewAdmin\",\"outputs\":[{\"name\":\"voterUpdate\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setDefaults\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"checkOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgAdmin\",\"type\":\"bool\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountAccessModified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgAdmin\",\"type\":\"bool\"}],\"name\":\"AccountAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountStatusChanged\",\"type\":\"event\"}]"
+const AcctManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"AccountAccessExpirySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_orgAdmin\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountAccessModified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_orgAdmin\",\"type\":\"bool\"}],\"name\":\"AccountAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountStatusChanged\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addNewAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"voterUpdate\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"_adminRole\",\"type\":\"bool\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"checkOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountDetails\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_aIndex\",\"type\":\"uint256\"}],\"name\":\"getAccountDetailsFromIndex\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountExpiry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountOrgRole\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountRole\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountStatus\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfAccounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isAccountExpired\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"orgAdminExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeExistingAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"voterUpdate\",\"type\":\"bool\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"setAccountExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setDefaults\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var AcctManagerParsedABI, _ = abi.JSON(strings.NewReader(AcctManagerABI))
 
 // AcctManagerBin is the compiled bytecode used for deploying new contracts.
-var AcctManagerBin = "0x608060405234801561001057600080fd5b50604051602080613d2d8339810180604052602081101561003057600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055613ccb806100626000396000f3fe608060405234801561001057600080fd5b50600436106100f55760003560e01c806384b7a84a11610097578063cef7f6af11610066578063cef7f6af1461078c578063e3483a9d1461084a578063e8b42bf414610918578063fd4fa05a14610a51576100f5565b806384b7a84a146105d7578063950145cf14610654578063b2018568146106f8578063c214e5e514610715576100f5565b8063309e36ef116100d3578063309e36ef1461038c5780636acee5fd146103a65780636b568d76146104aa57806381d66b231461053c576100f5565b8063143a5604146100fa5780631d09dc93146101cc5780632aceb5341461025d575b600080fd5b6101ca6004803603608081101561011057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561013a57600080fd5b82018360208201111561014c57600080fd5b803590602001918460018302840111600160201b8311171561016d57600080fd5b919390929091602081019035600160201b81111561018a57600080fd5b82018360208201111561019c57600080fd5b803590602001918460018302840111600160201b831117156101bd57600080fd5b9193509150351515610a77565b005b61023a600480360360208110156101e257600080fd5b810190602081018135600160201b8111156101fc57600080fd5b82018360208201111561020e57600080fd5b803590602001918460018302840111600160201b8311171561022f57600080fd5b509092509050610e75565b6040805192151583526001600160a01b0390911660208301528051918290030190f35b6102836004803603602081101561027357600080fd5b50356001600160a01b0316611401565b60405180866001600160a01b03166001600160a01b03168152602001806020018060200185815260200184151515158152602001838103835287818151815260200191508051906020019080838360005b838110156102ec5781810151838201526020016102d4565b50505050905090810190601f1680156103195780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b8381101561034c578181015183820152602001610334565b50505050905090810190601f1680156103795780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390f35b610394611659565b60408051918252519081900360200190f35b6103cc600480360360208110156103bc57600080fd5b50356001600160a01b0316611660565b604051808060200180602001838103835285818151815260200191508051906020019080838360005b8381101561040d5781810151838201526020016103f5565b50505050905090810190601f16801561043a5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561046d578181015183820152602001610455565b50505050905090810190601f16801561049a5780820380516001836020036101000a031916815260200191505b5094505050505060405180910390f35b610528600480360360408110156104c057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b8111156104ea57600080fd5b8201836020820111156104fc57600080fd5b803590602001918460018302840111600160201b8311171561051d57600080fd5b509092509050611831565b604080519115158252519081900360200190f35b6105626004803603602081101561055257600080fd5b50356001600160a01b031661198c565b6040805160208082528351818301528351919283929083019185019080838360005b8381101561059c578181015183820152602001610584565b50505050905090810190601f1680156105c95780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6101ca600480360360608110156105ed57600080fd5b810190602081018135600160201b81111561060757600080fd5b82018360208201111561061957600080fd5b803590602001918460018302840111600160201b8311171561063a57600080fd5b91935091506001600160a01b038135169060200135611ae2565b6105286004803603602081101561066a57600080fd5b810190602081018135600160201b81111561068457600080fd5b82018360208201111561069657600080fd5b803590602001918460018302840111600160201b831117156106b757600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550612215945050505050565b6102836004803603602081101561070e57600080fd5b5035612390565b6105286004803603604081101561072b57600080fd5b810190602081018135600160201b81111561074557600080fd5b82018360208201111561075757600080fd5b803590602001918460018302840111600160201b8311171561077857600080fd5b9193509150356001600160a01b031661257a565b6101ca600480360360408110156107a257600080fd5b810190602081018135600160201b8111156107bc57600080fd5b8201836020820111156107ce57600080fd5b803590602001918460018302840111600160201b831117156107ef57600080fd5b919390929091602081019035600160201b81111561080c57600080fd5b82018360208201111561081e57600080fd5b803590602001918460018302840111600160201b8311171561083f57600080fd5b509092509050612bcc565b6101ca6004803603608081101561086057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561088a57600080fd5b82018360208201111561089c57600080fd5b803590602001918460018302840111600160201b831117156108bd57600080fd5b919390929091602081019035600160201b8111156108da57600080fd5b8201836020820111156108ec57600080fd5b803590602001918460018302840111600160201b8311171561090d57600080fd5b919350915035612cb8565b6105286004803603606081101561092e57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561095857600080fd5b82018360208201111561096a57600080fd5b803590602001918460018302840111600160201b8311171561098b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156109dd57600080fd5b8201836020820111156109ef57600080fd5b803590602001918460018302840111600160201b83111715610a1057600080fd5b91908080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525092955061302a945050505050565b61039460048036036020811015610a6757600080fd5b50356001600160a01b0316613591565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610ac457600080fd5b505afa158015610ad8573d6000803e3d6000fd5b505050506040513d6020811015610aee57600080fd5b50516001600160a01b03163314610b435760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b6040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015610bc65780601f10610b9b57610100808354040283529160200191610bc6565b820191906000526020600020905b815481529060010190602001808311610ba957829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012014158015610db457506040805160208082019081526005805460026000196101006001841615020190911604938301849052929091829160609091019084908015610cc15780601f10610c9657610100808354040283529160200191610cc1565b820191906000526020600020905b815481529060010190602001808311610ca457829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040526040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015610d69578181015183820152602001610d51565b50505050905090810190601f168015610d965780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012014155b1515610df457604051600160e51b62461bcd028152600401808060200182810382526040815260200180613bfb6040913960400191505060405180910390fd5b610e6d8686868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a018190048102820181019092528881529250889150879081908401838280828437600092019190915250600292508791506135ee9050565b505050505050565b6000805460408051600160e41b62e32cf9028152905183926001600160a01b031691630e32cf90916004808301926020929190829003018186803b158015610ebc57600080fd5b505afa158015610ed0573d6000803e3d6000fd5b505050506040513d6020811015610ee657600080fd5b50516001600160a01b03163314610f3b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b610f7a84848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061221592505050565b156113f357600061100260066000878760405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120815260200190815260200160002060009054906101000a90046001600160a01b03166139c8565b9050600660018281548110151561101557fe5b906000526020600020906005020160030181905550600060018281548110151561103b57fe5b906000526020600020906005020160040160006101000a81548160ff0219169083151502179055507f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc77660018281548110151561109357fe5b6000918252602090912060059091020154600180546001600160a01b0390921691849081106110be57fe5b90600052602060002090600502016001016001848154811015156110de57fe5b90600052602060002090600502016002016001858154811015156110fe57fe5b60009182526020909120600460059092020101546001805460ff909216918790811061112657fe5b600091825260209182902060036005909202010154604080516001600160a01b038816815284151560608201526080810183905260a0938101848152875460026000196101006001841615020190911604948201859052929390929183019060c0840190889080156111d95780601f106111ae576101008083540402835291602001916111d9565b820191906000526020600020905b8154815290600101906020018083116111bc57829003601f168201915b505083810382528654600260001961010060018416150201909116048082526020909101908790801561124d5780601f106112225761010080835404028352916020019161124d565b820191906000526020600020905b81548152906001019060200180831161123057829003601f168201915b505097505050505050505060405180910390a160408051602080820190815260048054600260001961010060018416150201909116049383018490529290918291606090910190849080156112e35780601f106112b8576101008083540402835291602001916112e3565b820191906000526020600020905b8154815290600101906020018083116112c657829003601f168201915b5050925050506040516020818303038152906040528051906020012060018281548110151561130e57fe5b6000918252602091829020604080518085019485526002600590940290920183018054600019610100600183161502011693909304908201819052919291829160600190849080156113a15780601f10611376576101008083540402835291602001916113a1565b820191906000526020600020905b81548152906001019060200180831161138457829003601f168201915b50509250505060405160208183030381529060405280519060200120146001828154811015156113cd57fe5b60009182526020909120600590910201549093506001600160a01b031691506113fa9050565b5060009050805b9250929050565b6001600160a01b038116600090815260026020526040812054606090819083908190151561146857505060408051808201825260048152600160e01b634e4f4e45026020808301919091528251908101909252600080835286955090935090915080611650565b6000611473876139c8565b905060018181548110151561148457fe5b6000918252602090912060059091020154600180546001600160a01b0390921691839081106114af57fe5b90600052602060002090600502016001016001838154811015156114cf57fe5b90600052602060002090600502016002016001848154811015156114ef57fe5b90600052602060002090600502016003015460018581548110151561151057fe5b600091825260209182902060046005909202010154845460408051601f6002600019600186161561010002019094169390930492830185900485028101850190915281815260ff909216928691908301828280156115af5780601f10611584576101008083540402835291602001916115af565b820191906000526020600020905b81548152906001019060200180831161159257829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529599508894509250840190508282801561163d5780601f106116125761010080835404028352916020019161163d565b820191906000526020600020905b81548152906001019060200180831161162057829003601f168201915b5050505050925095509550955095509550505b91939590929450565b6001545b90565b6001600160a01b038116600090815260026020526040902054606090819015156116bd57604051806040016040528060048152602001600160e01b634e4f4e4502815250604051806020016040528060008152509150915061182c565b60006116c8846139c8565b90506001818154811015156116d957fe5b90600052602060002090600502016001016001828154811015156116f957fe5b6000918252602091829020835460408051601f600260001961010060018716150201909416849004908101879004870282018701909252818152600590940290920101928491908301828280156117915780601f1061176657610100808354040283529160200191611791565b820191906000526020600020905b81548152906001019060200180831161177457829003601f168201915b5050845460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529597508694509250840190508282801561181f5780601f106117f45761010080835404028352916020019161181f565b820191906000526020600020905b81548152906001019060200180831161180257829003601f168201915b5050505050905092509250505b915091565b6001600160a01b038316600090815260026020526040812054151561185857506001611985565b6000611863856139c8565b9050838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001206001828154811015156118c757fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156119645780601f1061193957610100808354040283529160200191611964565b820191906000526020600020905b81548152906001019060200180831161194757829003601f168201915b50509250505060405160208183030381529060405280519060200120149150505b9392505050565b6001600160a01b03811660009081526002602052604090205460609015156119d257506040805180820190915260048152600160e01b634e4f4e45026020820152611add565b60006119dd836139c8565b90506001818154811015156119ee57fe5b9060005260206000209060050201600301546000141515611abc576001805482908110611a1757fe5b600091825260209182902060026005909202018101805460408051601f600019610100600186161502019093169490940491820185900485028401850190528083529192909190830182828015611aaf5780601f10611a8457610100808354040283529160200191611aaf565b820191906000526020600020905b815481529060010190602001808311611a9257829003601f168201915b5050505050915050611add565b50506040805180820190915260048152600160e01b634e4f4e450260208201525b919050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611b2f57600080fd5b505afa158015611b43573d6000803e3d6000fd5b505050506040513d6020811015611b5957600080fd5b50516001600160a01b03163314611bae5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b83838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052506001600160a01b03871681526002602052604090205486935015159150611c5390505760408051600160e51b62461bcd02815260206004820152601760248201527f6163636f756e7420646f6573206e6f7420657869737473000000000000000000604482015290519081900360640190fd5b816040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611c94578181015183820152602001611c7c565b50505050905090810190601f168015611cc15780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001206001611ce7836139c8565b81548110611cf157fe5b90600052602060002090600502016001016040516020018080602001828103825283818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015611d8e5780601f10611d6357610100808354040283529160200191611d8e565b820191906000526020600020905b815481529060010190602001808311611d7157829003601f168201915b50509250505060405160208183030381529060405280519060200120141515611e015760408051600160e51b62461bcd02815260206004820152601860248201527f6163636f756e7420696e20646966666572656e74206f72670000000000000000604482015290519081900360640190fd5b600083118015611e115750600683105b1515611e675760408051600160e51b62461bcd02815260206004820152601d60248201527f696e76616c696420737461747573206368616e67652072657175657374000000604482015290519081900360640190fd5b611eb58487878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052506040805160208101909152908152925061302a915050565b151560011415611ef957604051600160e51b62461bcd028152600401808060200182810382526031815260200180613c6f6031913960400191505060405180910390fd5b60008360011415611f76576001611f0f866139c8565b81548110611f1957fe5b9060005260206000209060050201600301546002141515611f6e57604051600160e51b62461bcd028152600401808060200182810382526039815260200180613b9a6039913960400191505060405180910390fd5b50600461215f565b8360021415611ff1576001611f8a866139c8565b81548110611f9457fe5b9060005260206000209060050201600301546004141515611fe957604051600160e51b62461bcd02815260040180806020018281038252603c815260200180613b5e603c913960400191505060405180910390fd5b50600261215f565b836003141561206d576001612005866139c8565b8154811061200f57fe5b90600052602060002090600502016003015460051415151561206557604051600160e51b62461bcd028152600401808060200182810382526038815260200180613b266038913960400191505060405180910390fd5b50600561215f565b83600414156120e8576001612081866139c8565b8154811061208b57fe5b90600052602060002090600502016003015460051415156120e057604051600160e51b62461bcd028152600401808060200182810382526034815260200180613c3b6034913960400191505060405180910390fd5b50600761215f565b836005141561215f5760016120fc866139c8565b8154811061210657fe5b906000526020600020906005020160030154600714151561215b57604051600160e51b62461bcd028152600401808060200182810382526038815260200180613aee6038913960400191505060405180910390fd5b5060025b80600161216b876139c8565b8154811061217557fe5b9060005260206000209060050201600301819055507f36b0ea38154dec5e98b6bf928b971a9db5e8cd4b6946350e9e43fb9848c70b258588888460405180856001600160a01b03166001600160a01b03168152602001806020018381526020018281038252858582818152602001925080828437600083820152604051601f909101601f191690920182900397509095505050505050a150505050505050565b6000806001600160a01b031660066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561226657818101518382015260200161224e565b50505050905090810190601f1680156122935780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b03161461238857600060066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156123125781810151838201526020016122fa565b50505050905090810190601f16801561233f5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b0316905061237d81613591565b600214915050611add565b506000919050565b60006060806000806001868154811015156123a757fe5b6000918252602090912060059091020154600180546001600160a01b0390921691889081106123d257fe5b90600052602060002090600502016001016001888154811015156123f257fe5b906000526020600020906005020160020160018981548110151561241257fe5b90600052602060002090600502016003015460018a81548110151561243357fe5b600091825260209182902060046005909202010154845460408051601f6002600019600186161561010002019094169390930492830185900485028101850190915281815260ff909216928691908301828280156124d25780601f106124a7576101008083540402835291602001916124d2565b820191906000526020600020905b8154815290600101906020018083116124b557829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156125605780601f1061253557610100808354040283529160200191612560565b820191906000526020600020905b81548152906001019060200180831161254357829003601f168201915b505050505092509450945094509450945091939590929450565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156125c957600080fd5b505afa1580156125dd573d6000803e3d6000fd5b505050506040513d60208110156125f357600080fd5b50516001600160a01b031633146126485760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60606126538361198c565b9050600061266084613591565b9050600061266d856139c8565b604080516020808201908152600580546002600019610100600184161502019091160493830184905293945091829160600190849080156126ef5780601f106126c4576101008083540402835291602001916126ef565b820191906000526020600020905b8154815290600101906020018083116126d257829003601f168201915b50509250505060405160208183030381529060405280519060200120836040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561274c578181015183820152602001612734565b50505050905090810190601f1680156127795780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001201480156127a15750816001145b15612831578460066000898960405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b031602179055505b600260018281548110151561284257fe5b9060005260206000209060050201600301819055506001808281548110151561286757fe5b906000526020600020906005020160040160006101000a81548160ff0219169083151502179055507f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc776856001838154811015156128c057fe5b90600052602060002090600502016001016001848154811015156128e057fe5b906000526020600020906005020160020160018581548110151561290057fe5b60009182526020909120600460059092020101546001805460ff909216918790811061292857fe5b600091825260209182902060036005909202010154604080516001600160a01b038816815284151560608201526080810183905260a0938101848152875460026000196101006001841615020190911604948201859052929390929183019060c0840190889080156129db5780601f106129b0576101008083540402835291602001916129db565b820191906000526020600020905b8154815290600101906020018083116129be57829003601f168201915b5050838103825286546002600019610100600184161502019091160480825260209091019087908015612a4f5780601f10612a2457610100808354040283529160200191612a4f565b820191906000526020600020905b815481529060010190602001808311612a3257829003601f168201915b505097505050505050505060405180910390a16040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015612ae55780601f10612aba57610100808354040283529160200191612ae5565b820191906000526020600020905b815481529060010190602001808311612ac857829003601f168201915b50509250505060405160208183030381529060405280519060200120600182815481101515612b1057fe5b600091825260209182902060408051808501948552600260059094029092018301805460001961010060018316150201169390930490820181905291929182916060019084908015612ba35780601f10612b7857610100808354040283529160200191612ba3565b820191906000526020600020905b815481529060010190602001808311612b8657829003601f168201915b505092505050604051602081830303815290604052805190602001201493505050509392505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015612c1957600080fd5b505afa158015612c2d573d6000803e3d6000fd5b505050506040513d6020811015612c4357600080fd5b50516001600160a01b03163314612c985760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b612ca4600485856139e7565b50612cb1600583836139e7565b5050505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015612d0557600080fd5b505afa158015612d19573d6000803e3d6000fd5b505050506040513d6020811015612d2f57600080fd5b50516001600160a01b03163314612d845760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b6040805160208082019081526005805460026000196101006001841615020190911604938301849052929091829160609091019084908015612e075780601f10612ddc57610100808354040283529160200191612e07565b820191906000526020600020905b815481529060010190602001808311612dea57829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001201480612f7157506040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015612f005780601f10612ed557610100808354040283529160200191612f00565b820191906000526020600020905b815481529060010190602001808311612ee357829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120145b1515612fb157604051600160e51b62461bcd028152600401808060200182810382526028815260200180613bd36028913960400191505060405180910390fd5b610e6d8686868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a018190048102820181019092528881529250889150879081908401838280828437600092019190915250879250600191506135ee9050565b604080516020808201908152600480546002600019610100600184161502019091160493830184905260009390928291606090910190849080156130af5780601f10613084576101008083540402835291602001916130af565b820191906000526020600020905b81548152906001019060200180831161309257829003601f168201915b505092505050604051602081830303815290604052805190602001206130d48561198c565b6040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156131145781810151838201526020016130fc565b50505050905090810190601f1680156131415780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120141561342257600061316d856139c8565b9050836040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156131b0578181015183820152602001613198565b50505050905090810190601f1680156131dd5780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012060018281548110151561320757fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156132a45780601f10613279576101008083540402835291602001916132a4565b820191906000526020600020905b81548152906001019060200180831161328757829003601f168201915b50509250505060405160208183030381529060405280519060200120148061341a5750826040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156133085781810151838201526020016132f0565b50505050905090810190601f1680156133355780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012060018281548110151561335f57fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156133fc5780601f106133d1576101008083540402835291602001916133fc565b820191906000526020600020905b8154815290600101906020018083116133df57829003601f168201915b50509250505060405160208183030381529060405280519060200120145b915050611985565b836001600160a01b031660066000856040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015613471578181015183820152602001613459565b50505050905090810190601f16801561349e5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b031614806135895750836001600160a01b031660066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561352757818101518382015260200161350f565b50505050905090810190601f1680156135545780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b0316145b949350505050565b6001600160a01b03811660009081526002602052604081205415156135b857506000611add565b60006135c3836139c8565b90506001818154811015156135d457fe5b906000526020600020906005020160030154915050919050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561363b57600080fd5b505afa15801561364f573d6000803e3d6000fd5b505050506040513d602081101561366557600080fd5b50516001600160a01b031633146136ba5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60006136c5866139c8565b6001600160a01b0387166000908152600260205260409020549091501561377757836001828154811015156136f657fe5b9060005260206000209060050201600201908051906020019061371a929190613a65565b508260018281548110151561372b57fe5b9060005260206000209060050201600301819055508160018281548110151561375057fe5b60009182526020909120600590910201600401805460ff1916911515919091179055613892565b600380546001908101918290556001600160a01b03888116600081815260026020908152604080832096909655855160a0810187529283528281018b81529583018a905260608301899052871515608084015284548086018087559590925282517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600590930292830180546001600160a01b03191691909516178455945180519495929461384f937fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf7909301929190910190613a65565b506040820151805161386b916002840191602090910190613a65565b50606082015160038201556080909101516004909101805460ff1916911515919091179055505b7f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc776868686858760405180866001600160a01b03166001600160a01b03168152602001806020018060200185151515158152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015613921578181015183820152602001613909565b50505050905090810190601f16801561394e5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015613981578181015183820152602001613969565b50505050905090810190601f1680156139ae5780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390a1505050505050565b6001600160a01b03166000908152600260205260409020546000190190565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10613a285782800160ff19823516178555613a55565b82800160010185558215613a55579182015b82811115613a55578235825591602001919060010190613a3a565b50613a61929150613ad3565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10613aa657805160ff1916838001178555613a55565b82800160010185558215613a55579182015b82811115613a55578251825591602001919060010190613ab8565b61165d91905b80821115613a615760008155600101613ad956fe6163636f756e74207265636f76657279206e6f7420696e697469617465642e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e7420697320616c726561647920626c61636b6c69737465642e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e74206973206e6f7420696e2073757370656e646564207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e74206973206e6f7420696e20616374697665207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e6563616e2062652063616c6c656420746f2061737369676e2061646d696e20726f6c6573206f6e6c7963616e6e6f742062652063616c6c65642066726f2061737369676e696e67206f72672061646d696e20616e64206e6574776f726b2061646d696e20726f6c65736163636f756e74206973206e6f7420626c61636b6c69737465642e206f7065726174696f6e2063616e6e6f7420626520646f6e65737461747573206368616e6765206e6f7420706f737369626c6520666f72206f72672061646d696e206163636f756e7473a165627a7a72305820f5cf4f43c37e9dae763b0a301bd310a91d3b733b1642aabb6208a69fcc6a272d0029"
+var AcctManagerBin = "0x60803461007657601f62002e2c38819003918201601f19168301916001600160401b0383118484101761007b5780849260209460405283398101031261007657516001600160a01b0381169081900361007657600080546001600160a01b031916919091179055604051612d819081620000ab8239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604052600436101561001257600080fd5b60003560e01c8063143a5604146119b35780631d09dc931461190b5780632aceb534146118d7578063309e36ef146118b957806339186c351461170e5780636acee5fd146116c25780636b568d761461167e5780637843ad791461163b57806381d66b231461160357806384b7a84a14610f3f578063950145cf14610f05578063b201856814610e5d578063c214e5e514610bd8578063cef7f6af146108dc578063e25f006b1461084c578063e3483a9d1461017a578063e8b42bf4146101125763fd4fa05a146100e257600080fd5b3461010d57602036600319011261010d576020610105610100612046565b612acc565b604051908152f35b600080fd5b3461010d57606036600319011261010d5761012b612046565b6001600160401b0360243581811161010d5761014b9036906004016121dc565b9160443591821161010d5760209261016a6101709336906004016121dc565b91612b1e565b6040519015158152f35b3461010d57608036600319011261010d57610193612046565b6024356001600160401b03811161010d576101b2903690600401612072565b90916044356001600160401b03811161010d576101d3903690600401612072565b60005460405162e32cf960e41b80825292966001600160a01b0396939492871693929091602081600481885afa9081156107845761021e91899160009161082d575b5016331461266f565b60405160208101906020825261024a8161023c604082018d87612700565b03601f198101835282612175565b5190206040516020810190602082526102698161023c60408201612341565b5190201480156107e6575b156107905761028a610292926020943691612196565b973691612196565b9260046040518094819382525afa908115610784576102bd918591600091610755575016331461266f565b6001600160a01b0382166000908152600260205260408082205485851683529120546000199091019490156104755760026102f78661221a565b5001948251956001600160401b03871161045f5761031f87610319835461226b565b836126ac565b602096601f81116001146103dc576103876103af936103bd96959361036d84600495600080516020612d0c8339815191529d6000916103d1575b508160011b916000199060031b1c19161790565b90555b6103798161221a565b50600360643591015561221a565b5001805460ff191660011790555b60405195869516855260a0602086015260a085019061209f565b90838203604085015261209f565b6001606083015260643560808301520390a1005b90508901518e610359565b601f198116978260005260206000209860005b8181106104475750936103bd969593600184600080516020612d0c8339815191529c610387956004976103af9a1061042e575b5050811b019055610370565b8a015160001960f88460031b161c191690558d80610422565b878301518b556001909a0199602092830192016103ef565b634e487b7160e01b600052604160045260246000fd5b9350600354600019811461073f57600101806003558383166000526002602052604060002055604051936104a885612124565b838316855260208501818152826040870152606435606087015260016080870152600154600160401b81101561045f578060016104e8920160015561221a565b91909161072957865182546001600160a01b031916908716178255518051906001600160401b03821161045f5761052f82610526600186015461226b565b600186016126ac565b602090601f83116001146106b7576105609291600091836106ac575b50508160011b916000199060031b1c19161790565b60018201555b60408601519586516001600160401b03811161045f576105968161058d600286015461226b565b600286016126ac565b6020601f821160011461061d5792600460806103bd9796946105e68561060d966103af99600080516020612d0c8339815191529f6000926106125750508160011b916000199060031b1c19161790565b60028501555b606081015160038501550151151591019060ff801983541691151516179055565b610395565b015190508f8061054b565b6002840160005260206000209860005b601f1984168110610694575060806103bd979694600185600080516020612d0c8339815191529d6103af999660049661060d99601f1981161061067b575b505050811b0160028501556105ec565b015160001960f88460031b161c191690558e808061066b565b828201518b556001909a01996020928301920161062d565b01519050898061054b565b9190600184016000526020600020906000935b601f198416851061070e576001945083601f198116106106f5575b505050811b016001820155610566565b015160001960f88460031b161c191690558880806106e5565b818101518355602094850194600190930192909101906106ca565b634e487b7160e01b600052600060045260246000fd5b634e487b7160e01b600052601160045260246000fd5b610777915060203d60201161077d575b61076f8183612175565b810190612650565b87610215565b503d610765565b6040513d6000823e3d90fd5b60405162461bcd60e51b815260206004820152602860248201527f63616e2062652063616c6c656420746f2061737369676e2061646d696e20726f6044820152676c6573206f6e6c7960c01b6064820152608490fd5b506040516020810190602082526108058161023c604082018d87612700565b5190206040516020810190602082526108248161023c604082016122a5565b51902014610274565b610846915060203d60201161077d5761076f8183612175565b8b610215565b3461010d57602036600319011261010d576001600160a01b0361086d612046565b166000526007602052602060406000208160405161088a8161213f565b60018354938483520154918291015281151591826108d2575b5081156108b6575b506040519015158152f35b8015159150816108c8575b50826108ab565b90504211826108c1565b43119150836108a3565b3461010d57604036600319011261010d576001600160401b0360043581811161010d5761090d903690600401612072565b909160243581811161010d57610927903690600401612072565b92909160018060a01b0391826000541660405193849162e32cf960e41b835282600460209788935afa80156107845761096a92600091610bbb575016331461266f565b81811161045f5761097c60045461226b565b95601f96878111610b6c575b50600090878311600114610af8576109b8929160009183610aed5750508160011b916000199060031b1c19161790565b6004555b831161045f576109cd60055461226b565b848111610a91575b506000938311600114610a145750610a049260009183610a095750508160011b916000199060031b1c19161790565b600555005b01359050838061054b565b601f198316937f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db092916000905b868210610a795750508360019510610a5f575b505050811b01600555005b0135600019600384901b60f8161c19169055828080610a54565b80600184968294958701358155019501920190610a41565b7f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db08580860160051c820192848710610ae4575b0160051c01905b818110610ad857506109d5565b60008155600101610acb565b92508192610ac4565b01359050888061054b565b601f198316916004600052600080516020612d2c8339815191529260005b87828210610b56575050908460019594939210610b3c575b505050811b016004556109bc565b0135600019600384901b60f8161c19169055878080610b2e565b6001849682939587013581550195019201610b16565b6004600052600080516020612d2c8339815191528880850160051c820192878610610bb2575b0160051c01905b818110610ba65750610988565b60008155600101610b99565b92508192610b92565b610bd29150863d881161077d5761076f8183612175565b89610215565b3461010d57604036600319011261010d576004356001600160401b03811161010d57610c08903690600401612072565b90610c1161205c565b60005460405162e32cf960e41b81526020946001600160a01b0393909290919086908290600490829088165afa9182156107845784610c70610d8c96600080516020612d0c83398151915295600295600091610e46575016331461266f565b87610c7a83612a57565b97610c8484612acc565b6001600160a01b03851660009081526002602052604090205490979060001901976040519a8b610cbe86820192878452604083019061209f565b039b610cd2601f199d8e8101835282612175565b5190208b604051610cfc8782019288845282610cf060408201612341565b03908101835282612175565b519020149081610e3b575b50610de1575b50505050816003610d1d8661221a565b5001556004610d2b8561221a565b5001805460ff19166001179055610d84610d448561221a565b5091610d4f8661221a565b509260ff6004610d5e8961221a565b50015416906003610d6e8961221a565b5001549260016040519788970192019086612721565b0390a161221a565b50604051610db781610dab86820194878652600260408401910161244f565b03848101835282612175565b51902090604051610dd58482019285845282610cf0604082016122a5565b51902014604051908152f35b610e0a90610dfe6040519384928684019687526040840191612700565b038a8101835282612175565b5190206000526006885260406000209082166bffffffffffffffffffffffff60a01b82541617905587878180610d0d565b60019150148c610d07565b61084691508b3d8d1161077d5761076f8183612175565b3461010d57602036600319011261010d576001600435610f01610e7f8261221a565b50838060a01b0390541691610e938161221a565b50906002610ef5610ea38361221a565b50610ede60ff6004610ec26003610eb98961221a565b5001549761221a565b5001541695610ed7604051809b81930161244f565b0389612175565b610eee604051809481930161244f565b0382612175565b604051958695866120df565b0390f35b3461010d57602036600319011261010d576004356001600160401b03811161010d57610170610f3a60209236906004016121dc565b6129c5565b3461010d57606036600319011261010d576004356001600160401b03811161010d57610f6f903690600401612072565b610f7761205c565b60005460405162e32cf960e41b81526044946020946001600160a01b0394919390928735918790829060049082908a165afa90811561078457610fc69187916000916115e6575016331461266f565b610fd1368486612196565b948216948560005260028752610fed60406000205415156128cc565b6001600160a01b038316600090815260026020526040902054611018906000190161221a565b61221a565b509060405191826110368a8201928b8452600160408401910161244f565b039261104a601f1994858101835282612175565b5190209161106a6040519182610cf08c8201958d8752604083019061209f565b519020036115a25780151580611598575b156115545760016110a7611090368688612196565b6040519061109d8261215a565b6000825285612b1e565b1515146114f657600090600181036111be575050600260036110e36110138460018060a01b031660005260026020526000196040600020540190565b5001540361115457600080516020612cec8339815191529550916111499161101393600361112f60049687935b6001600160a01b03166000908152600260205260409020546000190190565b500155606060405196879687528601526060850191612700565b9060408301520390a1005b60405162461bcd60e51b815260048101869052603960248201527f6163636f756e74206973206e6f7420696e20616374697665207374617475732e818801527f206f7065726174696f6e2063616e6e6f7420626520646f6e65000000000000006064820152608490fd5b6002810361128f5750506001600160a01b0381166000908152600260205260409020546004906003906111f4906000190161221a565b5001540361122557600080516020612cec8339815191529550916111499161101393600361112f6002968793611110565b60405162461bcd60e51b815260048101869052603c60248201527f6163636f756e74206973206e6f7420696e2073757370656e6465642073746174818801527f75732e206f7065726174696f6e2063616e6e6f7420626520646f6e65000000006064820152608490fd5b6003810361135b5750506001600160a01b0381166000908152600260205260409020546005906003906112c5906000190161221a565b500154146112f657600080516020612cec8339815191529550916111499161101393600361112f6005968793611110565b60405162461bcd60e51b815260048101869052603860248201527f6163636f756e7420697320616c726561647920626c61636b6c69737465642e2081880152776f7065726174696f6e2063616e6e6f7420626520646f6e6560401b6064820152608490fd5b600481036114235750506001600160a01b038116600090815260026020526040902054600590600390611391906000190161221a565b500154036113c257600080516020612cec8339815191529550916111499161101393600361112f6007968793611110565b60405162461bcd60e51b815260048101869052603460248201527f6163636f756e74206973206e6f7420626c61636b6c69737465642e206f70657281880152736174696f6e2063616e6e6f7420626520646f6e6560601b6064820152608490fd5b909690600514611453575b50916111499186600361112f611013600080516020612cec8339815191529a97611110565b95506007600361147d6110138460018060a01b031660005260026020526000196040600020540190565b50015403611491576002955061114961142e565b60405162461bcd60e51b815260048101869052603860248201527f6163636f756e74207265636f76657279206e6f7420696e697469617465642e2081880152776f7065726174696f6e2063616e6e6f7420626520646f6e6560401b6064820152608490fd5b60405162461bcd60e51b815260048101879052603160248201527f737461747573206368616e6765206e6f7420706f737369626c6520666f72206f818901527072672061646d696e206163636f756e747360781b6064820152608490fd5b60405162461bcd60e51b815260048101879052601d60248201527f696e76616c696420737461747573206368616e6765207265717565737400000081890152606490fd5b506006811061107b565b60405162461bcd60e51b815260048101879052601860248201527f6163636f756e7420696e20646966666572656e74206f7267000000000000000081890152606490fd5b6115fd9150893d8b1161077d5761076f8183612175565b8a610215565b3461010d57602036600319011261010d57610f01611627611622612046565b612a57565b60405191829160208352602083019061209f565b3461010d57602036600319011261010d576001600160a01b0361165c612046565b1660005260076020526040806000206001815491015482519182526020820152f35b3461010d57604036600319011261010d57611697612046565b6024356001600160401b03811161010d576020916116bc610170923690600401612072565b91612918565b3461010d57602036600319011261010d57611700610f016116e96116e4612046565b6125b8565b60409291925193849360408552604085019061209f565b90838203602085015261209f565b3461010d57606036600319011261010d57611727612046565b60005460405162e32cf960e41b815260209160443591602435916001600160a01b03919085908290600490829086165afa9081156107845761177591839160009161189c575016331461266f565b841691826000526002845261179060406000205415156128cc565b81158015611892575b80611880575b15611843576118336118186110137f25bfd70c6ef699d541c53a5a44db124a2d26bd3901f37e24c67aaebb0700976e976040516117db8161213f565b86815260018982018781528960005260078b526040600020925183555191015560018060a01b031660005260026020526000196040600020540190565b5060806040519687968752860152600160808601910161244f565b91604084015260608301520390a1005b60405162461bcd60e51b8152600481018590526015602482015274195e1c1a5c9e481a5cc81a5b881d1a19481c185cdd605a1b6044820152606490fd5b5080158061179f57504281101561179f565b5043821015611799565b6118b39150873d891161077d5761076f8183612175565b88610215565b3461010d57600036600319011261010d576020600154604051908152f35b3461010d57602036600319011261010d57610f016118fb6118f6612046565b6124e4565b91604095939551958695866120df565b3461010d57602036600319011261010d576004356001600160401b03811161010d5761193b903690600401612072565b60005460405162e32cf960e41b81526001600160a01b039290916020908390600490829087165afa938415610784576119858460409661198a9560009161199b575016331461266f565b612766565b835191151582529091166020820152f35b6118b3915060203d811161077d5761076f8183612175565b3461010d57608036600319011261010d576119cc612046565b6024356001600160401b03811161010d576119eb903690600401612072565b916044356001600160401b03811161010d57611a0b903690600401612072565b92909360643515156064350361010d5760018060a01b03600054169162e32cf960e41b95866080526020608060046080875afa801561078457600090612010575b611a60906001600160a01b0316331461266f565b604051602081019060208252611a7e8161023c604082018b87612700565b519020604051602081019060208252611a9d8161023c604082016122a5565b519020141580611fae575b15611f4457611abe611ac6926020943691612196565b953691612196565b9460046040518094819382525afa801561078457611af791600091611f25575b506001600160a01b0316331461266f565b6001600160a01b0381166000908152600260205260409020546000198101939015611c9b57611b258461221a565b50938151946001600160401b03861161045f57611b5286611b49600284015461226b565b600284016126ac565b602095601f8111600114611c1357611bf29392611bce926002611b9e84611bb795600080516020612d0c8339815191529c600091611c0857508160011b916000199060031b1c19161790565b9101555b60026003611baf8361221a565b50015561221a565b50600401805460ff191660ff606435151516179055565b6103af60405194859460018060a01b0316855260a0602086015260a085019061209f565b60643515156060830152600260808301520390a1005b90508801518d610359565b6002820160005260206000209660005b601f1983168110611c83575092611bce926002600184600080516020612d0c8339815191529b611bf29998611bb797601f19811610611c6a575b5050811b01910155611ba2565b89015160001960f88460031b161c191690558c80611c5d565b85820151895560019098019760209182019101611c23565b9250600354600019811461073f576001018060035560018060a01b038216600052600260205260406000205560405192611cd484612124565b6001600160a01b038216845260208401838152604085018290526002606086015260643515156080860152600154600160401b81101561045f57806001611d1e920160015561221a565b91909161072957855182546001600160a01b0319166001600160a01b0391909116178255518051906001600160401b03821161045f57611d6582610526600186015461226b565b602090601f8311600114611eb357611d95929160009183611ea85750508160011b916000199060031b1c19161790565b60018201555b60408501519485516001600160401b03811161045f57611dc28161058d600286015461226b565b6020601f8211600114611e1e576080611e0e936105e684611bf2989795600495600080516020612d0c8339815191529d600092611e135750508160011b916000199060031b1c19161790565b611bce565b015190508d8061054b565b6002840160005260206000209760005b601f1984168110611e905750611e0e93600184600080516020612d0c8339815191529b600495608095611bf29b9a98601f19811610611e7757505050811b0160028501556105ec565b015160001960f88460031b161c191690558c808061066b565b828201518a5560019099019860209283019201611e2e565b01519050888061054b565b9190600184016000526020600020906000935b601f1984168510611f0a576001945083601f19811610611ef1575b505050811b016001820155611d9b565b015160001960f88460031b161c19169055878080611ee1565b81810151835560209485019460019093019290910190611ec6565b611f3e915060203d60201161077d5761076f8183612175565b85611ae6565b608460405162461bcd60e51b815260206004820152604060248201527f63616e6e6f742062652063616c6c65642066726f2061737369676e696e67206f60448201527f72672061646d696e20616e64206e6574776f726b2061646d696e20726f6c65736064820152fd5b50604051602080820152611fca8161023c604082018a86612700565b604051611fe78161023c602082019460208652604083019061209f565b5190206040516020810190602082526120068161023c60408201612341565b5190201415611aa8565b5060203d60201161203f575b6120388161202e611a60936080612175565b6080016080612650565b9050611a4c565b503d61201c565b600435906001600160a01b038216820361010d57565b602435906001600160a01b038216820361010d57565b9181601f8401121561010d578235916001600160401b03831161010d576020838186019501011161010d57565b919082519283825260005b8481106120cb575050826000602080949584010152601f8019910116010190565b6020818301810151848301820152016120aa565b93959491926121096080946121179360018060a01b0316875260a0602088015260a087019061209f565b90858203604087015261209f565b9460608401521515910152565b60a081019081106001600160401b0382111761045f57604052565b604081019081106001600160401b0382111761045f57604052565b602081019081106001600160401b0382111761045f57604052565b90601f801991011681019081106001600160401b0382111761045f57604052565b9291926001600160401b03821161045f57604051916121bf601f8201601f191660200184612175565b82948184528183011161010d578281602093846000960137010152565b9080601f8301121561010d578160206121f793359101612196565b90565b604051906122078261213f565b60048252634e4f4e4560e01b6020830152565b600154811015612255576005906001600052027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b90600182811c9216801561229b575b602083101461228557565b634e487b7160e01b600052602260045260246000fd5b91607f169161227a565b600454600092916122b58261226b565b90818152602092600190818116908160001461232457506001146122da575b50505050565b92939450906004600052600080516020612d2c83398151915292846000945b8386106123105750505050010190388080806122d4565b8054858701830152940193859082016122f9565b60ff191685840152505090151560051b01019150388080806122d4565b600554600092916123518261226b565b90818152602092600190818116908160001461232457506001146123755750505050565b929394509060056000527f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db092846000945b8386106123bd5750505050010190388080806122d4565b8054858701830152940193859082016123a6565b600454600092916123e18261226b565b90818152602092600190818116908160001461232457506001146124055750505050565b92939450906004600052600080516020612d2c83398151915292846000945b83861061243b5750505050010190388080806122d4565b805485870183015294019385908201612424565b80546000939261245e8261226b565b9182825260209360019182811690816000146124c55750600114612484575b5050505050565b90939495506000929192528360002092846000945b8386106124b15750505050010190388080808061247d565b805485870183015294019385908201612499565b60ff19168685015250505090151560051b01019150388080808061247d565b9060018060a01b0391828116600052600260205260406000205415612594576001600160a01b031660009081526002602052604090205460001901916125298361221a565b505416916125368161221a565b50926125418261221a565b5092600261258f600161257e60ff6004612568600361255f8b61221a565b5001549961221a565b500154169598610eee604051809481930161244f565b95610eee604051809481930161244f565b929190565b915061259e6121fa565b906040516125ab8161215a565b6000815290600090600090565b6001600160a01b03811660009081526002602052604090205415612633576001600160a01b0316600090815260026020526040902054600019019060026121f760016126226126106126098761221a565b509661221a565b5095610eee604051809481930161244f565b93610eee604051809481930161244f565b5061263c6121fa565b906040516126498161215a565b6000815290565b9081602091031261010d57516001600160a01b038116810361010d5790565b1561267657565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b90601f81116126ba57505050565b600091825260208220906020601f850160051c830194106126f6575b601f0160051c01915b8281106126eb57505050565b8181556001016126df565b90925082906126d6565b908060209392818452848401376000828201840152601f01601f1916010190565b919261274c60809461275a939897969860018060a01b0316855260a0602086015260a085019061244f565b90838203604085015261244f565b94151560608201520152565b919091612777610f3a368584612196565b6127845750600091508190565b6128c66040805194856127a36020958683019387855285840191612700565b03956127b7601f1997888101835282612175565b519020600090815260068452818120546001600160a01b0390811682526002602052604090912054909390610cf0906128bc906000190197600660036127fc8b61221a565b500155600461280a8a61221a565b5001805460ff19169055600080516020612d0c83398151915260028861282f8c61221a565b50541661287d61283e8d61221a565b50918d61284a8161221a565b5093600361286860ff600461285e8661221a565b500154169361221a565b5001549260018d519788970192019086612721565b0390a16128898961221a565b5085516128a681610dab8882019489865260028b8401910161244f565b51902094805193849186830196875282016123d1565b519020149361221a565b50541690565b156128d357565b60405162461bcd60e51b815260206004820152601760248201527f6163636f756e7420646f6573206e6f74206578697374730000000000000000006044820152606490fd5b6001600160a01b038116600090815260026020526040902054919291156129bd576001600160a01b0316600090815260026020526040902054610cf0906129b690612966906000190161221a565b506040519081612985602082019260208452600160408401910161244f565b0391612999601f1993848101835282612175565b519020946040519384916020830196602088526040840191612700565b5190201490565b505050600190565b6040908151602092838201848152826129e08382018661209f565b03926129f4601f1994858101835282612175565b519020600090815260068552819020546001600160a01b0392908316612a1e575050505050600090565b600294612a5394600692612a4285519182610cf0868201958787528983019061209f565b519020600052526000205416612acc565b1490565b6001600160a01b03811660009081526002602052604090205415612ac3576001600160a01b0316600090815260026020526040902054600019016003612a9c8261221a565b50015415612ac3576121f7612ab260029261221a565b50610eee604051809481930161244f565b506121f76121fa565b6001600160a01b03811660009081526002602052604090205415612b18576001600160a01b0316600090815260026020526040902054600390612b12906000190161221a565b50015490565b50600090565b9091612b2982612a57565b60409081519460209186612b46848201928584528683019061209f565b0396612b5a601f1998898101835282612175565b519020835183810190848252612b7581610dfe8882016123d1565b51902014612c0b578251612ba281612b96858201948686528783019061209f565b03888101835282612175565b5190206000526006815260018060a01b039283808460002054169516809514958615612bd2575b50505050505090565b8394959650612bf3600694519182610cf0868201958787528983019061209f565b51902060005252600020541614388080808080612bc9565b9490929193612c319060018060a01b031660005260026020526000196040600020540190565b94612c3b8661221a565b508551612c6481612c588882019489865260018b8401910161244f565b03858101835282612175565b519020908551612c8181612c58888201948986528a83019061209f565b51902014948515612c95575b505050505090565b612cdd9293949550612ca69061221a565b508551612cc381610dab8882019489865260018b8401910161244f565b51902094610cf0815194859287840197885283019061209f565b519020143880808080612c8d56fe36b0ea38154dec5e98b6bf928b971a9db5e8cd4b6946350e9e43fb9848c70b2568e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc7768a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19ba2646970667358221220cd782998372422b79b69e3202974370e1f995559831b1feffd496ce14622186f64736f6c63430008150033"
 
 // DeployAcctManager deploys a new Ethereum contract, binding an instance of AcctManager to it.
 func DeployAcctManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *AcctManager, error) {
@@ -194,7 +192,7 @@ func (_AcctManager *AcctManagerTransactorRaw) Transact(opts *bind.TransactOpts,
 
 // CheckOrgAdmin is a free data retrieval call binding the contract method 0xe8b42bf4.
 //
-// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) view returns(bool)
 func (_AcctManager *AcctManagerCaller) CheckOrgAdmin(opts *bind.CallOpts, _account common.Address, _orgId string, _ultParent string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -206,21 +204,21 @@ func (_AcctManager *AcctManagerCaller) CheckOrgAdmin(opts *bind.CallOpts, _accou
 
 // CheckOrgAdmin is a free data retrieval call binding the contract method 0xe8b42bf4.
 //
-// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) view returns(bool)
 func (_AcctManager *AcctManagerSession) CheckOrgAdmin(_account common.Address, _orgId string, _ultParent string) (bool, error) {
 	return _AcctManager.Contract.CheckOrgAdmin(&_AcctManager.CallOpts, _account, _orgId, _ultParent)
 }
 
 // CheckOrgAdmin is a free data retrieval call binding the contract method 0xe8b42bf4.
 //
-// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function checkOrgAdmin(address _account, string _orgId, string _ultParent) view returns(bool)
 func (_AcctManager *AcctManagerCallerSession) CheckOrgAdmin(_account common.Address, _orgId string, _ultParent string) (bool, error) {
 	return _AcctManager.Contract.CheckOrgAdmin(&_AcctManager.CallOpts, _account, _orgId, _ultParent)
 }
 
 // GetAccountDetails is a free data retrieval call binding the contract method 0x2aceb534.
 //
-// Solidity: function getAccountDetails(address _account) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetails(address _account) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerCaller) GetAccountDetails(opts *bind.CallOpts, _account common.Address) (common.Address, string, string, *big.Int, bool, error) {
 	var (
 		ret0 = new(common.Address)
@@ -242,21 +240,21 @@ func (_AcctManager *AcctManagerCaller) GetAccountDetails(opts *bind.CallOpts, _a
 
 // GetAccountDetails is a free data retrieval call binding the contract method 0x2aceb534.
 //
-// Solidity: function getAccountDetails(address _account) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetails(address _account) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerSession) GetAccountDetails(_account common.Address) (common.Address, string, string, *big.Int, bool, error) {
 	return _AcctManager.Contract.GetAccountDetails(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountDetails is a free data retrieval call binding the contract method 0x2aceb534.
 //
-// Solidity: function getAccountDetails(address _account) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetails(address _account) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerCallerSession) GetAccountDetails(_account common.Address) (common.Address, string, string, *big.Int, bool, error) {
 	return _AcctManager.Contract.GetAccountDetails(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountDetailsFromIndex is a free data retrieval call binding the contract method 0xb2018568.
 //
-// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerCaller) GetAccountDetailsFromIndex(opts *bind.CallOpts, _aIndex *big.Int) (common.Address, string, string, *big.Int, bool, error) {
 	var (
 		ret0 = new(common.Address)
@@ -278,21 +276,51 @@ func (_AcctManager *AcctManagerCaller) GetAccountDetailsFromIndex(opts *bind.Cal
 
 // GetAccountDetailsFromIndex is a free data retrieval call binding the contract method 0xb2018568.
 //
-// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerSession) GetAccountDetailsFromIndex(_aIndex *big.Int) (common.Address, string, string, *big.Int, bool, error) {
 	return _AcctManager.Contract.GetAccountDetailsFromIndex(&_AcctManager.CallOpts, _aIndex)
 }
 
 // GetAccountDetailsFromIndex is a free data retrieval call binding the contract method 0xb2018568.
 //
-// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) constant returns(address, string, string, uint256, bool)
+// Solidity: function getAccountDetailsFromIndex(uint256 _aIndex) view returns(address, string, string, uint256, bool)
 func (_AcctManager *AcctManagerCallerSession) GetAccountDetailsFromIndex(_aIndex *big.Int) (common.Address, string, string, *big.Int, bool, error) {
 	return _AcctManager.Contract.GetAccountDetailsFromIndex(&_AcctManager.CallOpts, _aIndex)
 }
 
+// GetAccountExpiry is a free data retrieval call binding the contract method 0x7843ad79.
+//
+// Solidity: function getAccountExpiry(address _account) view returns(uint256, uint256)
+func (_AcctManager *AcctManagerCaller) GetAccountExpiry(opts *bind.CallOpts, _account common.Address) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _AcctManager.contract.Call(opts, out, "getAccountExpiry", _account)
+	return *ret0, *ret1, err
+}
+
+// GetAccountExpiry is a free data retrieval call binding the contract method 0x7843ad79.
+//
+// Solidity: function getAccountExpiry(address _account) view returns(uint256, uint256)
+func (_AcctManager *AcctManagerSession) GetAccountExpiry(_account common.Address) (*big.Int, *big.Int, error) {
+	return _AcctManager.Contract.GetAccountExpiry(&_AcctManager.CallOpts, _account)
+}
+
+// GetAccountExpiry is a free data retrieval call binding the contract method 0x7843ad79.
+//
+// Solidity: function getAccountExpiry(address _account) view returns(uint256, uint256)
+func (_AcctManager *AcctManagerCallerSession) GetAccountExpiry(_account common.Address) (*big.Int, *big.Int, error) {
+	return _AcctManager.Contract.GetAccountExpiry(&_AcctManager.CallOpts, _account)
+}
+
 // GetAccountOrgRole is a free data retrieval call binding the contract method 0x6acee5fd.
 //
-// Solidity: function getAccountOrgRole(address _account) constant returns(string, string)
+// Solidity: function getAccountOrgRole(address _account) view returns(string, string)
 func (_AcctManager *AcctManagerCaller) GetAccountOrgRole(opts *bind.CallOpts, _account common.Address) (string, string, error) {
 	var (
 		ret0 = new(string)
@@ -308,21 +336,21 @@ func (_AcctManager *AcctManagerCaller) GetAccountOrgRole(opts *bind.CallOpts, _a
 
 // GetAccountOrgRole is a free data retrieval call binding the contract method 0x6acee5fd.
 //
-// Solidity: function getAccountOrgRole(address _account) constant returns(string, string)
+// Solidity: function getAccountOrgRole(address _account) view returns(string, string)
 func (_AcctManager *AcctManagerSession) GetAccountOrgRole(_account common.Address) (string, string, error) {
 	return _AcctManager.Contract.GetAccountOrgRole(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountOrgRole is a free data retrieval call binding the contract method 0x6acee5fd.
 //
-// Solidity: function getAccountOrgRole(address _account) constant returns(string, string)
+// Solidity: function getAccountOrgRole(address _account) view returns(string, string)
 func (_AcctManager *AcctManagerCallerSession) GetAccountOrgRole(_account common.Address) (string, string, error) {
 	return _AcctManager.Contract.GetAccountOrgRole(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountRole is a free data retrieval call binding the contract method 0x81d66b23.
 //
-// Solidity: function getAccountRole(address _account) constant returns(string)
+// Solidity: function getAccountRole(address _account) view returns(string)
 func (_AcctManager *AcctManagerCaller) GetAccountRole(opts *bind.CallOpts, _account common.Address) (string, error) {
 	var (
 		ret0 = new(string)
@@ -334,21 +362,21 @@ func (_AcctManager *AcctManagerCaller) GetAccountRole(opts *bind.CallOpts, _acco
 
 // GetAccountRole is a free data retrieval call binding the contract method 0x81d66b23.
 //
-// Solidity: function getAccountRole(address _account) constant returns(string)
+// Solidity: function getAccountRole(address _account) view returns(string)
 func (_AcctManager *AcctManagerSession) GetAccountRole(_account common.Address) (string, error) {
 	return _AcctManager.Contract.GetAccountRole(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountRole is a free data retrieval call binding the contract method 0x81d66b23.
 //
-// Solidity: function getAccountRole(address _account) constant returns(string)
+// Solidity: function getAccountRole(address _account) view returns(string)
 func (_AcctManager *AcctManagerCallerSession) GetAccountRole(_account common.Address) (string, error) {
 	return _AcctManager.Contract.GetAccountRole(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountStatus is a free data retrieval call binding the contract method 0xfd4fa05a.
 //
-// Solidity: function getAccountStatus(address _account) constant returns(uint256)
+// Solidity: function getAccountStatus(address _account) view returns(uint256)
 func (_AcctManager *AcctManagerCaller) GetAccountStatus(opts *bind.CallOpts, _account common.Address) (*big.Int, error) {
 	var (
 		ret0 = new(*big.Int)
@@ -360,21 +388,21 @@ func (_AcctManager *AcctManagerCaller) GetAccountStatus(opts *bind.CallOpts, _ac
 
 // GetAccountStatus is a free data retrieval call binding the contract method 0xfd4fa05a.
 //
-// Solidity: function getAccountStatus(address _account) constant returns(uint256)
+// Solidity: function getAccountStatus(address _account) view returns(uint256)
 func (_AcctManager *AcctManagerSession) GetAccountStatus(_account common.Address) (*big.Int, error) {
 	return _AcctManager.Contract.GetAccountStatus(&_AcctManager.CallOpts, _account)
 }
 
 // GetAccountStatus is a free data retrieval call binding the contract method 0xfd4fa05a.
 //
-// Solidity: function getAccountStatus(address _account) constant returns(uint256)
+// Solidity: function getAccountStatus(address _account) view returns(uint256)
 func (_AcctManager *AcctManagerCallerSession) GetAccountStatus(_account common.Address) (*big.Int, error) {
 	return _AcctManager.Contract.GetAccountStatus(&_AcctManager.CallOpts, _account)
 }
 
 // GetNumberOfAccounts is a free data retrieval call binding the contract method 0x309e36ef.
 //
-// Solidity: function getNumberOfAccounts() constant returns(uint256)
+// Solidity: function getNumberOfAccounts() view returns(uint256)
 func (_AcctManager *AcctManagerCaller) GetNumberOfAccounts(opts *bind.CallOpts) (*big.Int, error) {
 	var (
 		ret0 = new(*big.Int)
@@ -386,21 +414,47 @@ func (_AcctManager *AcctManagerCaller) GetNumberOfAccounts(opts *bind.CallOpts)
 
 // GetNumberOfAccounts is a free data retrieval call binding the contract method 0x309e36ef.
 //
-// Solidity: function getNumberOfAccounts() constant returns(uint256)
+// Solidity: function getNumberOfAccounts() view returns(uint256)
 func (_AcctManager *AcctManagerSession) GetNumberOfAccounts() (*big.Int, error) {
 	return _AcctManager.Contract.GetNumberOfAccounts(&_AcctManager.CallOpts)
 }
 
 // GetNumberOfAccounts is a free data retrieval call binding the contract method 0x309e36ef.
 //
-// Solidity: function getNumberOfAccounts() constant returns(uint256)
+// Solidity: function getNumberOfAccounts() view returns(uint256)
 func (_AcctManager *AcctManagerCallerSession) GetNumberOfAccounts() (*big.Int, error) {
 	return _AcctManager.Contract.GetNumberOfAccounts(&_AcctManager.CallOpts)
 }
 
+// IsAccountExpired is a free data retrieval call binding the contract method 0xe25f006b.
+//
+// Solidity: function isAccountExpired(address _account) view returns(bool)
+func (_AcctManager *AcctManagerCaller) IsAccountExpired(opts *bind.CallOpts, _account common.Address) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _AcctManager.contract.Call(opts, out, "isAccountExpired", _account)
+	return *ret0, err
+}
+
+// IsAccountExpired is a free data retrieval call binding the contract method 0xe25f006b.
+//
+// Solidity: function isAccountExpired(address _account) view returns(bool)
+func (_AcctManager *AcctManagerSession) IsAccountExpired(_account common.Address) (bool, error) {
+	return _AcctManager.Contract.IsAccountExpired(&_AcctManager.CallOpts, _account)
+}
+
+// IsAccountExpired is a free data retrieval call binding the contract method 0xe25f006b.
+//
+// Solidity: function isAccountExpired(address _account) view returns(bool)
+func (_AcctManager *AcctManagerCallerSession) IsAccountExpired(_account common.Address) (bool, error) {
+	return _AcctManager.Contract.IsAccountExpired(&_AcctManager.CallOpts, _account)
+}
+
 // OrgAdminExists is a free data retrieval call binding the contract method 0x950145cf.
 //
-// Solidity: function orgAdminExists(string _orgId) constant returns(bool)
+// Solidity: function orgAdminExists(string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerCaller) OrgAdminExists(opts *bind.CallOpts, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -412,21 +466,21 @@ func (_AcctManager *AcctManagerCaller) OrgAdminExists(opts *bind.CallOpts, _orgI
 
 // OrgAdminExists is a free data retrieval call binding the contract method 0x950145cf.
 //
-// Solidity: function orgAdminExists(string _orgId) constant returns(bool)
+// Solidity: function orgAdminExists(string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerSession) OrgAdminExists(_orgId string) (bool, error) {
 	return _AcctManager.Contract.OrgAdminExists(&_AcctManager.CallOpts, _orgId)
 }
 
 // OrgAdminExists is a free data retrieval call binding the contract method 0x950145cf.
 //
-// Solidity: function orgAdminExists(string _orgId) constant returns(bool)
+// Solidity: function orgAdminExists(string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerCallerSession) OrgAdminExists(_orgId string) (bool, error) {
 	return _AcctManager.Contract.OrgAdminExists(&_AcctManager.CallOpts, _orgId)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerCaller) ValidateAccount(opts *bind.CallOpts, _account common.Address, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -438,14 +492,14 @@ func (_AcctManager *AcctManagerCaller) ValidateAccount(opts *bind.CallOpts, _acc
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _AcctManager.Contract.ValidateAccount(&_AcctManager.CallOpts, _account, _orgId)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_AcctManager *AcctManagerCallerSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _AcctManager.Contract.ValidateAccount(&_AcctManager.CallOpts, _account, _orgId)
 }
@@ -534,6 +588,27 @@ func (_AcctManager *AcctManagerTransactorSession) RemoveExistingAdmin(_orgId str
 	return _AcctManager.Contract.RemoveExistingAdmin(&_AcctManager.TransactOpts, _orgId)
 }
 
+// SetAccountExpiry is a paid mutator transaction binding the contract method 0x39186c35.
+//
+// Solidity: function setAccountExpiry(address _account, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_AcctManager *AcctManagerTransactor) SetAccountExpiry(opts *bind.TransactOpts, _account common.Address, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.contract.Transact(opts, "setAccountExpiry", _account, _expiryBlock, _expiryTime)
+}
+
+// SetAccountExpiry is a paid mutator transaction binding the contract method 0x39186c35.
+//
+// Solidity: function setAccountExpiry(address _account, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_AcctManager *AcctManagerSession) SetAccountExpiry(_account common.Address, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetAccountExpiry(&_AcctManager.TransactOpts, _account, _expiryBlock, _expiryTime)
+}
+
+// SetAccountExpiry is a paid mutator transaction binding the contract method 0x39186c35.
+//
+// Solidity: function setAccountExpiry(address _account, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_AcctManager *AcctManagerTransactorSession) SetAccountExpiry(_account common.Address, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetAccountExpiry(&_AcctManager.TransactOpts, _account, _expiryBlock, _expiryTime)
+}
+
 // SetDefaults is a paid mutator transaction binding the contract method 0xcef7f6af.
 //
 // Solidity: function setDefaults(string _nwAdminRole, string _oAdminRole) returns()
@@ -576,6 +651,144 @@ func (_AcctManager *AcctManagerTransactorSession) UpdateAccountStatus(_orgId str
 	return _AcctManager.Contract.UpdateAccountStatus(&_AcctManager.TransactOpts, _orgId, _account, _action)
 }
 
+// AcctManagerAccountAccessExpirySetIterator is returned from FilterAccountAccessExpirySet and is used to iterate over the raw logs and unpacked data for AccountAccessExpirySet events raised by the AcctManager contract.
+type AcctManagerAccountAccessExpirySetIterator struct {
+	Event *AcctManagerAccountAccessExpirySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AcctManagerAccountAccessExpirySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AcctManagerAccountAccessExpirySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AcctManagerAccountAccessExpirySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AcctManagerAccountAccessExpirySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AcctManagerAccountAccessExpirySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AcctManagerAccountAccessExpirySet represents a AccountAccessExpirySet event raised by the AcctManager contract.
+type AcctManagerAccountAccessExpirySet struct {
+	Account     common.Address
+	OrgId       string
+	ExpiryBlock *big.Int
+	ExpiryTime  *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterAccountAccessExpirySet is a free log retrieval operation binding the contract event 0x25bfd70c6ef699d541c53a5a44db124a2d26bd3901f37e24c67aaebb0700976e.
+//
+// Solidity: event AccountAccessExpirySet(address _account, string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_AcctManager *AcctManagerFilterer) FilterAccountAccessExpirySet(opts *bind.FilterOpts) (*AcctManagerAccountAccessExpirySetIterator, error) {
+
+	logs, sub, err := _AcctManager.contract.FilterLogs(opts, "AccountAccessExpirySet")
+	if err != nil {
+		return nil, err
+	}
+	return &AcctManagerAccountAccessExpirySetIterator{contract: _AcctManager.contract, event: "AccountAccessExpirySet", logs: logs, sub: sub}, nil
+}
+
+var AccountAccessExpirySetTopicHash = "0x25bfd70c6ef699d541c53a5a44db124a2d26bd3901f37e24c67aaebb0700976e"
+
+// WatchAccountAccessExpirySet is a free log subscription operation binding the contract event 0x25bfd70c6ef699d541c53a5a44db124a2d26bd3901f37e24c67aaebb0700976e.
+//
+// Solidity: event AccountAccessExpirySet(address _account, string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_AcctManager *AcctManagerFilterer) WatchAccountAccessExpirySet(opts *bind.WatchOpts, sink chan<- *AcctManagerAccountAccessExpirySet) (event.Subscription, error) {
+
+	logs, sub, err := _AcctManager.contract.WatchLogs(opts, "AccountAccessExpirySet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AcctManagerAccountAccessExpirySet)
+				if err := _AcctManager.contract.UnpackLog(event, "AccountAccessExpirySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAccountAccessExpirySet is a log parse operation binding the contract event 0x25bfd70c6ef699d541c53a5a44db124a2d26bd3901f37e24c67aaebb0700976e.
+//
+// Solidity: event AccountAccessExpirySet(address _account, string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_AcctManager *AcctManagerFilterer) ParseAccountAccessExpirySet(log types.Log) (*AcctManagerAccountAccessExpirySet, error) {
+	event := new(AcctManagerAccountAccessExpirySet)
+	if err := _AcctManager.contract.UnpackLog(event, "AccountAccessExpirySet", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // AcctManagerAccountAccessModifiedIterator is returned from FilterAccountAccessModified and is used to iterate over the raw logs and unpacked data for AccountAccessModified events raised by the AcctManager contract.
 type AcctManagerAccountAccessModifiedIterator struct {
 	Event *AcctManagerAccountAccessModified // Event containing the contract specifics and raw log
@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // OrgManagerABI is the input ABI used to generate the binding from.
-const OrgManagerABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrg\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatusUpdate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getUltimateParent\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgActive\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgIndex\",\"type\":\"uint256\"}],\"name\":\"getOrgInfo\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getSubOrgIndexes\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfOrgs\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_orgStatus\",\"type\":\"uint256\"}],\"name\":\"checkOrgStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"setUpOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getOrgDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgExists\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgPendingApproval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspended\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspensionRevoked\",\"type\":\"event\"}]"
+const OrgManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgPendingApproval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspended\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"OrgSuspensionExpirySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspensionRevoked\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatusUpdate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_orgStatus\",\"type\":\"uint256\"}],\"name\":\"checkOrgStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfOrgs\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getOrgDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_orgIndex\",\"type\":\"uint256\"}],\"name\":\"getOrgInfo\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getSubOrgIndexes\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getSuspensionExpiry\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getUltimateParent\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"setSuspensionExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"setUpOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrg\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var OrgManagerParsedABI, _ = abi.JSON(strings.NewReader(OrgManagerABI))
 
 // OrgManagerBin is the compiled bytecode used for deploying new contracts.
-var OrgManagerBin = "0x608060405260018054600160a01b60ff021916905560046002819055600355600060065534801561002f57600080fd5b50604051602080613bb58339810180604052602081101561004f57600080fd5b5051600180546001600160a01b0319166001600160a01b03909216919091179055613b368061007f6000396000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c80637755ebdd1161008c578063e302831611610066578063e302831614610787578063f4d6d9f5146107f5578063f9953de514610863578063ffe40d1d146108d1576100ea565b80637755ebdd146106655780638c8642df1461066d5780639e58eb9f14610713576100ea565b80631f953480116100c85780631f953480146102c25780633fd62ae7146103805780635c4f32ee146104385780635e99f6e5146105a7576100ea565b80630cc27493146100ef57806314f775f91461016f578063177c8d8a146101df575b600080fd5b61015d6004803603604081101561010557600080fd5b810190602081018135600160201b81111561011f57600080fd5b82018360208201111561013157600080fd5b803590602001918460018302840111600160201b8311171561015257600080fd5b919350915035610975565b60408051918252519081900360200190f35b6101dd6004803603604081101561018557600080fd5b810190602081018135600160201b81111561019f57600080fd5b8201836020820111156101b157600080fd5b803590602001918460018302840111600160201b831117156101d257600080fd5b919350915035610d07565b005b61024d600480360360208110156101f557600080fd5b810190602081018135600160201b81111561020f57600080fd5b82018360208201111561022157600080fd5b803590602001918460018302840111600160201b8311171561024257600080fd5b509092509050610ef9565b6040805160208082528351818301528351919283929083019185019080838360005b8381101561028757818101518382015260200161026f565b50505050905090810190601f1680156102b45780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6101dd600480360360408110156102d857600080fd5b810190602081018135600160201b8111156102f257600080fd5b82018360208201111561030457600080fd5b803590602001918460018302840111600160201b8311171561032557600080fd5b919390929091602081019035600160201b81111561034257600080fd5b82018360208201111561035457600080fd5b803590602001918460018302840111600160201b8311171561037557600080fd5b5090925090506110ae565b6104246004803603602081101561039657600080fd5b810190602081018135600160201b8111156103b057600080fd5b8201836020820111156103c257600080fd5b803590602001918460018302840111600160201b831117156103e357600080fd5b91908080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525092955061128c945050505050565b604080519115158252519081900360200190f35b6104556004803603602081101561044e57600080fd5b5035611499565b60405180806020018060200180602001868152602001858152602001848103845289818151815260200191508051906020019080838360005b838110156104a657818101518382015260200161048e565b50505050905090810190601f1680156104d35780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b838110156105065781810151838201526020016104ee565b50505050905090810190601f1680156105335780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b8381101561056657818101518382015260200161054e565b50505050905090810190601f1680156105935780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390f35b610615600480360360208110156105bd57600080fd5b810190602081018135600160201b8111156105d757600080fd5b8201836020820111156105e957600080fd5b803590602001918460018302840111600160201b8311171561060a57600080fd5b50909250905061170e565b60408051602080825283518183015283519192839290830191858101910280838360005b83811015610651578181015183820152602001610639565b505050509050019250505060405180910390f35b61015d61185b565b6104246004803603604081101561068357600080fd5b810190602081018135600160201b81111561069d57600080fd5b8201836020820111156106af57600080fd5b803590602001918460018302840111600160201b831117156106d057600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505091359250611862915050565b6101dd6004803603606081101561072957600080fd5b810190602081018135600160201b81111561074357600080fd5b82018360208201111561075557600080fd5b803590602001918460018302840111600160201b8311171561077657600080fd5b9193509150803590602001356119ba565b6101dd6004803603602081101561079d57600080fd5b810190602081018135600160201b8111156107b757600080fd5b8201836020820111156107c957600080fd5b803590602001918460018302840111600160201b831117156107ea57600080fd5b509092509050611ae6565b6104556004803603602081101561080b57600080fd5b810190602081018135600160201b81111561082557600080fd5b82018360208201111561083757600080fd5b803590602001918460018302840111600160201b8311171561085857600080fd5b509092509050611ef1565b6101dd6004803603602081101561087957600080fd5b810190602081018135600160201b81111561089357600080fd5b8201836020820111156108a557600080fd5b803590602001918460018302840111600160201b831117156108c657600080fd5b50909250905061225a565b610424600480360360208110156108e757600080fd5b810190602081018135600160201b81111561090157600080fd5b82018360208201111561091357600080fd5b803590602001918460018302840111600160201b8311171561093457600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550612407945050505050565b60015460408051600160e41b62e32cf902815290516000926001600160a01b031691630e32cf90916004808301926020929190829003018186803b1580156109bc57600080fd5b505afa1580156109d0573d6000803e3d6000fd5b505050506040513d60208110156109e657600080fd5b50516001600160a01b03163314610a3b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b83838080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250610a7d92508391506124079050565b1515600114610ace5760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b8260011480610add5750826002145b1515610b1d57604051600160e51b62461bcd028152600401808060200182810382526025815260200180613a646025913960400191505060405180910390fd5b6000610b5e86868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b9050600481815481101515610b6f57fe5b9060005260206000209060080201600601546001141515610bc457604051600160e51b62461bcd028152600401808060200182810382526027815260200180613a896027913960400191505060405180910390fd5b6000808560011415610bdb57506002905080610bec565b8560021415610bec57506004905060035b610c2d88888080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250869250611862915050565b1515600114610c7057604051600160e51b62461bcd028152600401808060200182810382526027815260200180613ab06027913960400191505060405180910390fd5b8560011415610cbd57610cb888888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061252292505050565b610cfc565b610cfc88888080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506127e492505050565b979650505050505050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610d5557600080fd5b505afa158015610d69573d6000803e3d6000fd5b505050506040513d6020811015610d7f57600080fd5b50516001600160a01b03163314610dd45760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b82828080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250610e1692508391506124079050565b1515600114610e675760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b8160011415610eb457610eaf84848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061299192505050565b610ef3565b610ef384848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250612c4f92505050565b50505050565b60015460408051600160e41b62e32cf902815290516060926001600160a01b031691630e32cf90916004808301926020929190829003018186803b158015610f4057600080fd5b505afa158015610f54573d6000803e3d6000fd5b505050506040513d6020811015610f6a57600080fd5b50516001600160a01b03163314610fbf5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b600461100084848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b8154811061100a57fe5b6000918252602091829020600460089092020101805460408051601f60026000196101006001871615020190941693909304928301859004850281018501909152818152928301828280156110a05780601f10611075576101008083540402835291602001916110a0565b820191906000526020600020905b81548152906001019060200180831161108357829003601f168201915b505050505090505b92915050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156110fc57600080fd5b505afa158015611110573d6000803e3d6000fd5b505050506040513d602081101561112657600080fd5b50516001600160a01b0316331461117b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8383838360405160200180858580828437600160f91b601702920191825250600101838380828437808301925050509450505050506040516020818303038152906040526111c881612407565b1561120d5760408051600160e51b62461bcd02815260206004820152600a6024820152600160b01b696f72672065786973747302604482015290519081900360640190fd5b61128585858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8901819004810282018101909252878152925087915086908190840183828082843760009201919091525060029250829150612d0e9050565b5050505050565b600060056000836040516020018082805190602001908083835b602083106112c55780518252601f1990920191602091820191016112a6565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014151561149057600061132383612495565b905060048181548110151561133457fe5b906000526020600020906008020160010154600214806113735750600480548290811061135d57fe5b9060005260206000209060080201600101546003145b1561148e57600061142c60048381548110151561138c57fe5b6000918252602091829020600460089092020101805460408051601f60026000196101006001871615020190941693909304928301859004850281018501909152818152928301828280156114225780601f106113f757610100808354040283529160200191611422565b820191906000526020600020905b81548152906001019060200180831161140557829003601f168201915b5050505050612495565b905060048181548110151561143d57fe5b9060005260206000209060080201600101546002148061147c5750600480548290811061146657fe5b9060005260206000209060080201600101546003145b1561148c57600192505050611494565b505b505b5060005b919050565b60608060606000806004868154811015156114b057fe5b90600052602060002090600802016000016004878154811015156114d057fe5b90600052602060002090600802016002016004888154811015156114f057fe5b906000526020600020906008020160040160048981548110151561151057fe5b90600052602060002090600802016006015460048a81548110151561153157fe5b906000526020600020906008020160010154848054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156115d85780601f106115ad576101008083540402835291602001916115d8565b820191906000526020600020905b8154815290600101906020018083116115bb57829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a50899450925084019050828280156116665780601f1061163b57610100808354040283529160200191611666565b820191906000526020600020905b81548152906001019060200180831161164957829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156116f45780601f106116c9576101008083540402835291602001916116f4565b820191906000526020600020905b8154815290600101906020018083116116d757829003601f168201915b505050505092509450945094509450945091939590929450565b606061174f83838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061240792505050565b15156001146117a05760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b60006117e184848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b90506004818154811015156117f257fe5b906000526020600020906008020160070180548060200260200160405190810160405280929190818152602001828054801561184d57602002820191906000526020600020905b815481526020019060010190808311611839575b505050505091505092915050565b6004545b90565b600060056000846040516020018082805190602001908083835b6020831061189b5780518252601f19909201916020918201910161187c565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014156118f5575060006110a8565b600061190084612495565b905060056000856040516020018082805190602001908083835b602083106119395780518252601f19909201916020918201910161191a565b6001836020036101000a038019825116818451168082178552505050505050905001915050604051602081830303815290604052805190602001208152602001908152602001600020546000141580156119b257508260048281548110151561199e57fe5b906000526020600020906008020160010154145b949350505050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611a0857600080fd5b505afa158015611a1c573d6000803e3d6000fd5b505050506040513d6020811015611a3257600080fd5b50516001600160a01b03163314611a875760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b611adc6040518060200160405280600081525085858080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506001925060029150612d0e9050565b6002556003555050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611b3457600080fd5b505afa158015611b48573d6000803e3d6000fd5b505050506040513d6020811015611b5e57600080fd5b50516001600160a01b03163314611bb35760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b611bf582828080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525060019250611862915050565b1515600114611c465760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b6000611c8783838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b90506002600482815481101515611c9a57fe5b9060005260206000209060080201600101819055507fd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591c600482815481101515611cdf57fe5b9060005260206000209060080201600001600483815481101515611cff57fe5b9060005260206000209060080201600201600484815481101515611d1f57fe5b9060005260206000209060080201600401600485815481101515611d3f57fe5b906000526020600020906008020160060154600260405180806020018060200180602001868152602001858152602001848103845289818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015611df05780601f10611dc557610100808354040283529160200191611df0565b820191906000526020600020905b815481529060010190602001808311611dd357829003601f168201915b5050848103835288546002600019610100600184161502019091160480825260209091019089908015611e645780601f10611e3957610100808354040283529160200191611e64565b820191906000526020600020905b815481529060010190602001808311611e4757829003601f168201915b5050848103825287546002600019610100600184161502019091160480825260209091019088908015611ed85780601f10611ead57610100808354040283529160200191611ed8565b820191906000526020600020905b815481529060010190602001808311611ebb57829003601f168201915b50509850505050505050505060405180910390a1505050565b6060806060600080611f3887878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061240792505050565b1515611fa757868660008083838080601f016020809104026020016040519081016040528093929190818152602001838380828437600092018290525060408051602080820183528382528251908101909252918152949d509b50929950939750919550612250945050505050565b6000611fe888888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b9050600481815481101515611ff957fe5b906000526020600020906008020160000160048281548110151561201957fe5b906000526020600020906008020160020160048381548110151561203957fe5b906000526020600020906008020160040160048481548110151561205957fe5b90600052602060002090600802016006015460048581548110151561207a57fe5b906000526020600020906008020160010154848054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156121215780601f106120f657610100808354040283529160200191612121565b820191906000526020600020905b81548152906001019060200180831161210457829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a50899450925084019050828280156121af5780601f10612184576101008083540402835291602001916121af565b820191906000526020600020905b81548152906001019060200180831161219257829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529599508894509250840190508282801561223d5780601f106122125761010080835404028352916020019161223d565b820191906000526020600020905b81548152906001019060200180831161222057829003601f168201915b5050505050925095509550955095509550505b9295509295909350565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156122a857600080fd5b505afa1580156122bc573d6000803e3d6000fd5b505050506040513d60208110156122d257600080fd5b50516001600160a01b031633146123275760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b81818080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061236992508391506124079050565b156123ae5760408051600160e51b62461bcd02815260206004820152600a6024820152600160b01b696f72672065786973747302604482015290519081900360640190fd5b6124026040518060200160405280600081525084848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525060019250829150612d0e9050565b505050565b600060056000836040516020018082805190602001908083835b602083106124405780518252601f199092019160209182019101612421565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014159050919050565b6000600160056000846040516020018082805190602001908083835b602083106124d05780518252601f1990920191602091820191016124b1565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054039050919050565b61252d816002611862565b151560011461257057604051600160e51b62461bcd028152600401808060200182810382526034815260200180613ad76034913960400191505060405180910390fd5b600061257b82612495565b9050600360048281548110151561258e57fe5b9060005260206000209060080201600101819055507f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156125d357fe5b90600052602060002090600802016000016004838154811015156125f357fe5b906000526020600020906008020160020160048481548110151561261357fe5b906000526020600020906008020160040160048581548110151561263357fe5b9060005260206000209060080201600601546003604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156126e45780601f106126b9576101008083540402835291602001916126e4565b820191906000526020600020905b8154815290600101906020018083116126c757829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156127585780601f1061272d57610100808354040283529160200191612758565b820191906000526020600020905b81548152906001019060200180831161273b57829003601f168201915b50508481038252875460026000196101006001841615020190911604808252602090910190889080156127cc5780601f106127a1576101008083540402835291602001916127cc565b820191906000526020600020905b8154815290600101906020018083116127af57829003601f168201915b50509850505050505050505060405180910390a15050565b6127ef816004611862565b15156001146128485760408051600160e51b62461bcd02815260206004820152601a60248201527f6f7267206e6f7420696e2073757370656e646564207374617465000000000000604482015290519081900360640190fd5b600061285382612495565b9050600560048281548110151561286657fe5b9060005260206000209060080201600101819055507f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156128ab57fe5b90600052602060002090600802016000016004838154811015156128cb57fe5b90600052602060002090600802016002016004848154811015156128eb57fe5b906000526020600020906008020160040160048581548110151561290b57fe5b9060005260206000209060080201600601546005604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156126e45780601f106126b9576101008083540402835291602001916126e4565b61299c816003611862565b15156001146129ed5760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b60006129f882612495565b905060048082815481101515612a0a57fe5b9060005260206000209060080201600101819055507f73ccf8d6c8385bf5347269bd59712da33183c1a5e1702494bcdb87d0f4674d96600482815481101515612a4f57fe5b9060005260206000209060080201600001600483815481101515612a6f57fe5b9060005260206000209060080201600201600484815481101515612a8f57fe5b9060005260206000209060080201600401600485815481101515612aaf57fe5b600091825260209182902060066008909202010154604080516060810183905260808082528754600260001961010060018416150201909116049082018190529293909283929183019183019060a084019089908015612b505780601f10612b2557610100808354040283529160200191612b50565b820191906000526020600020905b815481529060010190602001808311612b3357829003601f168201915b5050848103835287546002600019610100600184161502019091160480825260209091019088908015612bc45780601f10612b9957610100808354040283529160200191612bc4565b820191906000526020600020905b815481529060010190602001808311612ba757829003601f168201915b5050848103825286546002600019610100600184161502019091160480825260209091019087908015612c385780601f10612c0d57610100808354040283529160200191612c38565b820191906000526020600020905b815481529060010190602001808311612c1b57829003601f168201915b505097505050505050505060405180910390a15050565b612c5a816005611862565b1515600114612cab5760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b6000612cb682612495565b90506002600482815481101515612cc957fe5b9060005260206000209060080201600101819055507f882f030c609566cd82918a97d457fd48f9cfcefd11282e2654cde3f94579c15f600482815481101515612a4f57fe5b600080806001851415612d9057856040516020018082805190602001908083835b60208310612d4e5780518252601f199092019160209182019101612d2f565b6001836020036101000a038019825116818451168082178552505050505050905001915050604051602081830303815290604052805190602001209150612ecf565b866040516020018082805190602001908083835b60208310612dc35780518252601f199092019160209182019101612da4565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120925086866040516020018083805190602001908083835b60208310612e345780518252601f199092019160209182019101612e15565b6001836020036101000a03801982511681845116808217855250505050505090500180600160f91b60170281525060010182805190602001908083835b60208310612e905780518252601f199092019160209182019101612e71565b6001836020036101000a038019825116818451168082178552505050505050905001925050506040516020818303038152906040528051906020012091505b600680546001908101918290556000848152600560205260408120929092556004805491612eff9190830161382a565b90508560011415612fc45785600482815481101515612f1a57fe5b9060005260206000209060080201600601819055506000600482815481101515612f4057fe5b90600052602060002090600802016005018190555086600482815481101515612f6557fe5b90600052602060002090600802016003019080519060200190612f89929190613856565b5086600482815481101515612f9a57fe5b90600052602060002090600802016004019080519060200190612fbe929190613856565b50613308565b600084815260056020526040902054600354600480546000199093019450909184908110612fee57fe5b6000918252602090912060076008909202010154106130575760408051600160e51b62461bcd02815260206004820152601660248201527f62726561647468206c6576656c20657863656564656400000000000000000000604482015290519081900360640190fd5b600254600480548490811061306857fe5b9060005260206000209060080201600601541015156130d15760408051600160e51b62461bcd02815260206004820152601460248201527f6465707468206c6576656c206578636565646564000000000000000000000000604482015290519081900360640190fd5b60048054839081106130df57fe5b90600052602060002090600802016006015460010160048281548110151561310357fe5b9060005260206000209060080201600601819055508160048281548110151561312857fe5b6000918252602090912060056008909202010155600480548390811061314a57fe5b906000526020600020906008020160040160048281548110151561316a57fe5b9060005260206000209060080201600401908054600181600116156101000203166002900461319a9291906138d4565b5060006004838154811015156131ac57fe5b906000526020600020906008020160070180548091906001016131cf9190613949565b9050816004848154811015156131e157fe5b9060005260206000209060080201600701828154811015156131ff57fe5b906000526020600020018190555088886040516020018083805190602001908083835b602083106132415780518252601f199092019160209182019101613222565b6001836020036101000a03801982511681845116808217855250505050505090500180600160f91b60170281525060010182805190602001908083835b6020831061329d5780518252601f19909201916020918201910161327e565b6001836020036101000a038019825116818451168082178552505050505050905001925050506040516020818303038152906040526004838154811015156132e157fe5b90600052602060002090600802016003019080519060200190613305929190613856565b50505b8660048281548110151561331857fe5b9060005260206000209060080201600001908051906020019061333c929190613856565b508760048281548110151561334d57fe5b90600052602060002090600802016002019080519060200190613371929190613856565b508460048281548110151561338257fe5b90600052602060002090600802016001018190555084600114156135e2577f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156133d057fe5b90600052602060002090600802016000016004838154811015156133f057fe5b906000526020600020906008020160020160048481548110151561341057fe5b906000526020600020906008020160040160048581548110151561343057fe5b9060005260206000209060080201600601546001604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156134e15780601f106134b6576101008083540402835291602001916134e1565b820191906000526020600020905b8154815290600101906020018083116134c457829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156135555780601f1061352a57610100808354040283529160200191613555565b820191906000526020600020905b81548152906001019060200180831161353857829003601f168201915b50508481038252875460026000196101006001841615020190911604808252602090910190889080156135c95780601f1061359e576101008083540402835291602001916135c9565b820191906000526020600020905b8154815290600101906020018083116135ac57829003601f168201915b50509850505050505050505060405180910390a1613820565b7fd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591c60048281548110151561361257fe5b906000526020600020906008020160000160048381548110151561363257fe5b906000526020600020906008020160020160048481548110151561365257fe5b906000526020600020906008020160040160048581548110151561367257fe5b9060005260206000209060080201600601546002604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156137235780601f106136f857610100808354040283529160200191613723565b820191906000526020600020905b81548152906001019060200180831161370657829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156137975780601f1061376c57610100808354040283529160200191613797565b820191906000526020600020905b81548152906001019060200180831161377a57829003601f168201915b505084810382528754600260001961010060018416150201909116048082526020909101908890801561380b5780601f106137e05761010080835404028352916020019161380b565b820191906000526020600020905b8154815290600101906020018083116137ee57829003601f168201915b50509850505050505050505060405180910390a15b5050505050505050565b81548183558181111561240257600802816008028360005260206000209182019101612402919061396d565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061389757805160ff19168380011785556138c4565b828001600101855582156138c4579182015b828111156138c45782518255916020019190600101906138a9565b506138d09291506139e4565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061390d57805485556138c4565b828001600101855582156138c457600052602060002091601f016020900482015b828111156138c457825482559160010191906001019061392e565b815481835581811115612402576000838152602090206124029181019083016139e4565b61185f91905b808211156138d057600061398782826139fe565b600182016000905560028201600061399f91906139fe565b6139ad6003830160006139fe565b6139bb6004830160006139fe565b600582016000905560068201600090556007820160006139db9190613a45565b50600801613973565b61185f91905b808211156138d057600081556001016139ea565b50805460018160011615610100020316600290046000825580601f10613a245750613a42565b601f016020900490600052602060002090810190613a4291906139e4565b50565b5080546000825590600052602060002090810190613a4291906139e456fe696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6c6f7765646e6f742061206d6173746572206f72672e206f7065726174696f6e206e6f7420616c6c6f7765646f72672073746174757320646f6573206e6f7420616c6c6f7720746865206f7065726174696f6e6f7267206e6f7420696e20617070726f766564207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e65a165627a7a72305820e20472358f6cc444b5476d1b471677de46e77b7421a1bcae4549f346a29e10870029"
+var OrgManagerBin = "0x60803461007f57601f612b7f38819003918201601f19168301916001600160401b038311848410176100845780849260209460405283398101031261007f57516001600160a01b0381169081900361007f5760015460046002556004600355600060065560018060a81b03191617600155604051612acb90816100b48239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe608080604052600436101561001357600080fd5b60003560e01c9081630cc2749314611a075750806314f775f914611864578063177c8d8a146117955780631f953480146111625780633bcb6c3b14610f385780633fd62ae714610efe578063524c84c514610e745780635c4f32ee14610dc35780635e99f6e514610ce35780637755ebdd14610cc55780638c8642df14610c875780639e58eb9f146107ec578063e3028316146106c2578063f4d6d9f514610672578063f9953de5146101185763ffe40d1d146100cf57600080fd5b34610113576020366003190112610113576004356001600160401b038111610113576101096101046020923690600401611f4d565b612865565b6040519015158152f35b600080fd5b3461011357602080600319360112610113576001600160401b036004358181116101135761014a903690600401611dd3565b60018060a01b03600192600486838654166040519283809262e32cf960e41b82525afa928315610666576101bc9361018d92600091610639575b50163314611fd1565b6101a461019e610104368685611f07565b1561200e565b604051926101b184611ecb565b600084523691611f07565b92604051858101906101e5878288516101d88187858d01611e32565b8101038084520182611ee6565b519020600654600019919082811461062357850190816006556000526005875260406000205560045494600160401b8610156104d95784860160045561022a86612088565b505084600661023888612088565b5001556000600561024888612088565b500155600361025687612088565b500181518481116104d9576102758161026f8454612119565b846128d7565b88601f82116001146105c45790806102a39260009161055a575b508160011b916000199060031b1c19161790565b90555b60046102b187612088565b500181518481116104d9576102ca8161026f8454612119565b88601f82116001146105655790806102f79260009161055a57508160011b916000199060031b1c19161790565b90555b61030386612088565b50908051908482116104d9576103238261031d8554612119565b856128d7565b8890601f83116001146104fa576103539291600091836104ef575b50508160011b916000199060031b1c19161790565b90555b600261036186612088565b50019280519283116104d9576103818361037b8654612119565b866128d7565b8691601f841160011461045e575082610426969593600080516020612a568339815191529895936103c8936000926104535750508160011b916000199060031b1c19161790565b90555b80806103d685612088565b5001556104436103e584612088565b509360046104346103f583612088565b50956002600661040e61040787612088565b5096612088565b500154976040519a8b9a60a08c5260a08c0190612153565b928a8403908b015201612153565b91868303604088015201612153565b91606084015260808301520390a1005b01519050898061033e565b9091601f1984169285600052886000209360005b8181106104c45750928592600080516020612a568339815191529a97959289976104269b9a96106104ac575b50505050811b0190556103cb565b01519060f88460031b161c191690558880808061049e565b82840151865594880194928a01928a01610472565b634e487b7160e01b600052604160045260246000fd5b015190508a8061033e565b879291601f19831691856000528b6000209260005b8d828210610544575050841161052d575b505050811b019055610356565b01518560f88460031b161c19169055898080610520565b8385015186558c9790950194938401930161050f565b90508401518b61028f565b908791601f19821690846000528b6000209160005b8d8282106105ae5750508311610597575b5050811b0190556102fa565b8501518660f88460031b161c191690558a8061058b565b8389015185558c9690940193928301920161057a565b908791601f19821690846000528b6000209160005b8d82821061060d57505083116105f6575b5050811b0190556102a6565b8501518660f88460031b161c191690558a806105ea565b8389015185558c969094019392830192016105d9565b634e487b7160e01b600052601160045260246000fd5b6106599150893d8b1161065f575b6106518183611ee6565b810190611fb2565b89610184565b503d610647565b6040513d6000823e3d90fd5b34610113576020366003190112610113576004356001600160401b038111610113576106ae6106a86106be923690600401611dd3565b90612226565b9160409593955195869586611f6b565b0390f35b34610113576020366003190112610113576004356001600160401b038111610113576106f360049136908301611dd3565b60015460405162e32cf960e41b8152919391926001600160a01b039290916020918591829086165afa90811561066657600080516020612a768339815191529461075161077d94610778946004976000916107d45750163314611fd1565b6107716001610769610764368587611f07565b61251f565b1515146120d8565b3691611f07565b612a20565b6002600161078a83612088565b5001556107cf61079982612088565b506107a383612088565b5060066107b96107b286612088565b5095612088565b50015491600260405196879601920190856121e8565b0390a1005b610659915060203d811161065f576106518183611ee6565b34610113576107fa36611e7a565b60015460405162e32cf960e41b815292949193926001600160a01b0392916020908290600490829087165afa92831561066657610845936101a492600091610c685750163314611fd1565b90604051602081019061086360208286516101d88187858b01611e32565b5190206006546000198114610623576001019081600655600052600560205260406000205560045491600160401b8310156104d957600183016004556108a883612088565b5050600160066108b785612088565b500155600060056108c785612088565b5001556108d383612088565b5081516001600160401b0381116104d9576108fe816108f56003850154612119565b600385016128d7565b6020601f8211600114610bfc578160039261092e92600091610b8457508160011b916000199060031b1c19161790565b9101555b61093b83612088565b5081516001600160401b0381116104d9576109668161095d6004850154612119565b600485016128d7565b6020601f8211600114610b8f578160049261099692600091610b8457508160011b916000199060031b1c19161790565b9101555b6109a383612088565b50908051906001600160401b0382116104d9576109c48261031d8554612119565b602090601f8311600114610b18576109f4929160009183610b0d5750508160011b916000199060031b1c19161790565b90555b6002610a0283612088565b5001908051906001600160401b0382116104d957610a248261031d8554612119565b602090601f8311600114610a925782600080516020612a76833981519152959360049593610a68936000926104535750508160011b916000199060031b1c19161790565b90555b60026001610a7883612088565b500155610a8761079982612088565b0390a1600255600355005b90601f198316918460005260206000209260005b818110610af55750926001928592600080516020612a7683398151915298966004989610610adc575b505050811b019055610a6b565b015160001960f88460031b161c19169055888080610acf565b92936020600181928786015181550195019301610aa6565b01519050888061033e565b9190836000526020600020906000935b601f1984168510610b69576001945083601f19811610610b50575b505050811b0190556109f7565b015160001960f88460031b161c19169055878080610b43565b81810151835560209485019460019093019290910190610b28565b90508501518a61028f565b6004830160005260206000209060005b601f1984168110610be457509160019160049382601f19811610610bcb575b5050811b0191015561099a565b86015160001960f88460031b161c191690558980610bbe565b90916020600181928589015181550193019101610b9f565b6003830160005260206000209060005b601f1984168110610c5057509160019160039382601f19811610610c38575b5050811b01910155610932565b86015160001983861b60f8161c191690558980610c2b565b90916020600181928589015181550193019101610c0c565b610c81915060203d60201161065f576106518183611ee6565b88610184565b34610113576040366003190112610113576004356001600160401b03811161011357610109610cbc6020923690600401611f4d565b602435906125a2565b34610113576000366003190112610113576020600454604051908152f35b346101135760208060031936011261011357600435906001600160401b038211610113576007610d44610d3f610d20610778953690600401611dd3565b959060019661077188610d37610104368587611f07565b151514612047565b612088565b50019160405191828185549182815201908195600052826000209060005b818110610db05750505083610d78910384611ee6565b60405192818401908285525180915260408401949160005b828110610d9d5785870386f35b8351875295810195928101928401610d90565b8254845292840192918501918501610d62565b34610113576020366003190112610113576002610e336106be600435610de881612088565b50906004610e68610df883612088565b50610e51610e0585612088565b5091610e3a6001610e236006610e1a8a612088565b50015498612088565b500154976040519a8b8092612153565b038a611ee6565b610e4a604051809b819301612153565b0389611ee6565b610e616040518094819301612153565b0382611ee6565b60405195869586611f6b565b346101135760208060031936011261011357600435906001600160401b03821161011357610ea86040923690600401611dd3565b610eca8385518381948383019687378101600083820152038084520182611ee6565b519020600052600781528160002090808351610ee581611eb0565b6001845494858352015492839101528351928352820152f35b34610113576020366003190112610113576004356001600160401b03811161011357610109610f336020923690600401611f4d565b612630565b3461011357610f4636611e7a565b60015460405162e32cf960e41b815260209593916001600160a01b039087908390600490829085165afa801561066657610f8a9260009161114b5750163314611fd1565b610f9d6001610d37610104368888611f07565b610fb0610fab368686611f07565b61249b565b8015611132575b156110d657801580156110cc575b806110ba575b1561107d576080929184917ff5931362a572745b01fc2b15f8730529fe20d6e8f4bde3dbdb1f81e6be0e88789660405161100481611eb0565b828152600182820185815260405184810190888a833761103386828b8101600083820152038084520182611ee6565b5190206000526007845260406000209251835551910155604051968795606087528160608801528787013760008585018701528401526040830152601f01601f19168101030190a1005b60405162461bcd60e51b8152600481018690526015602482015274195e1c1a5c9e481a5cc81a5b881d1a19481c185cdd605a1b6044820152606490fd5b50811580610fcb575042821015610fcb565b5043811015610fc5565b60405162461bcd60e51b815260048101869052602e60248201527f6f7267206973206e6f742073757370656e6465642e206f7065726174696f6e2060448201526d63616e6e6f7420626520646f6e6560901b6064820152608490fd5b50611146611141368686611f07565b612300565b610fb7565b610c819150883d8a1161065f576106518183611ee6565b34610113576040366003190112610113576004356001600160401b03811161011357611192903690600401611dd3565b602435916001600160401b038311610113576111b360049336908501611dd3565b60015460405162e32cf960e41b815291956001600160a01b039593949293916020918591829089165afa928315610666576112479561123f946112009260009161177c5750163314611fd1565b61077161019e604051838560208301376101046021828b878201601760f91b6020820152818c8583013701600083820152036001810184520182611ee6565b923691611f07565b90604051602081019061126560208285516101d88187858a01611e32565b5190209060405160208101906112b56021828551611287818760208a01611e32565b8101601760f91b60208201528851906112a68285830160208d01611e32565b01036001810184520182611ee6565b5190206006546000198114610623576001019081600655600052600560205260406000205560045491600160401b8310156104d957600183016004556112fa83612088565b50506000526005602052604060002054806000198101116106235760076113246000198301612088565b500154600354111561173e57600661133f6000198301612088565b500154600254111561170257600661135a6000198301612088565b500154600181018111610623576006600161137486612088565b50920191015561138383612088565b50600560001983019101556113b561139e6000198301612088565b506004806113ab87612088565b509201910161292b565b60076113c46000198301612088565b5001546113d46000198301612088565b506007810154600160401b8110156104d957611415936114038260078095600161140e96018282015501612a08565b505060001901612088565b5001612a08565b81549060031b9084821b91600019901b19161790556040516114656021828451611446816020840160208901611e32565b8101601760f91b60208201528751906112a68285830160208c01611e32565b600361147084612088565b5001908051906001600160401b0382116104d9576114928261031d8554612119565b602090601f831160011461169a576114c29291600091836115a35750508160011b916000199060031b1c19161790565b90555b6114ce82612088565b5083516001600160401b0381116104d9576114ed8161026f8454612119565b602094601f8211600114611634576115209293949582916000926116295750508160011b916000199060031b1c19161790565b90555b600261152e83612088565b5001908051906001600160401b0382116104d9576115508261031d8554612119565b602090601f83116001146115ae5782600080516020612a76833981519152959360049593611594936000926115a35750508160011b916000199060031b1c19161790565b90556002600161078a83612088565b01519050878061033e565b90601f198316918460005260206000209260005b8181106116115750926001928592600080516020612a76833981519152989660049896106115f8575b505050811b01905561077d565b015160001960f88460031b161c191690558680806115eb565b929360206001819287860151815501950193016115c2565b01519050868061033e565b601f198216958360005260206000209160005b88811061168257508360019596979810611669575b505050811b019055611523565b015160001960f88460031b161c1916905585808061165c565b91926020600181928685015181550194019201611647565b90601f198316918460005260206000209260005b8181106116ea57509084600195949392106116d1575b505050811b0190556114c5565b015160001960f88460031b161c191690558680806116c4565b929360206001819287860151815501950193016116ae565b60405162461bcd60e51b815260206004820152601460248201527319195c1d1a081b195d995b08195e18d95959195960621b6044820152606490fd5b60405162461bcd60e51b8152602060048201526016602482015275189c9958591d1a081b195d995b08195e18d95959195960521b6044820152606490fd5b610659915060203d60201161065f576106518183611ee6565b3461011357602080600319360112610113576004356001600160401b038111610113576117c6903690600401611dd3565b60015460405162e32cf960e41b8152929392906001600160a01b039084908390600490829085165afa80156106665760049561183394610771610d3f946107789461181b976000916118475750163314611fd1565b5061182c6040518095819301612153565b0383611ee6565b6106be604051928284938452830190611e55565b61185e91508a3d8c1161065f576106518183611ee6565b8b610184565b346101135761187236611e00565b6001805460405162e32cf960e41b8152919493926020926001600160a01b039284908290600490829087165afa9283156106665787936118bc926000916119f05750163314611fd1565b6118ce82610d3761010436888a611f07565b0361195d575091600461191561190882947f73ccf8d6c8385bf5347269bd59712da33183c1a5e1702494bcdb87d0f4674d96963691611f07565b610778846107698361249b565b9161191f83612088565b5001556107cf61192e82612088565b5061193883612088565b5060066119476107b286612088565b5001549160026040519687960192019085612898565b907f882f030c609566cd82918a97d457fd48f9cfcefd11282e2654cde3f94579c15f9360076119926004956000943691611f07565b936119a08361076987612417565b6119a985612a20565b946002846119b688612088565b5001556040516119d58382816101d88183019687815193849201611e32565b5190208452526040822082815501556107cf61192e82612088565b6106599150863d881161065f576106518183611ee6565b3461011357611a1536611e00565b919060018060a01b0391846001938085541662e32cf960e41b83528260046020998a935afa801561066657611a549260009161114b5750163314611fd1565b611a6683610d37610104368686611f07565b82841493848015611dc9575b15611d7657836006611a8b610d3f610778368888611f07565b50015403611d21576000809186600014611d005750505060029383611abb865b611ab6368787611f07565b6125a2565b151503611cab5715611bf357611ad2913691611f07565b81611adc82612393565b151503611b9157600080516020612a56833981519152916003611b01611b5b93612a20565b91611b0b83612088565b500155611b1781612088565b5090611b78611b2582612088565b50916004611b696006611b41611b3a85612088565b5094612088565b50015494600260405198899860a08a5260a08a0190612153565b918883038d8a015201612153565b91858303604087015201612153565b906060830152600360808301520390a15b604051908152f35b60405162461bcd60e51b815260048101859052603460248201527f6f7267206e6f7420696e20617070726f766564207374617475732e206f7065726044820152736174696f6e2063616e6e6f7420626520646f6e6560601b6064820152608490fd5b611bfe913691611f07565b81611c0882612300565b151503611c6657600080516020612a56833981519152916005611c2d611b5b93612a20565b91611c3783612088565b500155611c4381612088565b5090611c51611b2582612088565b906060830152600560808301520390a1611b89565b60405162461bcd60e51b815260048101859052601a60248201527f6f7267206e6f7420696e2073757370656e6465642073746174650000000000006044820152606490fd5b60405162461bcd60e51b815260048101879052602760248201527f6f72672073746174757320646f6573206e6f7420616c6c6f7720746865206f7060448201526632b930ba34b7b760c91b6064820152608490fd5b919591600214611d15575b611abb8591611aab565b50600394506004611d0b565b60405162461bcd60e51b815260048101879052602760248201527f6e6f742061206d6173746572206f72672e206f7065726174696f6e206e6f7420604482015266185b1b1bddd95960ca1b6064820152608490fd5b60405162461bcd60e51b815260048101879052602560248201527f696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6044820152641b1bddd95960da1b6064820152608490fd5b5060028114611a72565b9181601f84011215610113578235916001600160401b038311610113576020838186019501011161011357565b604060031982011261011357600435906001600160401b03821161011357611e2a91600401611dd3565b909160243590565b60005b838110611e455750506000910152565b8181015183820152602001611e35565b90602091611e6e81518092818552858086019101611e32565b601f01601f1916010190565b606060031982011261011357600435906001600160401b03821161011357611ea491600401611dd3565b90916024359060443590565b604081019081106001600160401b038211176104d957604052565b602081019081106001600160401b038211176104d957604052565b90601f801991011681019081106001600160401b038211176104d957604052565b9291926001600160401b0382116104d95760405191611f30601f8201601f191660200184611ee6565b829481845281830111610113578281602093846000960137010152565b9080601f8301121561011357816020611f6893359101611f07565b90565b9192611f9a608094611f8c611fa8949998979960a0875260a0870190611e55565b908582036020870152611e55565b908382036040850152611e55565b9460608201520152565b9081602091031261011357516001600160a01b03811681036101135790565b15611fd857565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b1561201557565b60405162461bcd60e51b815260206004820152600a6024820152696f72672065786973747360b01b6044820152606490fd5b1561204e57565b60405162461bcd60e51b81526020600482015260126024820152711bdc99c8191bd95cc81b9bdd08195e1a5cdd60721b6044820152606490fd5b6004548110156120c257600460005260031b7f8a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19b0190600090565b634e487b7160e01b600052603260045260246000fd5b156120df57565b60405162461bcd60e51b81526020600482015260126024820152716e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b90600182811c92168015612149575b602083101461213357565b634e487b7160e01b600052602260045260246000fd5b91607f1691612128565b80546000939261216282612119565b9182825260209360019182811690816000146121c95750600114612188575b5050505050565b90939495506000929192528360002092846000945b8386106121b557505050500101903880808080612181565b80548587018301529401938590820161219d565b60ff19168685015250505090151560051b010191503880808080612181565b9060029361221860809461220a611fa89499989960a0875260a0870190612153565b908582036020870152612153565b908382036040850152612153565b612234610104368484611f07565b156122ca5761077890612248923691611f07565b9061225282612088565b509161225d81612088565b509261226882612088565b509260046122c560026122b4610e616122a36001612293600661228a8c612088565b5001549a612088565b5001549760405192838092612153565b98610e616040518094819301612153565b95610e616040518094819301612153565b929190565b6122d5913691611f07565b906040516122e281611ecb565b60008152906040516122f381611ecb565b6000815290600090600090565b604051602080820183519261231f8382818801966101d881878a611e32565b519020600052600581526040600020541561238b5760059161234084612a20565b9361235c8360405180936101d883830196879251928391611e32565b5190206000525260406000205415159081612375575090565b60049150612384600191612088565b5001541490565b505050600090565b60405160208082018351926123b28382818801966101d881878a611e32565b519020600052600581526040600020541561238b576005916123d384612a20565b936123ef8360405180936101d883830196879251928391611e32565b5190206000525260406000205415159081612408575090565b60029150612384600191612088565b60405160208082018351926124368382818801966101d881878a611e32565b519020600052600581526040600020541561238b5760059161245784612a20565b936124738360405180936101d883830196879251928391611e32565b519020600052526040600020541515908161248c575090565b60059150612384600191612088565b60405160208082018351926124ba8382818801966101d881878a611e32565b519020600052600581526040600020541561238b576005916124db84612a20565b936124f78360405180936101d883830196879251928391611e32565b5190206000525260406000205415159081612510575090565b60039150612384600191612088565b604051602080820183519261253e8382818801966101d881878a611e32565b519020600052600581526040600020541561238b5760059161255f84612a20565b9361257b8360405180936101d883830196879251928391611e32565b5190206000525260406000205415159081612594575090565b600191506123848291612088565b9060405160208082018451926125c28382818901966101d881878a611e32565b5190206000526005815260406000205415612627576005916125e385612a20565b946125ff8360405180936101d883830196879251928391611e32565b519020600052526040600020541515918261261957505090565b600191925061238490612088565b50505050600090565b604051602081019061264d60208285516101d88187858a01611e32565b5190206000526005602052604060002054612669575b50600090565b61267290612a20565b6002600161267f83612088565b500154148015612720575b8015612711575b156126635760046107786126a76126b893612088565b50610e616040518094819301612153565b600260016126c583612088565b500154149081156126f8575b81156126e8575b506126e35738612663565b600190565b6126f29150612737565b386126d8565b90506003600161270783612088565b50015414906126d1565b5061271b81612737565b612691565b506003600161272e83612088565b5001541461268a565b61274081612088565b509060046001809301540361285e5761275a600391612088565b5001604051906020918281019181600082549261277684612119565b93878982169182600014612841575050600114612800575b506127a2925003601f198101835282611ee6565b51902060005260078152604060002091604051906127bf82611eb0565b8354938483520154918291015281151591826127f6575b5081156127e1575090565b8015159150816127ef575090565b9050421190565b43119150386127d6565b915050600052818480600020876000915b858310612828575050506127a2928201013861278e565b8091929450548385880101520191018590878593612811565b60ff191688526127a29580151502850101925038915061278e9050565b5050600090565b604051612882602082816101d88183019687815193849201611e32565b5190206000526005602052604060002054151590565b9493926128c46060936128b66128d29460808a5260808a0190612153565b9088820360208a0152612153565b908682036040880152612153565b930152565b90601f81116128e557505050565b600091825260208220906020601f850160051c83019410612921575b601f0160051c01915b82811061291657505050565b81815560010161290a565b9092508290612901565b90808214612a045761293d8154612119565b906001600160401b0382116104d95761295a8261031d8554612119565b600090601f83116001146129995761298a92916000918361298e5750508160011b916000199060031b1c19161790565b9055565b01549050388061033e565b815260208082208483528183209291601f1985169083905b8282106129eb5750509084600195949392106129d2575b505050811b019055565b015460001960f88460031b161c191690553880806129c8565b84958192958501548155600180910196019401906129b1565b5050565b80548210156120c25760005260206000200190600090565b604051612a3d602082816101d88183019687815193849201611e32565b5190206000526005602052600019604060002054019056fe0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054bd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591ca2646970667358221220dc412b71820aca4f089bca96e9b65d08b85bdbd65576754ddbe7eebae451c9ab64736f6c63430008150033"
 
 // DeployOrgManager deploys a new Ethereum contract, binding an instance of OrgManager to it.
 func DeployOrgManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *OrgManager, error) {
@@ -194,7 +192,7 @@ func (_OrgManager *OrgManagerTransactorRaw) Transact(opts *bind.TransactOpts, me
 
 // CheckOrgActive is a free data retrieval call binding the contract method 0x3fd62ae7.
 //
-// Solidity: function checkOrgActive(string _orgId) constant returns(bool)
+// Solidity: function checkOrgActive(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerCaller) CheckOrgActive(opts *bind.CallOpts, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -206,21 +204,21 @@ func (_OrgManager *OrgManagerCaller) CheckOrgActive(opts *bind.CallOpts, _orgId
 
 // CheckOrgActive is a free data retrieval call binding the contract method 0x3fd62ae7.
 //
-// Solidity: function checkOrgActive(string _orgId) constant returns(bool)
+// Solidity: function checkOrgActive(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerSession) CheckOrgActive(_orgId string) (bool, error) {
 	return _OrgManager.Contract.CheckOrgActive(&_OrgManager.CallOpts, _orgId)
 }
 
 // CheckOrgActive is a free data retrieval call binding the contract method 0x3fd62ae7.
 //
-// Solidity: function checkOrgActive(string _orgId) constant returns(bool)
+// Solidity: function checkOrgActive(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerCallerSession) CheckOrgActive(_orgId string) (bool, error) {
 	return _OrgManager.Contract.CheckOrgActive(&_OrgManager.CallOpts, _orgId)
 }
 
 // CheckOrgExists is a free data retrieval call binding the contract method 0xffe40d1d.
 //
-// Solidity: function checkOrgExists(string _orgId) constant returns(bool)
+// Solidity: function checkOrgExists(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerCaller) CheckOrgExists(opts *bind.CallOpts, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -232,21 +230,21 @@ func (_OrgManager *OrgManagerCaller) CheckOrgExists(opts *bind.CallOpts, _orgId
 
 // CheckOrgExists is a free data retrieval call binding the contract method 0xffe40d1d.
 //
-// Solidity: function checkOrgExists(string _orgId) constant returns(bool)
+// Solidity: function checkOrgExists(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerSession) CheckOrgExists(_orgId string) (bool, error) {
 	return _OrgManager.Contract.CheckOrgExists(&_OrgManager.CallOpts, _orgId)
 }
 
 // CheckOrgExists is a free data retrieval call binding the contract method 0xffe40d1d.
 //
-// Solidity: function checkOrgExists(string _orgId) constant returns(bool)
+// Solidity: function checkOrgExists(string _orgId) view returns(bool)
 func (_OrgManager *OrgManagerCallerSession) CheckOrgExists(_orgId string) (bool, error) {
 	return _OrgManager.Contract.CheckOrgExists(&_OrgManager.CallOpts, _orgId)
 }
 
 // CheckOrgStatus is a free data retrieval call binding the contract method 0x8c8642df.
 //
-// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) constant returns(bool)
+// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) view returns(bool)
 func (_OrgManager *OrgManagerCaller) CheckOrgStatus(opts *bind.CallOpts, _orgId string, _orgStatus *big.Int) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -258,21 +256,21 @@ func (_OrgManager *OrgManagerCaller) CheckOrgStatus(opts *bind.CallOpts, _orgId
 
 // CheckOrgStatus is a free data retrieval call binding the contract method 0x8c8642df.
 //
-// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) constant returns(bool)
+// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) view returns(bool)
 func (_OrgManager *OrgManagerSession) CheckOrgStatus(_orgId string, _orgStatus *big.Int) (bool, error) {
 	return _OrgManager.Contract.CheckOrgStatus(&_OrgManager.CallOpts, _orgId, _orgStatus)
 }
 
 // CheckOrgStatus is a free data retrieval call binding the contract method 0x8c8642df.
 //
-// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) constant returns(bool)
+// Solidity: function checkOrgStatus(string _orgId, uint256 _orgStatus) view returns(bool)
 func (_OrgManager *OrgManagerCallerSession) CheckOrgStatus(_orgId string, _orgStatus *big.Int) (bool, error) {
 	return _OrgManager.Contract.CheckOrgStatus(&_OrgManager.CallOpts, _orgId, _orgStatus)
 }
 
 // GetNumberOfOrgs is a free data retrieval call binding the contract method 0x7755ebdd.
 //
-// Solidity: function getNumberOfOrgs() constant returns(uint256)
+// Solidity: function getNumberOfOrgs() view returns(uint256)
 func (_OrgManager *OrgManagerCaller) GetNumberOfOrgs(opts *bind.CallOpts) (*big.Int, error) {
 	var (
 		ret0 = new(*big.Int)
@@ -284,21 +282,21 @@ func (_OrgManager *OrgManagerCaller) GetNumberOfOrgs(opts *bind.CallOpts) (*big.
 
 // GetNumberOfOrgs is a free data retrieval call binding the contract method 0x7755ebdd.
 //
-// Solidity: function getNumberOfOrgs() constant returns(uint256)
+// Solidity: function getNumberOfOrgs() view returns(uint256)
 func (_OrgManager *OrgManagerSession) GetNumberOfOrgs() (*big.Int, error) {
 	return _OrgManager.Contract.GetNumberOfOrgs(&_OrgManager.CallOpts)
 }
 
 // GetNumberOfOrgs is a free data retrieval call binding the contract method 0x7755ebdd.
 //
-// Solidity: function getNumberOfOrgs() constant returns(uint256)
+// Solidity: function getNumberOfOrgs() view returns(uint256)
 func (_OrgManager *OrgManagerCallerSession) GetNumberOfOrgs() (*big.Int, error) {
 	return _OrgManager.Contract.GetNumberOfOrgs(&_OrgManager.CallOpts)
 }
 
 // GetOrgDetails is a free data retrieval call binding the contract method 0xf4d6d9f5.
 //
-// Solidity: function getOrgDetails(string _orgId) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgDetails(string _orgId) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerCaller) GetOrgDetails(opts *bind.CallOpts, _orgId string) (string, string, string, *big.Int, *big.Int, error) {
 	var (
 		ret0 = new(string)
@@ -320,21 +318,21 @@ func (_OrgManager *OrgManagerCaller) GetOrgDetails(opts *bind.CallOpts, _orgId s
 
 // GetOrgDetails is a free data retrieval call binding the contract method 0xf4d6d9f5.
 //
-// Solidity: function getOrgDetails(string _orgId) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgDetails(string _orgId) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerSession) GetOrgDetails(_orgId string) (string, string, string, *big.Int, *big.Int, error) {
 	return _OrgManager.Contract.GetOrgDetails(&_OrgManager.CallOpts, _orgId)
 }
 
 // GetOrgDetails is a free data retrieval call binding the contract method 0xf4d6d9f5.
 //
-// Solidity: function getOrgDetails(string _orgId) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgDetails(string _orgId) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerCallerSession) GetOrgDetails(_orgId string) (string, string, string, *big.Int, *big.Int, error) {
 	return _OrgManager.Contract.GetOrgDetails(&_OrgManager.CallOpts, _orgId)
 }
 
 // GetOrgInfo is a free data retrieval call binding the contract method 0x5c4f32ee.
 //
-// Solidity: function getOrgInfo(uint256 _orgIndex) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgInfo(uint256 _orgIndex) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerCaller) GetOrgInfo(opts *bind.CallOpts, _orgIndex *big.Int) (string, string, string, *big.Int, *big.Int, error) {
 	var (
 		ret0 = new(string)
@@ -356,21 +354,21 @@ func (_OrgManager *OrgManagerCaller) GetOrgInfo(opts *bind.CallOpts, _orgIndex *
 
 // GetOrgInfo is a free data retrieval call binding the contract method 0x5c4f32ee.
 //
-// Solidity: function getOrgInfo(uint256 _orgIndex) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgInfo(uint256 _orgIndex) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerSession) GetOrgInfo(_orgIndex *big.Int) (string, string, string, *big.Int, *big.Int, error) {
 	return _OrgManager.Contract.GetOrgInfo(&_OrgManager.CallOpts, _orgIndex)
 }
 
 // GetOrgInfo is a free data retrieval call binding the contract method 0x5c4f32ee.
 //
-// Solidity: function getOrgInfo(uint256 _orgIndex) constant returns(string, string, string, uint256, uint256)
+// Solidity: function getOrgInfo(uint256 _orgIndex) view returns(string, string, string, uint256, uint256)
 func (_OrgManager *OrgManagerCallerSession) GetOrgInfo(_orgIndex *big.Int) (string, string, string, *big.Int, *big.Int, error) {
 	return _OrgManager.Contract.GetOrgInfo(&_OrgManager.CallOpts, _orgIndex)
 }
 
 // GetSubOrgIndexes is a free data retrieval call binding the contract method 0x5e99f6e5.
 //
-// Solidity: function getSubOrgIndexes(string _orgId) constant returns(uint256[])
+// Solidity: function getSubOrgIndexes(string _orgId) view returns(uint256[])
 func (_OrgManager *OrgManagerCaller) GetSubOrgIndexes(opts *bind.CallOpts, _orgId string) ([]*big.Int, error) {
 	var (
 		ret0 = new([]*big.Int)
@@ -382,21 +380,51 @@ func (_OrgManager *OrgManagerCaller) GetSubOrgIndexes(opts *bind.CallOpts, _orgI
 
 // GetSubOrgIndexes is a free data retrieval call binding the contract method 0x5e99f6e5.
 //
-// Solidity: function getSubOrgIndexes(string _orgId) constant returns(uint256[])
+// Solidity: function getSubOrgIndexes(string _orgId) view returns(uint256[])
 func (_OrgManager *OrgManagerSession) GetSubOrgIndexes(_orgId string) ([]*big.Int, error) {
 	return _OrgManager.Contract.GetSubOrgIndexes(&_OrgManager.CallOpts, _orgId)
 }
 
 // GetSubOrgIndexes is a free data retrieval call binding the contract method 0x5e99f6e5.
 //
-// Solidity: function getSubOrgIndexes(string _orgId) constant returns(uint256[])
+// Solidity: function getSubOrgIndexes(string _orgId) view returns(uint256[])
 func (_OrgManager *OrgManagerCallerSession) GetSubOrgIndexes(_orgId string) ([]*big.Int, error) {
 	return _OrgManager.Contract.GetSubOrgIndexes(&_OrgManager.CallOpts, _orgId)
 }
 
+// GetSuspensionExpiry is a free data retrieval call binding the contract method 0x524c84c5.
+//
+// Solidity: function getSuspensionExpiry(string _orgId) view returns(uint256, uint256)
+func (_OrgManager *OrgManagerCaller) GetSuspensionExpiry(opts *bind.CallOpts, _orgId string) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _OrgManager.contract.Call(opts, out, "getSuspensionExpiry", _orgId)
+	return *ret0, *ret1, err
+}
+
+// GetSuspensionExpiry is a free data retrieval call binding the contract method 0x524c84c5.
+//
+// Solidity: function getSuspensionExpiry(string _orgId) view returns(uint256, uint256)
+func (_OrgManager *OrgManagerSession) GetSuspensionExpiry(_orgId string) (*big.Int, *big.Int, error) {
+	return _OrgManager.Contract.GetSuspensionExpiry(&_OrgManager.CallOpts, _orgId)
+}
+
+// GetSuspensionExpiry is a free data retrieval call binding the contract method 0x524c84c5.
+//
+// Solidity: function getSuspensionExpiry(string _orgId) view returns(uint256, uint256)
+func (_OrgManager *OrgManagerCallerSession) GetSuspensionExpiry(_orgId string) (*big.Int, *big.Int, error) {
+	return _OrgManager.Contract.GetSuspensionExpiry(&_OrgManager.CallOpts, _orgId)
+}
+
 // GetUltimateParent is a free data retrieval call binding the contract method 0x177c8d8a.
 //
-// Solidity: function getUltimateParent(string _orgId) constant returns(string)
+// Solidity: function getUltimateParent(string _orgId) view returns(string)
 func (_OrgManager *OrgManagerCaller) GetUltimateParent(opts *bind.CallOpts, _orgId string) (string, error) {
 	var (
 		ret0 = new(string)
@@ -408,14 +436,14 @@ func (_OrgManager *OrgManagerCaller) GetUltimateParent(opts *bind.CallOpts, _org
 
 // GetUltimateParent is a free data retrieval call binding the contract method 0x177c8d8a.
 //
-// Solidity: function getUltimateParent(string _orgId) constant returns(string)
+// Solidity: function getUltimateParent(string _orgId) view returns(string)
 func (_OrgManager *OrgManagerSession) GetUltimateParent(_orgId string) (string, error) {
 	return _OrgManager.Contract.GetUltimateParent(&_OrgManager.CallOpts, _orgId)
 }
 
 // GetUltimateParent is a free data retrieval call binding the contract method 0x177c8d8a.
 //
-// Solidity: function getUltimateParent(string _orgId) constant returns(string)
+// Solidity: function getUltimateParent(string _orgId) view returns(string)
 func (_OrgManager *OrgManagerCallerSession) GetUltimateParent(_orgId string) (string, error) {
 	return _OrgManager.Contract.GetUltimateParent(&_OrgManager.CallOpts, _orgId)
 }
@@ -504,6 +532,27 @@ func (_OrgManager *OrgManagerTransactorSession) ApproveOrgStatusUpdate(_orgId st
 	return _OrgManager.Contract.ApproveOrgStatusUpdate(&_OrgManager.TransactOpts, _orgId, _action)
 }
 
+// SetSuspensionExpiry is a paid mutator transaction binding the contract method 0x3bcb6c3b.
+//
+// Solidity: function setSuspensionExpiry(string _orgId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_OrgManager *OrgManagerTransactor) SetSuspensionExpiry(opts *bind.TransactOpts, _orgId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _OrgManager.contract.Transact(opts, "setSuspensionExpiry", _orgId, _expiryBlock, _expiryTime)
+}
+
+// SetSuspensionExpiry is a paid mutator transaction binding the contract method 0x3bcb6c3b.
+//
+// Solidity: function setSuspensionExpiry(string _orgId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_OrgManager *OrgManagerSession) SetSuspensionExpiry(_orgId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _OrgManager.Contract.SetSuspensionExpiry(&_OrgManager.TransactOpts, _orgId, _expiryBlock, _expiryTime)
+}
+
+// SetSuspensionExpiry is a paid mutator transaction binding the contract method 0x3bcb6c3b.
+//
+// Solidity: function setSuspensionExpiry(string _orgId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_OrgManager *OrgManagerTransactorSession) SetSuspensionExpiry(_orgId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _OrgManager.Contract.SetSuspensionExpiry(&_OrgManager.TransactOpts, _orgId, _expiryBlock, _expiryTime)
+}
+
 // SetUpOrg is a paid mutator transaction binding the contract method 0x9e58eb9f.
 //
 // Solidity: function setUpOrg(string _orgId, uint256 _breadth, uint256 _depth) returns()
@@ -962,6 +1011,143 @@ func (_OrgManager *OrgManagerFilterer) ParseOrgSuspended(log types.Log) (*OrgMan
 	return event, nil
 }
 
+// OrgManagerOrgSuspensionExpirySetIterator is returned from FilterOrgSuspensionExpirySet and is used to iterate over the raw logs and unpacked data for OrgSuspensionExpirySet events raised by the OrgManager contract.
+type OrgManagerOrgSuspensionExpirySetIterator struct {
+	Event *OrgManagerOrgSuspensionExpirySet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OrgManagerOrgSuspensionExpirySetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OrgManagerOrgSuspensionExpirySet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OrgManagerOrgSuspensionExpirySet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OrgManagerOrgSuspensionExpirySetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OrgManagerOrgSuspensionExpirySetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OrgManagerOrgSuspensionExpirySet represents a OrgSuspensionExpirySet event raised by the OrgManager contract.
+type OrgManagerOrgSuspensionExpirySet struct {
+	OrgId       string
+	ExpiryBlock *big.Int
+	ExpiryTime  *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrgSuspensionExpirySet is a free log retrieval operation binding the contract event 0xf5931362a572745b01fc2b15f8730529fe20d6e8f4bde3dbdb1f81e6be0e8878.
+//
+// Solidity: event OrgSuspensionExpirySet(string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_OrgManager *OrgManagerFilterer) FilterOrgSuspensionExpirySet(opts *bind.FilterOpts) (*OrgManagerOrgSuspensionExpirySetIterator, error) {
+
+	logs, sub, err := _OrgManager.contract.FilterLogs(opts, "OrgSuspensionExpirySet")
+	if err != nil {
+		return nil, err
+	}
+	return &OrgManagerOrgSuspensionExpirySetIterator{contract: _OrgManager.contract, event: "OrgSuspensionExpirySet", logs: logs, sub: sub}, nil
+}
+
+var OrgSuspensionExpirySetTopicHash = "0xf5931362a572745b01fc2b15f8730529fe20d6e8f4bde3dbdb1f81e6be0e8878"
+
+// WatchOrgSuspensionExpirySet is a free log subscription operation binding the contract event 0xf5931362a572745b01fc2b15f8730529fe20d6e8f4bde3dbdb1f81e6be0e8878.
+//
+// Solidity: event OrgSuspensionExpirySet(string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_OrgManager *OrgManagerFilterer) WatchOrgSuspensionExpirySet(opts *bind.WatchOpts, sink chan<- *OrgManagerOrgSuspensionExpirySet) (event.Subscription, error) {
+
+	logs, sub, err := _OrgManager.contract.WatchLogs(opts, "OrgSuspensionExpirySet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OrgManagerOrgSuspensionExpirySet)
+				if err := _OrgManager.contract.UnpackLog(event, "OrgSuspensionExpirySet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrgSuspensionExpirySet is a log parse operation binding the contract event 0xf5931362a572745b01fc2b15f8730529fe20d6e8f4bde3dbdb1f81e6be0e8878.
+//
+// Solidity: event OrgSuspensionExpirySet(string _orgId, uint256 _expiryBlock, uint256 _expiryTime)
+func (_OrgManager *OrgManagerFilterer) ParseOrgSuspensionExpirySet(log types.Log) (*OrgManagerOrgSuspensionExpirySet, error) {
+	event := new(OrgManagerOrgSuspensionExpirySet)
+	if err := _OrgManager.contract.UnpackLog(event, "OrgSuspensionExpirySet", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // OrgManagerOrgSuspensionRevokedIterator is returned from FilterOrgSuspensionRevoked and is used to iterate over the raw logs and unpacked data for OrgSuspensionRevoked events raised by the OrgManager contract.
 type OrgManagerOrgSuspensionRevokedIterator struct {
 	Event *OrgManagerOrgSuspensionRevoked // Event containing the contract specifics and raw log
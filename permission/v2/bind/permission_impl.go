@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // PermImplABI is the input ABI used to generate the binding from.
-const PermImplABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_access\",\"type\":\"uint256\"},{\"name\":\"_voter\",\"type\":\"bool\"},{\"name\":\"_admin\",\"type\":\"bool\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNewRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_sender\",\"type\":\"address\"},{\"name\":\"_target\",\"type\":\"address\"},{\"name\":\"_value\",\"type\":\"uint256\"},{\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getPolicyDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"},{\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"setMigrationPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"},{\"name\":\"_orgManager\",\"type\":\"address\"},{\"name\":\"_rolesManager\",\"type\":\"address\"},{\"name\":\"_accountManager\",\"type\":\"address\"},{\"name\":\"_voterManager\",\"type\":\"address\"},{\"name\":\"_nodeManager\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"PermissionsInitialized\",\"type\":\"event\"}]"
+const PermImplABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_orgManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_rolesManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_accountManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_voterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_nodeManager\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"PermissionsInitialized\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_access\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_admin\",\"type\":\"bool\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNewRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveVoteThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAccountRoleWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAdminRoleWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getNumberOfPendingOps\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getPendingOpDetailsFromIndex\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPolicyDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getThresholdProposal\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"}],\"name\":\"getVoteThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"isPendingOp\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_accounts\",\"type\":\"address[]\"}],\"name\":\"migrateVoters\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"setMigrationPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"setVoteThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateOrgStatusWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var PermImplParsedABI, _ = abi.JSON(strings.NewReader(PermImplABI))
 
 // PermImplBin is the compiled bytecode used for deploying new contracts.
-var PermImplBin = "0x60806040526003600955600a805460ff191690553480156200002057600080fd5b5060405160c08062008587833981018060405260c08110156200004257600080fd5b508051602082015160408301516060840151608085015160a090950151600580546001600160a01b039687166001600160a01b03199182161790915560048054958716958216959095179094556001805493861693851693909317909255600080549185169184169190911790556002805494841694831694909417909355600380549290931691161790556184a980620000de6000396000f3fe608060405234801561001057600080fd5b50600436106101e55760003560e01c8063888430411161010f578063cc9ba6fa116100a2578063ecad01d511610071578063ecad01d5146117db578063f346a3a7146119a5578063f5ad584a14611b11578063f75f0a0614611c21576101e5565b8063cc9ba6fa146112c4578063d1aa0c2014611418578063d621d9571461143e578063e91b0e1914611608576101e5565b8063a042bf40116100de578063a042bf4014610e8b578063a5843f0814611055578063b554656414611078578063b9b7fe6c146110f5576101e5565b80638884304114610b7a5780638baa819114610bf9578063936421d514610d3d5780639bd3810114610dd7576101e5565b806345a59e5b116101875780635ca5adbe116101565780635ca5adbe146107b957806368a61273146108805780636b568d76146109fc5780638683c7fe14610ab0576101e5565b806345a59e5b1461064a5780634b20f45f1461070c5780634cbfa82e1461078b5780634fe57e7a14610793576101e5565b80631c249912116101c35780631c2499121461045e5780633cf5f33b146104dd578063404bf3eb1461055a57806344478e791461062e576101e5565b806304e81f1e146101ea5780631b04c276146102735780631b61022014610350575b600080fd5b6102716004803603608081101561020057600080fd5b810190602081018135600160201b81111561021a57600080fd5b82018360208201111561022c57600080fd5b803590602001918460018302840111600160201b8311171561024d57600080fd5b91935091506001600160a01b03813581169160208101359160409091013516611df4565b005b610271600480360360c081101561028957600080fd5b810190602081018135600160201b8111156102a357600080fd5b8201836020820111156102b557600080fd5b803590602001918460018302840111600160201b831117156102d657600080fd5b919390929091602081019035600160201b8111156102f357600080fd5b82018360208201111561030557600080fd5b803590602001918460018302840111600160201b8311171561032657600080fd5b919350915080359060208101351515906040810135151590606001356001600160a01b0316612047565b6102716004803603606081101561036657600080fd5b810190602081018135600160201b81111561038057600080fd5b82018360208201111561039257600080fd5b803590602001918460018302840111600160201b831117156103b357600080fd5b919390929091602081019035600160201b8111156103d057600080fd5b8201836020820111156103e257600080fd5b803590602001918460018302840111600160201b8311171561040357600080fd5b919390929091602081019035600160201b81111561042057600080fd5b82018360208201111561043257600080fd5b803590602001918460018302840111600160201b8311171561045357600080fd5b509092509050612309565b6102716004803603606081101561047457600080fd5b810190602081018135600160201b81111561048e57600080fd5b8201836020820111156104a057600080fd5b803590602001918460018302840111600160201b831117156104c157600080fd5b91935091506001600160a01b0381358116916020013516612448565b610271600480360360608110156104f357600080fd5b810190602081018135600160201b81111561050d57600080fd5b82018360208201111561051f57600080fd5b803590602001918460018302840111600160201b8311171561054057600080fd5b9193509150803590602001356001600160a01b0316612747565b6102716004803603608081101561057057600080fd5b810190602081018135600160201b81111561058a57600080fd5b82018360208201111561059c57600080fd5b803590602001918460018302840111600160201b831117156105bd57600080fd5b919390926001600160a01b0383351692604081019060200135600160201b8111156105e757600080fd5b8201836020820111156105f957600080fd5b803590602001918460018302840111600160201b8311171561061a57600080fd5b9193509150356001600160a01b0316612a4f565b610636612e0e565b604080519115158252519081900360200190f35b6106366004803603606081101561066057600080fd5b810190602081018135600160201b81111561067a57600080fd5b82018360208201111561068c57600080fd5b803590602001918460018302840111600160201b831117156106ad57600080fd5b919390929091602081019035600160201b8111156106ca57600080fd5b8201836020820111156106dc57600080fd5b803590602001918460018302840111600160201b831117156106fd57600080fd5b91935091503561ffff16612f6c565b6102716004803603606081101561072257600080fd5b810190602081018135600160201b81111561073c57600080fd5b82018360208201111561074e57600080fd5b803590602001918460018302840111600160201b8311171561076f57600080fd5b91935091506001600160a01b0381358116916020013516613070565b6106366132b9565b610271600480360360208110156107a957600080fd5b50356001600160a01b03166132c3565b610271600480360360608110156107cf57600080fd5b810190602081018135600160201b8111156107e957600080fd5b8201836020820111156107fb57600080fd5b803590602001918460018302840111600160201b8311171561081c57600080fd5b919390929091602081019035600160201b81111561083957600080fd5b82018360208201111561084b57600080fd5b803590602001918460018302840111600160201b8311171561086c57600080fd5b9193509150356001600160a01b03166135e4565b610271600480360360e081101561089657600080fd5b810190602081018135600160201b8111156108b057600080fd5b8201836020820111156108c257600080fd5b803590602001918460018302840111600160201b831117156108e357600080fd5b919390929091602081019035600160201b81111561090057600080fd5b82018360208201111561091257600080fd5b803590602001918460018302840111600160201b8311171561093357600080fd5b919390929091602081019035600160201b81111561095057600080fd5b82018360208201111561096257600080fd5b803590602001918460018302840111600160201b8311171561098357600080fd5b919390929091602081019035600160201b8111156109a057600080fd5b8201836020820111156109b257600080fd5b803590602001918460018302840111600160201b831117156109d357600080fd5b9193509150803561ffff90811691602081013590911690604001356001600160a01b0316613aa6565b61063660048036036040811015610a1257600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610a3c57600080fd5b820183602082011115610a4e57600080fd5b803590602001918460018302840111600160201b83111715610a6f57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550613ef1945050505050565b61027160048036036080811015610ac657600080fd5b810190602081018135600160201b811115610ae057600080fd5b820183602082011115610af257600080fd5b803590602001918460018302840111600160201b83111715610b1357600080fd5b919390929091602081019035600160201b811115610b3057600080fd5b820183602082011115610b4257600080fd5b803590602001918460018302840111600160201b83111715610b6357600080fd5b919350915061ffff81358116916020013516613fdf565b61027160048036036060811015610b9057600080fd5b810190602081018135600160201b811115610baa57600080fd5b820183602082011115610bbc57600080fd5b803590602001918460018302840111600160201b83111715610bdd57600080fd5b91935091506001600160a01b0381358116916020013516614228565b61027160048036036080811015610c0f57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610c3957600080fd5b820183602082011115610c4b57600080fd5b803590602001918460018302840111600160201b83111715610c6c57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610cbe57600080fd5b820183602082011115610cd057600080fd5b803590602001918460018302840111600160201b83111715610cf157600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550505090356001600160a01b0316915061467d9050565b610636600480360360c0811015610d5357600080fd5b6001600160a01b0382358116926020810135909116916040820135916060810135916080820135919081019060c0810160a0820135600160201b811115610d9957600080fd5b820183602082011115610dab57600080fd5b803590602001918460018302840111600160201b83111715610dcc57600080fd5b509092509050614b8b565b61063660048036036040811015610ded57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610e1757600080fd5b820183602082011115610e2957600080fd5b803590602001918460018302840111600160201b83111715610e4a57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550615059945050505050565b610271600480360360c0811015610ea157600080fd5b810190602081018135600160201b811115610ebb57600080fd5b820183602082011115610ecd57600080fd5b803590602001918460018302840111600160201b83111715610eee57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610f4057600080fd5b820183602082011115610f5257600080fd5b803590602001918460018302840111600160201b83111715610f7357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610fc557600080fd5b820183602082011115610fd757600080fd5b803590602001918460018302840111600160201b83111715610ff857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050615411565b6102716004803603604081101561106b57600080fd5b508035906020013561577d565b6102716004803603606081101561108e57600080fd5b810190602081018135600160201b8111156110a857600080fd5b8201836020820111156110ba57600080fd5b803590602001918460018302840111600160201b831117156110db57600080fd5b9193509150803590602001356001600160a01b0316615c4c565b610271600480360360e081101561110b57600080fd5b810190602081018135600160201b81111561112557600080fd5b82018360208201111561113757600080fd5b803590602001918460018302840111600160201b8311171561115857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156111aa57600080fd5b8201836020820111156111bc57600080fd5b803590602001918460018302840111600160201b831117156111dd57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561122f57600080fd5b82018360208201111561124157600080fd5b803590602001918460018302840111600160201b8311171561126257600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff90811693506020830135169160408101359150606001356001600160a01b0316615fa5565b6112cc61619f565b604080518215156060820152608080825286519082015285519091829160208084019284019160a08501918a019080838360005b83811015611318578181015183820152602001611300565b50505050905090810190601f1680156113455780820380516001836020036101000a031916815260200191505b50848103835287518152875160209182019189019080838360005b83811015611378578181015183820152602001611360565b50505050905090810190601f1680156113a55780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b838110156113d85781810151838201526020016113c0565b50505050905090810190601f1680156114055780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390f35b6106366004803603602081101561142e57600080fd5b50356001600160a01b0316616372565b610271600480360360c081101561145457600080fd5b810190602081018135600160201b81111561146e57600080fd5b82018360208201111561148057600080fd5b803590602001918460018302840111600160201b831117156114a157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156114f357600080fd5b82018360208201111561150557600080fd5b803590602001918460018302840111600160201b8311171561152657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561157857600080fd5b82018360208201111561158a57600080fd5b803590602001918460018302840111600160201b831117156115ab57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050616576565b610271600480360360e081101561161e57600080fd5b810190602081018135600160201b81111561163857600080fd5b82018360208201111561164a57600080fd5b803590602001918460018302840111600160201b8311171561166b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156116bd57600080fd5b8201836020820111156116cf57600080fd5b803590602001918460018302840111600160201b831117156116f057600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561174257600080fd5b82018360208201111561175457600080fd5b803590602001918460018302840111600160201b8311171561177557600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926001600160a01b036040820135811693506060909101351690506169fb565b610271600480360360c08110156117f157600080fd5b810190602081018135600160201b81111561180b57600080fd5b82018360208201111561181d57600080fd5b803590602001918460018302840111600160201b8311171561183e57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561189057600080fd5b8201836020820111156118a257600080fd5b803590602001918460018302840111600160201b831117156118c357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561191557600080fd5b82018360208201111561192757600080fd5b803590602001918460018302840111600160201b8311171561194857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b0316905061713d565b611a13600480360360208110156119bb57600080fd5b810190602081018135600160201b8111156119d557600080fd5b8201836020820111156119e757600080fd5b803590602001918460018302840111600160201b83111715611a0857600080fd5b5090925090506173e7565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015611a72578181015183820152602001611a5a565b50505050905090810190601f168015611a9f5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015611ad2578181015183820152602001611aba565b50505050905090810190601f168015611aff5780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b61027160048036036080811015611b2757600080fd5b810190602081018135600160201b811115611b4157600080fd5b820183602082011115611b5357600080fd5b803590602001918460018302840111600160201b83111715611b7457600080fd5b919390929091602081019035600160201b811115611b9157600080fd5b820183602082011115611ba357600080fd5b803590602001918460018302840111600160201b83111715611bc457600080fd5b919390929091602081019035600160201b811115611be157600080fd5b820183602082011115611bf357600080fd5b803590602001918460018302840111600160201b83111715611c1457600080fd5b919350915035151561754f565b610271600480360360e0811015611c3757600080fd5b810190602081018135600160201b811115611c5157600080fd5b820183602082011115611c6357600080fd5b803590602001918460018302840111600160201b83111715611c8457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611cd657600080fd5b820183602082011115611ce857600080fd5b803590602001918460018302840111600160201b83111715611d0957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611d5b57600080fd5b820183602082011115611d6d57600080fd5b803590602001918460018302840111600160201b83111715611d8e57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926001600160a01b0360408201358116935060609091013516905061763e565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015611e4257600080fd5b505afa158015611e56573d6000803e3d6000fd5b505050506040513d6020811015611e6c57600080fd5b50516001600160a01b03163314611eb757604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8085858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611efb9250849150839050615059565b1515600114611f3e57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b8360011480611f4d5750836002145b80611f585750836003145b1515611f9857604051600160e51b62461bcd0281526004018080602001828103825260258152602001806183e96025913960400191505060405180910390fd5b600054604051600160e11b63425bd4250281526001600160a01b03878116602483015260448201879052606060048301908152606483018a90529216916384b7a84a918a918a918a918a918190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b15801561202657600080fd5b505af115801561203a573d6000803e3d6000fd5b5050505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561209557600080fd5b505afa1580156120a9573d6000803e3d6000fd5b505050506040513d60208110156120bf57600080fd5b50516001600160a01b0316331461210a57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b85858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061214c9250839150617d129050565b15156001146121935760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b8187878080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506121d79250849150839050615059565b151560011461221a57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600154604051600160e01b637b713579028152604481018990528715156064820152861515608482015260a06004820190815260a482018d90526001600160a01b0390921691637b713579918e918e918e918e918e918e918e91908190602481019060c4018a8a80828437600083820152601f01601f191690910184810383528881526020019050888880828437600081840152601f19601f8201169050808301925050509950505050505050505050600060405180830381600087803b1580156122e457600080fd5b505af11580156122f8573d6000803e3d6000fd5b505050505050505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561235757600080fd5b505afa15801561236b573d6000803e3d6000fd5b505050506040513d602081101561238157600080fd5b50516001600160a01b031633146123cc57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156124185760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b6124246006888861831a565b506124316007868661831a565b5061243e6008848461831a565b5050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561249657600080fd5b505afa1580156124aa573d6000803e3d6000fd5b505050506040513d60208110156124c057600080fd5b50516001600160a01b0316331461250b57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061251581616372565b151560011461255857604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600054604051600160e11b63425bd4250281526001600160a01b03858116602483015260046044830181905260608382019081526064840189905291909316926384b7a84a928992899289929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b1580156125ea57600080fd5b505af11580156125fe573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d0281526001600160a01b03888116606483015260066084830181905260a060048401908152815460001960018216156101000201169590950460a4840181905291909316955063e98ac22d945091928a928a928a928692909182916024820191604481019160c490910190869080156126cb5780601f106126a0576101008083540402835291602001916126cb565b820191906000526020600020905b8154815290600101906020018083116126ae57829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b15801561272857600080fd5b505af115801561273c573d6000803e3d6000fd5b505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561279557600080fd5b505afa1580156127a9573d6000803e3d6000fd5b505050506040513d60208110156127bf57600080fd5b50516001600160a01b0316331461280a57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061281481616372565b151560011461285757604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b6004805460408051600160e01b630cc2749302815260248101879052928301908152604483018790526000926001600160a01b0390921691630cc27493918991899189918190606401858580828437600081840152601f19601f820116905080830192505050945050505050602060405180830381600087803b1580156128dd57600080fd5b505af11580156128f1573d6000803e3d6000fd5b505050506040513d602081101561290757600080fd5b505160028054604051600160e01b63e98ac22d0281526000606482018190526084820185905260a0600483019081526006805460001960018216156101000201169590950460a484018190529596506001600160a01b039093169463e98ac22d94938c938c939289929182916024820191604481019160c4909101908a9080156129d25780601f106129a7576101008083540402835291602001916129d2565b820191906000526020600020905b8154815290600101906020018083116129b557829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b158015612a2f57600080fd5b505af1158015612a43573d6000803e3d6000fd5b50505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015612a9d57600080fd5b505afa158015612ab1573d6000803e3d6000fd5b505050506040513d6020811015612ac757600080fd5b50516001600160a01b03163314612b1257604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250612b549250839150617e019050565b1515600114612ba55760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b81612baf81616372565b1515600114612bf257604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600054604051600160e01b63e3483a9d0281526001600160a01b0388811660048301908152600160648401819052608060248501908152608485018d9052929094169363e3483a9d938b938e938e938d938d9391929190604481019060a401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b158015612cb557600080fd5b505af1158015612cc9573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d0281526001600160a01b038b8116606483015260046084830181905260a08382019081526006805460001960018216156101000201169690960460a4850181905292909416965063e98ac22d95508e938e938e9382916024810191604482019160c401908a908015612d8f5780601f10612d6457610100808354040283529160200191612d8f565b820191906000526020600020905b815481529060010190602001808311612d7257829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b158015612dec57600080fd5b505af1158015612e00573d6000803e3d6000fd5b505050505050505050505050565b60055460408051600160e21b63395c945702815290516000926001600160a01b03169163e572515c916004808301926020929190829003018186803b158015612e5657600080fd5b505afa158015612e6a573d6000803e3d6000fd5b505050506040513d6020811015612e8057600080fd5b50516001600160a01b03163314612ecb57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff1615612f175760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b600a805460ff1916600117908190556040805160ff9290921615158252517f04f651be6fb8fc575d94591e56e9f6e66e33ef23e949765918b3bdae50c617cf9181900360200190a1600a5460ff1691505b5090565b600a5460009060ff161515612f8357506001613067565b600354604051600160e01b6345a59e5b02815261ffff84166044820152606060048201908152606482018890526001600160a01b03909216916345a59e5b91899189918991899189919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505097505050505050505060206040518083038186803b15801561303857600080fd5b505afa15801561304c573d6000803e3d6000fd5b505050506040513d602081101561306257600080fd5b505190505b95945050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156130be57600080fd5b505afa1580156130d2573d6000803e3d6000fd5b505050506040513d60208110156130e857600080fd5b50516001600160a01b0316331461313357604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061313d81616372565b151560011461318057604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261321a939092909183018282801561320d5780601f106131e25761010080835404028352916020019161320d565b820191906000526020600020905b8154815290600101906020018083116131f057829003601f168201915b5050505050836006617ec6565b156132b257600054604051600160e11b63425bd4250281526001600160a01b0385811660248301526005604483018190526060600484019081526064840189905291909316926384b7a84a928992899289929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b15801561272857600080fd5b5050505050565b600a5460ff165b90565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561331157600080fd5b505afa158015613325573d6000803e3d6000fd5b505050506040513d602081101561333b57600080fd5b50516001600160a01b0316331461338657604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156133d25760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261346c939092909183018282801561345f5780601f106134345761010080835404028352916020019161345f565b820191906000526020600020905b81548152906001019060200180831161344257829003601f168201915b5050505050836001617fc0565b600054604051600160e01b63e3483a9d0281526001600160a01b038481166004830190815260026064840181905260806024850190815260068054600019600182161561010002011683900460848701819052949096169563e3483a9d95899591946007949390929091604481019160a490910190879080156135305780601f1061350557610100808354040283529160200191613530565b820191906000526020600020905b81548152906001019060200180831161351357829003601f168201915b50508381038252855460026000196101006001841615020190911604808252602090910190869080156135a45780601f10613579576101008083540402835291602001916135a4565b820191906000526020600020905b81548152906001019060200180831161358757829003601f168201915b50509650505050505050600060405180830381600087803b1580156135c857600080fd5b505af11580156135dc573d6000803e3d6000fd5b505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561363257600080fd5b505afa158015613646573d6000803e3d6000fd5b505050506040513d602081101561365c57600080fd5b50516001600160a01b031633146136a757604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b82828080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506136e99250839150617d129050565b15156001146137305760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b8184848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506137749250849150839050615059565b15156001146137b757604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b604080516020808201908152600780546002600019610100600184161502019091160493830184905292909182916060909101908490801561383a5780601f1061380f5761010080835404028352916020019161383a565b820191906000526020600020905b81548152906001019060200180831161381d57829003601f168201915b50509250505060405160208183030381529060405280519060200120888860405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120141580156139a7575060408051602080820190815260088054600260001961010060018416150201909116049383018490529290918291606090910190849080156139355780601f1061390a57610100808354040283529160200191613935565b820191906000526020600020905b81548152906001019060200180831161391857829003601f168201915b50509250505060405160208183030381529060405280519060200120888860405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012014155b15156139fd5760408051600160e51b62461bcd02815260206004820152601d60248201527f61646d696e20726f6c65732063616e6e6f742062652072656d6f766564000000604482015290519081900360640190fd5b60015460408051600160e11b63531a180902815260048101918252604481018a90526001600160a01b039092169163a6343012918b918b918b918b919081906024810190606401878780828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015612dec57600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015613af457600080fd5b505afa158015613b08573d6000803e3d6000fd5b505050506040513d6020811015613b1e57600080fd5b50516001600160a01b03163314613b6957604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8a8a8080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613bab9250839150617e019050565b1515600114613bfc5760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b818c8c8080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613c409250849150839050615059565b1515600114613c8357604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600460009054906101000a90046001600160a01b03166001600160a01b0316631f9534808f8f8f8f6040518563ffffffff1660e01b81526004018080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015613d3657600080fd5b505af1158015613d4a573d6000803e3d6000fd5b5050505060608e8e8e8e60405160200180858580828437600160f91b6017029201918252506001018383808284376040805191909301818103601f19018252909252509550508d1593506122f89250505057600354604051600160e01b634c57331102815261ffff808a1660448301528816606482015260a06004820190815260a482018d90526001600160a01b0390921691634c573311918e918e918e918e918e918e918a919081906024810190608481019060c4018b8b80828437600083820152601f01601f1916909101858103845289815260200190508989808284376000838201819052601f909101601f191690920186810384528751815287516020918201939189019250908190849084905b83811015613e74578181015183820152602001613e5c565b50505050905090810190601f168015613ea15780820380516001836020036101000a031916815260200191505b509a5050505050505050505050600060405180830381600087803b158015613ec857600080fd5b505af1158015613edc573d6000803e3d6000fd5b50505050505050505050505050505050505050565b6000805460408051600160e11b6335ab46bb0281526001600160a01b0386811660048301908152602483019384528651604484015286519190941693636b568d76938893889360649091019060208501908083838c5b83811015613f5f578181015183820152602001613f47565b50505050905090810190601f168015613f8c5780820380516001836020036101000a031916815260200191505b50935050505060206040518083038186803b158015613faa57600080fd5b505afa158015613fbe573d6000803e3d6000fd5b505050506040513d6020811015613fd457600080fd5b505190505b92915050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561402d57600080fd5b505afa158015614041573d6000803e3d6000fd5b505050506040513d602081101561405757600080fd5b50516001600160a01b031633146140a257604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156140ee5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b600354604051600160e01b634530abe102815261ffff80861660448301528416606482015260a06004820190815260a482018990526001600160a01b0390921691634530abe1918a918a918a918a918a918a916006919081906024810190608481019060c4018b8b80828437600083820152601f01601f191690910185810384528981526020019050898980828437600083820152601f01601f1916909101858103835286546002600019610100600184161502019091160480825260209091019150869080156142005780601f106141d557610100808354040283529160200191614200565b820191906000526020600020905b8154815290600101906020018083116141e357829003601f168201915b50509a5050505050505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561427657600080fd5b505afa15801561428a573d6000803e3d6000fd5b505050506040513d60208110156142a057600080fd5b50516001600160a01b031633146142eb57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b806142f581616372565b151560011461433857604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f600260001961010060018816150201909516949094049384018190048102820181019092528281526143d293909290918301828280156143c55780601f1061439a576101008083540402835291602001916143c5565b820191906000526020600020905b8154815290600101906020018083116143a857829003601f168201915b5050505050836004617ec6565b156132b25760008054604051600160e01b631d09dc930281526020600482019081526024820188905283926001600160a01b031691631d09dc93918a918a91908190604401848480828437600081840152601f19601f82011690508083019250505093505050506040805180830381600087803b15801561445257600080fd5b505af1158015614466573d6000803e3d6000fd5b505050506040513d604081101561447c57600080fd5b5080516020909101519092509050811561452a5760068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261452a939092909183018282801561451d5780601f106144f25761010080835404028352916020019161451d565b820191906000526020600020905b81548152906001019060200180831161450057829003601f168201915b5050505050826000617fc0565b6000805460408051600160e01b63c214e5e50281526001600160a01b03898116602483015260048201928352604482018b90529092169163c214e5e5918b918b918b918190606401858580828437600081840152601f19601f820116905080830192505050945050505050602060405180830381600087803b1580156145af57600080fd5b505af11580156145c3573d6000803e3d6000fd5b505050506040513d60208110156145d957600080fd5b50519050801561243e5760068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261243e93909290918301828280156146705780601f1061464557610100808354040283529160200191614670565b820191906000526020600020905b81548152906001019060200180831161465357829003601f168201915b5050505050876001617fc0565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156146cb57600080fd5b505afa1580156146df573d6000803e3d6000fd5b505050506040513d60208110156146f557600080fd5b50516001600160a01b0316331461474057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b808361474c8282615059565b151560011461478f57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b8461479981617d12565b15156001146147e05760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b6147ea8787613ef1565b15156001146148435760408051600160e51b62461bcd02815260206004820152601d60248201527f6f7065726174696f6e2063616e6e6f7420626520706572666f726d6564000000604482015290519081900360640190fd5b61484d8587618156565b15156001146148a65760408051600160e51b62461bcd02815260206004820152601460248201527f726f6c6520646f6573206e6f7420657869737473000000000000000000000000604482015290519081900360640190fd5b6001546000906001600160a01b031663be322e5487896148c581618171565b6040518463ffffffff1660e01b815260040180806020018060200180602001848103845287818151815260200191508051906020019080838360005b83811015614919578181015183820152602001614901565b50505050905090810190601f1680156149465780820380516001836020036101000a031916815260200191505b50848103835286518152865160209182019188019080838360005b83811015614979578181015183820152602001614961565b50505050905090810190601f1680156149a65780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156149d95781810151838201526020016149c1565b50505050905090810190601f168015614a065780820380516001836020036101000a031916815260200191505b50965050505050505060206040518083038186803b158015614a2757600080fd5b505afa158015614a3b573d6000803e3d6000fd5b505050506040513d6020811015614a5157600080fd5b505160008054604051600160e21b63050e95810281526001600160a01b038c81166004830190815285151560648401526080602484019081528d5160848501528d51969750919093169463143a5604948e948e948e948a9492939092604483019260a401916020890191908190849084905b83811015614adb578181015183820152602001614ac3565b50505050905090810190601f168015614b085780820380516001836020036101000a031916815260200191505b50838103825285518152855160209182019187019080838360005b83811015614b3b578181015183820152602001614b23565b50505050905090810190601f168015614b685780820380516001836020036101000a031916815260200191505b509650505050505050600060405180830381600087803b158015612dec57600080fd5b600a5460009060ff161515614ba25750600161504e565b60005460408051600160e11b637ea7d02d0281526001600160a01b038b811660048301529151919092169163fd4fa05a916024808301926020929190829003018186803b158015614bf257600080fd5b505afa158015614c06573d6000803e3d6000fd5b505050506040513d6020811015614c1c57600080fd5b50516002141561504a576000805460408051600160e01b636acee5fd0281526001600160a01b038c81166004830152915160609485949390931692636acee5fd9260248082019391829003018186803b158015614c7857600080fd5b505afa158015614c8c573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040908152811015614cb557600080fd5b810190808051600160201b811115614ccc57600080fd5b82016020810184811115614cdf57600080fd5b8151600160201b811182820187101715614cf857600080fd5b50509291906020018051600160201b811115614d1357600080fd5b82016020810184811115614d2657600080fd5b8151600160201b811182820187101715614d3f57600080fd5b5050929190505050915091506060614d5683618171565b60048054604051600160e01b633fd62ae702815260209281018381528751602483015287519495506001600160a01b0390921693633fd62ae793889392839260449091019185019080838360005b83811015614dbc578181015183820152602001614da4565b50505050905090810190601f168015614de95780820380516001836020036101000a031916815260200191505b509250505060206040518083038186803b158015614e0657600080fd5b505afa158015614e1a573d6000803e3d6000fd5b505050506040513d6020811015614e3057600080fd5b50511561504657614e408b616372565b80614e505750614e508b84615059565b15614e61576001935050505061504e565b60016001600160a01b038b161515614e7b57506002614e85565b8515614e85575060035b600154604051600160e11b6368fbbc33028152606481018390526080600482019081528551608483015285516001600160a01b039093169263d1f778669287928992889288929182916024810191604482019160a4019060208a019080838360005b83811015614eff578181015183820152602001614ee7565b50505050905090810190601f168015614f2c5780820380516001836020036101000a031916815260200191505b50848103835287518152875160209182019189019080838360005b83811015614f5f578181015183820152602001614f47565b50505050905090810190601f168015614f8c5780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b83811015614fbf578181015183820152602001614fa7565b50505050905090810190601f168015614fec5780820380516001836020036101000a031916815260200191505b5097505050505050505060206040518083038186803b15801561500e57600080fd5b505afa158015615022573d6000803e3d6000fd5b505050506040513d602081101561503857600080fd5b5051945061504e9350505050565b5050505b5060005b979650505050505050565b600080546001600160a01b031663e8b42bf4848461507681618171565b6040518463ffffffff1660e01b815260040180846001600160a01b03166001600160a01b031681526020018060200180602001838103835285818151815260200191508051906020019080838360005b838110156150de5781810151838201526020016150c6565b50505050905090810190601f16801561510b5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561513e578181015183820152602001615126565b50505050905090810190601f16801561516b5780820380516001836020036101000a031916815260200191505b509550505050505060206040518083038186803b15801561518b57600080fd5b505afa15801561519f573d6000803e3d6000fd5b505050506040513d60208110156151b557600080fd5b5051156151c457506001613fd9565b6001546000805460408051600160e01b6381d66b230281526001600160a01b03888116600483015291519482169463be322e549493909216926381d66b2392602480840193829003018186803b15801561521d57600080fd5b505afa158015615231573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561525a57600080fd5b810190808051600160201b81111561527157600080fd5b8201602081018481111561528457600080fd5b8151600160201b81118282018710171561529d57600080fd5b5050929190505050846152af86618171565b6040518463ffffffff1660e01b815260040180806020018060200180602001848103845287818151815260200191508051906020019080838360005b838110156153035781810151838201526020016152eb565b50505050905090810190601f1680156153305780820380516001836020036101000a031916815260200191505b50848103835286518152865160209182019188019080838360005b8381101561536357818101518382015260200161534b565b50505050905090810190601f1680156153905780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156153c35781810151838201526020016153ab565b50505050905090810190601f1680156153f05780820380516001836020036101000a031916815260200191505b50965050505050505060206040518083038186803b158015613faa57600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561545f57600080fd5b505afa158015615473573d6000803e3d6000fd5b505050506040513d602081101561548957600080fd5b50516001600160a01b031633146154d457604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b806154de81616372565b151560011461552157604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f600260001961010060018816150201909516949094049384018190048102820181019092528281526155bb93909290918301828280156155ae5780601f10615583576101008083540402835291602001916155ae565b820191906000526020600020905b81548152906001019060200180831161559157829003601f168201915b5050505050836005617ec6565b1561577457600360009054906101000a90046001600160a01b03166001600160a01b03166337d50b27878787878c60056040518763ffffffff1660e01b81526004018080602001806020018761ffff1661ffff1681526020018661ffff1661ffff1681526020018060200185815260200184810384528a818151815260200191508051906020019080838360005b83811015615661578181015183820152602001615649565b50505050905090810190601f16801561568e5780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b838110156156c15781810151838201526020016156a9565b50505050905090810190601f1680156156ee5780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b83811015615721578181015183820152602001615709565b50505050905090810190601f16801561574e5780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561202657600080fd5b50505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156157cb57600080fd5b505afa1580156157df573d6000803e3d6000fd5b505050506040513d60208110156157f557600080fd5b50516001600160a01b0316331461584057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff161561588c5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b60048054604051600160e01b639e58eb9f028152602481018690526044810185905260609281019283526006805460026000196001831615610100020190911604606483018190526001600160a01b0390931693639e58eb9f93919288928892918291608490910190869080156159445780601f1061591957610100808354040283529160200191615944565b820191906000526020600020905b81548152906001019060200180831161592757829003601f168201915b5050945050505050600060405180830381600087803b15801561596657600080fd5b505af115801561597a573d6000803e3d6000fd5b505060018054600954604051600160e01b637b71357902815260448101829052606481018490526084810184905260a0600482019081526007805460026000198289161561010002019091160460a484018190526001600160a01b039095169750637b71357996509460069490928392918291602481019160c49091019089908015615a475780601f10615a1c57610100808354040283529160200191615a47565b820191906000526020600020905b815481529060010190602001808311615a2a57829003601f168201915b5050838103825287546002600019610100600184161502019091160480825260209091019088908015615abb5780601f10615a9057610100808354040283529160200191615abb565b820191906000526020600020905b815481529060010190602001808311615a9e57829003601f168201915b5050975050505050505050600060405180830381600087803b158015615ae057600080fd5b505af1158015615af4573d6000803e3d6000fd5b505060005460408051600160e01b63cef7f6af028152600481019182526007805460026000196001831615610100020190911604604483018190526001600160a01b03909416955063cef7f6af94509260089291829160248201916064019086908015615ba25780601f10615b7757610100808354040283529160200191615ba2565b820191906000526020600020905b815481529060010190602001808311615b8557829003601f168201915b5050838103825284546002600019610100600184161502019091160480825260209091019085908015615c165780601f10615beb57610100808354040283529160200191615c16565b820191906000526020600020905b815481529060010190602001808311615bf957829003601f168201915b5050945050505050600060405180830381600087803b158015615c3857600080fd5b505af1158015615774573d6000803e3d6000fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015615c9a57600080fd5b505afa158015615cae573d6000803e3d6000fd5b505050506040513d6020811015615cc457600080fd5b50516001600160a01b03163314615d0f57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b80615d1981616372565b1515600114615d5c57604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b8260011480615d6b5750826002145b1515615dc15760408051600160e51b62461bcd02815260206004820152601560248201527f4f7065726174696f6e206e6f7420616c6c6f7765640000000000000000000000604482015290519081900360640190fd5b6000808460011415615dd95750600290506003615dea565b8460021415615dea57506003905060055b615e2b87878080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152508592506182ad915050565b1515600114615e845760408051600160e51b62461bcd02815260206004820152601560248201527f6f7065726174696f6e206e6f7420616c6c6f7765640000000000000000000000604482015290519081900360640190fd5b60068054604080516020601f60026000196101006001881615020190951694909404938401819004810282018101909252828152615f1d9390929091830182828015615f115780601f10615ee657610100808354040283529160200191615f11565b820191906000526020600020905b815481529060010190602001808311615ef457829003601f168201915b50505050508584617ec6565b15615774576004805460408051600160e01b6314f775f902815260248101899052928301908152604483018990526001600160a01b03909116916314f775f9918a918a918a918190606401858580828437600081840152601f19601f820116905080830192505050945050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015615ff357600080fd5b505afa158015616007573d6000803e3d6000fd5b505050506040513d602081101561601d57600080fd5b50516001600160a01b0316331461606857604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b6160728188615059565b15156001146160b557604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b81600114806160c45750816002145b806160cf5750816003145b151561610f57604051600160e51b62461bcd0281526004018080602001828103825260258152602001806183e96025913960400191505060405180910390fd5b600354604051600160e01b6337d50b2702815261ffff80871660448301528516606482015260a4810184905260c060048201908152885160c483015288516001600160a01b03909316926337d50b27928a928a928a928a928f928b9282916024820191608481019160e49091019060208c0190808383600083811015615661578181015183820152602001615649565b600a5460068054604080516020601f6002600019600187161561010002019095169490940493840181900481028201810190925282815260609485948594600094919360079360089360ff9091169286918301828280156162415780601f1061621657610100808354040283529160200191616241565b820191906000526020600020905b81548152906001019060200180831161622457829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156162cf5780601f106162a4576101008083540402835291602001916162cf565b820191906000526020600020905b8154815290600101906020018083116162b257829003601f168201915b5050855460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529598508794509250840190508282801561635d5780601f106163325761010080835404028352916020019161635d565b820191906000526020600020905b81548152906001019060200180831161634057829003601f168201915b50505050509150935093509350935090919293565b604080516020808201908152600780546002600019610100600184161502019091160493830184905260009390928291606090910190849080156163f75780601f106163cc576101008083540402835291602001916163f7565b820191906000526020600020905b8154815290600101906020018083116163da57829003601f168201915b505060408051601f19818403018152828252805160209091012060008054600160e01b6381d66b230285526001600160a01b038a8116600487015293519297509290921694506381d66b239350602480840193829003018186803b15801561645e57600080fd5b505afa158015616472573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561649b57600080fd5b810190808051600160201b8111156164b257600080fd5b820160208101848111156164c557600080fd5b8151600160201b8111828201871017156164de57600080fd5b50509291905050506040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561652657818101518382015260200161650e565b50505050905090810190601f1680156165535780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120149050919050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156165c457600080fd5b505afa1580156165d8573d6000803e3d6000fd5b505050506040513d60208110156165ee57600080fd5b50516001600160a01b0316331461663957604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061664381616372565b151560011461668657604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600360009054906101000a90046001600160a01b03166001600160a01b03166337d50b27878787878c60046040518763ffffffff1660e01b81526004018080602001806020018761ffff1661ffff1681526020018661ffff1661ffff1681526020018060200185815260200184810384528a818151815260200191508051906020019080838360005b8381101561672757818101518382015260200161670f565b50505050905090810190601f1680156167545780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b8381101561678757818101518382015260200161676f565b50505050905090810190601f1680156167b45780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b838110156167e75781810151838201526020016167cf565b50505050905090810190601f1680156168145780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561683a57600080fd5b505af115801561684e573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d02815260006064820181905260056084830181905260a0600484019081526006805460001960018216156101000201169690960460a485018190526001600160a01b03909516975063e98ac22d96508e948e9482916024820191604481019160c4909101908a9080156169155780601f106168ea57610100808354040283529160200191616915565b820191906000526020600020905b8154815290600101906020018083116168f857829003601f168201915b505084810383528851815288516020918201918a019080838360005b83811015616949578181015183820152602001616931565b50505050905090810190601f1680156169765780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b838110156169a9578181015183820152602001616991565b50505050905090810190601f1680156169d65780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015616a4957600080fd5b505afa158015616a5d573d6000803e3d6000fd5b505050506040513d6020811015616a7357600080fd5b50516001600160a01b03163314616abe57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460ff161515600114616b0b5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b616b1481616372565b1515600114616b5757604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60028054604051600160e01b63e98ac22d0281526001600160a01b03858116606483015260016084830181905260a06004840190815260068054600019818516156101000201169690960460a48501819052929094169463e98ac22d9490938d938d938a939092909182916024810191604482019160c401908a908015616c1f5780601f10616bf457610100808354040283529160200191616c1f565b820191906000526020600020905b815481529060010190602001808311616c0257829003601f168201915b505084810383528851815288516020918201918a019080838360005b83811015616c53578181015183820152602001616c3b565b50505050905090810190601f168015616c805780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b83811015616cb3578181015183820152602001616c9b565b50505050905090810190601f168015616ce05780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015616d0557600080fd5b505af1158015616d19573d6000803e3d6000fd5b505060048054604051600160e01b63f9953de502815260209281018381528c5160248301528c516001600160a01b03909316955063f9953de594508c93909283926044019185019080838360005b83811015616d7f578181015183820152602001616d67565b50505050905090810190601f168015616dac5780820380516001836020036101000a031916815260200191505b5092505050600060405180830381600087803b158015616dcb57600080fd5b505af1158015616ddf573d6000803e3d6000fd5b5050600354604051600160e01b63549583df02815261ffff80891660448301528716606482015260a0600482019081528a5160a48301528a516001600160a01b03909316945063549583df93508a928a928a928a928f9282916024820191608481019160c49091019060208b019080838360005b83811015616e6b578181015183820152602001616e53565b50505050905090810190601f168015616e985780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015616ecb578181015183820152602001616eb3565b50505050905090810190601f168015616ef85780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b83811015616f2b578181015183820152602001616f13565b50505050905090810190601f168015616f585780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015616f7d57600080fd5b505af1158015616f91573d6000803e3d6000fd5b50505050616f9f8288613ef1565b1515600114616ff85760408051600160e51b62461bcd02815260206004820152601d60248201527f4f7065726174696f6e2063616e6e6f7420626520706572666f726d6564000000604482015290519081900360640190fd5b60008054604051600160e01b63e3483a9d0281526001600160a01b03858116600483019081526001606484018190526080602485019081528d5160848601528d51939095169563e3483a9d9589958f9560089593604483019260a4019160208901918190849084905b83811015617079578181015183820152602001617061565b50505050905090810190601f1680156170a65780820380516001836020036101000a031916815260200191505b508381038252855460026000196101006001841615020190911604808252602090910190869080156171195780601f106170ee57610100808354040283529160200191617119565b820191906000526020600020905b8154815290600101906020018083116170fc57829003601f168201915b50509650505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561718b57600080fd5b505afa15801561719f573d6000803e3d6000fd5b505050506040513d60208110156171b557600080fd5b50516001600160a01b0316331461720057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8561720a81617d12565b15156001146172515760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b61725b8288615059565b151560011461729e57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600354604051600160e01b634c57331102815261ffff80871660448301528516606482015260a060048201908152885160a483015288516001600160a01b0390931692634c573311928a928a928a928a928f92909182916024820191608481019160c49091019060208b019080838360005b83811015617328578181015183820152602001617310565b50505050905090810190601f1680156173555780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015617388578181015183820152602001617370565b50505050905090810190601f1680156173b55780820380516001836020036101000a031916815260200191505b5084810382528551815285516020918201918701908083836000838110156169a9578181015183820152602001616991565b600254604051600160e21b62539ab302815260206004820190815260248201849052606092839260009283926001600160a01b03169163014e6acc9189918991908190604401848480828437600081840152601f19601f820116905080830192505050935050505060006040518083038186803b15801561746757600080fd5b505afa15801561747b573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405260808110156174a457600080fd5b810190808051600160201b8111156174bb57600080fd5b820160208101848111156174ce57600080fd5b8151600160201b8111828201871017156174e757600080fd5b50509291906020018051600160201b81111561750257600080fd5b8201602081018481111561751557600080fd5b8151600160201b81118282018710171561752e57600080fd5b50506020820151604090920151949b909a5090985092965091945050505050565b6005546001600160a01b031633146175b15760408051600160e51b62461bcd02815260206004820152600e60248201527f696e76616c69642063616c6c6572000000000000000000000000000000000000604482015290519081900360640190fd5b600a5460009060ff16156175fd5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b6176096006898961831a565b506176166007878761831a565b506176236008858561831a565b5050600a805460ff1916911515919091179055505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561768c57600080fd5b505afa1580156176a0573d6000803e3d6000fd5b505050506040513d60208110156176b657600080fd5b50516001600160a01b0316331461770157604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b61770a81616372565b151560011461774d57604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b6177588760016182ad565b15156001146177b15760408051600160e51b62461bcd02815260206004820152601260248201527f4e6f7468696e6720746f20617070726f76650000000000000000000000000000604482015290519081900360640190fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261784b939092909183018282801561783e5780601f106178135761010080835404028352916020019161783e565b820191906000526020600020905b81548152906001019060200180831161782157829003601f168201915b5050505050826001617ec6565b156157745760048054604051600160e11b637181418b02815260209281018381528a5160248301528a516001600160a01b039093169363e3028316938c9383926044909101919085019080838360005b838110156178b357818101518382015260200161789b565b50505050905090810190601f1680156178e05780820380516001836020036101000a031916815260200191505b5092505050600060405180830381600087803b1580156178ff57600080fd5b505af1158015617913573d6000803e3d6000fd5b505060018054600954604051600160e01b637b71357902815260448101829052606481018490526084810184905260a0600482019081526008805460026000198289161561010002019091160460a484018190526001600160a01b039095169750637b7135799650948e9490928392918291602481019160c490910190899080156179df5780601f106179b4576101008083540402835291602001916179df565b820191906000526020600020905b8154815290600101906020018083116179c257829003601f168201915b5050838103825287518152875160209182019189019080838360005b83811015617a135781810151838201526020016179fb565b50505050905090810190601f168015617a405780820380516001836020036101000a031916815260200191505b50975050505050505050600060405180830381600087803b158015617a6457600080fd5b505af1158015617a78573d6000803e3d6000fd5b5050600354604051600160e21b633e0b822b02815261ffff80891660448301528716606482015260a0600482019081528a5160a48301528a516001600160a01b03909316945063f82e08ac93508a928a928a928a928f9282916024820191608481019160c49091019060208b019080838360005b83811015617b04578181015183820152602001617aec565b50505050905090810190601f168015617b315780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015617b64578181015183820152602001617b4c565b50505050905090810190601f168015617b915780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b83811015617bc4578181015183820152602001617bac565b50505050905090810190601f168015617bf15780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015617c1657600080fd5b505af1158015617c2a573d6000803e3d6000fd5b50506000805460408051600160e01b63c214e5e50281526001600160a01b038881166024830152600482019283528d5160448301528d519316955063c214e5e594508c9388938392606401916020870191908190849084905b83811015617c9b578181015183820152602001617c83565b50505050905090810190601f168015617cc85780820380516001836020036101000a031916815260200191505b509350505050602060405180830381600087803b158015617ce857600080fd5b505af1158015617cfc573d6000803e3d6000fd5b505050506040513d602081101561273c57600080fd5b6004805460408051600160e01b638c8642df0281526002602482018190529381019182528451604482015284516000946001600160a01b0390941693638c8642df93879391929091829160649091019060208601908083838c5b83811015617d84578181015183820152602001617d6c565b50505050905090810190601f168015617db15780820380516001836020036101000a031916815260200191505b50935050505060206040518083038186803b158015617dcf57600080fd5b505afa158015617de3573d6000803e3d6000fd5b505050506040513d6020811015617df957600080fd5b505192915050565b600480546040517fffe40d1d00000000000000000000000000000000000000000000000000000000815260209281018381528451602483015284516000946001600160a01b039094169363ffe40d1d9387939283926044909201918501908083838b5b83811015617e7c578181015183820152602001617e64565b50505050905090810190601f168015617ea95780820380516001836020036101000a031916815260200191505b509250505060206040518083038186803b158015617dcf57600080fd5b600254604051600160e21b632c084e190281526001600160a01b03848116602483015260448201849052606060048301908152865160648401528651600094929092169263b02138649288928892889282916084019060208701908083838d5b83811015617f3e578181015183820152602001617f26565b50505050905090810190601f168015617f6b5780820380516001836020036101000a031916815260200191505b50945050505050602060405180830381600087803b158015617f8c57600080fd5b505af1158015617fa0573d6000803e3d6000fd5b505050506040513d6020811015617fb657600080fd5b5051949350505050565b801561809a5760025460408051600160e01b635607395b0281526001600160a01b03858116602483015260048201928352865160448301528651931692635607395b9287928792829160640190602086019080838360005b83811015618030578181015183820152602001618018565b50505050905090810190601f16801561805d5780820380516001836020036101000a031916815260200191505b509350505050600060405180830381600087803b15801561807d57600080fd5b505af1158015618091573d6000803e3d6000fd5b50505050618151565b60025460408051600160e11b632ce5eb7f0281526001600160a01b038581166024830152600482019283528651604483015286519316926359cbd6fe9287928792829160640190602086019080838360005b838110156181045781810151838201526020016180ec565b50505050905090810190601f1680156181315780820380516001836020036101000a031916815260200191505b509350505050600060405180830381600087803b158015615c3857600080fd5b505050565b6001546000906001600160a01b031663abf5739f84846152af815b60048054604051600160e11b630bbe46c502815260209281018381528451602483015284516060946001600160a01b039094169363177c8d8a93879392839260449092019185019080838360005b838110156181d75781810151838201526020016181bf565b50505050905090810190601f1680156182045780820380516001836020036101000a031916815260200191505b509250505060006040518083038186803b15801561822157600080fd5b505afa158015618235573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561825e57600080fd5b810190808051600160201b81111561827557600080fd5b8201602081018481111561828857600080fd5b8151600160201b8111828201871017156182a157600080fd5b50909695505050505050565b6004805460408051600160e01b638c8642df028152602481018590529283019081528451604484015284516000936001600160a01b0390931692638c8642df9287928792829160649091019060208601908083838c83811015613f5f578181015183820152602001613f47565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061835b5782800160ff19823516178555618388565b82800160010185558215618388579182015b8281111561838857823582559160200191906001019061836d565b50612f68926132c09250905b80821115612f68576000815560010161839456fe6f7267206e6f7420696e20617070726f76656420737461747573000000000000496e636f7272656374206e6574776f726b20626f6f7420737461747573000000696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6c6f77656463616e2062652063616c6c656420627920696e7465726661636520636f6e7472616374206f6e6c796163636f756e74206973206e6f742061206e6574776f726b2061646d696e206163636f756e746163636f756e74206973206e6f742061206f72672061646d696e206163636f756e74a165627a7a72305820076e128f2df9e9b08b731798802e430db87855f1ea7dd2b592784c23ef6897aa0029"
+var PermImplBin = "0x6080346200010957601f62005fb938819003918201601f19168301916001600160401b038311848410176200010e5780849260c09460405283398101031262000109576200004d816200013d565b906200005c602082016200013d565b6200006a604083016200013d565b62000078606084016200013d565b926200009560a06200008d608084016200013d565b92016200013d565b92600360095560ff19600a5416600a5560018060a01b03948580948180948160018060a01b03199b168b60055416176005551689600454161760045516876001541617600155168560005416176000551683600254161760025516906003541617600355604051615e669081620001538239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b51906001600160a01b0382168203620001095756fe608080604052600436101561001357600080fd5b600090813560e01c90816304e81f1e146149b65750806309188a971461472d5780631a2e91d7146146ae5780631b04c276146145035780631b610220146140d15780631c24991214613f3f57806337a7db5514613e415780633cf5f33b14613c72578063404bf3eb14613aae57806342bc161a1461398857806344478e791461389c57806345a59e5b1461383a5780634b20f45f1461361f5780634cbfa82e146135fc5780634fe57e7a146134d75780635ca5adbe1461328357806368a6127314612fb65780636b568d7614612f9c5780637ae41f0414612cfc5780638683c7fe14612b9a57806388843041146128635780638baa819114612500578063936421d5146124ae5780639bd3810114612494578063a042bf4014612277578063a5843f081461209f578063ae06ab7814611e76578063b554656414611b23578063b9b7fe6c14611934578063ba125758146117f6578063c4b4d8a014611746578063c62dd61214611605578063cc9ba6fa14611570578063ceec8b70146114db578063d1aa0c20146114b2578063d621d957146112cb578063e91b0e1914610fdd578063ecad01d514610ea5578063f346a3a714610d63578063f43e6fbd14610ac4578063f5ad584a146106995763f75f0a06146101ef57600080fd5b346105c8576101fd36614eec565b9695936004602060018060a099969795991b03600554166040519283809263395c945760e21b82525afa801561068e578890610653575b61024991506001600160a01b03163314614f9f565b61025f60016102578a615557565b151514615278565b6004805460408051638c8642df60e01b81529283015260209082906001600160a01b03168180610292604482018c614ec7565b6001602483015203915afa80156105d357600090610619575b600191501515036105df5760405197886102c4816150bf565b036102cf908a614d12565b600254604051631bc4815b60e11b815260c06004820152996001600160a01b03909116906103019060c48c0190614ec7565b6001600160a01b0390921660248b015289820360031990810160448c0152918a90819061032e908a614ec7565b848282030160648301526103429088614ec7565b6001600160a01b038b166084830152600160a483015203815a602094600091f180156105d35760009061058f575b8899509795969761037f578580f35b6004546001600160a01b0316803b1561058b57604051637181418b60e11b81526020600482015290879082908183816103bb602482018f614ec7565b03925af190811561058057879161056c575b505060018060a01b036001541660095491813b1561056857879161042391838b60405196879586948593637b71357960e01b855260a0600486015261041460a486016151d9565b91858303016024860152614ec7565b906044830152600160648301526001608483015203925af190811561055d578691610545575b50506003546001600160a01b031690813b15610541578580946104838960405198899788968795633e0b822b60e21b8752600487016152d3565b03925af1801561051e57610529575b5050825460405163c214e5e560e01b81529260209284926001600160a01b0316918391879183916104c7919060048401615329565b03925af1801561051e576104e1575b808281808080808580f35b6020813d602011610516575b816104fa60209383614d12565b810103126105125761050b9061531c565b50386104d6565b5080fd5b3d91506104ed565b6040513d84823e3d90fd5b61053290614cce565b61053d578238610492565b8280fd5b8580fd5b61054e90614cce565b610559578438610449565b8480fd5b6040513d88823e3d90fd5b8780fd5b61057590614cce565b6105415785386103cd565b6040513d89823e3d90fd5b8680fd5b60208a3d6020116105cb575b816105a860209383614d12565b810103126105c857506105bf88999896979861531c565b97969597610370565b80fd5b3d915061059b565b6040513d6000823e3d90fd5b60405162461bcd60e51b81526020600482015260126024820152714e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b6020823d60201161064b575b8161063260209383614d12565b810103126105c8575061064660019161531c565b6102ab565b3d9150610625565b506020813d602011610686575b8161066d60209383614d12565b810103126105685761068161024991614f8b565b610234565b3d9150610660565b6040513d8a823e3d90fd5b50346105c85760803660031901126105c8576001600160401b0390600435828111610512576106cc903690600401614b30565b9092602435818111610ac0576106e6903690600401614b30565b94909260443583811161054157610701903690600401614b30565b93909661070c614bcb565b9560059361072460018060a01b038654163314615082565b61073360ff600a541615614ffc565b838111610aac5780610746600654615048565b96601f97888111610a54575b508a908883116001146109e1578b926109d6575b50508160011b916000199060031b1c1916176006555b8282116109c2578190610790600754615048565b86811161096a575b5088908683116001146108f75789926108ec575b50508160011b916000199060031b1c1916176007555b83116108d8576107d3600854615048565b90828211610894575b5050839082116001146108285783948293949261081d575b50508160011b916000199060031b1c1916176008555b60ff8019600a54169115151617600a5580f35b0135905038806107f4565b601f19821694600080516020615df183398151915291855b87811061087c575083600195969710610862575b505050811b0160085561080a565b0135600019600384901b60f8161c19169055388080610854565b90926020600181928686013581550194019101610840565b600080516020615df18339815191529083808601821c830193602087106108cf575b01901c01905b818110156107dc578581556001016108bc565b935082936108b6565b634e487b7160e01b85526041600452602485fd5b0135905038806107ac565b60078a52600080516020615dd1833981519152925090601f1984168a5b8181106109525750908460019594939210610938575b505050811b016007556107c2565b0135600019600384901b60f8161c1916905538808061092a565b91936020600181928787013581550195019201610914565b90915060078952600080516020615dd183398151915286808501871c820192602086106109b9575b90859493929101871c01905b8181106109ab5750610798565b8a815584935060010161099e565b92508192610992565b634e487b7160e01b88526041600452602488fd5b013590503880610766565b60068c52600080516020615e11833981519152925090601f1984168c5b818110610a3c5750908460019594939210610a22575b505050811b0160065561077c565b0135600019600384901b60f8161c19169055388080610a14565b919360206001819287870135815501950192016109fe565b90915060068b52600080516020615e1183398151915288808501891c82019260208610610aa3575b90859493929101891c01905b818110610a955750610752565b8c8155849350600101610a88565b92508192610a7c565b634e487b7160e01b89526041600452602489fd5b8380fd5b50346105c85760031960c036820112610512576001600160401b03600435818111610ac057610af7903690600401614b30565b610aff614b5d565b9260443590811161054157610b1960049136908301614b30565b93610b22614b89565b60055460405163395c945760e21b81526001600160a01b03969095909291602091879182908a165afa8015610d585786958b91610cfc575b506001610257610ba4959493610b79610bac99610b9d95163314614f9f565b610b9883610b90610b8b36898b614d4e565b6158cb565b151514615351565b615557565b3691614d4e565b933691614d4e565b936000948286541690813b1561058b57918691610c02938360405180968195829463e3483a9d60e01b84528a8d16600485015260806024850152610bf3608485018d614ec7565b91848303016044850152614ec7565b6001606483015203925af18015610cde57610ce9575b5080845416803b15610559576040516339186c3560e01b81526001600160a01b038516600482015260648035602483015260843560448301529091869183919082908490829081015b03925af18015610cde57908591610cca575b50506002541690813b15610ac057918391610ca5938360405180968195829463e98ac22d60e01b8452600484016159f5565b03925af1801561051e57610cb7578280f35b610cc18291614cce565b6105c857808280f35b610cd390614cce565b610ac0578338610c73565b6040513d87823e3d90fd5b610cf590949194614cce565b9238610c18565b95505091906020853d8211610d50575b81610d1960209383614d12565b81010312610d4c57610b9d6001610257610ba495610b798a610d3d610bac9b614f8b565b95509950509394955050610b5a565b8980fd5b3d9150610d0c565b6040513d8c823e3d90fd5b50346105c85760203660031901126105c8576001600160401b03600435828282116105c857610d99610dd0923690600401614b30565b60025460405162539ab360e21b815260206004820152946001600160a01b0394938693928616928492839290916024840191615257565b03915afa908115610e9a5784938580948194610e23575b505050610e13610e0595604051968796608088526080880190614ec7565b908682036020880152614ec7565b9216604084015260608301520390f35b93509350945092503d8083833e610e3a8183614d12565b81019360808286031261053d578151818111610ac05785610e5c9184016154b6565b9260208301519182116105c85750610e0594610e799183016154b6565b92610e136060610e8b60408501614f8b565b93015193949293929538610de7565b6040513d86823e3d90fd5b50346105c85780610eb536614de2565b60055460405163395c945760e21b815292969394926001600160a01b0392916020908290600490829087165afa908115610fd257859184918b91610f7f575b5091610f2e93610f0c610f2693600195163314614f9f565b610f2184610f1984615906565b151514615410565b615640565b151514615392565b6003541691823b1561058b578694610f5e869260405198899788968795634c57331160e01b8752600487016152d3565b03925af1801561051e57610f6f5750f35b610f7890614cce565b6105c85780f35b925050506020813d8211610fca575b81610f9b60209383614d12565b81010312610fc657610f2685610f2e93610f0c86610fba600196614f8b565b93955093505093610ef4565b8880fd5b3d9150610f8e565b6040513d8b823e3d90fd5b50346105c85780610fed36614eec565b60055460405163395c945760e21b81526001600160a01b039996989793969495929091602090839060049082908e165afa8015610cde578a92869161127e575b506001916110446110569461025793163314614f9f565b610b988360ff600a5416151514614ffc565b876002541696873b15610ac05760405163e98ac22d60e01b815260a060048201528998859082908183816110b76110a58f61109360a485016150bf565b84810360031901602486015290614ec7565b8281036003190160448401528b614ec7565b9e8d169e8f60648301526001608483015203925af1908115610cde57859161126a575b50508860045416803b156105595760405163f9953de560e01b8152602060048201529085908290818381611111602482018f614ec7565b03925af1908115610cde578591611256575b5050886003541690813b15610559578480946111568a604051998a978896879563549583df60e01b8752600487016152d3565b03925af1918215611249578492611232575b5050611176906001926157eb565b1515036111ed57839283541691823b156111e8576111d0928492836111bf936040519687958694859363e3483a9d60e01b85526004850152608060248501526084840190614ec7565b8281036003190160448401526151d9565b6001606483015203925af1801561051e57610f6f5750f35b505050fd5b60405162461bcd60e51b815260206004820152601d60248201527f4f7065726174696f6e2063616e6e6f7420626520706572666f726d65640000006044820152606490fd5b61123d919250614cce565b61055957818538611168565b50604051903d90823e3d90fd5b61125f90614cce565b610ac0578338611123565b61127390614cce565b610ac05783386110da565b9250506020823d6020116112c3575b8161129a60209383614d12565b81010312610559576102576001916110448c6112b861105696614f8b565b93509450509161102d565b3d915061128d565b50346105c8578060046112dd36614de2565b60055460405163395c945760e21b8152959792959394939260209184919082906001600160a01b03165afa91821561068e578892611469575b50610257600191610b9861133294848060a01b03163314614f9f565b6003546001600160a01b0316803b1561058b5761137887876113ae61138a978397604051998a98899788966337d50b2760e01b885260c0600489015260c4880190614ec7565b86810360031901602488015290614ec7565b61ffff91821660448601529116606484015282810360031901608484015289614ec7565b600460a483015203925af1908115610e9a578491611451575b50506002546001600160a01b031691823b156111e857611432926114208580946040519687958694859363e98ac22d60e01b855260a0600486015261140e60a486016150bf565b85810360031901602487015290614ec7565b83810360031901604485015290614ec7565b600060648301526005608483015203925af1801561051e57610f6f5750f35b61145a90614cce565b6114655782386113c7565b5050fd5b91506020823d82116114aa575b8161148360209383614d12565b8101031261056857610257600191610b986114a061133295614f8b565b9450509150611316565b3d9150611476565b50346105c85760203660031901126105c85760206114d1610b98614b9f565b6040519015158152f35b50346105c857602090816003193601126105c857600254604051630ceec8b760e41b81526004803590820152908390829060249082906001600160a01b03165afa9182156115645791611532575b50604051908152f35b90508181813d831161155d575b6115498183614d12565b81010312611558575138611529565b600080fd5b503d61153f565b604051903d90823e3d90fd5b50346105c857806003193601126105c857610e0560ff600a5416604051906115a28261159b816150bf565b0383614d12565b6115f96040516115bc816115b58161515b565b0382614d12565b6115eb604051916115d7836115d0816151d9565b0384614d12565b604051968796608088526080880190614ec7565b908482036040860152614ec7565b90151560608301520390f35b50346105c8578061165461161836614e72565b60025460408051636316eb0960e11b81526004810191909152966001600160a01b03959094889492871693859384939192604485019190615257565b90602483015203915afa801561051e57828094819382918380956116b8575b505061169e6116909760405198899860c08a5260c08a0190614ec7565b9088820360208a0152614ec7565b941660408601526060850152608084015260a08301520390f35b9650965093505050503d8083853e6116d08185614d12565b83019260c08185031261053d5780516001600160401b039081811161055957856116fb9184016154b6565b9360208301519182116105c85750611690946117189183016154b6565b9261172560408301614f8b565b9160608101519361169e60a060808401519301519396949592939497611673565b50346105c857611790604061175a36614e72565b6002548451630625a6c560e51b8152600481018690529586946001600160a01b0390921693859384939092916044850191615257565b90602483015203915afa801561051e57829183916117b9575b6040838382519182526020820152f35b9150506040813d6040116117ee575b816117d560409383614d12565b81010312610512576040915060208151910151386117a9565b3d91506117c8565b50346105c8576003199060a0368301126105c8576001600160401b0360043581811161053d5761182a903690600401614b30565b60243583811161055957611842903690600401614b30565b9590936044359081116105415761185d903690600401614b30565b90611866614b73565b92600160a01b60019003928360025416976040519a8b9889986317424aeb60e31b8a5260048a0160a0905260a48a019061189f92615257565b90848983030160248a01526118b392615257565b918683030160448701526118c692615257565b91166064830152608435608483015203815a93602094fa9081156115645780916118f8575b6020826040519015158152f35b90506020823d821161192c575b8161191260209383614d12565b810103126105c8575061192660209161531c565b386118eb565b3d9150611905565b50346105c8578060031960e036820112611b20576001600160401b03906004358281116111e857611969903690600401614d85565b9160243581811161055957611982903690600401614d85565b906044359081116105595761199b903690600401614d85565b906119a4614cbd565b936119ad614cac565b9360a435926119ba614bb5565b60055460405163395c945760e21b81526001600160a01b0392916020908290600490829087165afa908115611b1557869184918d91611ac2575b5091611a0c93610f21610f2693600195163314614f9f565b600185148015611ab8575b8015611aae575b611a279061545c565b6003541693843b15610fc657611a978997611a67958995611a779b6040519c8d9b8c9a8b996337d50b2760e01b8b5260c060048c015260c48b0190614ec7565b90848a83030160248b0152614ec7565b9361ffff8092166044890152166064870152858303016084860152614ec7565b9060a483015203925af1801561051e57610f6f5750f35b5060038514611a1e565b5060028514611a17565b925050506020813d8211611b0d575b81611ade60209383614d12565b81010312611b0957610f2686611a0c93610f2186611afd600196614f8b565b939550935050936119f4565b8a80fd5b3d9150611ad1565b6040513d8d823e3d90fd5b50fd5b50346105c857611b3236614c55565b60055460405163395c945760e21b815291959492939190602090829060049082906001600160a01b03165afa8015610cde578590611e3b575b611b8091506001600160a01b03163314614f9f565b611b8e600161025787615557565b60018314808015611e31575b15611df4578490819015611de1575050600260035b6020611bef611bbf368688614d4e565b60018060a01b036004541690604051809581948293638c8642df60e01b8452604060048501526044840190614ec7565b90602483015203915afa80156105d357600090611da7575b60019150151503611d6a57611ca295602091611c7e6000604051611c2e816115b5816150bf565b611c3936888a614d4e565b93611cb160405195611c4a87614cf7565b8c875260018060a01b0360025416966040519e8f998a988997631bc4815b60e11b895260c060048a015260c4890190614ec7565b6001600160a01b039091166024880152868103600319908101604489015293614ec7565b91858303016064860152614ec7565b9083608484015260a483015203925af180156105d357600090611d31575b849550611cdb57505050f35b6004546001600160a01b0316803b1561055957849283611d1a93604051968795869485936314f775f960e01b8552604060048601526044850191615257565b90602483015203925af1801561051e57610f6f5750f35b6020863d602011611d62575b81611d4a60209383614d12565b810103126105c85750611d5d849561531c565b611ccf565b3d9150611d3d565b60405162461bcd60e51b81526020600482015260156024820152741bdc195c985d1a5bdb881b9bdd08185b1b1bddd959605a1b6044820152606490fd5b6020823d602011611dd9575b81611dc060209383614d12565b810103126105c85750611dd460019161531c565b611c07565b3d9150611db3565b60028503611baf57505060036005611baf565b60405162461bcd60e51b815260206004820152601560248201527413dc195c985d1a5bdb881b9bdd08185b1b1bddd959605a1b6044820152606490fd5b5060028414611b9a565b506020813d602011611e6e575b81611e5560209383614d12565b8101031261055957611e69611b8091614f8b565b611b6b565b3d9150611e48565b50346105c85760208060031936011261051257600435906001600160401b03808311610ac05736602384011215610ac0578260040135908111610ac057602490818401938236918360051b01011161055957600193611edd8560ff600a5416151514614ffc565b6005546040516353adc3e960e11b81526001600160a01b0395918290829060049082908a165afa801561068e5786908990612067575b611f209250163314615082565b865b838110611f2d578780f35b611f43610b98611f3e8387876154f8565b61551e565b80611ff2575b15611f9757611f74611f5f611f3e8387876154f8565b604051611f6f816115b5816150bf565b615877565b6000198114611f84578601611f22565b634e487b7160e01b885260116004528488fd5b60405162461bcd60e51b815260048101839052602e818701527f6163636f756e74206973206e6f7420616e20616374697665206e6574776f726b60448201526d0818591b5a5b881858d8dbdd5b9d60921b6064820152608490fd5b5085885416828787612008611f3e868a8a6154f8565b6040519485938492637ea7d02d60e11b84521660048301525afa8015610fd2578990612039575b6002915014611f49565b508281813d8311612060575b61204f8183614d12565b81010312611558576002905161202f565b503d612045565b50508181813d8311612098575b61207e8183614d12565b810103126105685785612093611f2092614f8b565b611f13565b503d612074565b50346105c85780600319604036820112611b205760055460405163395c945760e21b81526001600160a01b03916020908290600490829086165afa8015610e9a578290859061223b575b6120f69250163314614f9f565b61210560ff600a541615614ffc565b8060045416803b156111e857836040518092639e58eb9f60e01b825260606004830152818381612137606482016150bf565b6004356024830152602435604483015203925af1908115610e9a578491612227575b5050806001541660095490803b1561055957849182916040518094818094637b71357960e01b825260a060048301526121a661219760a4840161515b565b8b8482030160248501526150bf565b906044830152600160648301526001608483015203925af1908115610e9a578491612213575b505416803b1561146557610f5e839291839260405194858094819363cef7f6af60e01b8352604060048401526122046044840161515b565b908382030160248401526151d9565b61221c90614cce565b6114655782386121cc565b61223090614cce565b611465578238612159565b50506020813d821161226f575b8161225560209383614d12565b810103126111e8578161226a6120f692614f8b565b6120e9565b3d9150612248565b50346105c85761228636614de2565b60055460405163395c945760e21b81529796939592949391906001600160a01b039060209081908b90600490829086165afa998a15610fd257899a612456575b506122d78261231d9b163314614f9f565b6122e5600161025785615557565b806040516122f6816115b5816150bf565b8360025416846040519d8e93631bc4815b60e11b855260c0600486015260c4850190614ec7565b96166024830152816000816123526123426003199a8b8482030160448501528c614ec7565b8a8382030160648401528c614ec7565b826084830152600560a483015203925af19081156105d357600091612422575b5088995061237e578780f35b6003541692833b15610568576123ee88966123be9488946123ce9a6040519b8c9a8b998a986337d50b2760e01b8a5260c060048b015260c48a0190614ec7565b90848983030160248a0152614ec7565b9361ffff8092166044880152166064860152848303016084850152614ec7565b600560a483015203925af1801561051e5761240e575b8080808080808780f35b61241790614cce565b6105c8578038612404565b8a813d831161244f575b6124368183614d12565b810103126105c85750612449889961531c565b38612372565b503d61242c565b9950808a813d831161248d575b61246d8183614d12565b81010312610fc6576122d78261248561231d9c614f8b565b9b50506122c6565b503d612463565b50346105c85760206114d16124a836614da3565b90615640565b50346105c85760c03660031901126105c8576124c8614b9f565b906124d1614b5d565b9060a435906001600160401b0382116105c85760206114d185856124f83660048801614b30565b929050615ac6565b50346105c8576003196080368201126105125761251b614b9f565b6001600160401b036024358181116105595761253b903690600401614d85565b9060443590811161055957612554903690600401614d85565b9061255d614b73565b60055460405163395c945760e21b81526001600160a01b0394926020929091908390829060049082908a165afa908115610fd257859187918b91612818575b50916125b493610f21610f2693600195163314614f9f565b6125c26001610f1985615906565b60009560016125d185886157eb565b1515036127d3578460015416612606836125ea87615957565b6040518093819263abf5739f60e01b83528a8a60048501615617565b0381855afa8015610fd257899061279e575b60019150151503612762578261264f9161263187615957565b906040518080958194632f8c8b9560e21b83528b8b60048501615617565b03915afa92831561068e57889361272a575b50508487541692833b156105685787876126a996946126b88397958a956040519a8b998a98899763050e958160e21b8952166004880152608060248801526084870190614ec7565b91858303016044860152614ec7565b901515606483015203925af18015610e9a57612717575b5082541690813b1561053d576040516339186c3560e01b81526001600160a01b0390911660048201526024810183905260448101839052908290829081838160648101610ca5565b61272390939193614cce565b91386126cf565b9080929350813d831161275b575b6127428183614d12565b8101031261058b576127539061531c565b903880612661565b503d612738565b60405162461bcd60e51b8152600481018490526014602482015273726f6c6520646f6573206e6f742065786973747360601b6044820152606490fd5b508381813d83116127cc575b6127b48183614d12565b81010312610fc6576127c760019161531c565b612618565b503d6127aa565b60405162461bcd60e51b815260048101839052601d60248201527f6f7065726174696f6e2063616e6e6f7420626520706572666f726d65640000006044820152606490fd5b925050508281813d831161285c575b6128318183614d12565b81010312610fc657610f26856125b493610f2189612850600196614f8b565b9395509350509361259c565b503d612827565b50346105c85761287236614bda565b60055460405163395c945760e21b8152929460209492936001600160a01b0393919286908290600490829088165afa801561068e5784908990612b62575b6128bd9250163314614f9f565b6128cb600161025786615557565b612927856040516128df816115b5816150bf565b6129506128ed368688614d4e565b6040516128f981614cf7565b8c815261294189600254169a8a604051988997631bc4815b60e11b895260c060048a015260c4890190614ec7565b911660248701526003199384878303016044880152614ec7565b91848303016064850152614ec7565b9681600081898d169a8b6084830152600460a483015203925af19081156105d357600091612b2d575b50612982578680f35b828754169187604080518095631d09dc9360e01b82528960048301528184816129af602482018a8a615257565b03925af1801561124957879482908392612ae3575b50612a71575b506129f99394815416926040519687958694859363c214e5e560e01b8552604060048601526044850191615257565b90602483015203925af1918215610e9a578492612a3b575b5050612a21575b80808080808680f35b612a3590604051611f6f816115b5816150bf565b38612a18565b90809250813d8311612a6a575b612a528183614d12565b8101031261053d57612a639061531c565b3880612a11565b503d612a48565b935050604051612a84816115b5816150bf565b846002541693843b1561155857612ab59460009283604051809881958294632ce5eb7f60e11b845260048401615329565b03925af180156105d35786946129f9948a92612ad4575b5094936129ca565b612add90614cce565b38612acc565b955050506040843d604011612b25575b81612b0060409383614d12565b810103126105c85783612b1e88612b17819761531c565b9201614f8b565b90386129c4565b3d9150612af3565b908682813d8311612b5b575b612b438183614d12565b810103126105c85750612b559061531c565b38612979565b503d612b39565b50508581813d8311612b93575b612b798183614d12565b810103126105685783612b8e6128bd92614f8b565b6128b0565b503d612b6f565b50346105c85780600319608036820112611b20576001600160401b03906004358281116111e857612bcf903690600401614b30565b91909260243590811161055957612bea903690600401614b30565b93612bf3614c9b565b612bfb614cbd565b60055460405163395c945760e21b81529196916001600160a01b03916020908290600490829086165afa8015610d585782908b90612cc0575b612c419250163314614f9f565b612c5060ff600a541615614ffc565b6003541693843b15610fc6578896612c90968894612ca0610f5e946040519c8d9b8c9a8b99634530abe160e01b8b5260a060048c015260a48b0191615257565b91858984030160248a0152615257565b9261ffff80921660448701521660648501528382030160848401526150bf565b50506020813d8211612cf4575b81612cda60209383614d12565b81010312610d4c5781612cef612c4192614f8b565b612c34565b3d9150612ccd565b50346105c85760031960c03682011261051257612d17614b9f565b6001600160401b0360243581811161055957612d37903690600401614d85565b9060443590811161055957612d50903690600401614d85565b90612d59614b89565b60055460405163395c945760e21b81526001600160a01b0394926020929091908390829060049082908a165afa908115610fd257859187918b91612f51575b5091612db093610f21610f2693600195163314614f9f565b612dbe6001610f1985615906565b612dc883866157eb565b9560016000971515036127d3578460015416612de7836125ea87615957565b0381855afa8015610fd2578990612f1c575b600191501515036127625782612e129161263187615957565b03915afa92831561068e578893612ee4575b50508487541692833b156105685787876126a99694612e6c8397958a956040519a8b998a98899763050e958160e21b8952166004880152608060248801526084870190614ec7565b901515606483015203925af18015610e9a57612ed1575b5082541690813b1561053d576040516339186c3560e01b81526001600160a01b0390911660048201526064803560248301526084356044830152909183918391908290849082908101610ca5565b612edd90939193614cce565b9138612e83565b9080929350813d8311612f15575b612efc8183614d12565b8101031261058b57612f0d9061531c565b903880612e24565b503d612ef2565b508381813d8311612f4a575b612f328183614d12565b81010312610fc657612f4560019161531c565b612df9565b503d612f28565b925050508281813d8311612f95575b612f6a8183614d12565b81010312610fc657610f2685612db093610f2189612f89600196614f8b565b93955093505093612d98565b503d612f60565b50346105c85760206114d1612fb036614da3565b906157eb565b50346105c85760e03660031901126105c8576001600160401b0360043581811161053d57612fe8903690600401614b30565b919060243582811161055957613002903690600401614b30565b91909360443584811161058b5761301d903690600401614b30565b9460643590811161056857613036903690600401614b30565b939095613041614cac565b9560a4359261ffff84168403611558578a99600461305d614bb5565b60055460405163395c945760e21b81529260209184919082906001600160a01b03165afa80156132785789928e91613219575b506001916130bf83610b90610b8b6130ca976130b7610f2697858060a01b03163314614f9f565b36908b614d4e565b6124a8368c88614d4e565b6004546001600160a01b0316803b15613215578b846131039284838c60405196879586948593623f2a6960e71b85528c600486016153e9565b03925af190811561320a578c916131f2575b5050908260219261315194896040519a8b956020870137840191601760f91b602084015285830137018b83820152036001810187520185614d12565b8061315a578780f35b6003546001600160a01b031693843b15610fc65788968793604051998a9889978896634c57331160e01b88526004880160a0905260a488019061319c92615257565b906003198783030160248801526131b292615257565b9161ffff16604485015261ffff1660648401526003198382030160848401526131da91614ec7565b03925af1801561051e5761240e578080808080808780f35b6131fe90939293614cce565b611b0957908a38613115565b6040513d8e823e3d90fd5b8b80fd5b9250506020823d602011613270575b8161323560209383614d12565b8101031261326c57610f266001916130bf83610b90610b8b8e6130b761325d6130ca9a614f8b565b97505097505050505091613090565b8c80fd5b3d9150613228565b6040513d8f823e3d90fd5b50346105c85760603660031901126105c8576001600160401b0390600435828111610512576132b6903690600401614b30565b9260243590811161053d576132cf903690600401614b30565b60443594909291906001600160a01b03808716870361155857806005541696604051809863395c945760e21b825281600460209b8c935afa90811561068e57908391899161348e575b506133519261332f600193610f2693163314614f9f565b61334683610f19613341368d8c614d4e565b615906565b6124a8368a89614d4e565b60405187810188815281613369604082018689615257565b039161337d601f1993848101835282614d12565b519020604051898101908a82526133a68161339a6040820161515b565b03858101835282614d12565b5190201415908161342f575b50156133ea578596506001541690813b1561054157858094610f5e6040519788968795869463531a180960e11b8652600486016153e9565b60405162461bcd60e51b815260048101889052601d60248201527f61646d696e20726f6c65732063616e6e6f742062652072656d6f7665640000006044820152606490fd5b9050604051888101908982526134598161344d60408201888b615257565b03848101835282614d12565b519020906040516134838a8201928b845282613477604082016151d9565b03908101835282614d12565b5190201415386133b2565b8092508a8092503d83116134d0575b6134a78183614d12565b8101031261056857610f266133519261332f856134c5600195614f8b565b935093505092613318565b503d61349d565b50346105c85780600319602036820112611b20576134f3614b9f565b60055460405163395c945760e21b81526001600160a01b0393916020908290600490829088165afa8015610cde57849086906135c0575b6135379250163314614f9f565b61354660ff600a541615614ffc565b61355a82604051611f6f816115b5816150bf565b82845416803b15610559576135a8938580946040519687958694859363e3483a9d60e01b855216600484015260806024840152613599608484016150bf565b9083820301604484015261515b565b6002606483015203925af1801561051e57610f6f5750f35b50506020813d82116135f4575b816135da60209383614d12565b8101031261055957836135ef61353792614f8b565b61352a565b3d91506135cd565b50346105c857806003193601126105c857602060ff600a54166040519015158152f35b50346105c85761362e36614bda565b60055460405163395c945760e21b81529195949293916001600160a01b03916020908290600490829086165afa90811561055d5790829187916137fe575b5016331461367990614f9f565b61368286615557565b151560011461369090615278565b604051958661369e816150bf565b036136a99088614d12565b816136b5368587614d4e565b97816040516136c381614cf7565b8981528160025416946040519b8c958695631bc4815b60e11b87526004870160c0905260c487016136f391614ec7565b92166024860152600319918286820301604487015261371191614ec7565b9084820301606485015261372491614ec7565b97169687608483015260a482016006905203815a602094600091f180156105d3576000906137c5575b859650613758578480f35b845416803b156105595784928361378e936040519687958694859363425bd42560e11b8552606060048601526064850191615257565b9060248301526005604483015203925af1801561051e576137b1575b8080808480f35b6137ba90614cce565b6105c85780386137aa565b6020873d6020116137f6575b816137de60209383614d12565b810103126105c857506137f1859661531c565b61374d565b3d91506137d1565b9150506020813d602011613832575b8161381a60209383614d12565b810103126105415761382c8291614f8b565b3861366c565b3d915061380d565b50346105c85760603660031901126105c8576001600160401b0360043581811161053d5761386c903690600401614b30565b9190926024359182116105c85760206114d1858561388d3660048801614b30565b91613896614c9b565b93615a3f565b50346105c857806003193601126105c85760055460405163395c945760e21b8152602092916001600160a01b039084908390600490829085165afa801561397d578390613945575b6138f19250163314614f9f565b7f04f651be6fb8fc575d94591e56e9f6e66e33ef23e949765918b3bdae50c617cf826001600a549361392660ff861615614ffc565b60ff1985168217600a55604051941617151580845292a1604051908152f35b50908381813d8311613976575b61395c8183614d12565b8101031261053d57906139716138f192614f8b565b6138e4565b503d613952565b6040513d85823e3d90fd5b50346105c85761399736614c2b565b60055460405163395c945760e21b81529193602093916001600160a01b03919085908590600490829086165afa80156105805785948891613a73575b50826139e191163314614f9f565b6139ef600161025788615557565b8682600254169260405197889586948593630214ddc360e01b855260806004860152613a1d608486016150bf565b931660248501526044840152606483015203925af1801561397d57613a40578280f35b81813d8311613a6c575b613a548183614d12565b8101031261051257613a659061531c565b5038808280f35b503d613a4a565b85819692503d8311613aa7575b613a8a8183614d12565b8101031261058b576139e182613aa08796614f8b565b91506139d3565b503d613a80565b50346105c857600319608036820112610512576001600160401b03600435818111610ac057613ae1903690600401614b30565b613ae9614b5d565b9260443590811161054157613b0360049136908301614b30565b93613b0c614b73565b60055460405163395c945760e21b81526001600160a01b03969095909291602091879182908a165afa8015610d585786958b91613c1a575b506001610257610ba4959493610b79613b6399610b9d95163314614f9f565b936000948286541690813b1561058b57918691613baa938360405180968195829463e3483a9d60e01b84528a8d16600485015260806024850152610bf3608485018d614ec7565b6001606483015203925af18015610cde57613c07575b5080845416803b15610559576040516339186c3560e01b81526001600160a01b03851660048201526024810186905260448101869052908590829081838160648101610c61565b613c1390949194614cce565b9238613bc0565b95505091906020853d8211613c6a575b81613c3760209383614d12565b81010312610d4c57610b9d6001610257610ba495610b798a613c5b613b639b614f8b565b95509950509394955050613b44565b3d9150613c2a565b50346105c857613c8136614c55565b60055460405163395c945760e21b815292946020946001600160a01b0394919290919086908290600490829089165afa801561068e5785918991613df4575b50610257613cda95610b98610b9d94600194163314614f9f565b906000938160045416846040518092630cc2749360e01b825260406004830152818981613d0a604482018b614ec7565b88602483015203925af194851561055d578695613dc3575b5050600114613d5c575b6002541690813b15610ac057918391610ca5938360405180968195829463e98ac22d60e01b8452600484016159b4565b8060045416803b1561055957846040518092633bcb6c3b60e01b825260606004830152818381613d8f606482018a614ec7565b82602483015282604483015203925af18015610cde57613db0575b50613d2c565b613dbc90949194614cce565b9238613daa565b9080929550813d8311613ded575b613ddb8183614d12565b81010312610559575192600138613d22565b503d613dd1565b809250878092503d8311613e3a575b613e0d8183614d12565b81010312610568576001610257613cda95610b9888613e2e610b9d96614f8b565b94509450509550613cc0565b503d613e03565b50346105c85780613e5136614c2b565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa801561055d5783928791613ef3575b50600191610b98613ea09461025793163314614f9f565b6002541691823b156111e85760405163578a279d60e11b8152606060048201529284928492839185918391613ed7606484016150bf565b916024840152604483015203925af1801561051e57610f6f5750f35b9250506020823d8211613f37575b81613f0e60209383614d12565b8101031261054157610257600191610b9885613f2c613ea096614f8b565b935094505091613e89565b3d9150613f01565b50346105c85780613f4f36614bda565b60055460405163395c945760e21b81529394936001600160a01b039290916020908390600490829087165afa80156105805783928891614085575b50600191610b98613fa19461025793163314614f9f565b80855416803b156105415785604051809263425bd42560e11b82526060600483015281838187613fd5606483018d8d615257565b9916988960248301526004604483015203925af190811561055d578691614071575b505060025416803b15610559578493846020819561404895604051988997889663e98ac22d60e01b885260a0600489015261403460a489016150bf565b9060031995868a84030160248b0152615257565b86810393840160448801525260648501526006608485015201925af1801561051e57610f6f5750f35b61407a90614cce565b610559578438613ff7565b9250506020823d82116140c9575b816140a060209383614d12565b8101031261058b57610257600191610b98856140be613fa196614f8b565b935094505091613f8a565b3d9150614093565b50346105c85760603660031901126105c8576001600160401b039060043582811161051257614104903690600401614b30565b602493919335828111610ac05761411f903690600401614b30565b9160443584811161054157614138903690600401614b30565b94909360018060a01b03936005938585541660405196879163395c945760e21b835282600460209a8b935afa8015611b15578b906144cb575b61417e9250163314614f9f565b61418d60ff600a541615614ffc565b838111610aac57806141a0600654615048565b9a601f9b8c8111614474575b508a908c8311600114614401578b926143f6575b50508160011b916000199060031b1c1916176006555b8282116109c25781906141ea600754615048565b8a811161439f575b5088908a831160011461432c578992614321575b50508160011b916000199060031b1c1916176007555b84116108d85761422d600854615048565b908682116142de575b5050839483116001146142725750928293829392614267575b50508160011b916000199060031b1c19161760085580f35b01359050388061424f565b601f19831694600080516020615df1833981519152929185905b8782106142c65750508360019596106142ac575b505050811b0160085580f35b0135600019600384901b60f8161c191690553880806142a0565b8060018496829495870135815501950192019061428c565b600080516020615df18339815191529087808701821c830193858810614318575b01901c01905b8181101561423657858155600101614305565b935082936142ff565b013590503880614206565b60078a52600080516020615dd1833981519152925090601f1984168a5b8882821061438957505090846001959493921061436f575b505050811b0160075561421c565b0135600019600384901b60f8161c19169055388080614361565b6001849682939587013581550195019201614349565b90915060078952600080516020615dd18339815191528a808501871c8201928886106143ed575b90859493929101871c01905b8181106143df57506141f2565b8a81558493506001016143d2565b925081926143c6565b0135905038806141c0565b60068c52600080516020615e11833981519152925090601f1984168c5b8a82821061445e575050908460019594939210614444575b505050811b016006556141d6565b0135600019600384901b60f8161c19169055388080614436565b600184968293958701358155019501920161441e565b90915060068b52600080516020615e118339815191528c808501891c8201928a86106144c2575b90859493929101891c01905b8181106144b457506141ac565b8c81558493506001016144a7565b9250819261449b565b50908781813d83116144fc575b6144e28183614d12565b81010312611b0957906144f761417e92614f8b565b614171565b503d6144d8565b50346105c85760031960c036820112610512576001600160401b03600435818111610ac057614536903690600401614b30565b92909160243590811161055957614551903690600401614b30565b909261455b614bcb565b9060843593841515809503611558578796614574614b89565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa8015611b15578389918c948e9161464e575b506001610f196133416145dd97610f2696610b9d8599976145d297163314614f9f565b6124a8368b8e614d4e565b6001541693843b15610fc65788966146288894614619986040519b8c9a8b998a98637b71357960e01b8a5260a060048b015260a48a0191615257565b92878403016024880152615257565b91604435604485015215156064840152608483015203925af1801561051e57610f6f5750f35b94505050506020823d82116146a6575b8161466b60209383614d12565b81010312611b0957610f266001916145d283610f196133418f8e610b9d8b6146956145dd9c614f8b565b9750979950509650975050506145af565b3d915061465e565b50346105c857602090816003193601126105c8576004356001600160401b03811161051257826146e5614714923690600401614b30565b60018060a01b036002541690604051809581948293631a2e91d760e01b84528760048501526024840191615257565b03915afa91821561156457916115325750604051908152f35b50346105c85760a03660031901126105c8576004356001600160401b0381116105125761475e903690600401614b30565b6024359160843591906001600160a01b03906044359060643590838616860361155857836005541660405196879163395c945760e21b835282600460209a8b935afa8015610d585786928b9161496d575b50600191610b986147c69461025793163314614f9f565b600187149485801561495c575b1561490757906147e4913691614d4e565b936004549686614817600099888b89604051809781968295630cc2749360e01b8452604060048501526044840190614ec7565b9060248301520393165af196871561068e5788976148d7575b5050614869575b50506002541690813b15610ac057918391610ca5938360405180968195829463e98ac22d60e01b8452600484016159b4565b826004541690813b1561058b57604051633bcb6c3b60e01b815260606004820152928792849290918391859183916148a4606484018c614ec7565b916024840152604483015203925af18015610cde576148c4575b80614837565b6148d090949194614cce565b92386148be565b9080929750813d8311614900575b6148ef8183614d12565b8101031261058b5751943880614830565b503d6148e5565b60405162461bcd60e51b815260048101889052602760248201527f6578706972792063616e20626520676976656e20666f722073757370656e73696044820152666f6e206f6e6c7960c81b6064820152608490fd5b50831580156147d3575082156147d3565b809350898092503d83116149af575b6149868183614d12565b81010312610d4c57610257600191610b98886149a46147c696614f8b565b9350945050916147af565b503d61497c565b90503461051257608036600319011261051257816004356001600160401b038111610512576149e9903690600401614b30565b6149f1614b5d565b916044356149fd614b73565b60055463395c945760e21b88526001600160a01b03979190602090839060049082908c165afa80156105805788928891614ae4575b50600191614a49614a5494610f2693163314614f9f565b6124a8368888614d4e565b600181148015614ada575b8015614ad0575b614a6f9061545c565b8585541692833b1561054157614aa69486926040519889978896879563425bd42560e11b8752606060048801526064870191615257565b92166024840152604483015203925af1801561051e57614ac4575080f35b614acd90614cce565b80f35b5060038114614a66565b5060028114614a5f565b9250506020823d8211614b28575b81614aff60209383614d12565b8101031261058b57610f26600191614a498a614b1d614a5496614f8b565b935094505091614a32565b3d9150614af2565b9181601f84011215611558578235916001600160401b038311611558576020838186019501011161155857565b602435906001600160a01b038216820361155857565b606435906001600160a01b038216820361155857565b60a435906001600160a01b038216820361155857565b600435906001600160a01b038216820361155857565b60c435906001600160a01b038216820361155857565b60643590811515820361155857565b606060031982011261155857600435906001600160401b03821161155857614c0491600401614b30565b90916001600160a01b03906024358281168103611558579160443590811681036115585790565b60609060031901126115585760043590602435906044356001600160a01b03811681036115585790565b606060031982011261155857600435906001600160401b03821161155857614c7f91600401614b30565b9091602435906044356001600160a01b03811681036115585790565b6044359061ffff8216820361155857565b6084359061ffff8216820361155857565b6064359061ffff8216820361155857565b6001600160401b038111614ce157604052565b634e487b7160e01b600052604160045260246000fd5b602081019081106001600160401b03821117614ce157604052565b90601f801991011681019081106001600160401b03821117614ce157604052565b6001600160401b038111614ce157601f01601f191660200190565b929192614d5a82614d33565b91614d686040519384614d12565b829481845281830111611558578281602093846000960137010152565b9080601f8301121561155857816020614da093359101614d4e565b90565b906040600319830112611558576004356001600160a01b03811681036115585791602435906001600160401b03821161155857614da091600401614d85565b60c0600319820112611558576001600160401b03916004358381116115585782614e0e91600401614d85565b926024358181116115585783614e2691600401614d85565b9260443591821161155857614e3d91600401614d85565b9061ffff90606435828116810361155857916084359081168103611558579060a4356001600160a01b03811681036115585790565b604060031982011261155857600435906001600160401b03821161155857614e9c91600401614b30565b909160243590565b60005b838110614eb75750506000910152565b8181015183820152602001614ea7565b90602091614ee081518092818552858086019101614ea4565b601f01601f1916010190565b60e0600319820112611558576001600160401b03916004358381116115585782614f1891600401614d85565b926024358181116115585783614f3091600401614d85565b9260443591821161155857614f4791600401614d85565b9061ffff9060643582811681036115585791608435908116810361155857906001600160a01b039060a4358281168103611558579160c43590811681036115585790565b51906001600160a01b038216820361155857565b15614fa657565b60405162461bcd60e51b815260206004820152602860248201527f63616e2062652063616c6c656420627920696e7465726661636520636f6e7472604482015267616374206f6e6c7960c01b6064820152608490fd5b1561500357565b60405162461bcd60e51b815260206004820152601d60248201527f496e636f7272656374206e6574776f726b20626f6f74207374617475730000006044820152606490fd5b90600182811c92168015615078575b602083101461506257565b634e487b7160e01b600052602260045260246000fd5b91607f1691615057565b1561508957565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b600654600092916150cf82615048565b90818152602092600190818116908160001461513e57506001146150f4575b50505050565b92939450906006600052600080516020615e1183398151915292846000945b83861061512a5750505050010190388080806150ee565b805485870183015294019385908201615113565b60ff191685840152505090151560051b01019150388080806150ee565b6007546000929161516b82615048565b90818152602092600190818116908160001461513e575060011461518f5750505050565b92939450906007600052600080516020615dd183398151915292846000945b8386106151c55750505050010190388080806150ee565b8054858701830152940193859082016151ae565b600854600092916151e982615048565b90818152602092600190818116908160001461513e575060011461520d5750505050565b92939450906008600052600080516020615df183398151915292846000945b8386106152435750505050010190388080806150ee565b80548587018301529401938590820161522c565b908060209392818452848401376000828201840152601f01601f1916010190565b1561527f57565b60405162461bcd60e51b815260206004820152602660248201527f6163636f756e74206973206e6f742061206e6574776f726b2061646d696e206160448201526518d8dbdd5b9d60d21b6064820152608490fd5b916152fd906152ef614da097959360a0865260a0860190614ec7565b908482036020860152614ec7565b9361ffff80921660408401521660608201526080818403910152614ec7565b5190811515820361155857565b90615341602091949394604084526040840190614ec7565b6001600160a01b03909416910152565b1561535857565b60405162461bcd60e51b81526020600482015260126024820152711bdc99c8191bd95cc81b9bdd08195e1a5cdd60721b6044820152606490fd5b1561539957565b60405162461bcd60e51b815260206004820152602260248201527f6163636f756e74206973206e6f742061206f72672061646d696e206163636f756044820152611b9d60f21b6064820152608490fd5b929061540290614da09593604086526040860191615257565b926020818503910152615257565b1561541757565b60405162461bcd60e51b815260206004820152601a60248201527f6f7267206e6f7420696e20617070726f766564207374617475730000000000006044820152606490fd5b1561546357565b60405162461bcd60e51b815260206004820152602560248201527f696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6044820152641b1bddd95960da1b6064820152608490fd5b81601f820112156115585780516154cc81614d33565b926154da6040519485614d12565b8184526020828401011161155857614da09160208085019101614ea4565b91908110156155085760051b0190565b634e487b7160e01b600052603260045260246000fd5b356001600160a01b03811681036115585790565b906020828203126115585781516001600160401b03811161155857614da092016154b6565b60008054604080516381d66b2360e01b81526001600160a01b0394851660048201529390929184916024918391165afa91821561560c576000926155e9575b50805191826155b060208201926020845284830190614ec7565b03926155c4601f1994858101835282614d12565b519020916155e282519182613477602082019560208752820161515b565b5190201490565b61560591923d8091833e6155fd8183614d12565b810190615532565b9038615596565b50513d6000823e3d90fd5b91615632906152ef614da09593606086526060860190614ec7565b916040818403910152614ec7565b600080546001600160a01b039391929084169061565c83615957565b9260409580875193633a2d0afd60e21b855216928360048201526060602482015261568a6064820184614ec7565b9560031982880301604483015281806156a66020998a94614ec7565b0381885afa9081156157e15787916157ac575b506157a0578590600154169260248851809681936381d66b2360e01b835260048301525afa928315615796579184939186959361571d979361577a575b5061570081615957565b8851632f8c8b9560e21b8152978894859384939160048501615617565b03915afa93841561576f57508193615736575b50505090565b9091809350813d8311615768575b61574e8183614d12565b810103126105c857506157609061531c565b388080615730565b503d615744565b51913d9150823e3d90fd5b61578f9193503d8088833e6155fd8183614d12565b91386156f6565b86513d87823e3d90fd5b50505050505050600190565b90508581813d83116157da575b6157c38183614d12565b8101031261058b576157d49061531c565b386156b9565b503d6157b9565b88513d89823e3d90fd5b600054604080516335ab46bb60e11b81526001600160a01b039384166004820152602481019190915292602092849216908290819061582e906044830190614ec7565b03915afa9081156105d357600091615844575090565b906020823d821161586f575b8161585d60209383614d12565b810103126105c85750614da09061531c565b3d9150615850565b6002546001600160a01b031691823b15611558576158af9260009283604051809681958294635607395b60e01b845260048401615329565b03925af180156105d3576158c05750565b6158c990614cce565b565b600480546040516001621bf2e360e01b0319815260209281018390529283916001600160a01b0316908290819061582e906024830190614ec7565b602061593a9160018060a01b03600454166040518080958194638c8642df60e01b8352604060048401526044830190614ec7565b6002602483015203915afa9081156105d357600091615844575090565b600061598b9160018060a01b03600454166040518080958194630bbe46c560e11b8352602060048401526024830190614ec7565b03915afa9081156105d3576000916159a1575090565b614da0913d8091833e6155fd8183614d12565b92919060206159dc60809260a087526159cf60a088016150bf565b9087820384890152614ec7565b8581036040870152600081520193600060608201520152565b6080906020615a2060049496959660a08452615a1360a085016150bf565b9084820384860152614ec7565b828103604084015260008152019460018060a01b031660608201520152565b939190929360ff600a541615615abc57602093615a8d9361ffff615a9f60018060a01b036003541695604051998a98899788976345a59e5b60e01b8952606060048a01526064890191615257565b86810360031901602488015291615257565b9116604483015203915afa9081156105d357600091615844575090565b5050505050600190565b9060ff600a541615615dc85760018060a01b03926000938085541690604094855193637ea7d02d60e11b85526004838316808288015260209687816024818a5afa908115615d8a57908b92918391615d94575b5060021480615d1b575b615b35575b5050505050505050505090565b60248a5180988193636acee5fd60e01b8352868301525afa938415615d115789958a95615cb7575b50615b6786615957565b9381835416888b518092633fd62ae760e01b825282878301528180615b908d6024830190614ec7565b03915afa908115615cad578c91615c78575b50615baf578a9150615b28565b86615bbd829c9a9b9c615557565b918215615c67575b5050615c5857615c0f9887966080946001928416615c405750615c2291506002925b60015416956126a98d519c8d998a9889986368fbbc3360e11b8a528901526084880190614ec7565b6003199384888303016024890152614ec7565b90606483015203915afa93841561576f575081936157365750505090565b615c4f575b615c229192615be7565b60039150615c45565b50505050505050505050600190565b615c719250615640565b8638615bc5565b90508881813d8311615ca6575b615c8f8183614d12565b8101031261321557615ca09061531c565b38615ba2565b503d615c85565b8b513d8e823e3d90fd5b955093503d808a873e615cca8187614d12565b85018886820312610d4c5785516001600160401b03908181116132155782615cf39189016154b6565b968881015191821161321557615d0a9291016154b6565b9338615b5d565b88513d8b823e3d90fd5b509050885163e25f006b60e01b8152818382015287816024818a5afa908115615d8a57908b92918391615d50575b5015615b23565b80929350898092503d8311615d83575b615d6a8183614d12565b81010312611b095790615d7d8b9261531c565b38615d49565b503d615d60565b8a513d8d823e3d90fd5b80929350898092503d8311615dc1575b615dae8183614d12565b81010312611b0957518a91906002615b19565b503d615da4565b50505060019056fea66cc928b5edb82af9bd49922954155ab7b0942694bea4ce44661d9a8736c688f3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee3f652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3fa2646970667358221220705150bf39b9677c9e5dbd4a373c52f8941cb7eb8f7ed72729f415e58387e3a864736f6c63430008150033"
 
 // DeployPermImpl deploys a new Ethereum contract, binding an instance of PermImpl to it.
 func DeployPermImpl(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address, _orgManager common.Address, _rolesManager common.Address, _accountManager common.Address, _voterManager common.Address, _nodeManager common.Address) (common.Address, *types.Transaction, *PermImpl, error) {
@@ -194,7 +192,7 @@ func (_PermImpl *PermImplTransactorRaw) Transact(opts *bind.TransactOpts, method
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermImpl *PermImplCaller) ConnectionAllowed(opts *bind.CallOpts, _enodeId string, _ip string, _port uint16) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -206,21 +204,21 @@ func (_PermImpl *PermImplCaller) ConnectionAllowed(opts *bind.CallOpts, _enodeId
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermImpl *PermImplSession) ConnectionAllowed(_enodeId string, _ip string, _port uint16) (bool, error) {
 	return _PermImpl.Contract.ConnectionAllowed(&_PermImpl.CallOpts, _enodeId, _ip, _port)
 }
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermImpl *PermImplCallerSession) ConnectionAllowed(_enodeId string, _ip string, _port uint16) (bool, error) {
 	return _PermImpl.Contract.ConnectionAllowed(&_PermImpl.CallOpts, _enodeId, _ip, _port)
 }
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermImpl *PermImplCaller) GetNetworkBootStatus(opts *bind.CallOpts) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -232,21 +230,47 @@ func (_PermImpl *PermImplCaller) GetNetworkBootStatus(opts *bind.CallOpts) (bool
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermImpl *PermImplSession) GetNetworkBootStatus() (bool, error) {
 	return _PermImpl.Contract.GetNetworkBootStatus(&_PermImpl.CallOpts)
 }
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermImpl *PermImplCallerSession) GetNetworkBootStatus() (bool, error) {
 	return _PermImpl.Contract.GetNetworkBootStatus(&_PermImpl.CallOpts)
 }
 
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermImpl *PermImplCaller) GetNumberOfPendingOps(opts *bind.CallOpts, _orgId string) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermImpl.contract.Call(opts, out, "getNumberOfPendingOps", _orgId)
+	return *ret0, err
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermImpl *PermImplSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _PermImpl.Contract.GetNumberOfPendingOps(&_PermImpl.CallOpts, _orgId)
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermImpl *PermImplCallerSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _PermImpl.Contract.GetNumberOfPendingOps(&_PermImpl.CallOpts, _orgId)
+}
+
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermImpl *PermImplCaller) GetPendingOp(opts *bind.CallOpts, _orgId string) (string, string, common.Address, *big.Int, error) {
 	var (
 		ret0 = new(string)
@@ -266,21 +290,59 @@ func (_PermImpl *PermImplCaller) GetPendingOp(opts *bind.CallOpts, _orgId string
 
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermImpl *PermImplSession) GetPendingOp(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _PermImpl.Contract.GetPendingOp(&_PermImpl.CallOpts, _orgId)
 }
 
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermImpl *PermImplCallerSession) GetPendingOp(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _PermImpl.Contract.GetPendingOp(&_PermImpl.CallOpts, _orgId)
 }
 
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermImpl *PermImplCaller) GetPendingOpDetailsFromIndex(opts *bind.CallOpts, _orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(string)
+		ret1 = new(string)
+		ret2 = new(common.Address)
+		ret3 = new(*big.Int)
+		ret4 = new(*big.Int)
+		ret5 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+		ret4,
+		ret5,
+	}
+	err := _PermImpl.contract.Call(opts, out, "getPendingOpDetailsFromIndex", _orgId, _index)
+	return *ret0, *ret1, *ret2, *ret3, *ret4, *ret5, err
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermImpl *PermImplSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _PermImpl.Contract.GetPendingOpDetailsFromIndex(&_PermImpl.CallOpts, _orgId, _index)
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermImpl *PermImplCallerSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _PermImpl.Contract.GetPendingOpDetailsFromIndex(&_PermImpl.CallOpts, _orgId, _index)
+}
+
 // GetPolicyDetails is a free data retrieval call binding the contract method 0xcc9ba6fa.
 //
-// Solidity: function getPolicyDetails() constant returns(string, string, string, bool)
+// Solidity: function getPolicyDetails() view returns(string, string, string, bool)
 func (_PermImpl *PermImplCaller) GetPolicyDetails(opts *bind.CallOpts) (string, string, string, bool, error) {
 	var (
 		ret0 = new(string)
@@ -300,21 +362,77 @@ func (_PermImpl *PermImplCaller) GetPolicyDetails(opts *bind.CallOpts) (string,
 
 // GetPolicyDetails is a free data retrieval call binding the contract method 0xcc9ba6fa.
 //
-// Solidity: function getPolicyDetails() constant returns(string, string, string, bool)
+// Solidity: function getPolicyDetails() view returns(string, string, string, bool)
 func (_PermImpl *PermImplSession) GetPolicyDetails() (string, string, string, bool, error) {
 	return _PermImpl.Contract.GetPolicyDetails(&_PermImpl.CallOpts)
 }
 
 // GetPolicyDetails is a free data retrieval call binding the contract method 0xcc9ba6fa.
 //
-// Solidity: function getPolicyDetails() constant returns(string, string, string, bool)
+// Solidity: function getPolicyDetails() view returns(string, string, string, bool)
 func (_PermImpl *PermImplCallerSession) GetPolicyDetails() (string, string, string, bool, error) {
 	return _PermImpl.Contract.GetPolicyDetails(&_PermImpl.CallOpts)
 }
 
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermImpl *PermImplCaller) GetThresholdProposal(opts *bind.CallOpts, _orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _PermImpl.contract.Call(opts, out, "getThresholdProposal", _orgId, _index)
+	return *ret0, *ret1, err
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermImpl *PermImplSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _PermImpl.Contract.GetThresholdProposal(&_PermImpl.CallOpts, _orgId, _index)
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermImpl *PermImplCallerSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _PermImpl.Contract.GetThresholdProposal(&_PermImpl.CallOpts, _orgId, _index)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermImpl *PermImplCaller) GetVoteThreshold(opts *bind.CallOpts, _opType *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermImpl.contract.Call(opts, out, "getVoteThreshold", _opType)
+	return *ret0, err
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermImpl *PermImplSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _PermImpl.Contract.GetVoteThreshold(&_PermImpl.CallOpts, _opType)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermImpl *PermImplCallerSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _PermImpl.Contract.GetVoteThreshold(&_PermImpl.CallOpts, _opType)
+}
+
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermImpl *PermImplCaller) IsNetworkAdmin(opts *bind.CallOpts, _account common.Address) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -326,21 +444,21 @@ func (_PermImpl *PermImplCaller) IsNetworkAdmin(opts *bind.CallOpts, _account co
 
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermImpl *PermImplSession) IsNetworkAdmin(_account common.Address) (bool, error) {
 	return _PermImpl.Contract.IsNetworkAdmin(&_PermImpl.CallOpts, _account)
 }
 
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermImpl *PermImplCallerSession) IsNetworkAdmin(_account common.Address) (bool, error) {
 	return _PermImpl.Contract.IsNetworkAdmin(&_PermImpl.CallOpts, _account)
 }
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplCaller) IsOrgAdmin(opts *bind.CallOpts, _account common.Address, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -352,21 +470,47 @@ func (_PermImpl *PermImplCaller) IsOrgAdmin(opts *bind.CallOpts, _account common
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplSession) IsOrgAdmin(_account common.Address, _orgId string) (bool, error) {
 	return _PermImpl.Contract.IsOrgAdmin(&_PermImpl.CallOpts, _account, _orgId)
 }
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplCallerSession) IsOrgAdmin(_account common.Address, _orgId string) (bool, error) {
 	return _PermImpl.Contract.IsOrgAdmin(&_PermImpl.CallOpts, _account, _orgId)
 }
 
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermImpl *PermImplCaller) IsPendingOp(opts *bind.CallOpts, _authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _PermImpl.contract.Call(opts, out, "isPendingOp", _authOrg, _orgId, _enodeId, _account, _pendingOp)
+	return *ret0, err
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermImpl *PermImplSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _PermImpl.Contract.IsPendingOp(&_PermImpl.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermImpl *PermImplCallerSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _PermImpl.Contract.IsPendingOp(&_PermImpl.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermImpl *PermImplCaller) TransactionAllowed(opts *bind.CallOpts, _sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -378,21 +522,21 @@ func (_PermImpl *PermImplCaller) TransactionAllowed(opts *bind.CallOpts, _sender
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermImpl *PermImplSession) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	return _PermImpl.Contract.TransactionAllowed(&_PermImpl.CallOpts, _sender, _target, _value, _gasPrice, _gasLimit, _payload)
 }
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermImpl *PermImplCallerSession) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	return _PermImpl.Contract.TransactionAllowed(&_PermImpl.CallOpts, _sender, _target, _value, _gasPrice, _gasLimit, _payload)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplCaller) ValidateAccount(opts *bind.CallOpts, _account common.Address, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -404,14 +548,14 @@ func (_PermImpl *PermImplCaller) ValidateAccount(opts *bind.CallOpts, _account c
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _PermImpl.Contract.ValidateAccount(&_PermImpl.CallOpts, _account, _orgId)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermImpl *PermImplCallerSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _PermImpl.Contract.ValidateAccount(&_PermImpl.CallOpts, _account, _orgId)
 }
@@ -647,6 +791,27 @@ func (_PermImpl *PermImplTransactorSession) ApproveOrgStatus(_orgId string, _act
 	return _PermImpl.Contract.ApproveOrgStatus(&_PermImpl.TransactOpts, _orgId, _action, _caller)
 }
 
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x42bc161a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplTransactor) ApproveVoteThreshold(opts *bind.TransactOpts, _opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "approveVoteThreshold", _opType, _threshold, _caller)
+}
+
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x42bc161a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplSession) ApproveVoteThreshold(_opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.ApproveVoteThreshold(&_PermImpl.TransactOpts, _opType, _threshold, _caller)
+}
+
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x42bc161a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) ApproveVoteThreshold(_opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.ApproveVoteThreshold(&_PermImpl.TransactOpts, _opType, _threshold, _caller)
+}
+
 // AssignAccountRole is a paid mutator transaction binding the contract method 0x8baa8191.
 //
 // Solidity: function assignAccountRole(address _account, string _orgId, string _roleId, address _caller) returns()
@@ -668,6 +833,27 @@ func (_PermImpl *PermImplTransactorSession) AssignAccountRole(_account common.Ad
 	return _PermImpl.Contract.AssignAccountRole(&_PermImpl.TransactOpts, _account, _orgId, _roleId, _caller)
 }
 
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0x7ae41f04.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactor) AssignAccountRoleWithExpiry(opts *bind.TransactOpts, _account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "assignAccountRoleWithExpiry", _account, _orgId, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0x7ae41f04.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplSession) AssignAccountRoleWithExpiry(_account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AssignAccountRoleWithExpiry(&_PermImpl.TransactOpts, _account, _orgId, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0x7ae41f04.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) AssignAccountRoleWithExpiry(_account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AssignAccountRoleWithExpiry(&_PermImpl.TransactOpts, _account, _orgId, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
 // AssignAdminRole is a paid mutator transaction binding the contract method 0x404bf3eb.
 //
 // Solidity: function assignAdminRole(string _orgId, address _account, string _roleId, address _caller) returns()
@@ -689,6 +875,27 @@ func (_PermImpl *PermImplTransactorSession) AssignAdminRole(_orgId string, _acco
 	return _PermImpl.Contract.AssignAdminRole(&_PermImpl.TransactOpts, _orgId, _account, _roleId, _caller)
 }
 
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0xf43e6fbd.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactor) AssignAdminRoleWithExpiry(opts *bind.TransactOpts, _orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "assignAdminRoleWithExpiry", _orgId, _account, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0xf43e6fbd.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplSession) AssignAdminRoleWithExpiry(_orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AssignAdminRoleWithExpiry(&_PermImpl.TransactOpts, _orgId, _account, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0xf43e6fbd.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) AssignAdminRoleWithExpiry(_orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AssignAdminRoleWithExpiry(&_PermImpl.TransactOpts, _orgId, _account, _roleId, _expiryBlock, _expiryTime, _caller)
+}
+
 // Init is a paid mutator transaction binding the contract method 0xa5843f08.
 //
 // Solidity: function init(uint256 _breadth, uint256 _depth) returns()
@@ -710,6 +917,27 @@ func (_PermImpl *PermImplTransactorSession) Init(_breadth *big.Int, _depth *big.
 	return _PermImpl.Contract.Init(&_PermImpl.TransactOpts, _breadth, _depth)
 }
 
+// MigrateVoters is a paid mutator transaction binding the contract method 0xae06ab78.
+//
+// Solidity: function migrateVoters(address[] _accounts) returns()
+func (_PermImpl *PermImplTransactor) MigrateVoters(opts *bind.TransactOpts, _accounts []common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "migrateVoters", _accounts)
+}
+
+// MigrateVoters is a paid mutator transaction binding the contract method 0xae06ab78.
+//
+// Solidity: function migrateVoters(address[] _accounts) returns()
+func (_PermImpl *PermImplSession) MigrateVoters(_accounts []common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.MigrateVoters(&_PermImpl.TransactOpts, _accounts)
+}
+
+// MigrateVoters is a paid mutator transaction binding the contract method 0xae06ab78.
+//
+// Solidity: function migrateVoters(address[] _accounts) returns()
+func (_PermImpl *PermImplTransactorSession) MigrateVoters(_accounts []common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.MigrateVoters(&_PermImpl.TransactOpts, _accounts)
+}
+
 // RemoveRole is a paid mutator transaction binding the contract method 0x5ca5adbe.
 //
 // Solidity: function removeRole(string _roleId, string _orgId, address _caller) returns()
@@ -773,6 +1001,27 @@ func (_PermImpl *PermImplTransactorSession) SetPolicy(_nwAdminOrg string, _nwAdm
 	return _PermImpl.Contract.SetPolicy(&_PermImpl.TransactOpts, _nwAdminOrg, _nwAdminRole, _oAdminRole)
 }
 
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x37a7db55.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplTransactor) SetVoteThreshold(opts *bind.TransactOpts, _opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "setVoteThreshold", _opType, _threshold, _caller)
+}
+
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x37a7db55.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplSession) SetVoteThreshold(_opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetVoteThreshold(&_PermImpl.TransactOpts, _opType, _threshold, _caller)
+}
+
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x37a7db55.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) SetVoteThreshold(_opType *big.Int, _threshold *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetVoteThreshold(&_PermImpl.TransactOpts, _opType, _threshold, _caller)
+}
+
 // StartBlacklistedAccountRecovery is a paid mutator transaction binding the contract method 0x1c249912.
 //
 // Solidity: function startBlacklistedAccountRecovery(string _orgId, address _account, address _caller) returns()
@@ -899,6 +1148,27 @@ func (_PermImpl *PermImplTransactorSession) UpdateOrgStatus(_orgId string, _acti
 	return _PermImpl.Contract.UpdateOrgStatus(&_PermImpl.TransactOpts, _orgId, _action, _caller)
 }
 
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0x09188a97.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactor) UpdateOrgStatusWithExpiry(opts *bind.TransactOpts, _orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "updateOrgStatusWithExpiry", _orgId, _action, _expiryBlock, _expiryTime, _caller)
+}
+
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0x09188a97.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplSession) UpdateOrgStatusWithExpiry(_orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.UpdateOrgStatusWithExpiry(&_PermImpl.TransactOpts, _orgId, _action, _expiryBlock, _expiryTime, _caller)
+}
+
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0x09188a97.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) UpdateOrgStatusWithExpiry(_orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.UpdateOrgStatusWithExpiry(&_PermImpl.TransactOpts, _orgId, _action, _expiryBlock, _expiryTime, _caller)
+}
+
 // PermImplPermissionsInitializedIterator is returned from FilterPermissionsInitialized and is used to iterate over the raw logs and unpacked data for PermissionsInitialized events raised by the PermImpl contract.
 type PermImplPermissionsInitializedIterator struct {
 	Event *PermImplPermissionsInitialized // Event containing the contract specifics and raw log
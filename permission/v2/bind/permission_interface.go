@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // PermInterfaceABI is the input ABI used to generate the binding from.
-const PermInterfaceABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"getPermissionsImpl\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_acct\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_permImplementation\",\"type\":\"address\"}],\"name\":\"setPermImplementation\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_access\",\"type\":\"uint256\"},{\"name\":\"_voter\",\"type\":\"bool\"},{\"name\":\"_admin\",\"type\":\"bool\"}],\"name\":\"addNewRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_sender\",\"type\":\"address\"},{\"name\":\"_target\",\"type\":\"address\"},{\"name\":\"_value\",\"type\":\"uint256\"},{\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permImplUpgradeable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"}]"
+const PermInterfaceABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permImplUpgradeable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_acct\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_access\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_admin\",\"type\":\"bool\"}],\"name\":\"addNewRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"approveVoteThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"assignAccountRoleWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"assignAdminRoleWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getNumberOfPendingOps\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getPendingOpDetailsFromIndex\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPermissionsImpl\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getThresholdProposal\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"}],\"name\":\"getVoteThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"isPendingOp\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permImplementation\",\"type\":\"address\"}],\"name\":\"setPermImplementation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"setVoteThreshold\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_expiryTime\",\"type\":\"uint256\"}],\"name\":\"updateOrgStatusWithExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var PermInterfaceParsedABI, _ = abi.JSON(strings.NewReader(PermInterfaceABI))
 
 // PermInterfaceBin is the compiled bytecode used for deploying new contracts.
-var PermInterfaceBin = "0x608060405234801561001057600080fd5b506040516020806134518339810180604052602081101561003057600080fd5b5051600280546001600160a01b0319166001600160a01b039092169190911790556133f1806100606000396000f3fe608060405234801561001057600080fd5b50600436106101e55760003560e01c806358dcff711161010f578063a5843f08116100a2578063d1aa0c2011610071578063d1aa0c2014611673578063ef5f719614611699578063f346a3a714611858578063fa279d61146119c4576101e5565b8063a5843f08146114ad578063a6343012146114d0578063a97914bf1461158e578063bb3b6e8014611605576101e5565b80638683c7fe116100de5780638683c7fe1461109c57806391ba3f96146111d6578063936421d5146113955780639bd381011461142f576101e5565b806358dcff7114610d745780635be9672c14610f335780636b568d7614610fa157806384b7a84a1461101f576101e5565b806343de646c116101875780634fe57e7a116101565780634fe57e7a14610a90578063511bbd9f14610ab6578063513a327714610adc57806351f604c314610ca6576101e5565b806343de646c146108df57806344478e79146109aa57806345a59e5b146109c65780634cbfa82e14610a88576101e5565b80632e125a6c116101c35780632e125a6c146103955780632f7f0a12146105d95780633e239b23146106a75780633f9be4971461071e576101e5565b806303ed6933146101ea57806316724c441461020e5780631b61022014610287575b600080fd5b6101f2611b8e565b604080516001600160a01b039092168252519081900360200190f35b6102856004803603604081101561022457600080fd5b810190602081018135600160201b81111561023e57600080fd5b82018360208201111561025057600080fd5b803590602001918460018302840111600160201b8311171561027157600080fd5b9193509150356001600160a01b0316611b9d565b005b6102856004803603606081101561029d57600080fd5b810190602081018135600160201b8111156102b757600080fd5b8201836020820111156102c957600080fd5b803590602001918460018302840111600160201b831117156102ea57600080fd5b919390929091602081019035600160201b81111561030757600080fd5b82018360208201111561031957600080fd5b803590602001918460018302840111600160201b8311171561033a57600080fd5b919390929091602081019035600160201b81111561035757600080fd5b82018360208201111561036957600080fd5b803590602001918460018302840111600160201b8311171561038a57600080fd5b509092509050611c4c565b610285600480360360c08110156103ab57600080fd5b810190602081018135600160201b8111156103c557600080fd5b8201836020820111156103d757600080fd5b803590602001918460018302840111600160201b831117156103f857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561044a57600080fd5b82018360208201111561045c57600080fd5b803590602001918460018302840111600160201b8311171561047d57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156104cf57600080fd5b8201836020820111156104e157600080fd5b803590602001918460018302840111600160201b8311171561050257600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561055457600080fd5b82018360208201111561056657600080fd5b803590602001918460018302840111600160201b8311171561058757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150611d439050565b610285600480360360608110156105ef57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561061957600080fd5b82018360208201111561062b57600080fd5b803590602001918460018302840111600160201b8311171561064c57600080fd5b919390929091602081019035600160201b81111561066957600080fd5b82018360208201111561067b57600080fd5b803590602001918460018302840111600160201b8311171561069c57600080fd5b509092509050611f52565b610285600480360360408110156106bd57600080fd5b810190602081018135600160201b8111156106d757600080fd5b8201836020820111156106e957600080fd5b803590602001918460018302840111600160201b8311171561070a57600080fd5b9193509150356001600160a01b0316612033565b610285600480360360c081101561073457600080fd5b810190602081018135600160201b81111561074e57600080fd5b82018360208201111561076057600080fd5b803590602001918460018302840111600160201b8311171561078157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156107d357600080fd5b8201836020820111156107e557600080fd5b803590602001918460018302840111600160201b8311171561080657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561085857600080fd5b82018360208201111561086a57600080fd5b803590602001918460018302840111600160201b8311171561088b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926040013591506120c59050565b610285600480360360608110156108f557600080fd5b810190602081018135600160201b81111561090f57600080fd5b82018360208201111561092157600080fd5b803590602001918460018302840111600160201b8311171561094257600080fd5b919390926001600160a01b0383351692604081019060200135600160201b81111561096c57600080fd5b82018360208201111561097e57600080fd5b803590602001918460018302840111600160201b8311171561099f57600080fd5b509092509050612274565b6109b2612333565b604080519115158252519081900360200190f35b6109b2600480360360608110156109dc57600080fd5b810190602081018135600160201b8111156109f657600080fd5b820183602082011115610a0857600080fd5b803590602001918460018302840111600160201b83111715610a2957600080fd5b919390929091602081019035600160201b811115610a4657600080fd5b820183602082011115610a5857600080fd5b803590602001918460018302840111600160201b83111715610a7957600080fd5b91935091503561ffff166123b5565b6109b26124a1565b61028560048036036020811015610aa657600080fd5b50356001600160a01b0316612504565b61028560048036036020811015610acc57600080fd5b50356001600160a01b031661256d565b610285600480360360c0811015610af257600080fd5b810190602081018135600160201b811115610b0c57600080fd5b820183602082011115610b1e57600080fd5b803590602001918460018302840111600160201b83111715610b3f57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610b9157600080fd5b820183602082011115610ba357600080fd5b803590602001918460018302840111600160201b83111715610bc457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610c1657600080fd5b820183602082011115610c2857600080fd5b803590602001918460018302840111600160201b83111715610c4957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b031690506125f1565b610285600480360360a0811015610cbc57600080fd5b810190602081018135600160201b811115610cd657600080fd5b820183602082011115610ce857600080fd5b803590602001918460018302840111600160201b83111715610d0957600080fd5b919390929091602081019035600160201b811115610d2657600080fd5b820183602082011115610d3857600080fd5b803590602001918460018302840111600160201b83111715610d5957600080fd5b91935091508035906020810135151590604001351515612691565b610285600480360360a0811015610d8a57600080fd5b810190602081018135600160201b811115610da457600080fd5b820183602082011115610db657600080fd5b803590602001918460018302840111600160201b83111715610dd757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610e2957600080fd5b820183602082011115610e3b57600080fd5b803590602001918460018302840111600160201b83111715610e5c57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610eae57600080fd5b820183602082011115610ec057600080fd5b803590602001918460018302840111600160201b83111715610ee157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff83358116945060209093013590921691506127869050565b61028560048036036040811015610f4957600080fd5b810190602081018135600160201b811115610f6357600080fd5b820183602082011115610f7557600080fd5b803590602001918460018302840111600160201b83111715610f9657600080fd5b91935091503561292f565b6109b260048036036040811015610fb757600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610fe157600080fd5b820183602082011115610ff357600080fd5b803590602001918460018302840111600160201b8311171561101457600080fd5b5090925090506129bc565b6102856004803603606081101561103557600080fd5b810190602081018135600160201b81111561104f57600080fd5b82018360208201111561106157600080fd5b803590602001918460018302840111600160201b8311171561108257600080fd5b91935091506001600160a01b038135169060200135612a74565b610285600480360360808110156110b257600080fd5b810190602081018135600160201b8111156110cc57600080fd5b8201836020820111156110de57600080fd5b803590602001918460018302840111600160201b831117156110ff57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561115157600080fd5b82018360208201111561116357600080fd5b803590602001918460018302840111600160201b8311171561118457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150612b2c9050565b610285600480360360a08110156111ec57600080fd5b810190602081018135600160201b81111561120657600080fd5b82018360208201111561121857600080fd5b803590602001918460018302840111600160201b8311171561123957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561128b57600080fd5b82018360208201111561129d57600080fd5b803590602001918460018302840111600160201b831117156112be57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561131057600080fd5b82018360208201111561132257600080fd5b803590602001918460018302840111600160201b8311171561134357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150612c629050565b6109b2600480360360c08110156113ab57600080fd5b6001600160a01b0382358116926020810135909116916040820135916060810135916080820135919081019060c0810160a0820135600160201b8111156113f157600080fd5b82018360208201111561140357600080fd5b803590602001918460018302840111600160201b8311171561142457600080fd5b509092509050612cf7565b6109b26004803603604081101561144557600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561146f57600080fd5b82018360208201111561148157600080fd5b803590602001918460018302840111600160201b831117156114a257600080fd5b509092509050612dde565b610285600480360360408110156114c357600080fd5b5080359060200135612e62565b610285600480360360408110156114e657600080fd5b810190602081018135600160201b81111561150057600080fd5b82018360208201111561151257600080fd5b803590602001918460018302840111600160201b8311171561153357600080fd5b919390929091602081019035600160201b81111561155057600080fd5b82018360208201111561156257600080fd5b803590602001918460018302840111600160201b8311171561158357600080fd5b509092509050612ed0565b610285600480360360408110156115a457600080fd5b810190602081018135600160201b8111156115be57600080fd5b8201836020820111156115d057600080fd5b803590602001918460018302840111600160201b831117156115f157600080fd5b9193509150356001600160a01b0316612f84565b6102856004803603604081101561161b57600080fd5b810190602081018135600160201b81111561163557600080fd5b82018360208201111561164757600080fd5b803590602001918460018302840111600160201b8311171561166857600080fd5b919350915035613016565b6109b26004803603602081101561168957600080fd5b50356001600160a01b03166130a3565b610285600480360360a08110156116af57600080fd5b810190602081018135600160201b8111156116c957600080fd5b8201836020820111156116db57600080fd5b803590602001918460018302840111600160201b831117156116fc57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561174e57600080fd5b82018360208201111561176057600080fd5b803590602001918460018302840111600160201b8311171561178157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156117d357600080fd5b8201836020820111156117e557600080fd5b803590602001918460018302840111600160201b8311171561180657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff83358116945060209093013590921691506131269050565b6118c66004803603602081101561186e57600080fd5b810190602081018135600160201b81111561188857600080fd5b82018360208201111561189a57600080fd5b803590602001918460018302840111600160201b831117156118bb57600080fd5b5090925090506131bb565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b8381101561192557818101518382015260200161190d565b50505050905090810190601f1680156119525780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b8381101561198557818101518382015260200161196d565b50505050905090810190601f1680156119b25780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b610285600480360360c08110156119da57600080fd5b810190602081018135600160201b8111156119f457600080fd5b820183602082011115611a0657600080fd5b803590602001918460018302840111600160201b83111715611a2757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611a7957600080fd5b820183602082011115611a8b57600080fd5b803590602001918460018302840111600160201b83111715611aac57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611afe57600080fd5b820183602082011115611b1057600080fd5b803590602001918460018302840111600160201b83111715611b3157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050613325565b6000546001600160a01b031690565b600054604051600160e01b63888430410281526001600160a01b03838116602483015233604483018190526060600484019081526064840187905291909316926388843041928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b505af1158015611c43573d6000803e3d6000fd5b50505050505050565b600054604051600160e51b62db0811028152606060048201908152606482018890526001600160a01b0390921691631b610220918991899189918991899189918190602481019060448101906084018a8a80828437600083820152601f01601f191690910185810384528881526020019050888880828437600083820152601f01601f191690910185810383528681526020019050868680828437600081840152601f19601f8201169050808301925050509950505050505050505050600060405180830381600087803b158015611d2357600080fd5b505af1158015611d37573d6000803e3d6000fd5b50505050505050505050565b60008054604051600160e01b6368a6127302815261ffff8086166084830152841660a48201523360c4820181905260e0600483019081528a5160e48401528a516001600160a01b03909416946368a61273948c948c948c948c948c948c9483926024820192604483019260648101926101049091019160208f01918190849084905b83811015611ddd578181015183820152602001611dc5565b50505050905090810190601f168015611e0a5780820380516001836020036101000a031916815260200191505b5085810384528b5181528b516020918201918d019080838360005b83811015611e3d578181015183820152602001611e25565b50505050905090810190601f168015611e6a5780820380516001836020036101000a031916815260200191505b5085810383528a5181528a516020918201918c019080838360005b83811015611e9d578181015183820152602001611e85565b50505050905090810190601f168015611eca5780820380516001836020036101000a031916815260200191505b5085810382528951815289516020918201918b019080838360005b83811015611efd578181015183820152602001611ee5565b50505050905090810190601f168015611f2a5780820380516001836020036101000a031916815260200191505b509b505050505050505050505050600060405180830381600087803b158015611d2357600080fd5b600054604051600160e01b638baa81910281526001600160a01b03878116600483019081523360648401819052608060248501908152608485018990529290941693638baa8191938a938a938a938a938a9391929190604481019060a401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b15801561201457600080fd5b505af1158015612028573d6000803e3d6000fd5b505050505050505050565b600054604051600160e01b634b20f45f0281526001600160a01b0383811660248301523360448301819052606060048401908152606484018790529190931692634b20f45f928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b60008054604051600160e21b632e6dff9b02815261ffff80871660648301528516608482015260a481018490523360c4820181905260e0600483019081528a5160e48401528a516001600160a01b039094169463b9b7fe6c948c948c948c948c948c948c94839260248201926044830192610104019160208e0191908190849084905b83811015612160578181015183820152602001612148565b50505050905090810190601f16801561218d5780820380516001836020036101000a031916815260200191505b5084810383528a5181528a516020918201918c019080838360005b838110156121c05781810151838201526020016121a8565b50505050905090810190601f1680156121ed5780820380516001836020036101000a031916815260200191505b5084810382528951815289516020918201918b019080838360005b83811015612220578181015183820152602001612208565b50505050905090810190601f16801561224d5780820380516001836020036101000a031916815260200191505b509a5050505050505050505050600060405180830381600087803b158015611d2357600080fd5b600054604051600160e01b63404bf3eb0281526001600160a01b038581166024830152336064830181905260806004840190815260848401899052919093169263404bf3eb9289928992899289928992918190604481019060a401898980828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b15801561201457600080fd5b60008060009054906101000a90046001600160a01b03166001600160a01b03166344478e796040518163ffffffff1660e01b8152600401602060405180830381600087803b15801561238457600080fd5b505af1158015612398573d6000803e3d6000fd5b505050506040513d60208110156123ae57600080fd5b5051905090565b60008054604051600160e01b6345a59e5b02815261ffff84166044820152606060048201908152606482018890526001600160a01b03909216916345a59e5b91899189918991899189919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505097505050505050505060206040518083038186803b15801561246b57600080fd5b505afa15801561247f573d6000803e3d6000fd5b505050506040513d602081101561249557600080fd5b50519695505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316634cbfa82e6040518163ffffffff1660e01b815260040160206040518083038186803b1580156124f057600080fd5b505afa158015612398573d6000803e3d6000fd5b6000805460408051600160e11b6327f2bf3d0281526001600160a01b03858116600483015291519190921692634fe57e7a926024808201939182900301818387803b15801561255257600080fd5b505af1158015612566573d6000803e3d6000fd5b5050505050565b6002546001600160a01b031633146125cf5760408051600160e51b62461bcd02815260206004820152600e60248201527f696e76616c69642063616c6c6572000000000000000000000000000000000000604482015290519081900360640190fd5b600080546001600160a01b0319166001600160a01b0392909216919091179055565b60008054604051600160e01b63e91b0e1902815261ffff8087166064830152851660848201526001600160a01b0384811660a48301523360c4830181905260e0600484019081528b5160e48501528b51929094169463e91b0e19948c948c948c948c948c948c9492939092839260248201926044830192610104019160208e01919081908490849083811015612160578181015183820152602001612148565b600054604051600160e11b630d82613b02815260448101859052831515606482015282151560848201523360a4820181905260c06004830190815260c483018a90526001600160a01b0390931692631b04c276928b928b928b928b928b928b928b9291908190602481019060e4018b8b80828437600083820152601f01601f191690910184810383528981526020019050898980828437600081840152601f19601f8201169050808301925050509a5050505050505050505050600060405180830381600087803b15801561276557600080fd5b505af1158015612779573d6000803e3d6000fd5b5050505050505050505050565b60008054604051600160e61b6302810afd02815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463a042bf40948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084905b8381101561281c578181015183820152602001612804565b50505050905090810190601f1680156128495780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b8381101561287c578181015183820152602001612864565b50505050905090810190601f1680156128a95780820380516001836020036101000a031916815260200191505b5084810382528851815288516020918201918a019080838360005b838110156128dc5781810151838201526020016128c4565b50505050905090810190601f1680156129095780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561201457600080fd5b600054604051600160e21b632d551959028152602481018390523360448201819052606060048301908152606483018690526001600160a01b039093169263b5546564928792879287928190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b6000805460408051600160e11b6335ab46bb0281526001600160a01b03878116600483019081526024830193845260448301879052931692636b568d76928892889288929091606401848480828437600083820152604051601f909101601f1916909201965060209550909350505081840390508186803b158015612a4057600080fd5b505afa158015612a54573d6000803e3d6000fd5b505050506040513d6020811015612a6a57600080fd5b5051949350505050565b600054604051600160e11b6302740f8f0281526001600160a01b0384811660248301526044820184905233606483018190526080600484019081526084840188905291909316926304e81f1e92889288928892889290819060a401878780828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015612b0e57600080fd5b505af1158015612b22573d6000803e3d6000fd5b5050505050505050565b60008054604051600160e11b634341e3ff02815261ffff8086166044830152841660648201526080600482019081528751608483015287516001600160a01b0390931693638683c7fe93899389938993899391928392602482019260a49092019160208a0191908190849084905b83811015612bb2578181015183820152602001612b9a565b50505050905090810190601f168015612bdf5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015612c12578181015183820152602001612bfa565b50505050905090810190601f168015612c3f5780820380516001836020036101000a031916815260200191505b509650505050505050600060405180830381600087803b158015612b0e57600080fd5b60008054604051600160e01b63d621d95702815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463d621d957948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084908381101561281c578181015183820152602001612804565b60008054604051600160e01b63936421d50281526001600160a01b038a8116600483019081528a82166024840152604483018a9052606483018990526084830188905260c060a4840190815260c48401879052919093169263936421d5928c928c928c928c928c928c928c929060e401848480828437600081840152601f19601f8201169050808301925050509850505050505050505060206040518083038186803b158015612da657600080fd5b505afa158015612dba573d6000803e3d6000fd5b505050506040513d6020811015612dd057600080fd5b505198975050505050505050565b6000805460408051600160e01b639bd381010281526001600160a01b03878116600483019081526024830193845260448301879052931692639bd38101928892889288929091606401848480828437600083820152604051601f909101601f1916909201965060209550909350505081840390508186803b158015612a4057600080fd5b6000805460408051600160e31b6314b087e1028152600481018690526024810185905290516001600160a01b039092169263a5843f089260448084019382900301818387803b158015612eb457600080fd5b505af1158015612ec8573d6000803e3d6000fd5b505050505050565b600054604051600160e11b632e52d6df0281523360448201819052606060048301908152606483018790526001600160a01b0390931692635ca5adbe928892889288928892919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f820116905080830192505050975050505050505050600060405180830381600087803b158015612b0e57600080fd5b600054604051600160e11b630e124c890281526001600160a01b0383811660248301523360448301819052606060048401908152606484018790529190931692631c249912928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b600054604051600160e01b633cf5f33b028152602481018390523360448201819052606060048301908152606483018690526001600160a01b0390931692633cf5f33b928792879287928190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b6000805460408051600160e51b63068d50610281526001600160a01b0385811660048301529151919092169163d1aa0c20916024808301926020929190829003018186803b1580156130f457600080fd5b505afa158015613108573d6000803e3d6000fd5b505050506040513d602081101561311e57600080fd5b505192915050565b60008054604051600160e01b63ecad01d502815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463ecad01d5948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084908381101561281c578181015183820152602001612804565b60008054604051600160e01b63f346a3a7028152602060048201908152602482018590526060938493909283926001600160a01b039092169163f346a3a791899189918190604401848480828437600081840152601f19601f820116905080830192505050935050505060006040518083038186803b15801561323d57600080fd5b505afa158015613251573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052608081101561327a57600080fd5b810190808051600160201b81111561329157600080fd5b820160208101848111156132a457600080fd5b8151600160201b8111828201871017156132bd57600080fd5b50509291906020018051600160201b8111156132d857600080fd5b820160208101848111156132eb57600080fd5b8151600160201b81118282018710171561330457600080fd5b50506020820151604090920151949b909a5090985092965091945050505050565b60008054604051600160e11b637baf850302815261ffff8087166064830152851660848201526001600160a01b0384811660a48301523360c4830181905260e0600484019081528b5160e48501528b51929094169463f75f0a06948c948c948c948c948c948c9492939092839260248201926044830192610104019160208e0191908190849084908381101561216057818101518382015260200161214856fea165627a7a72305820b6e6a59155f89147a70225e82521ad7432c887b50d3e810e125f0b82322d043f0029"
+var PermInterfaceBin = "0x60803461007457601f611f8938819003918201601f19168301916001600160401b038311848410176100795780849260209460405283398101031261007457516001600160a01b0381169081900361007457600280546001600160a01b031916919091179055604051611ee090816100a98239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604081815260048036101561001557600080fd5b600092833560e01c90816303ed6933146119675750806316724c44146119015780631a2e91d714611881578381631b610220146117b55781631e6e262c1461171b575080632e125a6c146115e0578381632f7f0a121461153f5781633e239b23146114f95781633f9be497146113ea57816343de646c1461132257816344478e79146112b55750806345a59e5b146111ff5780634cbfa82e146111b3578381634fe57e7a1461115657508063511bbd9f146110da57838163513a32771461108d5750806351f604c314610fa15783816358dcff7114610f555781635a396e9a14610efb5781635be9672c14610eb5575080636b568d7614610e745783816379d5943d14610e1a57816384b7a84a14610d765781638683c7fe14610ca357816391ba3f9614610c5757508063936421d514610bb95780639bd3810114610b5c57838163a5843f0814610b05578163a634301214610a4b578163a97914bf14610a05578163b62010791461092857508063ba1257581461082557838163bb3b6e80146107db578163bced638d1461073357508063c4b4d8a014610685578063c62dd61214610541578063ceec8b70146104ad578063d1aa0c201461041057838163ef5f7196146103c0578163f346a3a71461027b575063fa279d61146101f857600080fd5b8291346102775761020836611b05565b90929391969460018060a01b0389541693843b156102735789968793610244928a519b8c998a988997637baf850360e11b895233968901611d1d565b03925af190811561026a57506102575750f35b61026090611a26565b6102675780f35b80fd5b513d84823e3d90fd5b8980fd5b5050fd5b919050346103bc5760203660031901126103bc576001600160401b039281358481116103ae576102ae903690840161198b565b60018060a09693961b0393602085845416916102e386519889958694859463f346a3a760e01b86528501526024840191611ce4565b03915afa9182156103b25785948680958195610334575b505050610325610317968351978897608089526080890190611cbf565b908782036020890152611cbf565b93169084015260608301520390f35b94509450955093503d8084843e61034b8184611a4f565b8201946080838703126103ae5782518181116103aa578661036d918501611e2f565b93602084015191821161026757506103179561038a918401611e2f565b93610325606061039b848601611e74565b940151949593949396386102fa565b8480fd5b8380fd5b81513d87823e3d90fd5b5080fd5b92905034610277576103d136611b95565b939192909560018060a01b0388541692833b1561040c57610244899692879389519a8b988997889663ecad01d560e01b885233958801611dd5565b8880fd5b5090346104a95760203660031901126104a957602061042d6119ce565b8454835163068d506160e51b81526001600160a01b039283169581019590955284916024918391165afa91821561049f5760209392610470575b50519015158152f35b610491919250833d8111610498575b6104898183611a4f565b810190611d05565b9038610467565b503d61047f565b81513d85823e3d90fd5b8280fd5b509190346103bc57602092836003193601126104a95782548251630ceec8b760e41b81528235928101929092528490829060249082906001600160a01b03165afa9283156105365792610502575b5051908152f35b9091508281813d831161052f575b61051a8183611a4f565b8101031261052a575190386104fb565b600080fd5b503d610510565b8251903d90823e3d90fd5b508290346103bc578161055336611c27565b83548751636316eb0960e11b81529586018890526001600160a01b039594938593918716928492839261058a916044850191611ce4565b90602483015203915afa90811561067b57838095819482809481966105ed575b50506105d46105c6988351998a9960c08b5260c08b0190611cbf565b9089820360208b0152611cbf565b9516908601526060850152608084015260a08301520390f35b975097509450925050503d8085853e6106068185611a4f565b83019360c0848603126102675783516001600160401b03908181116104a95786610631918701611e2f565b91602086015191821161026757506105c69561064e918601611e2f565b9361065a838201611e74565b926060820151946105d460a0608085015194015194979596939495986105aa565b84513d85823e3d90fd5b508290346103bc5782809161069936611c27565b9194909260018060a01b03875416926106cb835197889687958695630625a6c560e51b87528601526044850191611ce4565b90602483015203915afa908115610729578280926106f2575b505082519182526020820152f35b915091508282813d8311610722575b61070b8183611a4f565b8101031261026757506020815191015183806106e4565b503d610701565b83513d84823e3d90fd5b929050346102775760803660031901126102775781356001600160401b0381116107d657610764903690840161198b565b84549193916001600160a01b0316803b156107d25760a08680946107a18751988996879586946309188a9760e01b865285015260a4840191611ce4565b60243560248301526044356044830152606435606483015233608483015203925af190811561026a57506102575750f35b8580fd5b505050fd5b92905034610277576107ec36611c27565b9060018060a01b0386541690813b1561082157868094610244875198899687958694633cf5f33b60e01b865233938601611d75565b8680fd5b5090346104a95760031960a0368201126103ae576001600160401b039280358481116107d257610858903690830161198b565b91909460243581811161092457610872903690840161198b565b94909160443590811161040c5761088c903690850161198b565b6064356001600160a01b03818116959394939186900361052a578b54169789519a8b9889986317424aeb60e31b8a52890160a0905260a48901906108cf92611ce4565b90848883030160248901526108e392611ce4565b918583030160448601526108f692611ce4565b906064830152608435608483015203815a93602094fa91821561049f57602093926104705750519015158152f35b8780fd5b92905034610277576003199160a0368401126107d6576109466119ce565b90602435936001600160401b039485811161082157610968903690840161198b565b9560443590811161092457610980903690850161198b565b9460018060a01b0392838a541694853b15610a01578a9788946109c96109d8948c519d8e9b8c9a8b99631eb907c160e21b8b52169089015260c0602489015260c4880191611ce4565b92858403016044860152611ce4565b606435606483015260843560848301523360a483015203925af190811561026a57506102575750f35b8a80fd5b9290503461027757610a16366119e4565b9060018060a01b0386541690813b1561082157868094610244875198899687958694630e124c8960e11b865233938601611da4565b929050346102775760031981813601126107d6576001600160401b039280358481116107d257610a7e903690830161198b565b91909460243590811161082157610a98903690830161198b565b875491946001600160a01b0390921692833b1561040c57610aec6060938a97938894610add8b519c8d9a8b998a98632e52d6df60e11b8a528901526064880191611ce4565b92858403016024860152611ce4565b33604483015203925af190811561026a57506102575750f35b9290503461027757610b1636611c11565b8454909391906001600160a01b0316803b156107d257859283604492865197889586946314b087e160e31b865285015260248401525af190811561026a57506102575750f35b5090346104a9576020610b6e36611c59565b86548551639bd3810160e01b81529687946001600160a01b039092169385938493610b9d939192918501611e88565b03915afa91821561049f57602093926104705750519015158152f35b5090346104a95760c03660031901126104a957610bd46119ce565b610bdc6119b8565b9260a435916001600160401b0383116107d257610bfe6020933690840161198b565b91610b9d60018060a01b0392838a5416948489519a8b988997889763936421d560e01b8952169087015216602485015260443560448501526064356064850152608435608485015260c060a485015260c4840191611ce4565b9290503461027757610c6836611b95565b939192909560018060a01b0388541692833b1561040c57610244899692879389519a8b988997889663d621d95760e01b885233958801611dd5565b9290503461027757600319916080368401126107d6576001600160401b0381358181116107d257610cd79036908401611a8b565b906024359081116107d257610cef9036908401611a8b565b91610cf8611af4565b92610d01611ae3565b9560018060a01b0388541692833b1561040c57610d42978996610d5188946080968b519c8d9a8b998a98634341e3ff60e11b8a528901526084880190611cbf565b91868303016024870152611cbf565b9161ffff809216604485015216606483015203925af190811561026a57506102575750f35b929050346102775760603660031901126102775781356001600160401b0381116107d657610da7903690840161198b565b610db29391936119b8565b85546001600160a01b039081169190823b156109245787946080928692610df289519a8b98899788966302740f8f60e11b88528701526084860191611ce4565b91166024830152604435604483015233606483015203925af190811561026a57506102575750f35b9290503461027757610e2b36611c11565b84549093906001600160a01b0316803b156107d25783516337a7db5560e01b81529182019283526020830194909452336040830152928491849190829084908290606001610244565b5090346104a9576020610e8636611c59565b865485516335ab46bb60e11b81529687946001600160a01b039092169385938493610b9d939192918501611e88565b9290503461027757610ec636611c27565b9060018060a01b0386541690813b1561082157868094610244875198899687958694632d55195960e21b865233938601611d75565b9290503461027757610f0c36611c11565b84549093906001600160a01b0316803b156107d257835163215e0b0d60e11b81529182019283526020830194909452336040830152928491849190829084908290606001610244565b9290503461027757610f6636611b95565b939192909560018060a01b0388541692833b1561040c57610244899692879389519a8b98899788966302810afd60e61b885233958801611dd5565b5090346104a9576003199060a0368301126103ae576001600160401b039183358381116107d257610fd5903690860161198b565b909360243590811161082157610fee903690870161198b565b9590926064359687151580980361052a576084359283151580940361052a5789548a996001600160a01b0390911694853b15610a015761106160c0958c99958a966110528d519e8f9c8d9b8c9a630d82613b60e11b8c528b015260c48a0191611ce4565b92878403016024880152611ce4565b916044356044850152606484015260848301523360a483015203925af190811561026a57506102575750f35b929050346102775761109e36611b05565b90929391969460018060a01b0389541693843b156102735789968793610244928a519b8c998a98899763e91b0e1960e01b895233968901611d1d565b50346104a95760203660031901126104a9576110f46119ce565b6002546001600160a01b0393919291908416330361112257505082546001600160a01b031916911617815580f35b906020606492519162461bcd60e51b8352820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152fd5b92905034610277576020366003190112610277576111726119ce565b83546001600160a01b03908116803b156107d257859283602492865197889586946327f2bf3d60e11b865216908401525af190811561026a57506102575750f35b5090346104a957826003193601126104a9578254815163265fd41760e11b81529260209184919082906001600160a01b03165afa91821561049f57602093926104705750519015158152f35b5090346104a957600319916060368401126103ae576001600160401b039281358481116107d257611233903690840161198b565b9190946024359081116108215760209361129261ffff6112586060943690850161198b565b929095611263611af4565b9360018060a01b038d5416976110528c519d8e9b8c9a8b9a6345a59e5b60e01b8c528b015260648a0191611ce4565b9116604483015203915afa91821561049f57602093926104705750519015158152f35b80848434610277578260031936011261027757825482516344478e7960e01b8152936020928592909183916001600160a01b03165af191821561049f57602093926113035750519015158152f35b61131b919250833d8111610498576104898183611a4f565b9083610467565b92905034610277576003196060368201126107d6576001600160401b0383358181116107d257611355903690860161198b565b9190946113606119b8565b9160443590811161092457611378903690830161198b565b94909160018060a01b03808a541694853b15610a01578a9760809589956113d1946113bc8d519e8f9c8d9b8c9a63404bf3eb60e01b8c528b015260848a0191611ce4565b94166024870152858403016044860152611ce4565b33606483015203925af190811561026a57506102575750f35b92905034610277576003199160c0368401126107d6576001600160401b039080358281116107d25761141f9036908301611a8b565b90602435838111610821576114379036908301611a8b565b926044359081116108215761144f9036908301611a8b565b94611458611ae3565b95611461611ad2565b88546001600160a01b03169390843b15610273576114a7986114c660e0958c99956114b78b978d519e8f9c8d9b8c9a632e6dff9b60e21b8c528b015260e48a0190611cbf565b90848983030160248a0152611cbf565b91868303016044870152611cbf565b9161ffff809216606485015216608483015260a43560a48301523360c483015203925af190811561026a57506102575750f35b929050346102775761150a366119e4565b9060018060a01b0386541690813b1561082157868094610244875198899687958694634b20f45f60e01b865233938601611da4565b9290503461027757600319916060368401126107d65761155d6119ce565b90602435936001600160401b03948581116108215761157f903690840161198b565b9560443590811161092457611597903690850161198b565b9460018060a01b0392838a541694853b15610a01578a9788946109c96113d1948c519d8e9b8c9a8b99638baa819160e01b8b521690890152608060248901526084880191611ce4565b5090346104a9576003199060c0368301126103ae576001600160401b039183358381116107d2576116149036908601611a8b565b906024358481116108215761162c9036908701611a8b565b90604435858111610924576116449036908801611a8b565b946064359081116109245761165c9036908801611a8b565b92611665611ad2565b9160a4359761ffff93848a16809a0361052a578a548b9a6001600160a01b0390911695863b15611717578b9889958b519c8d9a8b998a986368a6127360e01b8a52890160e0905260e489016116b991611cbf565b838982030160248a01526116cc91611cbf565b828882030160448901526116df91611cbf565b908682030160648701526116f291611cbf565b9216608484015260a48301523360c483015203925af190811561026a57506102575750f35b8b80fd5b929050346102775760031960a0368201126107d6576001600160401b0383358181116107d25761174e903690860161198b565b9190946117596119b8565b9160443590811161092457611771903690830161198b565b94909160018060a01b03808a541694853b15610a01578a9760c09589956109d8946113bc8d519e8f9c8d9b8c9a63f43e6fbd60e01b8c528b015260c48a0191611ce4565b9290503461027757600319916060368401126107d6576001600160401b039281358481116107d2576117ea903690840161198b565b60249291923586811161092457611804903690860161198b565b9660443590811161040c5761181c903690870161198b565b959060018060a01b038a541694853b15610a01578a9788948a519b8c998a98899762db081160e51b8952880160609052606488019061185a92611ce4565b908487830301602488015261186e92611ce4565b9184830301604485015261024492611ce4565b509190346103bc57602092836003193601126104a95780356001600160401b0381116103ae576118b585913690840161198b565b85548551631a2e91d760e01b815294850184905284926001600160a01b0390911691839182916118ea91602484019190611ce4565b03915afa9283156105365792610502575051908152f35b5090346104a95782611912366119e4565b919060018060a01b0384541691823b156103aa578490611947875198899687958694638884304160e01b865233938601611da4565b03925af190811561026a575061195b575080f35b61196490611a26565b80f35b8490346103bc57816003193601126103bc5790546001600160a01b03168152602090f35b9181601f8401121561052a578235916001600160401b03831161052a576020838186019501011161052a57565b602435906001600160a01b038216820361052a57565b600435906001600160a01b038216820361052a57565b604060031982011261052a57600435906001600160401b03821161052a57611a0e9160040161198b565b90916024356001600160a01b038116810361052a5790565b6001600160401b038111611a3957604052565b634e487b7160e01b600052604160045260246000fd5b90601f801991011681019081106001600160401b03821117611a3957604052565b6001600160401b038111611a3957601f01601f191660200190565b81601f8201121561052a57803590611aa282611a70565b92611ab06040519485611a4f565b8284526020838301011161052a57816000926020809301838601378301015290565b6084359061ffff8216820361052a57565b6064359061ffff8216820361052a57565b6044359061ffff8216820361052a57565b60c060031982011261052a576001600160401b039160043583811161052a5782611b3191600401611a8b565b9260243581811161052a5783611b4991600401611a8b565b9260443591821161052a57611b6091600401611a8b565b9061ffff90606435828116810361052a5791608435908116810361052a579060a4356001600160a01b038116810361052a5790565b60a060031982011261052a576001600160401b039160043583811161052a5782611bc191600401611a8b565b9260243581811161052a5783611bd991600401611a8b565b9260443591821161052a57611bf091600401611a8b565b9061ffff90606435828116810361052a5791608435908116810361052a5790565b604090600319011261052a576004359060243590565b604060031982011261052a57600435906001600160401b03821161052a57611c519160040161198b565b909160243590565b90604060031983011261052a576004356001600160a01b038116810361052a5791602435906001600160401b03821161052a57611c989160040161198b565b9091565b60005b838110611caf5750506000910152565b8181015183820152602001611c9f565b90602091611cd881518092818552858086019101611c9c565b601f01601f1916010190565b908060209392818452848401376000828201840152601f01601f1916010190565b9081602091031261052a5751801515810361052a5790565b95939192611d3f60c0969998946105c6611d4d9460e08b5260e08b0190611cbf565b908782036040890152611cbf565b61ffff9182166060870152961660808501526001600160a01b0391821660a085015216910152565b92949390611d8e90604093606086526060860191611ce4565b60208401959095526001600160a01b0316910152565b9290611dbe90604093969596606086526060860191611ce4565b6001600160a01b0391821660208501529416910152565b94969592611e0360a09593611df5611e119460c08a5260c08a0190611cbf565b9088820360208a0152611cbf565b908682036040880152611cbf565b9561ffff8092166060860152166080840152600180831b0316910152565b81601f8201121561052a578051611e4581611a70565b92611e536040519485611a4f565b8184526020828401011161052a57611e719160208085019101611c9c565b90565b51906001600160a01b038216820361052a57565b6001600160a01b039091168152604060208201819052611e7193910191611ce456fea2646970667358221220ff7ed7cdae474004b0a1ec7d43065ad24815a3c490537971861747e3d32b9b2b64736f6c63430008150033"
 
 // DeployPermInterface deploys a new Ethereum contract, binding an instance of PermInterface to it.
 func DeployPermInterface(auth *bind.TransactOpts, backend bind.ContractBackend, _permImplUpgradeable common.Address) (common.Address, *types.Transaction, *PermInterface, error) {
@@ -194,7 +192,7 @@ func (_PermInterface *PermInterfaceTransactorRaw) Transact(opts *bind.TransactOp
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermInterface *PermInterfaceCaller) ConnectionAllowed(opts *bind.CallOpts, _enodeId string, _ip string, _port uint16) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -206,21 +204,21 @@ func (_PermInterface *PermInterfaceCaller) ConnectionAllowed(opts *bind.CallOpts
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermInterface *PermInterfaceSession) ConnectionAllowed(_enodeId string, _ip string, _port uint16) (bool, error) {
 	return _PermInterface.Contract.ConnectionAllowed(&_PermInterface.CallOpts, _enodeId, _ip, _port)
 }
 
 // ConnectionAllowed is a free data retrieval call binding the contract method 0x45a59e5b.
 //
-// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) constant returns(bool)
+// Solidity: function connectionAllowed(string _enodeId, string _ip, uint16 _port) view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) ConnectionAllowed(_enodeId string, _ip string, _port uint16) (bool, error) {
 	return _PermInterface.Contract.ConnectionAllowed(&_PermInterface.CallOpts, _enodeId, _ip, _port)
 }
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermInterface *PermInterfaceCaller) GetNetworkBootStatus(opts *bind.CallOpts) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -232,21 +230,47 @@ func (_PermInterface *PermInterfaceCaller) GetNetworkBootStatus(opts *bind.CallO
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermInterface *PermInterfaceSession) GetNetworkBootStatus() (bool, error) {
 	return _PermInterface.Contract.GetNetworkBootStatus(&_PermInterface.CallOpts)
 }
 
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
-// Solidity: function getNetworkBootStatus() constant returns(bool)
+// Solidity: function getNetworkBootStatus() view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) GetNetworkBootStatus() (bool, error) {
 	return _PermInterface.Contract.GetNetworkBootStatus(&_PermInterface.CallOpts)
 }
 
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermInterface *PermInterfaceCaller) GetNumberOfPendingOps(opts *bind.CallOpts, _orgId string) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermInterface.contract.Call(opts, out, "getNumberOfPendingOps", _orgId)
+	return *ret0, err
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermInterface *PermInterfaceSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _PermInterface.Contract.GetNumberOfPendingOps(&_PermInterface.CallOpts, _orgId)
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_PermInterface *PermInterfaceCallerSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _PermInterface.Contract.GetNumberOfPendingOps(&_PermInterface.CallOpts, _orgId)
+}
+
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermInterface *PermInterfaceCaller) GetPendingOp(opts *bind.CallOpts, _orgId string) (string, string, common.Address, *big.Int, error) {
 	var (
 		ret0 = new(string)
@@ -266,21 +290,59 @@ func (_PermInterface *PermInterfaceCaller) GetPendingOp(opts *bind.CallOpts, _or
 
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermInterface *PermInterfaceSession) GetPendingOp(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _PermInterface.Contract.GetPendingOp(&_PermInterface.CallOpts, _orgId)
 }
 
 // GetPendingOp is a free data retrieval call binding the contract method 0xf346a3a7.
 //
-// Solidity: function getPendingOp(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOp(string _orgId) view returns(string, string, address, uint256)
 func (_PermInterface *PermInterfaceCallerSession) GetPendingOp(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _PermInterface.Contract.GetPendingOp(&_PermInterface.CallOpts, _orgId)
 }
 
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermInterface *PermInterfaceCaller) GetPendingOpDetailsFromIndex(opts *bind.CallOpts, _orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(string)
+		ret1 = new(string)
+		ret2 = new(common.Address)
+		ret3 = new(*big.Int)
+		ret4 = new(*big.Int)
+		ret5 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+		ret4,
+		ret5,
+	}
+	err := _PermInterface.contract.Call(opts, out, "getPendingOpDetailsFromIndex", _orgId, _index)
+	return *ret0, *ret1, *ret2, *ret3, *ret4, *ret5, err
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermInterface *PermInterfaceSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _PermInterface.Contract.GetPendingOpDetailsFromIndex(&_PermInterface.CallOpts, _orgId, _index)
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_PermInterface *PermInterfaceCallerSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _PermInterface.Contract.GetPendingOpDetailsFromIndex(&_PermInterface.CallOpts, _orgId, _index)
+}
+
 // GetPermissionsImpl is a free data retrieval call binding the contract method 0x03ed6933.
 //
-// Solidity: function getPermissionsImpl() constant returns(address)
+// Solidity: function getPermissionsImpl() view returns(address)
 func (_PermInterface *PermInterfaceCaller) GetPermissionsImpl(opts *bind.CallOpts) (common.Address, error) {
 	var (
 		ret0 = new(common.Address)
@@ -292,21 +354,77 @@ func (_PermInterface *PermInterfaceCaller) GetPermissionsImpl(opts *bind.CallOpt
 
 // GetPermissionsImpl is a free data retrieval call binding the contract method 0x03ed6933.
 //
-// Solidity: function getPermissionsImpl() constant returns(address)
+// Solidity: function getPermissionsImpl() view returns(address)
 func (_PermInterface *PermInterfaceSession) GetPermissionsImpl() (common.Address, error) {
 	return _PermInterface.Contract.GetPermissionsImpl(&_PermInterface.CallOpts)
 }
 
 // GetPermissionsImpl is a free data retrieval call binding the contract method 0x03ed6933.
 //
-// Solidity: function getPermissionsImpl() constant returns(address)
+// Solidity: function getPermissionsImpl() view returns(address)
 func (_PermInterface *PermInterfaceCallerSession) GetPermissionsImpl() (common.Address, error) {
 	return _PermInterface.Contract.GetPermissionsImpl(&_PermInterface.CallOpts)
 }
 
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermInterface *PermInterfaceCaller) GetThresholdProposal(opts *bind.CallOpts, _orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _PermInterface.contract.Call(opts, out, "getThresholdProposal", _orgId, _index)
+	return *ret0, *ret1, err
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermInterface *PermInterfaceSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _PermInterface.Contract.GetThresholdProposal(&_PermInterface.CallOpts, _orgId, _index)
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_PermInterface *PermInterfaceCallerSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _PermInterface.Contract.GetThresholdProposal(&_PermInterface.CallOpts, _orgId, _index)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermInterface *PermInterfaceCaller) GetVoteThreshold(opts *bind.CallOpts, _opType *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermInterface.contract.Call(opts, out, "getVoteThreshold", _opType)
+	return *ret0, err
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermInterface *PermInterfaceSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _PermInterface.Contract.GetVoteThreshold(&_PermInterface.CallOpts, _opType)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_PermInterface *PermInterfaceCallerSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _PermInterface.Contract.GetVoteThreshold(&_PermInterface.CallOpts, _opType)
+}
+
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermInterface *PermInterfaceCaller) IsNetworkAdmin(opts *bind.CallOpts, _account common.Address) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -318,21 +436,21 @@ func (_PermInterface *PermInterfaceCaller) IsNetworkAdmin(opts *bind.CallOpts, _
 
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermInterface *PermInterfaceSession) IsNetworkAdmin(_account common.Address) (bool, error) {
 	return _PermInterface.Contract.IsNetworkAdmin(&_PermInterface.CallOpts, _account)
 }
 
 // IsNetworkAdmin is a free data retrieval call binding the contract method 0xd1aa0c20.
 //
-// Solidity: function isNetworkAdmin(address _account) constant returns(bool)
+// Solidity: function isNetworkAdmin(address _account) view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) IsNetworkAdmin(_account common.Address) (bool, error) {
 	return _PermInterface.Contract.IsNetworkAdmin(&_PermInterface.CallOpts, _account)
 }
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceCaller) IsOrgAdmin(opts *bind.CallOpts, _account common.Address, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -344,21 +462,47 @@ func (_PermInterface *PermInterfaceCaller) IsOrgAdmin(opts *bind.CallOpts, _acco
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceSession) IsOrgAdmin(_account common.Address, _orgId string) (bool, error) {
 	return _PermInterface.Contract.IsOrgAdmin(&_PermInterface.CallOpts, _account, _orgId)
 }
 
 // IsOrgAdmin is a free data retrieval call binding the contract method 0x9bd38101.
 //
-// Solidity: function isOrgAdmin(address _account, string _orgId) constant returns(bool)
+// Solidity: function isOrgAdmin(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) IsOrgAdmin(_account common.Address, _orgId string) (bool, error) {
 	return _PermInterface.Contract.IsOrgAdmin(&_PermInterface.CallOpts, _account, _orgId)
 }
 
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermInterface *PermInterfaceCaller) IsPendingOp(opts *bind.CallOpts, _authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _PermInterface.contract.Call(opts, out, "isPendingOp", _authOrg, _orgId, _enodeId, _account, _pendingOp)
+	return *ret0, err
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermInterface *PermInterfaceSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _PermInterface.Contract.IsPendingOp(&_PermInterface.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_PermInterface *PermInterfaceCallerSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _PermInterface.Contract.IsPendingOp(&_PermInterface.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermInterface *PermInterfaceCaller) TransactionAllowed(opts *bind.CallOpts, _sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -370,21 +514,21 @@ func (_PermInterface *PermInterfaceCaller) TransactionAllowed(opts *bind.CallOpt
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermInterface *PermInterfaceSession) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	return _PermInterface.Contract.TransactionAllowed(&_PermInterface.CallOpts, _sender, _target, _value, _gasPrice, _gasLimit, _payload)
 }
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0x936421d5.
 //
-// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) constant returns(bool)
+// Solidity: function transactionAllowed(address _sender, address _target, uint256 _value, uint256 _gasPrice, uint256 _gasLimit, bytes _payload) view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte) (bool, error) {
 	return _PermInterface.Contract.TransactionAllowed(&_PermInterface.CallOpts, _sender, _target, _value, _gasPrice, _gasLimit, _payload)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceCaller) ValidateAccount(opts *bind.CallOpts, _account common.Address, _orgId string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -396,14 +540,14 @@ func (_PermInterface *PermInterfaceCaller) ValidateAccount(opts *bind.CallOpts,
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _PermInterface.Contract.ValidateAccount(&_PermInterface.CallOpts, _account, _orgId)
 }
 
 // ValidateAccount is a free data retrieval call binding the contract method 0x6b568d76.
 //
-// Solidity: function validateAccount(address _account, string _orgId) constant returns(bool)
+// Solidity: function validateAccount(address _account, string _orgId) view returns(bool)
 func (_PermInterface *PermInterfaceCallerSession) ValidateAccount(_account common.Address, _orgId string) (bool, error) {
 	return _PermInterface.Contract.ValidateAccount(&_PermInterface.CallOpts, _account, _orgId)
 }
@@ -639,6 +783,27 @@ func (_PermInterface *PermInterfaceTransactorSession) ApproveOrgStatus(_orgId st
 	return _PermInterface.Contract.ApproveOrgStatus(&_PermInterface.TransactOpts, _orgId, _action)
 }
 
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x5a396e9a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceTransactor) ApproveVoteThreshold(opts *bind.TransactOpts, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "approveVoteThreshold", _opType, _threshold)
+}
+
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x5a396e9a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceSession) ApproveVoteThreshold(_opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.ApproveVoteThreshold(&_PermInterface.TransactOpts, _opType, _threshold)
+}
+
+// ApproveVoteThreshold is a paid mutator transaction binding the contract method 0x5a396e9a.
+//
+// Solidity: function approveVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceTransactorSession) ApproveVoteThreshold(_opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.ApproveVoteThreshold(&_PermInterface.TransactOpts, _opType, _threshold)
+}
+
 // AssignAccountRole is a paid mutator transaction binding the contract method 0x2f7f0a12.
 //
 // Solidity: function assignAccountRole(address _account, string _orgId, string _roleId) returns()
@@ -660,6 +825,27 @@ func (_PermInterface *PermInterfaceTransactorSession) AssignAccountRole(_account
 	return _PermInterface.Contract.AssignAccountRole(&_PermInterface.TransactOpts, _account, _orgId, _roleId)
 }
 
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0xb6201079.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactor) AssignAccountRoleWithExpiry(opts *bind.TransactOpts, _account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "assignAccountRoleWithExpiry", _account, _orgId, _roleId, _expiryBlock, _expiryTime)
+}
+
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0xb6201079.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceSession) AssignAccountRoleWithExpiry(_account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.AssignAccountRoleWithExpiry(&_PermInterface.TransactOpts, _account, _orgId, _roleId, _expiryBlock, _expiryTime)
+}
+
+// AssignAccountRoleWithExpiry is a paid mutator transaction binding the contract method 0xb6201079.
+//
+// Solidity: function assignAccountRoleWithExpiry(address _account, string _orgId, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactorSession) AssignAccountRoleWithExpiry(_account common.Address, _orgId string, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.AssignAccountRoleWithExpiry(&_PermInterface.TransactOpts, _account, _orgId, _roleId, _expiryBlock, _expiryTime)
+}
+
 // AssignAdminRole is a paid mutator transaction binding the contract method 0x43de646c.
 //
 // Solidity: function assignAdminRole(string _orgId, address _account, string _roleId) returns()
@@ -681,6 +867,27 @@ func (_PermInterface *PermInterfaceTransactorSession) AssignAdminRole(_orgId str
 	return _PermInterface.Contract.AssignAdminRole(&_PermInterface.TransactOpts, _orgId, _account, _roleId)
 }
 
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0x1e6e262c.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactor) AssignAdminRoleWithExpiry(opts *bind.TransactOpts, _orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "assignAdminRoleWithExpiry", _orgId, _account, _roleId, _expiryBlock, _expiryTime)
+}
+
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0x1e6e262c.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceSession) AssignAdminRoleWithExpiry(_orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.AssignAdminRoleWithExpiry(&_PermInterface.TransactOpts, _orgId, _account, _roleId, _expiryBlock, _expiryTime)
+}
+
+// AssignAdminRoleWithExpiry is a paid mutator transaction binding the contract method 0x1e6e262c.
+//
+// Solidity: function assignAdminRoleWithExpiry(string _orgId, address _account, string _roleId, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactorSession) AssignAdminRoleWithExpiry(_orgId string, _account common.Address, _roleId string, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.AssignAdminRoleWithExpiry(&_PermInterface.TransactOpts, _orgId, _account, _roleId, _expiryBlock, _expiryTime)
+}
+
 // Init is a paid mutator transaction binding the contract method 0xa5843f08.
 //
 // Solidity: function init(uint256 _breadth, uint256 _depth) returns()
@@ -765,6 +972,27 @@ func (_PermInterface *PermInterfaceTransactorSession) SetPolicy(_nwAdminOrg stri
 	return _PermInterface.Contract.SetPolicy(&_PermInterface.TransactOpts, _nwAdminOrg, _nwAdminRole, _oAdminRole)
 }
 
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x79d5943d.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceTransactor) SetVoteThreshold(opts *bind.TransactOpts, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "setVoteThreshold", _opType, _threshold)
+}
+
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x79d5943d.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceSession) SetVoteThreshold(_opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetVoteThreshold(&_PermInterface.TransactOpts, _opType, _threshold)
+}
+
+// SetVoteThreshold is a paid mutator transaction binding the contract method 0x79d5943d.
+//
+// Solidity: function setVoteThreshold(uint256 _opType, uint256 _threshold) returns()
+func (_PermInterface *PermInterfaceTransactorSession) SetVoteThreshold(_opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetVoteThreshold(&_PermInterface.TransactOpts, _opType, _threshold)
+}
+
 // StartBlacklistedAccountRecovery is a paid mutator transaction binding the contract method 0xa97914bf.
 //
 // Solidity: function startBlacklistedAccountRecovery(string _orgId, address _account) returns()
@@ -890,3 +1118,24 @@ func (_PermInterface *PermInterfaceSession) UpdateOrgStatus(_orgId string, _acti
 func (_PermInterface *PermInterfaceTransactorSession) UpdateOrgStatus(_orgId string, _action *big.Int) (*types.Transaction, error) {
 	return _PermInterface.Contract.UpdateOrgStatus(&_PermInterface.TransactOpts, _orgId, _action)
 }
+
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0xbced638d.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactor) UpdateOrgStatusWithExpiry(opts *bind.TransactOpts, _orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "updateOrgStatusWithExpiry", _orgId, _action, _expiryBlock, _expiryTime)
+}
+
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0xbced638d.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceSession) UpdateOrgStatusWithExpiry(_orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.UpdateOrgStatusWithExpiry(&_PermInterface.TransactOpts, _orgId, _action, _expiryBlock, _expiryTime)
+}
+
+// UpdateOrgStatusWithExpiry is a paid mutator transaction binding the contract method 0xbced638d.
+//
+// Solidity: function updateOrgStatusWithExpiry(string _orgId, uint256 _action, uint256 _expiryBlock, uint256 _expiryTime) returns()
+func (_PermInterface *PermInterfaceTransactorSession) UpdateOrgStatusWithExpiry(_orgId string, _action *big.Int, _expiryBlock *big.Int, _expiryTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.UpdateOrgStatusWithExpiry(&_PermInterface.TransactOpts, _orgId, _action, _expiryBlock, _expiryTime)
+}
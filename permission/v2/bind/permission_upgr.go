@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // PermUpgrABI is the input ABI used to generate the binding from.
-const PermUpgrABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"getPermImpl\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_proposedImpl\",\"type\":\"address\"}],\"name\":\"confirmImplChange\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getGuardian\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getPermInterface\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_permInterface\",\"type\":\"address\"},{\"name\":\"_permImpl\",\"type\":\"address\"}],\"name\":\"init\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_guardian\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"}]"
+const PermUpgrABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_guardian\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_proposedImpl\",\"type\":\"address\"}],\"name\":\"confirmImplChange\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGuardian\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPermImpl\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPermInterface\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permInterface\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_permImpl\",\"type\":\"address\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var PermUpgrParsedABI, _ = abi.JSON(strings.NewReader(PermUpgrABI))
 
 // PermUpgrBin is the compiled bytecode used for deploying new contracts.
-var PermUpgrBin = "0x608060405234801561001057600080fd5b506040516020806106e78339810180604052602081101561003057600080fd5b5051600080546001600160a01b039092166001600160a01b031990921691909117905560028054600160a01b60ff0219169055610675806100726000396000f3fe608060405234801561001057600080fd5b50600436106100575760003560e01c80630e32cf901461005c57806322bcb39a14610080578063a75b87d2146100a8578063e572515c146100b0578063f09a4016146100b8575b600080fd5b6100646100e6565b604080516001600160a01b039092168252519081900360200190f35b6100a66004803603602081101561009657600080fd5b50356001600160a01b03166100f5565b005b61006461030b565b61006461031a565b6100a6600480360360408110156100ce57600080fd5b506001600160a01b0381358116916020013516610329565b6001546001600160a01b031690565b6000546001600160a01b0316331461014b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60608060606000600160009054906101000a90046001600160a01b03166001600160a01b031663cc9ba6fa6040518163ffffffff1660e01b815260040160006040518083038186803b1580156101a057600080fd5b505afa1580156101b4573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405260808110156101dd57600080fd5b8101908080516401000000008111156101f557600080fd5b8201602081018481111561020857600080fd5b815164010000000081118282018710171561022257600080fd5b5050929190602001805164010000000081111561023e57600080fd5b8201602081018481111561025157600080fd5b815164010000000081118282018710171561026b57600080fd5b5050929190602001805164010000000081111561028757600080fd5b8201602081018481111561029a57600080fd5b81516401000000008111828201871017156102b457600080fd5b50506020909101519498509296509194509192506102d9915086905085858585610443565b600180546001600160a01b0319166001600160a01b03878116919091179182905561030491166105e4565b5050505050565b6000546001600160a01b031690565b6002546001600160a01b031690565b6000546001600160a01b0316331461037f5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b600254600160a01b900460ff16156103e15760408051600160e51b62461bcd02815260206004820152601960248201527f63616e206265206578656375746564206f6e6c79206f6e636500000000000000604482015290519081900360640190fd5b600180546001600160a01b038084166001600160a01b031992831617928390556002805486831693169290921790915561041b91166105e4565b50506002805474ff00000000000000000000000000000000000000001916600160a01b179055565b846001600160a01b031663f5ad584a858585856040518563ffffffff1660e01b81526004018080602001806020018060200185151515158152602001848103845288818151815260200191508051906020019080838360005b838110156104b457818101518382015260200161049c565b50505050905090810190601f1680156104e15780820380516001836020036101000a031916815260200191505b50848103835287518152875160209182019189019080838360005b838110156105145781810151838201526020016104fc565b50505050905090810190601f1680156105415780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b8381101561057457818101518382015260200161055c565b50505050905090810190601f1680156105a15780820380516001836020036101000a031916815260200191505b50975050505050505050600060405180830381600087803b1580156105c557600080fd5b505af11580156105d9573d6000803e3d6000fd5b505050505050505050565b60025460408051600160e01b63511bbd9f0281526001600160a01b0384811660048301529151919092169163511bbd9f91602480830192600092919082900301818387803b15801561063557600080fd5b505af1158015610304573d6000803e3d6000fdfea165627a7a7230582055489d1e43ffd1f6646b629ccf78d3fb7551dd246e111ec3ccbf9ae12f8b900a0029"
+var PermUpgrBin = "0x60803461008157601f6105f838819003918201601f19168301916001600160401b038311848410176100865780849260209460405283398101031261008157516001600160a01b0381169081900361008157600080546001600160a01b0319169190911790556002805460ff60a01b1916905560405161054290816100b68239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe60806040818152600436101561001457600080fd5b600091823560e01c9081630e32cf90146103505750806322bcb39a1461018c578063a75b87d214610165578063e572515c1461013d5763f09a40161461005957600080fd5b34610139578060031936011261013957610071610375565b6001600160a01b03602435818116939192908490036101345761009883865416331461038b565b6002549160ff8360a01c166100f157509184916100db9493600154926bffffffffffffffffffffffff60a01b9186838616176001551691161760025516176104b1565b6002805460ff60a01b1916600160a01b17905580f35b5162461bcd60e51b815260206004820152601960248201527f63616e206265206578656375746564206f6e6c79206f6e6365000000000000006044820152606490fd5b600080fd5b5080fd5b503461013957816003193601126101395760025490516001600160a01b039091168152602090f35b5034610139578160031936011261013957905490516001600160a01b039091168152602090f35b50346101395760031960203682011261034c57600491836101ab610375565b9260018060a01b036101c181845416331461038b565b82816001541685519788809263664dd37d60e11b82525afa80156103425783918496859886936102a9575b501696873b156102a55761022393610242916102338851998a978897637ad6ac2560e11b8952608060048a0152608489019061048c565b908488830301602489015261048c565b9185830301604486015261048c565b9015156064830152038183875af190811561029c5750610287575b506102849082600154826bffffffffffffffffffffffff60a01b82161760015516176104b1565b80f35b9161029561028492936103c8565b919061025d565b513d85823e3d90fd5b8480fd5b975097509150503d8084873e6102bf81876103f2565b85019560808688031261033e5785519167ffffffffffffffff9283811161033657886102ec918901610437565b97602088015184811161033a5781610305918a01610437565b938789015190811161033a5760609161031f918a01610437565b9701519788151589036103365792969791386101ec565b8580fd5b8680fd5b8380fd5b84513d85823e3d90fd5b8280fd5b8390346101395781600319360112610139576001546001600160a01b03168152602090f35b600435906001600160a01b038216820361013457565b1561039257565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b67ffffffffffffffff81116103dc57604052565b634e487b7160e01b600052604160045260246000fd5b90601f8019910116810190811067ffffffffffffffff8211176103dc57604052565b60005b8381106104275750506000910152565b8181015183820152602001610417565b81601f8201121561013457805167ffffffffffffffff81116103dc576040519261046b601f8301601f1916602001856103f2565b81845260208284010111610134576104899160208085019101610414565b90565b906020916104a581518092818552858086019101610414565b601f01601f1916010190565b6002546001600160a01b039081169190823b1561013457602460009283604051958694859363511bbd9f60e01b85521660048401525af18015610500576104f55750565b6104fe906103c8565b565b6040513d6000823e3d90fdfea26469706673582212207ce3bc8e267d0259acaa1026de37b0946d65af63bda5ae60f83e723452cc390e64736f6c63430008150033"
 
 // DeployPermUpgr deploys a new Ethereum contract, binding an instance of PermUpgr to it.
 func DeployPermUpgr(auth *bind.TransactOpts, backend bind.ContractBackend, _guardian common.Address) (common.Address, *types.Transaction, *PermUpgr, error) {
@@ -194,7 +192,7 @@ func (_PermUpgr *PermUpgrTransactorRaw) Transact(opts *bind.TransactOpts, method
 
 // GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
 //
-// Solidity: function getGuardian() constant returns(address)
+// Solidity: function getGuardian() view returns(address)
 func (_PermUpgr *PermUpgrCaller) GetGuardian(opts *bind.CallOpts) (common.Address, error) {
 	var (
 		ret0 = new(common.Address)
@@ -206,21 +204,21 @@ func (_PermUpgr *PermUpgrCaller) GetGuardian(opts *bind.CallOpts) (common.Addres
 
 // GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
 //
-// Solidity: function getGuardian() constant returns(address)
+// Solidity: function getGuardian() view returns(address)
 func (_PermUpgr *PermUpgrSession) GetGuardian() (common.Address, error) {
 	return _PermUpgr.Contract.GetGuardian(&_PermUpgr.CallOpts)
 }
 
 // GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
 //
-// Solidity: function getGuardian() constant returns(address)
+// Solidity: function getGuardian() view returns(address)
 func (_PermUpgr *PermUpgrCallerSession) GetGuardian() (common.Address, error) {
 	return _PermUpgr.Contract.GetGuardian(&_PermUpgr.CallOpts)
 }
 
 // GetPermImpl is a free data retrieval call binding the contract method 0x0e32cf90.
 //
-// Solidity: function getPermImpl() constant returns(address)
+// Solidity: function getPermImpl() view returns(address)
 func (_PermUpgr *PermUpgrCaller) GetPermImpl(opts *bind.CallOpts) (common.Address, error) {
 	var (
 		ret0 = new(common.Address)
@@ -232,21 +230,21 @@ func (_PermUpgr *PermUpgrCaller) GetPermImpl(opts *bind.CallOpts) (common.Addres
 
 // GetPermImpl is a free data retrieval call binding the contract method 0x0e32cf90.
 //
-// Solidity: function getPermImpl() constant returns(address)
+// Solidity: function getPermImpl() view returns(address)
 func (_PermUpgr *PermUpgrSession) GetPermImpl() (common.Address, error) {
 	return _PermUpgr.Contract.GetPermImpl(&_PermUpgr.CallOpts)
 }
 
 // GetPermImpl is a free data retrieval call binding the contract method 0x0e32cf90.
 //
-// Solidity: function getPermImpl() constant returns(address)
+// Solidity: function getPermImpl() view returns(address)
 func (_PermUpgr *PermUpgrCallerSession) GetPermImpl() (common.Address, error) {
 	return _PermUpgr.Contract.GetPermImpl(&_PermUpgr.CallOpts)
 }
 
 // GetPermInterface is a free data retrieval call binding the contract method 0xe572515c.
 //
-// Solidity: function getPermInterface() constant returns(address)
+// Solidity: function getPermInterface() view returns(address)
 func (_PermUpgr *PermUpgrCaller) GetPermInterface(opts *bind.CallOpts) (common.Address, error) {
 	var (
 		ret0 = new(common.Address)
@@ -258,14 +256,14 @@ func (_PermUpgr *PermUpgrCaller) GetPermInterface(opts *bind.CallOpts) (common.A
 
 // GetPermInterface is a free data retrieval call binding the contract method 0xe572515c.
 //
-// Solidity: function getPermInterface() constant returns(address)
+// Solidity: function getPermInterface() view returns(address)
 func (_PermUpgr *PermUpgrSession) GetPermInterface() (common.Address, error) {
 	return _PermUpgr.Contract.GetPermInterface(&_PermUpgr.CallOpts)
 }
 
 // GetPermInterface is a free data retrieval call binding the contract method 0xe572515c.
 //
-// Solidity: function getPermInterface() constant returns(address)
+// Solidity: function getPermInterface() view returns(address)
 func (_PermUpgr *PermUpgrCallerSession) GetPermInterface() (common.Address, error) {
 	return _PermUpgr.Contract.GetPermInterface(&_PermUpgr.CallOpts)
 }
@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // RoleManagerABI is the input ABI used to generate the binding from.
-const RoleManagerABI = "[{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getRoleDetails\",\"outputs\":[{\"name\":\"roleId\",\"type\":\"string\"},{\"name\":\"orgId\",\"type\":\"string\"},{\"name\":\"accessType\",\"type\":\"uint256\"},{\"name\":\"voter\",\"type\":\"bool\"},{\"name\":\"admin\",\"type\":\"bool\"},{\"name\":\"active\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_baseAccess\",\"type\":\"uint256\"},{\"name\":\"_isVoter\",\"type\":\"bool\"},{\"name\":\"_isAdmin\",\"type\":\"bool\"}],\"name\":\"addRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfRoles\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_rIndex\",\"type\":\"uint256\"}],\"name\":\"getRoleDetailsFromIndex\",\"outputs\":[{\"name\":\"roleId\",\"type\":\"string\"},{\"name\":\"orgId\",\"type\":\"string\"},{\"name\":\"accessType\",\"type\":\"uint256\"},{\"name\":\"voter\",\"type\":\"bool\"},{\"name\":\"admin\",\"type\":\"bool\"},{\"name\":\"active\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"roleExists\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"isAdminRole\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"roleAccess\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"},{\"name\":\"_typeOfTxn\",\"type\":\"uint256\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"isVoterRole\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_baseAccess\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_isVoter\",\"type\":\"bool\"},{\"indexed\":false,\"name\":\"_isAdmin\",\"type\":\"bool\"}],\"name\":\"RoleCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"}]"
+const RoleManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_baseAccess\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isVoter\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_isAdmin\",\"type\":\"bool\"}],\"name\":\"RoleCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"RoleRevoked\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_baseAccess\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_isVoter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_isAdmin\",\"type\":\"bool\"}],\"name\":\"addRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfRoles\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getRoleDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"accessType\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"admin\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_rIndex\",\"type\":\"uint256\"}],\"name\":\"getRoleDetailsFromIndex\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"accessType\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"admin\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"isAdminRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"isVoterRole\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"roleAccess\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"roleExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_typeOfTxn\",\"type\":\"uint256\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var RoleManagerParsedABI, _ = abi.JSON(strings.NewReader(RoleManagerABI))
 
 // RoleManagerBin is the compiled bytecode used for deploying new contracts.
-var RoleManagerBin = "0x608060405234801561001057600080fd5b506040516020806128d98339810180604052602081101561003057600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055612877806100626000396000f3fe608060405234801561001057600080fd5b506004361061009e5760003560e01c8063abf5739f11610066578063abf5739f1461048e578063be322e5414610650578063cfc83dfa1461075e578063d1f778661461090c578063deb16ba714610a1a5761009e565b80631870aba3146100a35780637b7135791461025f57806387f55d3114610399578063a451d4a8146103b3578063a6343012146103d0575b600080fd5b610161600480360360408110156100b957600080fd5b810190602081018135600160201b8111156100d357600080fd5b8201836020820111156100e557600080fd5b803590602001918460018302840111600160201b8311171561010657600080fd5b919390929091602081019035600160201b81111561012357600080fd5b82018360208201111561013557600080fd5b803590602001918460018302840111600160201b8311171561015657600080fd5b509092509050610b28565b604080519081018590528315156060820152821515608082015281151560a082015260c08082528751908201528651819060208083019160e08401918b019080838360005b838110156101be5781810151838201526020016101a6565b50505050905090810190601f1680156101eb5780820380516001836020036101000a031916815260200191505b5083810382528851815288516020918201918a019080838360005b8381101561021e578181015183820152602001610206565b50505050905090810190601f16801561024b5780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390f35b610397600480360360a081101561027557600080fd5b810190602081018135600160201b81111561028f57600080fd5b8201836020820111156102a157600080fd5b803590602001918460018302840111600160201b831117156102c257600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561031457600080fd5b82018360208201111561032657600080fd5b803590602001918460018302840111600160201b8311171561034757600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550508235935050506020810135151590604001351515610eae565b005b6103a1611454565b60408051918252519081900360200190f35b610161600480360360208110156103c957600080fd5b503561145b565b610397600480360360408110156103e657600080fd5b810190602081018135600160201b81111561040057600080fd5b82018360208201111561041257600080fd5b803590602001918460018302840111600160201b8311171561043357600080fd5b919390929091602081019035600160201b81111561045057600080fd5b82018360208201111561046257600080fd5b803590602001918460018302840111600160201b8311171561048357600080fd5b509092509050611679565b61063c600480360360608110156104a457600080fd5b810190602081018135600160201b8111156104be57600080fd5b8201836020820111156104d057600080fd5b803590602001918460018302840111600160201b831117156104f157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561054357600080fd5b82018360208201111561055557600080fd5b803590602001918460018302840111600160201b8311171561057657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156105c857600080fd5b8201836020820111156105da57600080fd5b803590602001918460018302840111600160201b831117156105fb57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550611974945050505050565b604080519115158252519081900360200190f35b61063c6004803603606081101561066657600080fd5b810190602081018135600160201b81111561068057600080fd5b82018360208201111561069257600080fd5b803590602001918460018302840111600160201b831117156106b357600080fd5b919390929091602081019035600160201b8111156106d057600080fd5b8201836020820111156106e257600080fd5b803590602001918460018302840111600160201b8311171561070357600080fd5b919390929091602081019035600160201b81111561072057600080fd5b82018360208201111561073257600080fd5b803590602001918460018302840111600160201b8311171561075357600080fd5b509092509050611be8565b6103a16004803603606081101561077457600080fd5b810190602081018135600160201b81111561078e57600080fd5b8201836020820111156107a057600080fd5b803590602001918460018302840111600160201b831117156107c157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561081357600080fd5b82018360208201111561082557600080fd5b803590602001918460018302840111600160201b8311171561084657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561089857600080fd5b8201836020820111156108aa57600080fd5b803590602001918460018302840111600160201b831117156108cb57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550611f68945050505050565b61063c6004803603608081101561092257600080fd5b810190602081018135600160201b81111561093c57600080fd5b82018360208201111561094e57600080fd5b803590602001918460018302840111600160201b8311171561096f57600080fd5b919390929091602081019035600160201b81111561098c57600080fd5b82018360208201111561099e57600080fd5b803590602001918460018302840111600160201b831117156109bf57600080fd5b919390929091602081019035600160201b8111156109dc57600080fd5b8201836020820111156109ee57600080fd5b803590602001918460018302840111600160201b83111715610a0f57600080fd5b9193509150356121c2565b61063c60048036036060811015610a3057600080fd5b810190602081018135600160201b811115610a4a57600080fd5b820183602082011115610a5c57600080fd5b803590602001918460018302840111600160201b83111715610a7d57600080fd5b919390929091602081019035600160201b811115610a9a57600080fd5b820183602082011115610aac57600080fd5b803590602001918460018302840111600160201b83111715610acd57600080fd5b919390929091602081019035600160201b811115610aea57600080fd5b820183602082011115610afc57600080fd5b803590602001918460018302840111600160201b83111715610b1d57600080fd5b50909250905061232e565b606080600080600080610bb28a8a8080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8e018190048102820181019092528c815292508c91508b9081908401838280828437600092018290525060408051602081019091529081529250611974915050565b1515610c1c57898960008060008085858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052506040805160208101909152908152939f50929d50959b509399509197509550610ea1945050505050565b6000610c918b8b8080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8f018190048102820181019092528d815292508d91508c90819084018382808284376000920191909152506126a392505050565b9050600181815481101515610ca257fe5b9060005260206000209060040201600001600182815481101515610cc257fe5b9060005260206000209060040201600101600183815481101515610ce257fe5b906000526020600020906004020160020154600184815481101515610d0357fe5b60009182526020909120600360049092020101546001805460ff9092169186908110610d2b57fe5b906000526020600020906004020160030160019054906101000a900460ff16600186815481101515610d5957fe5b6000918252602091829020600491909102016003015486546040805160026101006001851615026000190190931692909204601f81018590048502830185019091528082526201000090920460ff169290918891830182828015610dfe5780601f10610dd357610100808354040283529160200191610dfe565b820191906000526020600020905b815481529060010190602001808311610de157829003601f168201915b5050885460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959b508a945092508401905082828015610e8c5780601f10610e6157610100808354040283529160200191610e8c565b820191906000526020600020905b815481529060010190602001808311610e6f57829003601f168201915b50505050509450965096509650965096509650505b9499939850945094509450565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610efb57600080fd5b505afa158015610f0f573d6000803e3d6000fd5b505050506040513d6020811015610f2557600080fd5b50516001600160a01b03163314610f7a5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60088310610fd25760408051600160e51b62461bcd02815260206004820152601460248201527f696e76616c6964206163636573732076616c7565000000000000000000000000604482015290519081900360640190fd5b600260008686604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b8381101561101c578181015183820152602001611004565b50505050905090810190601f1680156110495780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561107c578181015183820152602001611064565b50505050905090810190601f1680156110a95780820380516001836020036101000a031916815260200191505b5094505050505060405160208183030381529060405280519060200120815260200190815260200160002054600014151561112e5760408051600160e51b62461bcd02815260206004820152601760248201527f726f6c652065786973747320666f7220746865206f7267000000000000000000604482015290519081900360640190fd5b60038054600101908190556040805160208082018381528951606084015289516002946000948c948c94938493830192608001918701908083838b5b8381101561118257818101518382015260200161116a565b50505050905090810190601f1680156111af5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b838110156111e25781810151838201526020016111ca565b50505050905090810190601f16801561120f5780820380516001836020036101000a031916815260200191505b5060408051601f1981840301815291815281516020928301208852878201989098529587016000908120989098555050845160c0810186528b81528085018b905294850189905250505084151560608301528315156080830152600160a083018190528054808201808355919094528251805191946004027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf601926112b9928492909101906127b3565b5060208281015180516112d292600185019201906127b3565b5060408281015160028301556060808401516003909301805460808087015160a09788015160ff199093169615159690961761ff001916610100961515969096029590951762ff0000191662010000911515919091021790558151918201889052861515908201528415159181019190915281815287519181019190915286517fefa5bc1bedbee25b04b00855c15a0c180ecb4a2440d4d08296e49561655e2b1c92508791879187918791879190819060208083019160c08401918a019080838360005b838110156113ae578181015183820152602001611396565b50505050905090810190601f1680156113db5780820380516001836020036101000a031916815260200191505b50838103825287518152875160209182019189019080838360005b8381101561140e5781810151838201526020016113f6565b50505050905090810190601f16801561143b5780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390a15050505050565b6001545b90565b60608060008060008060018781548110151561147357fe5b906000526020600020906004020160000160018881548110151561149357fe5b90600052602060002090600402016001016001898154811015156114b357fe5b90600052602060002090600402016002015460018a8154811015156114d457fe5b60009182526020909120600360049092020101546001805460ff909216918c9081106114fc57fe5b906000526020600020906004020160030160019054906101000a900460ff1660018c81548110151561152a57fe5b6000918252602091829020600491909102016003015486546040805160026101006001851615026000190190931692909204601f81018590048502830185019091528082526201000090920460ff1692909188918301828280156115cf5780601f106115a4576101008083540402835291602001916115cf565b820191906000526020600020905b8154815290600101906020018083116115b257829003601f168201915b5050885460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959b508a94509250840190508282801561165d5780601f106116325761010080835404028352916020019161165d565b820191906000526020600020905b81548152906001019060200180831161164057829003601f168201915b5050505050945095509550955095509550955091939550919395565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156116c657600080fd5b505afa1580156116da573d6000803e3d6000fd5b505050506040513d60208110156116f057600080fd5b50516001600160a01b031633146117455760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60026000858585856040516020018080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050604051602081830303815290604052805190602001208152602001908152602001600020546000141515156118315760408051600160e51b62461bcd02815260206004820152601360248201527f726f6c6520646f6573206e6f7420657869737400000000000000000000000000604482015290519081900360640190fd5b60006118a685858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f890181900481028201810190925287815292508791508690819084018382808284376000920191909152506126a392505050565b905060006001828154811015156118b957fe5b906000526020600020906004020160030160026101000a81548160ff0219169083151502179055507f1196059dd83524bf989fd94bb65808c09dbea2ab791fb6bfa87a0e0aa64b2ea6858585856040518080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600083820152604051601f909101601f19169092018290039850909650505050505050a15050505050565b600080600260008686604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b838110156119c15781810151838201526020016119a9565b50505050905090810190601f1680156119ee5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b83811015611a21578181015183820152602001611a09565b50505050905090810190601f168015611a4e5780820380516001836020036101000a031916815260200191505b50945050505050604051602081830303815290604052805190602001208152602001908152602001600020546000141515611ac557611a8d85856126a3565b9050600181815481101515611a9e57fe5b906000526020600020906004020160030160029054906101000a900460ff16915050611be1565b600260008685604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b83811015611b0f578181015183820152602001611af7565b50505050905090810190601f168015611b3c5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b83811015611b6f578181015183820152602001611b57565b50505050905090810190601f168015611b9c5780820380516001836020036101000a031916815260200191505b50945050505050604051602081830303815290604052805190602001208152602001908152602001600020546000141515611bdb57611a8d85846126a3565b60009150505b9392505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611c3757600080fd5b505afa158015611c4b573d6000803e3d6000fd5b505050506040513d6020811015611c6157600080fd5b50516001600160a01b03163314611cb65760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b611d5d87878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8b01819004810282018101909252898152925089915088908190840183828082843760009201919091525050604080516020601f8a01819004810282018101909252888152925088915087908190840183828082843760009201919091525061197492505050565b1515611d6b57506000611f5e565b600060026000898989896040516020018080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050604051602081830303815290604052805190602001208152602001908152602001600020546000141515611e8257611e7b88888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8c018190048102820181019092528a815292508a91508990819084018382808284376000920191909152506126a392505050565b9050611ef8565b611ef588888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a0181900481028201810190925288815292508891508790819084018382808284376000920191909152506126a392505050565b90505b6001805482908110611f0657fe5b906000526020600020906004020160030160029054906101000a900460ff168015611f5a57506001805482908110611f3a57fe5b906000526020600020906004020160030160019054906101000a900460ff165b9150505b9695505050505050565b600080600260008686604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b83811015611fb5578181015183820152602001611f9d565b50505050905090810190601f168015611fe25780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b83811015612015578181015183820152602001611ffd565b50505050905090810190601f1680156120425780820380516001836020036101000a031916815260200191505b509450505050506040516020818303038152906040528051906020012081526020019081526020016000205460001415156120ac5761208185856126a3565b905060018181548110151561209257fe5b906000526020600020906004020160020154915050611be1565b600260008685604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b838110156120f65781810151838201526020016120de565b50505050905090810190601f1680156121235780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561215657818101518382015260200161213e565b50505050905090810190601f1680156121835780820380516001836020036101000a031916815260200191505b50945050505050604051602081830303815290604052805190602001208152602001908152602001600020546000141515611bdb5761208185846126a3565b60008061226c89898080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8d018190048102820181019092528b815292508b91508a908190840183828082843760009201919091525050604080516020601f8c018190048102820181019092528a815292508a9150899081908401838280828437600092019190915250611f6892505050565b90508060031415612281576001915050612323565b8260011480156122a65750806001148061229b5750806005145b806122a65750806006145b156122b5576001915050612323565b8260021480156122da575080600214806122cf5750806006145b806122da5750806007145b156122e9576001915050612323565b82600314801561230e575080600414806123035750806005145b8061230e5750806007145b1561231d576001915050612323565b60009150505b979650505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561237d57600080fd5b505afa158015612391573d6000803e3d6000fd5b505050506040513d60208110156123a757600080fd5b50516001600160a01b031633146123fc5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b6124a387878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8b01819004810282018101909252898152925089915088908190840183828082843760009201919091525050604080516020601f8a01819004810282018101909252888152925088915087908190840183828082843760009201919091525061197492505050565b15156124b157506000611f5e565b600060026000898989896040516020018080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f82011690508083019250505096505050505050506040516020818303038152906040528051906020012081526020019081526020016000205460001415156125c8576125c188888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8c018190048102820181019092528a815292508a91508990819084018382808284376000920191909152506126a392505050565b905061263e565b61263b88888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a0181900481028201810190925288815292508891508790819084018382808284376000920191909152506126a392505050565b90505b600180548290811061264c57fe5b906000526020600020906004020160030160029054906101000a900460ff168015611f5a5750600180548290811061268057fe5b600091825260209091206004909102016003015460ff1698975050505050505050565b60006001600260008585604051602001808060200180602001838103835285818151815260200191508051906020019080838360005b838110156126f15781810151838201526020016126d9565b50505050905090810190601f16801561271e5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b83811015612751578181015183820152602001612739565b50505050905090810190601f16801561277e5780820380516001836020036101000a031916815260200191505b509450505050506040516020818303038152906040528051906020012081526020019081526020016000205403905092915050565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f106127f457805160ff1916838001178555612821565b82800160010185558215612821579182015b82811115612821578251825591602001919060010190612806565b5061282d929150612831565b5090565b61145891905b8082111561282d576000815560010161283756fea165627a7a7230582075c8fd5504ba38b54f31bd0f3c28ac8ef88060c8e359bff58088d242f8cd256c0029"
+var RoleManagerBin = "0x60803461007457601f61142238819003918201601f19168301916001600160401b038311848410176100795780849260209460405283398101031261007457516001600160a01b0381169081900361007457600080546001600160a01b03191691909117905560405161137990816100a98239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604052600436101561001257600080fd5b60003560e01c80631870aba3146109f25780637b7135791461046f57806387f55d3114610451578063a451d4a8146103b9578063a634301214610266578063abf5739f1461024d578063be322e54146101cd578063cfc83dfa146101ac578063d1f77866146101365763deb16ba71461008a57600080fd5b3461013157600461009a36610c1c565b60005460405162e32cf960e41b81526001600160a01b0398909792969395939291602091899182908c165afa968715610125576020986100ee986100e9926000916100f8575b50163314610c9c565b610dd0565b6040519015158152f35b61011891508b3d811161011e575b6101108183610b3c565b810190610c7d565b386100e0565b503d610106565b6040513d6000823e3d90fd5b600080fd5b34610131576080366003190112610131576001600160401b0360043581811161013157610167903690600401610a22565b919060243582811161013157610181903690600401610a22565b93604435938411610131576020946101a06100ee953690600401610a22565b93909260643595611231565b346101315760206101c56101bf36610bc1565b91611196565b604051908152f35b346101315760046101dd36610c1c565b60005460405162e32cf960e41b81526001600160a01b0398909792969395939291602091899182908c165afa968715610125576020986100ee9861022b926000916102305750163314610c9c565b610ea4565b61024791508b3d811161011e576101108183610b3c565b8b6100e0565b346101315760206100ee61026036610bc1565b916110e6565b346101315761027436610a4f565b60005460405162e32cf960e41b8152919492916020916001600160a01b039083908390600490829085165afa8015610125576102ba9260009161039c5750163314610c9c565b604051818101906102e0816102d289878a8a88610da9565b03601f198101835282610b3c565b519020600052600281526040600020541561036257507f1196059dd83524bf989fd94bb65808c09dbea2ab791fb6bfa87a0e0aa64b2ea69361035d91600361034561034061032f368989610b5d565b61033a368787610b5d565b90611312565b610cfe565b5001805462ff00001916905560405194859485610da9565b0390a1005b6064906040519062461bcd60e51b8252600482015260136024820152721c9bdb1948191bd95cc81b9bdd08195e1a5cdd606a1b6044820152fd5b6103b39150843d861161011e576101108183610b3c565b886100e0565b34610131576020366003190112610131576004356103d681610cfe565b5061044d6103e383610cfe565b509160026103f085610cfe565b5001549360ff600361040183610cfe565b50015416610440600161043960ff600361042c828261041f8a610cfe565b50015460081c1697610cfe565b50015460101c1695610f52565b9601610f52565b9560405196879687610ad9565b0390f35b34610131576000366003190112610131576020600154604051908152f35b346101315760a0366003190112610131576004356001600160401b0381116101315761049f903690600401610ba3565b6024356001600160401b038111610131576104be903690600401610ba3565b9060643580151581036101315760843580151581036101315760005460405162e32cf960e41b8152906001600160a01b03906020908390600490829085165afa801561012557610518926000916109d35750163314610c9c565b6008604435101561099757604051602081019061053a816102d2888886610cd9565b519020600052600260205260406000205461095257600354600019811461093c57600101806003556040516020810190610579816102d2898986610cd9565b51902060005260026020526040600020556040519360c085018581106001600160401b0382111761084957604052838552806020860152604435604086015282151560608601528115156080860152600160a086015260015468010000000000000000811015610849578060016105f39201600155610cfe565b9590956109265780518051906001600160401b0382116108495781906106198954610d4e565b601f81116108d6575b50602090601f831160011461086a5760009261085f575b50508160011b916000199060031b1c19161786555b60208101519586516001600160401b038111610849576106716001830154610d4e565b601f8111610802575b506020601f82116001146107655761073097969594928261073e95936003937fefa5bc1bedbee25b04b00855c15a0c180ecb4a2440d4d08296e49561655e2b1c9c60009261075a575b505060001982851b1c1916600191821b17908201555b6040830151600282015501906060810151151590825460ff62ff000060a061ff006080860151151560081b16940151151560101b1693169062ffffff1916171717905560405195869560a0875260a0870190610a99565b908582036020870152610a99565b91604435604085015215156060840152151560808301520390a1005b015190508c806106c3565b6001830160005260206000209860005b601f19841681106107ea5750926001837fefa5bc1bedbee25b04b00855c15a0c180ecb4a2440d4d08296e49561655e2b1c9b6003946107309c9b9a999761073e9997601f198116106107d2575b505050811b0160018201556106d9565b015160001983871b60f8161c191690558c80806107c2565b828201518b556001909a019960209283019201610775565b600183016000526020600020601f830160051c810160208410610842575b601f830160051c8201811061083657505061067a565b60008155600101610820565b5080610820565b634e487b7160e01b600052604160045260246000fd5b015190508880610639565b9250886000526020600020906000935b601f19841685106108bb576001945083601f198116106108a2575b505050811b01865561064e565b015160001960f88460031b161c19169055888080610895565b8181015183556020948501946001909301929091019061087a565b909150886000526020600020601f840160051c81016020851061091f575b90849392915b601f830160051c82018110610910575050610622565b600081558594506001016108fa565b50806108f4565b634e487b7160e01b600052600060045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60405162461bcd60e51b815260206004820152601760248201527f726f6c652065786973747320666f7220746865206f72670000000000000000006044820152606490fd5b60405162461bcd60e51b8152602060048201526014602482015273696e76616c6964206163636573732076616c756560601b6044820152606490fd5b6109ec915060203d60201161011e576101108183610b3c565b876100e0565b346101315761044d610a0f610a0636610a4f565b92919091610ff6565b9260409694969291925196879687610ad9565b9181601f84011215610131578235916001600160401b038311610131576020838186019501011161013157565b6040600319820112610131576001600160401b03916004358381116101315782610a7b91600401610a22565b9390939260243591821161013157610a9591600401610a22565b9091565b919082519283825260005b848110610ac5575050826000602080949584010152601f8019910116010190565b602081830181015184830182015201610aa4565b9491610b0690610af860a096939998959960c0895260c0890190610a99565b908782036020890152610a99565b96604086015215156060850152151560808401521515910152565b602081019081106001600160401b0382111761084957604052565b90601f801991011681019081106001600160401b0382111761084957604052565b9291926001600160401b0382116108495760405191610b86601f8201601f191660200184610b3c565b829481845281830111610131578281602093846000960137010152565b9080601f8301121561013157816020610bbe93359101610b5d565b90565b6060600319820112610131576001600160401b03916004358381116101315782610bed91600401610ba3565b926024358181116101315783610c0591600401610ba3565b9260443591821161013157610bbe91600401610ba3565b906060600319830112610131576001600160401b036004358181116101315783610c4891600401610a22565b939093926024358381116101315782610c6391600401610a22565b9390939260443591821161013157610a9591600401610a22565b9081602091031261013157516001600160a01b03811681036101315790565b15610ca357565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b9091610cf0610bbe93604084526040840190610a99565b916020818403910152610a99565b600154811015610d3857600160005260021b7fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b90600182811c92168015610d7e575b6020831014610d6857565b634e487b7160e01b600052602260045260246000fd5b91607f1691610d5d565b908060209392818452848401376000828201840152601f01601f1916010190565b9290610dc290610bbe9593604086526040860191610d88565b926020818503910152610d88565b919293949094610dfa610de4368886610b5d565b610def368888610b5d565b610260368686610b5d565b15610e995760406000878251610e1b816102d28b8b60208401968c88610da9565b51902081526002602052205415610e7d57505090610e4161033a92610e49953691610b5d565b923691610b5d565b60ff6003610e5683610cfe565b50015460101c169081610e67575090565b60ff9150610e76600391610cfe565b5001541690565b91610e4191945061033a9350610e94953691610b5d565b610e49565b505050505050600090565b919293949094610eb8610de4368886610b5d565b15610e995760406000878251610ed9816102d28b8b60208401968c88610da9565b51902081526002602052205415610f3657505090610e4161033a92610eff953691610b5d565b60ff6003610f0c83610cfe565b50015460101c169081610f1d575090565b60ff9150610f2c600391610cfe565b50015460081c1690565b91610e4191945061033a9350610f4d953691610b5d565b610eff565b90604051918260008254610f6581610d4e565b908184526020946001918281169081600014610fd45750600114610f95575b505050610f9392500383610b3c565b565b600090815285812095935091905b818310610fbc575050610f939350820101388080610f84565b85548884018501529485019487945091830191610fa3565b92505050610f9394925060ff191682840152151560051b820101388080610f84565b9093929193611006368284610b5d565b61102c611014368689610b5d565b6040519061102182610b21565b6000938483526110e6565b156110c0575093610e4161033a9261104695963691610b5d565b9061105082610cfe565b509061105b83610cfe565b5092600261106882610cfe565b5001549260ff600361107984610cfe565b50015416926110b960016110b260ff60036110a582826110988b610cfe565b50015460081c1698610cfe565b50015460101c1694610f52565b9701610f52565b9493929190565b94506110ce92503691610b5d565b916040516110db81610b21565b818152918180918190565b909160405160208101816110fb868684610cd9565b039161110f601f1993848101835282610b3c565b5190206000908152600260205260409020541561114357505061113961034060ff93600393611312565b50015460101c1690565b90925060405161116960208201928261115d878787610cd9565b03908101835282610b3c565b5190206000526002602052604060002054611185575050600090565b61113961034060ff93600393611312565b9160405160208101816111aa858784610cd9565b03916111be601f1993848101835282610b3c565b519020600090815260026020526040902054156111ec5750506103406111e691600293611312565b50015490565b90915060405161120660208201928261115d868887610cd9565b5190206000526002602052604060002054611222575050600090565b6103406111e691600293611312565b6101bf9261124a610e4192611252989697943691610b5d565b953691610b5d565b90600382146112c15760018114806112ed575b6112c15760028114806112c8575b6112c1576003149081611290575b5061128b57600090565b600190565b60048114915081156112b6575b81156112ab575b5038611281565b6007915014386112a4565b60058114915061129d565b5050600190565b506002821480156112e3575b80611273575060078214611273565b50600682146112d4565b50600182148015611308575b80611265575060068214611265565b50600582146112f9565b9061132b6102d291604051928391602083019586610cd9565b5190206000526002602052600019604060002054019056fea264697066735822122087d9e7b48df143bb36dc6ec5167839c70e69e2d798df2a6d7e3304f8c5df1fca64736f6c63430008150033"
 
 // DeployRoleManager deploys a new Ethereum contract, binding an instance of RoleManager to it.
 func DeployRoleManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *RoleManager, error) {
@@ -194,7 +192,7 @@ func (_RoleManager *RoleManagerTransactorRaw) Transact(opts *bind.TransactOpts,
 
 // GetNumberOfRoles is a free data retrieval call binding the contract method 0x87f55d31.
 //
-// Solidity: function getNumberOfRoles() constant returns(uint256)
+// Solidity: function getNumberOfRoles() view returns(uint256)
 func (_RoleManager *RoleManagerCaller) GetNumberOfRoles(opts *bind.CallOpts) (*big.Int, error) {
 	var (
 		ret0 = new(*big.Int)
@@ -206,21 +204,21 @@ func (_RoleManager *RoleManagerCaller) GetNumberOfRoles(opts *bind.CallOpts) (*b
 
 // GetNumberOfRoles is a free data retrieval call binding the contract method 0x87f55d31.
 //
-// Solidity: function getNumberOfRoles() constant returns(uint256)
+// Solidity: function getNumberOfRoles() view returns(uint256)
 func (_RoleManager *RoleManagerSession) GetNumberOfRoles() (*big.Int, error) {
 	return _RoleManager.Contract.GetNumberOfRoles(&_RoleManager.CallOpts)
 }
 
 // GetNumberOfRoles is a free data retrieval call binding the contract method 0x87f55d31.
 //
-// Solidity: function getNumberOfRoles() constant returns(uint256)
+// Solidity: function getNumberOfRoles() view returns(uint256)
 func (_RoleManager *RoleManagerCallerSession) GetNumberOfRoles() (*big.Int, error) {
 	return _RoleManager.Contract.GetNumberOfRoles(&_RoleManager.CallOpts)
 }
 
 // GetRoleDetails is a free data retrieval call binding the contract method 0x1870aba3.
 //
-// Solidity: function getRoleDetails(string _roleId, string _orgId) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetails(string _roleId, string _orgId) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerCaller) GetRoleDetails(opts *bind.CallOpts, _roleId string, _orgId string) (struct {
 	RoleId     string
 	OrgId      string
@@ -244,7 +242,7 @@ func (_RoleManager *RoleManagerCaller) GetRoleDetails(opts *bind.CallOpts, _role
 
 // GetRoleDetails is a free data retrieval call binding the contract method 0x1870aba3.
 //
-// Solidity: function getRoleDetails(string _roleId, string _orgId) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetails(string _roleId, string _orgId) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerSession) GetRoleDetails(_roleId string, _orgId string) (struct {
 	RoleId     string
 	OrgId      string
@@ -258,7 +256,7 @@ func (_RoleManager *RoleManagerSession) GetRoleDetails(_roleId string, _orgId st
 
 // GetRoleDetails is a free data retrieval call binding the contract method 0x1870aba3.
 //
-// Solidity: function getRoleDetails(string _roleId, string _orgId) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetails(string _roleId, string _orgId) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerCallerSession) GetRoleDetails(_roleId string, _orgId string) (struct {
 	RoleId     string
 	OrgId      string
@@ -272,7 +270,7 @@ func (_RoleManager *RoleManagerCallerSession) GetRoleDetails(_roleId string, _or
 
 // GetRoleDetailsFromIndex is a free data retrieval call binding the contract method 0xa451d4a8.
 //
-// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerCaller) GetRoleDetailsFromIndex(opts *bind.CallOpts, _rIndex *big.Int) (struct {
 	RoleId     string
 	OrgId      string
@@ -296,7 +294,7 @@ func (_RoleManager *RoleManagerCaller) GetRoleDetailsFromIndex(opts *bind.CallOp
 
 // GetRoleDetailsFromIndex is a free data retrieval call binding the contract method 0xa451d4a8.
 //
-// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerSession) GetRoleDetailsFromIndex(_rIndex *big.Int) (struct {
 	RoleId     string
 	OrgId      string
@@ -310,7 +308,7 @@ func (_RoleManager *RoleManagerSession) GetRoleDetailsFromIndex(_rIndex *big.Int
 
 // GetRoleDetailsFromIndex is a free data retrieval call binding the contract method 0xa451d4a8.
 //
-// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) constant returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
+// Solidity: function getRoleDetailsFromIndex(uint256 _rIndex) view returns(string roleId, string orgId, uint256 accessType, bool voter, bool admin, bool active)
 func (_RoleManager *RoleManagerCallerSession) GetRoleDetailsFromIndex(_rIndex *big.Int) (struct {
 	RoleId     string
 	OrgId      string
@@ -324,7 +322,7 @@ func (_RoleManager *RoleManagerCallerSession) GetRoleDetailsFromIndex(_rIndex *b
 
 // IsAdminRole is a free data retrieval call binding the contract method 0xbe322e54.
 //
-// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCaller) IsAdminRole(opts *bind.CallOpts, _roleId string, _orgId string, _ultParent string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -336,21 +334,21 @@ func (_RoleManager *RoleManagerCaller) IsAdminRole(opts *bind.CallOpts, _roleId
 
 // IsAdminRole is a free data retrieval call binding the contract method 0xbe322e54.
 //
-// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerSession) IsAdminRole(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.IsAdminRole(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // IsAdminRole is a free data retrieval call binding the contract method 0xbe322e54.
 //
-// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isAdminRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCallerSession) IsAdminRole(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.IsAdminRole(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // IsVoterRole is a free data retrieval call binding the contract method 0xdeb16ba7.
 //
-// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCaller) IsVoterRole(opts *bind.CallOpts, _roleId string, _orgId string, _ultParent string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -362,21 +360,21 @@ func (_RoleManager *RoleManagerCaller) IsVoterRole(opts *bind.CallOpts, _roleId
 
 // IsVoterRole is a free data retrieval call binding the contract method 0xdeb16ba7.
 //
-// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerSession) IsVoterRole(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.IsVoterRole(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // IsVoterRole is a free data retrieval call binding the contract method 0xdeb16ba7.
 //
-// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function isVoterRole(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCallerSession) IsVoterRole(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.IsVoterRole(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // RoleAccess is a free data retrieval call binding the contract method 0xcfc83dfa.
 //
-// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) constant returns(uint256)
+// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) view returns(uint256)
 func (_RoleManager *RoleManagerCaller) RoleAccess(opts *bind.CallOpts, _roleId string, _orgId string, _ultParent string) (*big.Int, error) {
 	var (
 		ret0 = new(*big.Int)
@@ -388,21 +386,21 @@ func (_RoleManager *RoleManagerCaller) RoleAccess(opts *bind.CallOpts, _roleId s
 
 // RoleAccess is a free data retrieval call binding the contract method 0xcfc83dfa.
 //
-// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) constant returns(uint256)
+// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) view returns(uint256)
 func (_RoleManager *RoleManagerSession) RoleAccess(_roleId string, _orgId string, _ultParent string) (*big.Int, error) {
 	return _RoleManager.Contract.RoleAccess(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // RoleAccess is a free data retrieval call binding the contract method 0xcfc83dfa.
 //
-// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) constant returns(uint256)
+// Solidity: function roleAccess(string _roleId, string _orgId, string _ultParent) view returns(uint256)
 func (_RoleManager *RoleManagerCallerSession) RoleAccess(_roleId string, _orgId string, _ultParent string) (*big.Int, error) {
 	return _RoleManager.Contract.RoleAccess(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // RoleExists is a free data retrieval call binding the contract method 0xabf5739f.
 //
-// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCaller) RoleExists(opts *bind.CallOpts, _roleId string, _orgId string, _ultParent string) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -414,21 +412,21 @@ func (_RoleManager *RoleManagerCaller) RoleExists(opts *bind.CallOpts, _roleId s
 
 // RoleExists is a free data retrieval call binding the contract method 0xabf5739f.
 //
-// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerSession) RoleExists(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.RoleExists(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // RoleExists is a free data retrieval call binding the contract method 0xabf5739f.
 //
-// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) constant returns(bool)
+// Solidity: function roleExists(string _roleId, string _orgId, string _ultParent) view returns(bool)
 func (_RoleManager *RoleManagerCallerSession) RoleExists(_roleId string, _orgId string, _ultParent string) (bool, error) {
 	return _RoleManager.Contract.RoleExists(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent)
 }
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0xd1f77866.
 //
-// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) constant returns(bool)
+// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) view returns(bool)
 func (_RoleManager *RoleManagerCaller) TransactionAllowed(opts *bind.CallOpts, _roleId string, _orgId string, _ultParent string, _typeOfTxn *big.Int) (bool, error) {
 	var (
 		ret0 = new(bool)
@@ -440,14 +438,14 @@ func (_RoleManager *RoleManagerCaller) TransactionAllowed(opts *bind.CallOpts, _
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0xd1f77866.
 //
-// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) constant returns(bool)
+// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) view returns(bool)
 func (_RoleManager *RoleManagerSession) TransactionAllowed(_roleId string, _orgId string, _ultParent string, _typeOfTxn *big.Int) (bool, error) {
 	return _RoleManager.Contract.TransactionAllowed(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent, _typeOfTxn)
 }
 
 // TransactionAllowed is a free data retrieval call binding the contract method 0xd1f77866.
 //
-// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) constant returns(bool)
+// Solidity: function transactionAllowed(string _roleId, string _orgId, string _ultParent, uint256 _typeOfTxn) view returns(bool)
 func (_RoleManager *RoleManagerCallerSession) TransactionAllowed(_roleId string, _orgId string, _ultParent string, _typeOfTxn *big.Int) (bool, error) {
 	return _RoleManager.Contract.TransactionAllowed(&_RoleManager.CallOpts, _roleId, _orgId, _ultParent, _typeOfTxn)
 }
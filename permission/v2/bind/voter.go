@@ -4,7 +4,6 @@
 package permission
 
 import (
-	"github.com/ethereum/go-ethereum/common/math"
 	"math/big"
 	"strings"
 
@@ -21,7 +20,6 @@ var (
 	_ = big.NewInt
 	_ = strings.NewReader
 	_ = ethereum.NotFound
-	_ = math.U256Bytes
 	_ = bind.Bind
 	_ = common.Big1
 	_ = types.BloomLookup
@@ -29,12 +27,12 @@ var (
 )
 
 // VoterManagerABI is the input ABI used to generate the binding from.
-const VoterManagerABI = "[{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOpDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"addVoter\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"deleteVoter\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_authOrg\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"},{\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"processVote\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_authOrg\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"addVotingItem\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterDeleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VotingItemAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VoteProcessed\",\"type\":\"event\"}]"
+const VoterManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VoteProcessed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"VoteThresholdSet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterDeleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VotingItemAdded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"addThresholdVotingItem\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"addVoter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"addVotingItem\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"deleteVoter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getNumberOfPendingOps\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOpDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getPendingOpDetailsFromIndex\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_index\",\"type\":\"uint256\"}],\"name\":\"getThresholdProposal\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"}],\"name\":\"getVoteThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"isPendingOp\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_opType\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_threshold\",\"type\":\"uint256\"}],\"name\":\"processThresholdVote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"processVote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var VoterManagerParsedABI, _ = abi.JSON(strings.NewReader(VoterManagerABI))
 
 // VoterManagerBin is the compiled bytecode used for deploying new contracts.
-var VoterManagerBin = "0x6080604052600060035534801561001557600080fd5b50604051602080611fe48339810180604052602081101561003557600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055611f7d806100676000396000f3fe608060405234801561001057600080fd5b50600436106100575760003560e01c8063014e6acc1461005c5780635607395b146101c857806359cbd6fe14610241578063b0213864146102b8578063e98ac22d14610349575b600080fd5b6100ca6004803603602081101561007257600080fd5b810190602081018135600160201b81111561008c57600080fd5b82018360208201111561009e57600080fd5b803590602001918460018302840111600160201b831117156100bf57600080fd5b509092509050610466565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015610129578181015183820152602001610111565b50505050905090810190601f1680156101565780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015610189578181015183820152602001610171565b50505050905090810190601f1680156101b65780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b61023f600480360360408110156101de57600080fd5b810190602081018135600160201b8111156101f857600080fd5b82018360208201111561020a57600080fd5b803590602001918460018302840111600160201b8311171561022b57600080fd5b9193509150356001600160a01b0316610740565b005b61023f6004803603604081101561025757600080fd5b810190602081018135600160201b81111561027157600080fd5b82018360208201111561028357600080fd5b803590602001918460018302840111600160201b831117156102a457600080fd5b9193509150356001600160a01b0316610f06565b610335600480360360608110156102ce57600080fd5b810190602081018135600160201b8111156102e857600080fd5b8201836020820111156102fa57600080fd5b803590602001918460018302840111600160201b8311171561031b57600080fd5b91935091506001600160a01b0381351690602001356111e8565b604080519115158252519081900360200190f35b61023f600480360360a081101561035f57600080fd5b810190602081018135600160201b81111561037957600080fd5b82018360208201111561038b57600080fd5b803590602001918460018302840111600160201b831117156103ac57600080fd5b919390929091602081019035600160201b8111156103c957600080fd5b8201836020820111156103db57600080fd5b803590602001918460018302840111600160201b831117156103fc57600080fd5b919390929091602081019035600160201b81111561041957600080fd5b82018360208201111561042b57600080fd5b803590602001918460018302840111600160201b8311171561044c57600080fd5b91935091506001600160a01b0381351690602001356116f8565b6060806000806000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156104b957600080fd5b505afa1580156104cd573d6000803e3d6000fd5b505050506040513d60208110156104e357600080fd5b50516001600160a01b031633146105385760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b600061057987878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060018181548110151561058a57fe5b90600052602060002090600b02016004016000016001828154811015156105ad57fe5b90600052602060002090600b02016004016001016001838154811015156105d057fe5b600091825260209091206006600b909202010154600180546001600160a01b0390921691859081106105fe57fe5b60009182526020918290206007600b909202010154845460408051601f6002600019610100600187161502019094169390930492830185900485028101850190915281815291928691908301828280156106995780601f1061066e57610100808354040283529160200191610699565b820191906000526020600020905b81548152906001019060200180831161067c57829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156107275780601f106106fc57610100808354040283529160200191610727565b820191906000526020600020905b81548152906001019060200180831161070a57829003601f168201915b5050505050925094509450945094505092959194509250565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561078d57600080fd5b505afa1580156107a1573d6000803e3d6000fd5b505050506040513d60208110156107b757600080fd5b50516001600160a01b0316331461080c5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60026000848460405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012081526020019081526020016000205460001415610b78576003805460010190819055604080516020808201908152918101859052600291600091879187918190606001848480828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001208152602001908152602001600020819055506000600180548091906001016109069190611cdd565b9050838360018381548110151561091957fe5b6000918252602090912061093393600b9092020191611d0e565b506001808281548110151561094457fe5b90600052602060002090600b0201600101819055506001808281548110151561096957fe5b90600052602060002090600b020160020181905550600060018281548110151561098f57fe5b90600052602060002090600b020160030181905550604051806020016040528060008152506001828154811015156109c357fe5b90600052602060002090600b020160040160000190805190602001906109ea929190611d8c565b506040805160208101909152600081526001805483908110610a0857fe5b90600052602060002090600b02016004016001019080519060200190610a2f929190611d8c565b506000600182815481101515610a4157fe5b600091825260208220600b919091020160060180546001600160a01b0319166001600160a01b0393909316929092179091556001805483908110610a8157fe5b600091825260209091206007600b9092020101556001805482908110610aa357fe5b90600052602060002090600b020160010154600182815481101515610ac457fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020556001805482908110610afb57fe5b60009182526020808320604080518082019091526001600160a01b0387811682526001828501818152600b969096029093016008018054938401815586529290942093519301805492516001600160a01b03199093169390911692909217600160a01b60ff021916600160a01b9115159190910217905550610e90565b6000610bb984848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b9050600181815481101515610bca57fe5b600091825260208083206001600160a01b03861684526009600b9093020191909101905260409020541515610d2c576001805482908110610c0757fe5b600091825260209091206001600b909202018101805482019055805482908110610c2d57fe5b90600052602060002090600b020160010154600182815481101515610c4e57fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020556001805482908110610c8557fe5b60009182526020808320604080518082019091526001600160a01b0387811682526001828501818152600b96909602909301600801805480850182559087529390952090519201805493516001600160a01b03199094169290941691909117600160a01b60ff021916600160a01b9215159290920291909117909155805482908110610d0d57fe5b600091825260209091206002600b909202010180546001019055610e8e565b6000610d6f85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250879250611ba5915050565b9050600182815481101515610d8057fe5b90600052602060002090600b020160080181815481101515610d9e57fe5b600091825260209091200154600160a01b900460ff16151560011415610e0e5760408051600160e51b62461bcd02815260206004820152600f60248201527f616c7265616479206120766f7465720000000000000000000000000000000000604482015290519081900360640190fd5b60018083815481101515610e1e57fe5b90600052602060002090600b020160080182815481101515610e3c57fe5b60009182526020909120018054911515600160a01b02600160a01b60ff02199092169190911790556001805483908110610e7257fe5b600091825260209091206002600b909202010180546001019055505b505b604080516001600160a01b03831660208201528181529081018390527f424f3ad05c61ea35cad66f22b70b1fad7250d8229921238078c401db36d34574908490849084908060608101858580828437600083820152604051601f909101601f1916909201829003965090945050505050a1505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610f5357600080fd5b505afa158015610f67573d6000803e3d6000fd5b505050506040513d6020811015610f7d57600080fd5b50516001600160a01b03163314610fd25760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b82828080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250849250611016915083905082611bf7565b15156001146110645760408051600160e51b62461bcd02815260206004820152600f6024820152600160891b6e36bab9ba1031329030903b37ba32b902604482015290519081900360640190fd5b60006110a586868080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060006110ea87878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250899250611ba5915050565b90506001828154811015156110fb57fe5b6000918252602082206002600b90920201018054600019019055600180548490811061112357fe5b90600052602060002090600b02016008018281548110151561114157fe5b9060005260206000200160000160146101000a81548160ff0219169083151502179055507f654cd85d9b2abaf3affef0a047625d088e6e4d0448935c9b5016b5f5aa0ca3b68787876040518080602001836001600160a01b03166001600160a01b031681526020018281038252858582818152602001925080828437600083820152604051601f909101601f1916909201829003965090945050505050a150505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561123757600080fd5b505afa15801561124b573d6000803e3d6000fd5b505050506040513d602081101561126157600080fd5b50516001600160a01b031633146112b65760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b84848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152508692506112fa915083905082611bf7565b15156001146113485760408051600160e51b62461bcd02815260206004820152600f6024820152600160891b6e36bab9ba1031329030903b37ba32b902604482015290519081900360640190fd5b61138987878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250889250611ca7915050565b15156001146113e25760408051600160e51b62461bcd02815260206004820152601260248201527f6e6f7468696e6720746f20617070726f76650000000000000000000000000000604482015290519081900360640190fd5b600061142388888080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060018181548110151561143457fe5b60009182526020808320848452600a600b9093020191909101815260408083206001600160a01b038a16845290915290205460ff161515600114156114c35760408051600160e51b62461bcd02815260206004820152601260248201527f63616e6e6f7420646f75626c6520766f74650000000000000000000000000000604482015290519081900360640190fd5b60018054829081106114d157fe5b600091825260209091206003600b90920201018054600190810190915580548190839081106114fc57fe5b60009182526020808320858452600b92909202909101600a01815260408083206001600160a01b038b168452825291829020805460ff19169315159390931790925580518281529182018990527f87999b54e45aa02834a1265e356d7bcdceb72b8cbb4396ebaeba32a103b43508918a918a919081908101848480828437600083820152604051601f909101601f19169092018290039550909350505050a160026001828154811015156115ac57fe5b90600052602060002090600b0201600201548115156115c757fe5b046001828154811015156115d757fe5b90600052602060002090600b02016003015411156116e857604080516020810190915260008152600180548390811061160c57fe5b90600052602060002090600b02016004016000019080519060200190611633929190611d8c565b50604080516020810190915260008152600180548390811061165157fe5b90600052602060002090600b02016004016001019080519060200190611678929190611d8c565b50600060018281548110151561168a57fe5b600091825260208220600b919091020160060180546001600160a01b0319166001600160a01b03939093169290921790915560018054839081106116ca57fe5b600091825260209091206007600b90920201015550600192506116ee565b60009350505b5050949350505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561174557600080fd5b505afa158015611759573d6000803e3d6000fd5b505050506040513d602081101561176f57600080fd5b50516001600160a01b031633146117c45760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b61180388888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052509250611ca7915050565b151561184357604051600160e51b62461bcd028152600401808060200182810382526034815260200180611f1e6034913960400191505060405180910390fd5b600061188489898080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b9050868660018381548110151561189757fe5b90600052602060002090600b020160040160000191906118b8929190611d0e565b5084846001838154811015156118ca57fe5b90600052602060002090600b020160040160010191906118eb929190611d0e565b50826001828154811015156118fc57fe5b90600052602060002090600b020160040160020160006101000a8154816001600160a01b0302191690836001600160a01b031602179055508160018281548110151561194457fe5b6000918252602082206007600b9092020101919091555b600180548390811061196957fe5b90600052602060002090600b020160080180549050811015611a6b57600180548390811061199357fe5b90600052602060002090600b0201600801818154811015156119b157fe5b600091825260209091200154600160a01b900460ff1615611a635760006001838154811015156119dd57fe5b90600052602060002090600b0201600a0160008481526020019081526020016000206000600185815481101515611a1057fe5b90600052602060002090600b020160080184815481101515611a2e57fe5b6000918252602080832091909101546001600160a01b031683528201929092526040019020805460ff19169115159190911790555b60010161195b565b506000600182815481101515611a7d57fe5b90600052602060002090600b0201600301819055507f5bfaebb5931145594f63236d2a59314c4dc6035b65d0ca4cee9c7298e2f06ca3898960405180806020018281038252848482818152602001925080828437600083820152604051601f909101601f19169092018290039550909350505050a1505050505050505050565b6000600160026000846040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611b46578181015183820152602001611b2e565b50505050905090810190601f168015611b735780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120815260200190815260200160002054039050919050565b600080611bb184611afd565b905060018082815481101515611bc357fe5b600091825260208083206001600160a01b03881684526009600b909302019190910190526040902054039150505b92915050565b600080611c0384611afd565b9050600181815481101515611c1457fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020541515611c4d576000915050611bf1565b6000611c598585611ba5565b9050600182815481101515611c6a57fe5b90600052602060002090600b020160080181815481101515611c8857fe5b600091825260209091200154600160a01b900460ff1695945050505050565b6000816001611cb585611afd565b81548110611cbf57fe5b90600052602060002090600b02016004016003015414905092915050565b815481835581811115611d0957600b0281600b028360005260206000209182019101611d099190611dfa565b505050565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10611d4f5782800160ff19823516178555611d7c565b82800160010185558215611d7c579182015b82811115611d7c578235825591602001919060010190611d61565b50611d88929150611e7f565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10611dcd57805160ff1916838001178555611d7c565b82800160010185558215611d7c579182015b82811115611d7c578251825591602001919060010190611ddf565b611e7c91905b80821115611d88576000611e148282611e99565b60006001830181905560028301819055600383018190556004830190611e3a8282611e99565b611e48600183016000611e99565b506002810180546001600160a01b031916905560006003909101819055611e73906008840190611ee0565b50600b01611e00565b90565b611e7c91905b80821115611d885760008155600101611e85565b50805460018160011615610100020316600290046000825580601f10611ebf5750611edd565b601f016020900490600052602060002090810190611edd9190611e7f565b50565b5080546000825590600052602060002090810190611edd9190611e7c91905b80821115611d885780546001600160a81b0319168155600101611eff56fe6974656d732070656e64696e6720666f7220617070726f76616c2e206e6577206974656d2063616e6e6f74206265206164646564a165627a7a723058207dc9a68c6931494f043f7420dfa8288733d9a3a676ed30b4ac8e9cc704bd928b0029"
+var VoterManagerBin = "0x60803461007557601f611f2938819003918201601f19168301916001600160401b0383118484101761007a5780849260209460405283398101031261007557516001600160a01b0381169081900361007557600060035560018060a01b03196000541617600055604051611e7f90816100aa8239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe608080604052600436101561001357600080fd5b60003560e01c908163014e6acc14610f72575080630214ddc314610e015780631a2e91d714610d5d578063378902b614610bad5780635607395b146107b557806359cbd6fe14610688578063af144f3a14610466578063ba1257581461043a578063c4b4d8a01461037d578063c62dd6121461025c578063ceec8b70146102305763e98ac22d146100a357600080fd5b3461022b5760046100b336611125565b60005460405162e32cf960e41b8152949994969491929160209188919082906001600160a01b03165afa95861561021f577f5bfaebb5931145594f63236d2a59314c4dc6035b65d0ca4cee9c7298e2f06ca39961016061015a858561013e8e8e6101376101d39f6101af9a6000916101f0575b506001600160a01b03163314611265565b36916112c3565b610149368d8d6112c3565b610154368c896112c3565b91611c90565b156114e4565b61017361016e368b8d6112c3565b611df4565b946101a66101988686610187368d8d6112c3565b6101923687896112c3565b90611d68565b97604051996101378b6111ef565b885236916112c3565b60208601526001600160a01b03166040850152606084015260006080840152611822565b506101eb6040519283926020845260208401916112a2565b0390a1005b610212915060203d602011610218575b61020a8183611225565b810190611246565b38610126565b503d610200565b6040513d6000823e3d90fd5b600080fd5b3461022b57602036600319011261022b5760043560005260046020526020604060002054604051908152f35b3461022b5761026a366111a2565b60005460405162e32cf960e41b81526001600160a01b0393916020908290600490829088165afa90811561021f57610338956102ed93610137876102bd9561016e9560009161035f575b50163314611265565b9160036102e06102cc85611309565b509260056102d987611309565b500161135a565b905490821b1c910161160d565b50916002830154169060038301549061030b82600486015492611cea565b91610346610324600161031d88611629565b9701611629565b60405197889760c0895260c089019061104e565b90878203602089015261104e565b9360408601526060850152608084015260a08301520390f35b610377915060203d81116102185761020a8183611225565b8b6102b4565b3461022b57600461038d366111a2565b60005460405162e32cf960e41b8152929491936001600160a01b039290916020918591829086165afa90811561021f576007956101376103df9461016e946103f59760009161041c5750163314611265565b9160056102d96103ee85611309565b5094611309565b90549060031b1c600052016020526040806000206001815491015482519182526020820152f35b610434915060203d81116102185761020a8183611225565b8a6102b4565b3461022b57602061045c61044d36611125565b9695909594919493929361158d565b6040519015158152f35b3461022b57606036600319011261022b576004356001600160401b03811161022b57610496903690600401611021565b60005460405162e32cf960e41b81526020939160243591604435916001600160a01b039087908390600490829085165afa801561021f576104e19260009161066b5750163314611265565b600182101580610660575b1561062257606481116105e957918160018461053b61015a7f5bfaebb5931145594f63236d2a59314c4dc6035b65d0ca4cee9c7298e2f06ca399976101eb97610536368c8a6112c3565b611cbf565b600761054b61016e368a886112c3565b6105c36105a861055b8588611d88565b60405190610568826111ef565b6040516105748161120a565b6000815282526040516105868161120a565b600081528c830152600060408301528560608301526000608083015284611822565b91604051966105b6886111d4565b8752898701948552611309565b5090600052018652604060002092518355519101556040519383859485528401916112a2565b60405162461bcd60e51b81526004810186905260116024820152701a5b9d985b1a59081d1a1c995cda1bdb19607a1b6044820152606490fd5b60405162461bcd60e51b8152600481018690526016602482015275696e76616c6964206f7065726174696f6e207479706560501b6044820152606490fd5b5060078211156104ec565b6106829150883d8a116102185761020a8183611225565b886102b4565b3461022b57610696366110e3565b91600460018060a01b03602081600054166040519384809262e32cf960e41b82525afa801561021f576106d3926000916107975750163314611265565b6106f460016106ec856106e73687876112c3565b6117c3565b1515146114a6565b61070261016e3684846112c3565b92610717816107123686866112c3565b611dc3565b90600261072386611309565b50019485549283156107815760086102d9610769937f654cd85d9b2abaf3affef0a047625d088e6e4d0448935c9b5016b5f5aa0ca3b6996101eb97600019019055611309565b50805460ff60a01b191690556040519384938461147d565b634e487b7160e01b600052601160045260246000fd5b6107af915060203d81116102185761020a8183611225565b866102b4565b3461022b576107c3366110e3565b60005460405162e32cf960e41b815260209492916001600160a01b03919086908290600490829086165afa90811561021f5761080b91839160009161066b5750163314611265565b604051858101868152816108236040820188886112a2565b0391610837601f1993848101835282611225565b5190206000526002865260406000205415600014610a4a5761085a600354611372565b80600355604051878101908882526108868161087a604082018b8b6112a2565b03858101835282611225565b51902060005260028752604060002055600190815490600160401b821015610a345782820183556108b682611309565b50506108c182611309565b50906001600160401b038811610a34576108e5886108df84546113ef565b84611429565b600090601f89116001146109b657509761099f936109856101eb9796946008946109368c80600080516020611e2a8339815191529f6000916109ab575b508160011b916000199060031b1c19161790565b90555b828061094483611309565b50015582600261095383611309565b5001558261096082611309565b500154600961096e83611309565b50978a169788600052018552604060002055611309565b509160405194610994866111d4565b855284015201611381565b6040519384938461147d565b90508d013538610922565b88169082600052896000209160005b818110610a1f57506101eb979694600894600080516020611e2a8339815191529c9461099f98948d6109859510610a05575b5050838c811b019055610939565b8c013560001960038f901b60f8161c191690558d806109f7565b89830135845592860192918b01918b016109c5565b634e487b7160e01b600052604160045260246000fd5b50610a5c61016e9592953686866112c3565b916009610a6884611309565b50928716928360005201815260406000205415600014610b0557610af283610aed600080516020611e2a833981519152989460086002956001610aad6101eb9a611309565b5001610ab98154611372565b90556001610ac686611309565b5001546009610ad487611309565b5085600052018252604060002055600161098586611309565b611309565b5001610afe8154611372565b905561099f565b9050610b16856107123687876112c3565b90600160ff610b2a8460086102d988611309565b505460a01c16151514610b775750936002610af283610b60600080516020611e2a8339815191529860086102d96101eb98611309565b50805460ff60a01b1916600160a01b179055611309565b6064906040519062461bcd60e51b82526004820152600f60248201526e30b63932b0b23c9030903b37ba32b960891b6044820152fd5b3461022b5760c036600319011261022b576001600160401b0360043581811161022b57610bde903690600401611021565b90610be76110cd565b9060443584811161022b57610c00903690600401611021565b9460643590811161022b57610c19903690600401611021565b9590936084356001600160a01b038116900361022b5760005460405162e32cf960e41b81529590602090879060049082906001600160a01b03165afa90811561021f57602098610d03610d1b96610ced6001610ce5610cc78c8f7f87999b54e45aa02834a1265e356d7bcdceb72b8cbb4396ebaeba32a103b435089f856106ec8e610cbc610d159f61013795600091610d3e575b50848060a01b03163314611265565b6106e73686886112c3565b610cd2368c876112c3565b60a4359160843591610154368b8d6112c3565b15151461154c565b610cfb61016e368d8c6112c3565b9636916112c3565b61019260a435936084359336916112c3565b91611a91565b92610d31604051928392878452878401916112a2565b0390a16040519015158152f35b6020610d5792503d6020116102185761020a8183611225565b38610cad565b3461022b57602036600319011261022b576004356001600160401b03811161022b57610d8d903690600401611021565b60005460405162e32cf960e41b8152906001600160a01b03906020908390600490829085165afa801561021f57602094600594610137610aed9461016e94610ddf97600091610dea5750163314611265565b500154604051908152f35b61043491508a3d81116102185761020a8183611225565b3461022b57608036600319011261022b576004356001600160401b03811161022b57610e31903690600401611021565b90610e3a6110cd565b91604435906064359060018060a01b03806000541660405196879162e32cf960e41b835282600460209a8b935afa91821561021f577f87999b54e45aa02834a1265e356d7bcdceb72b8cbb4396ebaeba32a103b4350893610eeb93610ea992600091610f5b5750163314611265565b610ebd60016106ec836106e736898d6112c3565b610ed26001610ce58789610536368a8e6112c3565b610ee061016e36868a6112c3565b90610d158688611d88565b948592610f036040519283928a84528a8401916112a2565b0390a1610f17575b50506040519015158152f35b816040917f1e792c873d26fa5a8db9cfa76847773d14791617a303de65b8a52c36d42299959360005260048652808360002055825191825285820152a18280610f0b565b61037791508b3d8d116102185761020a8183611225565b3461022b57602036600319011261022b576004356001600160401b03811161022b57610fa2903690600401611021565b60005462e32cf960e41b84526001600160a01b039392906020908490600490829088165afa92831561021f57610fff94610fef94610fea926000916110035750163314611265565b6116cd565b906040949294519485948561108e565b0390f35b61101b915060203d81116102185761020a8183611225565b876102b4565b9181601f8401121561022b578235916001600160401b03831161022b576020838186019501011161022b57565b919082519283825260005b84811061107a575050826000602080949584010152601f8019910116010190565b602081830181015184830182015201611059565b906060926110ab6110b9929796959760808552608085019061104e565b90838203602085015261104e565b6001600160a01b0390951660408201520152565b602435906001600160a01b038216820361022b57565b604060031982011261022b57600435906001600160401b03821161022b5761110d91600401611021565b90916024356001600160a01b038116810361022b5790565b9060a060031983011261022b576001600160401b0360043581811161022b578361115191600401611021565b9390939260243583811161022b578261116c91600401611021565b9390939260443591821161022b5761118691600401611021565b90916064356001600160a01b038116810361022b579060843590565b604060031982011261022b57600435906001600160401b03821161022b576111cc91600401611021565b909160243590565b604081019081106001600160401b03821117610a3457604052565b60a081019081106001600160401b03821117610a3457604052565b602081019081106001600160401b03821117610a3457604052565b90601f801991011681019081106001600160401b03821117610a3457604052565b9081602091031261022b57516001600160a01b038116810361022b5790565b1561126c57565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b908060209392818452848401376000828201840152601f01601f1916010190565b9291926001600160401b038211610a3457604051916112ec601f8201601f191660200184611225565b82948184528183011161022b578281602093846000960137010152565b60015481101561134457600b906001600052027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b80548210156113445760005260206000200190600090565b60001981146107815760010190565b8054600160401b811015610a345761139e9160018201815561135a565b9190916113d957805182546020909201516001600160a81b03199092166001600160a01b03919091161790151560a01b60ff60a01b16179055565b634e487b7160e01b600052600060045260246000fd5b90600182811c9216801561141f575b602083101461140957565b634e487b7160e01b600052602260045260246000fd5b91607f16916113fe565b90601f811161143757505050565b600091825260208220906020601f850160051c83019410611473575b601f0160051c01915b82811061146857505050565b81815560010161145c565b9092508290611453565b91602091611496919594956040855260408501916112a2565b6001600160a01b03909416910152565b156114ad57565b60405162461bcd60e51b815260206004820152600f60248201526e36bab9ba1031329030903b37ba32b960891b6044820152606490fd5b156114eb57565b60405162461bcd60e51b815260206004820152603360248201527f6974656d2070656e64696e6720666f7220617070726f76616c2e206e657720696044820152721d195b4818d85b9b9bdd081899481859191959606a1b6064820152608490fd5b1561155357565b60405162461bcd60e51b81526020600482015260126024820152716e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b96959492939091966040516020810190602082526115c1816115b36040820188876112a2565b03601f198101835282611225565b519020600052600260205260406000205415611600576115fd976115ed6115f5926101549536916112c3565b9536916112c3565b9236916112c3565b90565b5050505050505050600090565b8054821015611344576000526005602060002091020190600090565b9060405191826000825461163c816113ef565b9081845260209460019182811690816000146116ab575060011461166c575b50505061166a92500383611225565b565b600090815285812095935091905b81831061169357505061166a935082010138808061165b565b8554888401850152948501948794509183019161167a565b9250505061166a94925060ff191682840152151560051b82010138808061165b565b61016e906116dc9236916112c3565b9060056116e883611309565b50018054801561179557816000526020600020549160015b8281106117505750505060036117196117209394611309565b500161160d565b509060018060a01b036002830154169060038301549061174b600161174486611629565b9501611629565b929190565b8361175b828461135a565b919054600392831b1c11611779575b5061177490611372565b611700565b819450611789611774928461135a565b9054911b1c939061176a565b505090506040516117a58161120a565b60008152906040516117b68161120a565b6000815290600090600090565b906117cd82611df4565b60096117d882611309565b506001600160a01b0384166000908152910160205260409020541561181a5760086102d961180b6118119460ff96611dc3565b92611309565b505460a01c1690565b505050600090565b61182b90611309565b50906003820192835493600160401b9283861015610a3457600192611856878581019485815561160d565b9190916113d957805180516001600160401b0391828211610a34576118858261187f87546113ef565b87611429565b602090601f8311600114611a2f576118b69291600091836119b6575b50508160011b916000199060031b1c19161790565b83555b8583019060208301518051918211610a34576118df826118d985546113ef565b85611429565b602090601f83116001146119c157826004959360809593611916936000926119b65750508160011b916000199060031b1c19161790565b90555b6002840160018060a01b036040830151166bffffffffffffffffffffffff60a01b82541617905560608101516003850155015191015581861161078157600052600484016020526040600020556005830190815492831015610a34578461198a846119a3936006960185558461135a565b90919082549060031b91821b91600019901b1916179055565b5491836000520160205260406000205590565b0151905038806118a1565b889291601f1983169185600052816000209260005b818110611a17575091600497959391856080989694106119fe575b505050811b019055611919565b015160001960f88460031b161c191690553880806119f1565b8284015185558c9690940193602093840193016119d6565b889291601f1983169187600052816000209260005b818110611a7957508411611a60575b505050811b0183556118b9565b015160001960f88460031b161c19169055388080611a53565b8284015185558c969094019360209384019301611a44565b919091611a9d81611309565b509260049182850191600095858752602093808552604096878920549460001994858701968711611c7d57868b52600a85018089528a8c206001600160a01b03909216808d529189528a8c20549092919060ff161515600114611c4557908b929160038701928b611b0e8b8661160d565b5001611b1a8154611372565b90558985528a528b842090845289528a8320805460ff19166001179055611b5e8a611b458a8561160d565b500154916003611b558b8661160d565b50015490611cea565b11611c3857611b6f8760039261160d565b50015588528452868681205560068101948388528585528688205483810192818411611c2557600501928354858101908111611c125761198a91611bb6611bc6928761135a565b90549060031b1c9283918761135a565b8952868652878920558154908115611bff5750820191611be6838361135a565b909182549160031b1b1916905555845252812055600190565b634e487b7160e01b895260319052602488fd5b634e487b7160e01b8b526011845260248bfd5b634e487b7160e01b8a526011835260248afd5b5050505050505050505090565b8a5162461bcd60e51b8152808b018a9052601260248201527163616e6e6f7420646f75626c6520766f746560701b6044820152606490fd5b634e487b7160e01b8b526011895260248bfd5b92600494611ca591611cab94610aed94611d68565b92611df4565b509060005201602052604060002054151590565b600492611cd1610aed611cd893611df4565b5092611d88565b60005201602052604060002054151590565b611cf5600291611309565b50015490600090815260046020526040812054918215611d5257828102928184041490151715611d3e5760638201809211611d3e5750606490048015611d385790565b50600190565b634e487b7160e01b81526011600452602490fd5b90915060011c9060018201809211611d3e575090565b92906115b3611d829260405194859360208501978861108e565b51902090565b90604051906020820192600784526040830152606082015260608152608081018181106001600160401b03821117610a345760405251902090565b90611dd2610aed600993611df4565b506001600160a01b039091166000908152910160205260409020546000190190565b604051611e11816115b3602082019460208652604083019061104e565b5190206000526002602052600019604060002054019056fe424f3ad05c61ea35cad66f22b70b1fad7250d8229921238078c401db36d34574a2646970667358221220de374fe7ce93c26728c99ef9df084b328c05bed3401bcf299a6f119b50ad495364736f6c63430008150033"
 
 // DeployVoterManager deploys a new Ethereum contract, binding an instance of VoterManager to it.
 func DeployVoterManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *VoterManager, error) {
@@ -192,9 +190,35 @@ func (_VoterManager *VoterManagerTransactorRaw) Transact(opts *bind.TransactOpts
 	return _VoterManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_VoterManager *VoterManagerCaller) GetNumberOfPendingOps(opts *bind.CallOpts, _orgId string) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _VoterManager.contract.Call(opts, out, "getNumberOfPendingOps", _orgId)
+	return *ret0, err
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_VoterManager *VoterManagerSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _VoterManager.Contract.GetNumberOfPendingOps(&_VoterManager.CallOpts, _orgId)
+}
+
+// GetNumberOfPendingOps is a free data retrieval call binding the contract method 0x1a2e91d7.
+//
+// Solidity: function getNumberOfPendingOps(string _orgId) view returns(uint256)
+func (_VoterManager *VoterManagerCallerSession) GetNumberOfPendingOps(_orgId string) (*big.Int, error) {
+	return _VoterManager.Contract.GetNumberOfPendingOps(&_VoterManager.CallOpts, _orgId)
+}
+
 // GetPendingOpDetails is a free data retrieval call binding the contract method 0x014e6acc.
 //
-// Solidity: function getPendingOpDetails(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOpDetails(string _orgId) view returns(string, string, address, uint256)
 func (_VoterManager *VoterManagerCaller) GetPendingOpDetails(opts *bind.CallOpts, _orgId string) (string, string, common.Address, *big.Int, error) {
 	var (
 		ret0 = new(string)
@@ -214,18 +238,159 @@ func (_VoterManager *VoterManagerCaller) GetPendingOpDetails(opts *bind.CallOpts
 
 // GetPendingOpDetails is a free data retrieval call binding the contract method 0x014e6acc.
 //
-// Solidity: function getPendingOpDetails(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOpDetails(string _orgId) view returns(string, string, address, uint256)
 func (_VoterManager *VoterManagerSession) GetPendingOpDetails(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _VoterManager.Contract.GetPendingOpDetails(&_VoterManager.CallOpts, _orgId)
 }
 
 // GetPendingOpDetails is a free data retrieval call binding the contract method 0x014e6acc.
 //
-// Solidity: function getPendingOpDetails(string _orgId) constant returns(string, string, address, uint256)
+// Solidity: function getPendingOpDetails(string _orgId) view returns(string, string, address, uint256)
 func (_VoterManager *VoterManagerCallerSession) GetPendingOpDetails(_orgId string) (string, string, common.Address, *big.Int, error) {
 	return _VoterManager.Contract.GetPendingOpDetails(&_VoterManager.CallOpts, _orgId)
 }
 
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_VoterManager *VoterManagerCaller) GetPendingOpDetailsFromIndex(opts *bind.CallOpts, _orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(string)
+		ret1 = new(string)
+		ret2 = new(common.Address)
+		ret3 = new(*big.Int)
+		ret4 = new(*big.Int)
+		ret5 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+		ret4,
+		ret5,
+	}
+	err := _VoterManager.contract.Call(opts, out, "getPendingOpDetailsFromIndex", _orgId, _index)
+	return *ret0, *ret1, *ret2, *ret3, *ret4, *ret5, err
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_VoterManager *VoterManagerSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _VoterManager.Contract.GetPendingOpDetailsFromIndex(&_VoterManager.CallOpts, _orgId, _index)
+}
+
+// GetPendingOpDetailsFromIndex is a free data retrieval call binding the contract method 0xc62dd612.
+//
+// Solidity: function getPendingOpDetailsFromIndex(string _orgId, uint256 _index) view returns(string, string, address, uint256, uint256, uint256)
+func (_VoterManager *VoterManagerCallerSession) GetPendingOpDetailsFromIndex(_orgId string, _index *big.Int) (string, string, common.Address, *big.Int, *big.Int, *big.Int, error) {
+	return _VoterManager.Contract.GetPendingOpDetailsFromIndex(&_VoterManager.CallOpts, _orgId, _index)
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_VoterManager *VoterManagerCaller) GetThresholdProposal(opts *bind.CallOpts, _orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+	}
+	err := _VoterManager.contract.Call(opts, out, "getThresholdProposal", _orgId, _index)
+	return *ret0, *ret1, err
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_VoterManager *VoterManagerSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _VoterManager.Contract.GetThresholdProposal(&_VoterManager.CallOpts, _orgId, _index)
+}
+
+// GetThresholdProposal is a free data retrieval call binding the contract method 0xc4b4d8a0.
+//
+// Solidity: function getThresholdProposal(string _orgId, uint256 _index) view returns(uint256, uint256)
+func (_VoterManager *VoterManagerCallerSession) GetThresholdProposal(_orgId string, _index *big.Int) (*big.Int, *big.Int, error) {
+	return _VoterManager.Contract.GetThresholdProposal(&_VoterManager.CallOpts, _orgId, _index)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_VoterManager *VoterManagerCaller) GetVoteThreshold(opts *bind.CallOpts, _opType *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _VoterManager.contract.Call(opts, out, "getVoteThreshold", _opType)
+	return *ret0, err
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_VoterManager *VoterManagerSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _VoterManager.Contract.GetVoteThreshold(&_VoterManager.CallOpts, _opType)
+}
+
+// GetVoteThreshold is a free data retrieval call binding the contract method 0xceec8b70.
+//
+// Solidity: function getVoteThreshold(uint256 _opType) view returns(uint256)
+func (_VoterManager *VoterManagerCallerSession) GetVoteThreshold(_opType *big.Int) (*big.Int, error) {
+	return _VoterManager.Contract.GetVoteThreshold(&_VoterManager.CallOpts, _opType)
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_VoterManager *VoterManagerCaller) IsPendingOp(opts *bind.CallOpts, _authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	var (
+		ret0 = new(bool)
+	)
+	out := ret0
+	err := _VoterManager.contract.Call(opts, out, "isPendingOp", _authOrg, _orgId, _enodeId, _account, _pendingOp)
+	return *ret0, err
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_VoterManager *VoterManagerSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _VoterManager.Contract.IsPendingOp(&_VoterManager.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
+// IsPendingOp is a free data retrieval call binding the contract method 0xba125758.
+//
+// Solidity: function isPendingOp(string _authOrg, string _orgId, string _enodeId, address _account, uint256 _pendingOp) view returns(bool)
+func (_VoterManager *VoterManagerCallerSession) IsPendingOp(_authOrg string, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (bool, error) {
+	return _VoterManager.Contract.IsPendingOp(&_VoterManager.CallOpts, _authOrg, _orgId, _enodeId, _account, _pendingOp)
+}
+
+// AddThresholdVotingItem is a paid mutator transaction binding the contract method 0xaf144f3a.
+//
+// Solidity: function addThresholdVotingItem(string _authOrg, uint256 _opType, uint256 _threshold) returns()
+func (_VoterManager *VoterManagerTransactor) AddThresholdVotingItem(opts *bind.TransactOpts, _authOrg string, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.contract.Transact(opts, "addThresholdVotingItem", _authOrg, _opType, _threshold)
+}
+
+// AddThresholdVotingItem is a paid mutator transaction binding the contract method 0xaf144f3a.
+//
+// Solidity: function addThresholdVotingItem(string _authOrg, uint256 _opType, uint256 _threshold) returns()
+func (_VoterManager *VoterManagerSession) AddThresholdVotingItem(_authOrg string, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.AddThresholdVotingItem(&_VoterManager.TransactOpts, _authOrg, _opType, _threshold)
+}
+
+// AddThresholdVotingItem is a paid mutator transaction binding the contract method 0xaf144f3a.
+//
+// Solidity: function addThresholdVotingItem(string _authOrg, uint256 _opType, uint256 _threshold) returns()
+func (_VoterManager *VoterManagerTransactorSession) AddThresholdVotingItem(_authOrg string, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.AddThresholdVotingItem(&_VoterManager.TransactOpts, _authOrg, _opType, _threshold)
+}
+
 // AddVoter is a paid mutator transaction binding the contract method 0x5607395b.
 //
 // Solidity: function addVoter(string _orgId, address _vAccount) returns()
@@ -289,25 +454,46 @@ func (_VoterManager *VoterManagerTransactorSession) DeleteVoter(_orgId string, _
 	return _VoterManager.Contract.DeleteVoter(&_VoterManager.TransactOpts, _orgId, _vAccount)
 }
 
-// ProcessVote is a paid mutator transaction binding the contract method 0xb0213864.
+// ProcessThresholdVote is a paid mutator transaction binding the contract method 0x0214ddc3.
+//
+// Solidity: function processThresholdVote(string _authOrg, address _vAccount, uint256 _opType, uint256 _threshold) returns(bool)
+func (_VoterManager *VoterManagerTransactor) ProcessThresholdVote(opts *bind.TransactOpts, _authOrg string, _vAccount common.Address, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.contract.Transact(opts, "processThresholdVote", _authOrg, _vAccount, _opType, _threshold)
+}
+
+// ProcessThresholdVote is a paid mutator transaction binding the contract method 0x0214ddc3.
+//
+// Solidity: function processThresholdVote(string _authOrg, address _vAccount, uint256 _opType, uint256 _threshold) returns(bool)
+func (_VoterManager *VoterManagerSession) ProcessThresholdVote(_authOrg string, _vAccount common.Address, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.ProcessThresholdVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _opType, _threshold)
+}
+
+// ProcessThresholdVote is a paid mutator transaction binding the contract method 0x0214ddc3.
+//
+// Solidity: function processThresholdVote(string _authOrg, address _vAccount, uint256 _opType, uint256 _threshold) returns(bool)
+func (_VoterManager *VoterManagerTransactorSession) ProcessThresholdVote(_authOrg string, _vAccount common.Address, _opType *big.Int, _threshold *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.ProcessThresholdVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _opType, _threshold)
+}
+
+// ProcessVote is a paid mutator transaction binding the contract method 0x378902b6.
 //
-// Solidity: function processVote(string _authOrg, address _vAccount, uint256 _pendingOp) returns(bool)
-func (_VoterManager *VoterManagerTransactor) ProcessVote(opts *bind.TransactOpts, _authOrg string, _vAccount common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
-	return _VoterManager.contract.Transact(opts, "processVote", _authOrg, _vAccount, _pendingOp)
+// Solidity: function processVote(string _authOrg, address _vAccount, string _orgId, string _enodeId, address _account, uint256 _pendingOp) returns(bool)
+func (_VoterManager *VoterManagerTransactor) ProcessVote(opts *bind.TransactOpts, _authOrg string, _vAccount common.Address, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
+	return _VoterManager.contract.Transact(opts, "processVote", _authOrg, _vAccount, _orgId, _enodeId, _account, _pendingOp)
 }
 
-// ProcessVote is a paid mutator transaction binding the contract method 0xb0213864.
+// ProcessVote is a paid mutator transaction binding the contract method 0x378902b6.
 //
-// Solidity: function processVote(string _authOrg, address _vAccount, uint256 _pendingOp) returns(bool)
-func (_VoterManager *VoterManagerSession) ProcessVote(_authOrg string, _vAccount common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
-	return _VoterManager.Contract.ProcessVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _pendingOp)
+// Solidity: function processVote(string _authOrg, address _vAccount, string _orgId, string _enodeId, address _account, uint256 _pendingOp) returns(bool)
+func (_VoterManager *VoterManagerSession) ProcessVote(_authOrg string, _vAccount common.Address, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.ProcessVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _orgId, _enodeId, _account, _pendingOp)
 }
 
-// ProcessVote is a paid mutator transaction binding the contract method 0xb0213864.
+// ProcessVote is a paid mutator transaction binding the contract method 0x378902b6.
 //
-// Solidity: function processVote(string _authOrg, address _vAccount, uint256 _pendingOp) returns(bool)
-func (_VoterManager *VoterManagerTransactorSession) ProcessVote(_authOrg string, _vAccount common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
-	return _VoterManager.Contract.ProcessVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _pendingOp)
+// Solidity: function processVote(string _authOrg, address _vAccount, string _orgId, string _enodeId, address _account, uint256 _pendingOp) returns(bool)
+func (_VoterManager *VoterManagerTransactorSession) ProcessVote(_authOrg string, _vAccount common.Address, _orgId string, _enodeId string, _account common.Address, _pendingOp *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.ProcessVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _orgId, _enodeId, _account, _pendingOp)
 }
 
 // VoterManagerVoteProcessedIterator is returned from FilterVoteProcessed and is used to iterate over the raw logs and unpacked data for VoteProcessed events raised by the VoterManager contract.
@@ -445,6 +631,142 @@ func (_VoterManager *VoterManagerFilterer) ParseVoteProcessed(log types.Log) (*V
 	return event, nil
 }
 
+// VoterManagerVoteThresholdSetIterator is returned from FilterVoteThresholdSet and is used to iterate over the raw logs and unpacked data for VoteThresholdSet events raised by the VoterManager contract.
+type VoterManagerVoteThresholdSetIterator struct {
+	Event *VoterManagerVoteThresholdSet // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VoterManagerVoteThresholdSetIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VoterManagerVoteThresholdSet)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VoterManagerVoteThresholdSet)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VoterManagerVoteThresholdSetIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VoterManagerVoteThresholdSetIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VoterManagerVoteThresholdSet represents a VoteThresholdSet event raised by the VoterManager contract.
+type VoterManagerVoteThresholdSet struct {
+	OpType    *big.Int
+	Threshold *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoteThresholdSet is a free log retrieval operation binding the contract event 0x1e792c873d26fa5a8db9cfa76847773d14791617a303de65b8a52c36d4229995.
+//
+// Solidity: event VoteThresholdSet(uint256 _opType, uint256 _threshold)
+func (_VoterManager *VoterManagerFilterer) FilterVoteThresholdSet(opts *bind.FilterOpts) (*VoterManagerVoteThresholdSetIterator, error) {
+
+	logs, sub, err := _VoterManager.contract.FilterLogs(opts, "VoteThresholdSet")
+	if err != nil {
+		return nil, err
+	}
+	return &VoterManagerVoteThresholdSetIterator{contract: _VoterManager.contract, event: "VoteThresholdSet", logs: logs, sub: sub}, nil
+}
+
+var VoteThresholdSetTopicHash = "0x1e792c873d26fa5a8db9cfa76847773d14791617a303de65b8a52c36d4229995"
+
+// WatchVoteThresholdSet is a free log subscription operation binding the contract event 0x1e792c873d26fa5a8db9cfa76847773d14791617a303de65b8a52c36d4229995.
+//
+// Solidity: event VoteThresholdSet(uint256 _opType, uint256 _threshold)
+func (_VoterManager *VoterManagerFilterer) WatchVoteThresholdSet(opts *bind.WatchOpts, sink chan<- *VoterManagerVoteThresholdSet) (event.Subscription, error) {
+
+	logs, sub, err := _VoterManager.contract.WatchLogs(opts, "VoteThresholdSet")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VoterManagerVoteThresholdSet)
+				if err := _VoterManager.contract.UnpackLog(event, "VoteThresholdSet", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVoteThresholdSet is a log parse operation binding the contract event 0x1e792c873d26fa5a8db9cfa76847773d14791617a303de65b8a52c36d4229995.
+//
+// Solidity: event VoteThresholdSet(uint256 _opType, uint256 _threshold)
+func (_VoterManager *VoterManagerFilterer) ParseVoteThresholdSet(log types.Log) (*VoterManagerVoteThresholdSet, error) {
+	event := new(VoterManagerVoteThresholdSet)
+	if err := _VoterManager.contract.UnpackLog(event, "VoteThresholdSet", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // VoterManagerVoterAddedIterator is returned from FilterVoterAdded and is used to iterate over the raw logs and unpacked data for VoterAdded events raised by the VoterManager contract.
 type VoterManagerVoterAddedIterator struct {
 	Event *VoterManagerVoterAdded // Event containing the contract specifics and raw log
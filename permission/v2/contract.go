@@ -63,6 +63,10 @@ type Init struct {
 }
 
 func (a *Account) AssignAccountRole(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return a.Backend.PermInterfSession.AssignAccountRoleWithExpiry(_args.AcctId, _args.OrgId, _args.RoleId,
+			new(big.Int).SetUint64(_args.Expiry.Block), new(big.Int).SetUint64(_args.Expiry.Timestamp))
+	}
 	return a.Backend.PermInterfSession.AssignAccountRole(_args.AcctId, _args.OrgId, _args.RoleId)
 }
 
@@ -83,6 +87,10 @@ func (a *Account) ApproveAdminRole(_args ptype.TxArgs) (*types.Transaction, erro
 }
 
 func (a *Account) AssignAdminRole(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return a.Backend.PermInterfSession.AssignAdminRoleWithExpiry(_args.OrgId, _args.AcctId, _args.RoleId,
+			new(big.Int).SetUint64(_args.Expiry.Block), new(big.Int).SetUint64(_args.Expiry.Timestamp))
+	}
 	return a.Backend.PermInterfSession.AssignAdminRole(_args.OrgId, _args.AcctId, _args.RoleId)
 }
 
@@ -188,6 +196,22 @@ func (i *Init) GetOrgDetails(_orgId string) (string, string, string, *big.Int, *
 	return i.permOrgSession.GetOrgDetails(_orgId)
 }
 
+func (i *Init) GetAccountExpiry(_account common.Address) (core.AccessExpiry, error) {
+	block, time, err := i.permAcctSession.GetAccountExpiry(_account)
+	if err != nil {
+		return core.AccessExpiry{}, err
+	}
+	return core.AccessExpiry{Block: block.Uint64(), Timestamp: time.Uint64()}, nil
+}
+
+func (i *Init) GetSuspensionExpiry(_orgId string) (core.AccessExpiry, error) {
+	block, time, err := i.permOrgSession.GetSuspensionExpiry(_orgId)
+	if err != nil {
+		return core.AccessExpiry{}, err
+	}
+	return core.AccessExpiry{Block: block.Uint64(), Timestamp: time.Uint64()}, nil
+}
+
 // This is to make sure all contract instances are ready and initialized
 //
 // Required to be call after standard service start lifecycle
@@ -204,6 +228,16 @@ func (i *Init) BindContracts() error {
 }
 
 func (a *Audit) ValidatePendingOp(_authOrg, _orgId, _url string, _account common.Address, _pendingOp int64) bool {
+	return a.isPendingOp(_authOrg, _orgId, _url, _account, _pendingOp)
+}
+
+// v2 model allows multiple operations to be pending at the same time. only
+// the same operation being pending blocks a new one
+func (a *Audit) CheckPendingOp(_authOrg, _orgId, _url string, _account common.Address, _pendingOp int64) bool {
+	return a.isPendingOp(_authOrg, _orgId, _url, _account, _pendingOp)
+}
+
+func (a *Audit) isPendingOp(_authOrg, _orgId, _url string, _account common.Address, _pendingOp int64) bool {
 	var enodeId string
 	var err error
 	if _url != "" {
@@ -213,13 +247,54 @@ func (a *Audit) ValidatePendingOp(_authOrg, _orgId, _url string, _account common
 			return false
 		}
 	}
-	pOrg, pUrl, pAcct, op, err := a.Backend.PermInterfSession.GetPendingOp(_authOrg)
-	return err == nil && (op.Int64() == _pendingOp && pOrg == _orgId && pUrl == enodeId && pAcct == _account)
+	pending, err := a.Backend.PermInterfSession.IsPendingOp(_authOrg, _orgId, enodeId, _account, big.NewInt(_pendingOp))
+	if err != nil {
+		log.Error("permission: encountered error while checking for pending operations", "err", err)
+		return false
+	}
+	return pending
+}
+
+// returns the operations pending approval for the org along with the votes
+// received so far. the contract indexes the pending operations only, so
+// approved operations are not scanned
+func (a *Audit) GetPendingOps(_authOrg string) ([]ptype.PendingOp, error) {
+	count, err := a.Backend.PermInterfSession.GetNumberOfPendingOps(_authOrg)
+	if err != nil {
+		return nil, err
+	}
+	var ops []ptype.PendingOp
+	for i := int64(0); i < count.Int64(); i++ {
+		orgId, enodeId, account, opType, voteCount, requiredVotes, err := a.Backend.PermInterfSession.GetPendingOpDetailsFromIndex(_authOrg, big.NewInt(i))
+		if err != nil {
+			return nil, err
+		}
+		op := ptype.PendingOp{
+			OrgId:         orgId,
+			EnodeId:       enodeId,
+			Account:       account,
+			OpType:        opType.Int64(),
+			VoteCount:     voteCount.Int64(),
+			RequiredVotes: requiredVotes.Int64(),
+		}
+		if op.OpType == ptype.PendingOpVoteThreshold {
+			target, threshold, err := a.Backend.PermInterfSession.GetThresholdProposal(_authOrg, big.NewInt(i))
+			if err != nil {
+				return nil, err
+			}
+			op.TargetOpType, op.Threshold = target.Int64(), uint8(threshold.Uint64())
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
 }
 
-func (a *Audit) CheckPendingOp(_orgId string) bool {
-	_, _, _, op, err := a.Backend.PermInterfSession.GetPendingOp(_orgId)
-	return err == nil && op.Int64() != 0
+func (a *Audit) GetVoteThreshold(_opType uint8) (uint8, error) {
+	threshold, err := a.Backend.PermInterfSession.GetVoteThreshold(big.NewInt(int64(_opType)))
+	if err != nil {
+		return 0, err
+	}
+	return uint8(threshold.Uint64()), nil
 }
 
 func (c *Control) ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint16) (bool, error) {
@@ -232,8 +307,14 @@ func (c *Control) ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint1
 	return c.Backend.PermInterfSession.ConnectionAllowed(enodeId, ip, port)
 }
 
-func (c *Control) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType core.TransactionType) error {
-	if allowed, err := c.Backend.PermInterfSession.TransactionAllowed(_sender, _target, _value, _gasPrice, _gasLimit, _payload); err != nil {
+func (c *Control) TransactionAllowed(_sender common.Address, _target common.Address, _value *big.Int, _gasPrice *big.Int, _gasLimit *big.Int, _payload []byte, _transactionType core.TransactionType, _block *big.Int) error {
+	opts := c.Backend.PermInterfSession.CallOpts
+	if _block != nil {
+		// pending state would take precedence over the requested block
+		opts.Pending = false
+		opts.BlockNumber = _block
+	}
+	if allowed, err := c.Backend.PermInterfSession.Contract.TransactionAllowed(&opts, _sender, _target, _value, _gasPrice, _gasLimit, _payload); err != nil {
 		return err
 	} else if !allowed {
 		return ptype.ErrNoPermissionForTxn
@@ -257,9 +338,21 @@ func (o *Org) ApproveOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 }
 
 func (o *Org) UpdateOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
+	if !_args.Expiry.IsZero() {
+		return o.Backend.PermInterfSession.UpdateOrgStatusWithExpiry(_args.OrgId, big.NewInt(int64(_args.Action)),
+			new(big.Int).SetUint64(_args.Expiry.Block), new(big.Int).SetUint64(_args.Expiry.Timestamp))
+	}
 	return o.Backend.PermInterfSession.UpdateOrgStatus(_args.OrgId, big.NewInt(int64(_args.Action)))
 }
 
+func (o *Org) SetVoteThreshold(_args ptype.TxArgs) (*types.Transaction, error) {
+	return o.Backend.PermInterfSession.SetVoteThreshold(big.NewInt(int64(_args.Action)), big.NewInt(int64(_args.Threshold)))
+}
+
+func (o *Org) ApproveVoteThreshold(_args ptype.TxArgs) (*types.Transaction, error) {
+	return o.Backend.PermInterfSession.ApproveVoteThreshold(big.NewInt(int64(_args.Action)), big.NewInt(int64(_args.Threshold)))
+}
+
 func (o *Org) ApproveOrg(_args ptype.TxArgs) (*types.Transaction, error) {
 	enodeId, ip, port, raftPort, err := getNodeDetails(_args.Url, o.Backend.ContractBackend.IsRaft, o.Backend.ContractBackend.UseDns)
 	if err != nil {
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 
@@ -23,6 +23,9 @@ import "./PermissionsUpgradable.sol";
      When adding a new org admin account to an existing org, the existing org
      admin account will be in revoked status and can be assigned a new role
      later
+     A role assignment can be time bounded by an expiry block number and/or
+     an expiry block timestamp. Once either of them is passed the account
+     is treated as not having any access. A value of 0 means no bound.
   */
 contract AccountManager {
     PermissionsUpgradable private permUpgradable;
@@ -43,10 +46,18 @@ contract AccountManager {
 
     mapping(bytes32 => address) private orgAdminIndex;
 
+    struct AccessExpiry {
+        uint expiryBlock;
+        uint expiryTime;
+    }
+
+    mapping(address => AccessExpiry) private accountExpiry;
+
     // account permission events
     event AccountAccessModified(address _account, string _orgId, string _roleId, bool _orgAdmin, uint _status);
     event AccountAccessRevoked(address _account, string _orgId, string _roleId, bool _orgAdmin);
     event AccountStatusChanged(address _account, string _orgId, uint _status);
+    event AccountAccessExpirySet(address _account, string _orgId, uint _expiryBlock, uint _expiryTime);
 
     /** @notice confirms that the caller is the address of implementation
         contract
@@ -67,7 +78,7 @@ contract AccountManager {
     }
 
     /// @notice constructor. sets the permissions upgradable address
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -105,6 +116,15 @@ contract AccountManager {
         return (accountAccessList[aIndex].orgId, accountAccessList[aIndex].role);
     }
 
+    /** @notice returns the expiry of the role assignment of an account
+      * @param _account account id
+      * @return expiry block number, 0 if not bounded by block number
+      * @return expiry block timestamp, 0 if not bounded by timestamp
+      */
+    function getAccountExpiry(address _account) external view returns (uint, uint) {
+        return (accountExpiry[_account].expiryBlock, accountExpiry[_account].expiryTime);
+    }
+
     /** @notice returns the account details a given account index
       * @param  _aIndex account index
       * @return account id
@@ -172,8 +192,8 @@ contract AccountManager {
         the time of adding a new account as org admin account. at org
         level there can be one org admin account only
       * @param _orgId - org id
-      * @return bool to indicate if voter update is required or not
-      * @return _adminRole - indicates of the role is an admin role
+      * @return voterUpdate bool to indicate if voter update is required or not
+      * @return account _adminRole - indicates of the role is an admin role
       */
     function removeExistingAdmin(string calldata _orgId) external
     onlyImplementation
@@ -195,7 +215,7 @@ contract AccountManager {
     /** @notice function to add an account as network admin or org admin.
       * @param _orgId - org id
       * @param _account - account id
-      * @return bool to indicate if voter update is required or not
+      * @return voterUpdate bool to indicate if voter update is required or not
       */
     function addNewAdmin(string calldata _orgId, address _account) external
     onlyImplementation
@@ -266,12 +286,38 @@ contract AccountManager {
         emit AccountStatusChanged(_account, _orgId, newStatus);
     }
 
+    /** @notice sets the expiry of the role assignment of an account. passing
+        0 for both the values removes the expiry
+      * @param _account - account id
+      * @param _expiryBlock - block number after which the role is not valid
+      * @param _expiryTime - block timestamp after which the role is not valid
+      */
+    function setAccountExpiry(address _account, uint _expiryBlock, uint _expiryTime) external
+    onlyImplementation {
+        require((accountIndex[_account]) != 0, "account does not exists");
+        require((_expiryBlock == 0 || _expiryBlock >= block.number) &&
+            (_expiryTime == 0 || _expiryTime >= block.timestamp), "expiry is in the past");
+        accountExpiry[_account] = AccessExpiry(_expiryBlock, _expiryTime);
+        emit AccountAccessExpirySet(_account, accountAccessList[_getAccountIndex(_account)].orgId,
+            _expiryBlock, _expiryTime);
+    }
+
+    /** @notice checks if the role assignment of the account has expired
+      * @param _account - account id
+      * @return true if the expiry block or expiry timestamp has passed
+      */
+    function isAccountExpired(address _account) public view returns (bool) {
+        AccessExpiry memory expiry = accountExpiry[_account];
+        return ((expiry.expiryBlock != 0 && block.number > expiry.expiryBlock) ||
+        (expiry.expiryTime != 0 && block.timestamp > expiry.expiryTime));
+    }
+
     /** @notice checks if the passed account exists and if exists does it
         belong to the passed organization.
       * @param _account - account id
       * @param _orgId - org id
       * @return bool true if the account does not exists or exists and belongs
-      * @return passed org
+        passed org
       */
     function validateAccount(address _account, string calldata _orgId) external
     view returns (bool){
@@ -348,7 +394,9 @@ contract AccountManager {
       * @return account index
       */
     function _getAccountIndex(address _account) internal view returns (uint256) {
-        return accountIndex[_account] - 1;
+        unchecked {
+            return accountIndex[_account] - 1;
+        }
     }
 
     /** @notice sets the account role to the passed role id and sets the status
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 /** @title Node manager contract
@@ -89,15 +89,18 @@ contract NodeManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
     /** @notice fetches the node details given an enode id
       * @param _enodeId full enode id
-      * @return org id
-      * @return enode id
-      * @return status of the node
+      * @return _orgId org id
+      * @return _enodeId enode id
+      * @return _ip ip of the node
+      * @return _port port of the node
+      * @return _raftport raftport of the node
+      * @return _nodeStatus status of the node
       */
     function getNodeDetails(string calldata enodeId) external view
     returns (string memory _orgId, string memory _enodeId, string memory _ip, uint16 _port, uint16 _raftport, uint256 _nodeStatus) {
@@ -112,12 +115,12 @@ contract NodeManager {
 
     /** @notice fetches the node details given the index of the enode
       * @param _nodeIndex node index
-      * @return org id
-      * @return enode id
-      * @return ip of the node
-      * @return port of the node
-      * @return raftport of the node
-      * @return status of the node
+      * @return _orgId org id
+      * @return _enodeId enode id
+      * @return _ip ip of the node
+      * @return _port port of the node
+      * @return _raftport raftport of the node
+      * @return _nodeStatus status of the node
       */
     function getNodeDetailsFromIndex(uint256 _nodeIndex) external view
     returns (string memory _orgId, string memory _enodeId, string memory _ip, uint16 _port, uint16 _raftport, uint256 _nodeStatus) {
@@ -265,7 +268,9 @@ contract NodeManager {
       */
     function _getNodeIndex(string memory _enodeId) internal view
     returns (uint256) {
-        return enodeIdToIndex[keccak256(abi.encode(_enodeId))] - 1;
+        unchecked {
+            return enodeIdToIndex[keccak256(abi.encode(_enodeId))] - 1;
+        }
     }
 
     /** @notice checks if enode id is linked to the org id passed
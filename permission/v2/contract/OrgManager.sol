@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 /** @title Organization manager contract
@@ -16,6 +16,9 @@ import "./PermissionsUpgradable.sol";
         4 - Org in Suspended,
      Once the node is blacklisted no further activity on the node is
      possible.
+     A suspension can be time bounded by an expiry block number and/or an
+     expiry block timestamp. Once either of them is passed the suspended org
+     is treated as active again. A value of 0 means no bound.
   */
 contract OrgManager {
     string private adminOrgId;
@@ -42,6 +45,13 @@ contract OrgManager {
     mapping(bytes32 => uint) private OrgIndex;
     uint private orgNum = 0;
 
+    struct SuspensionExpiry {
+        uint expiryBlock;
+        uint expiryTime;
+    }
+
+    mapping(bytes32 => SuspensionExpiry) private suspensionExpiry;
+
     // events related to Master Org add
     event OrgApproved(string _orgId, string _porgId, string _ultParent,
         uint _level, uint _status);
@@ -51,6 +61,7 @@ contract OrgManager {
         uint _level);
     event OrgSuspensionRevoked(string _orgId, string _porgId, string _ultParent,
         uint _level);
+    event OrgSuspensionExpirySet(string _orgId, uint _expiryBlock, uint _expiryTime);
 
     /** @notice confirms that the caller is the address of implementation
         contract
@@ -62,7 +73,7 @@ contract OrgManager {
 
     /** @notice checks if the org id does not exists
       * @param _orgId - org id
-      * @return true if org does not exist
+      * true if org does not exist
       */
     modifier orgDoesNotExist(string memory _orgId) {
         require(checkOrgExists(_orgId) == false, "org exists");
@@ -71,7 +82,7 @@ contract OrgManager {
 
     /** @notice checks if the org id does exists
       * @param _orgId - org id
-      * @return true if org exists
+      * true if org exists
       */
     modifier orgExists(string memory _orgId) {
         require(checkOrgExists(_orgId) == true, "org does not exist");
@@ -80,7 +91,7 @@ contract OrgManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -214,6 +225,33 @@ contract OrgManager {
         orgList[_orgIndex].ultParent, orgList[_orgIndex].level, orgList[_orgIndex].status);
     }
 
+    /** @notice returns the expiry of the suspension of an org
+      * @param _orgId org id
+      * @return expiry block number, 0 if not bounded by block number
+      * @return expiry block timestamp, 0 if not bounded by timestamp
+      */
+    function getSuspensionExpiry(string calldata _orgId) external view returns (uint, uint) {
+        SuspensionExpiry memory expiry = suspensionExpiry[keccak256(abi.encodePacked(_orgId))];
+        return (expiry.expiryBlock, expiry.expiryTime);
+    }
+
+    /** @notice sets the expiry of a pending or approved suspension of a
+        master org. passing 0 for both the values removes the expiry
+      * @param _orgId org id
+      * @param _expiryBlock block number after which the suspension lapses
+      * @param _expiryTime block timestamp after which the suspension lapses
+      */
+    function setSuspensionExpiry(string calldata _orgId, uint _expiryBlock, uint _expiryTime) external
+    onlyImplementation
+    orgExists(_orgId) {
+        require((checkOrgStatus(_orgId, 3) || checkOrgStatus(_orgId, 4)),
+            "org is not suspended. operation cannot be done");
+        require((_expiryBlock == 0 || _expiryBlock >= block.number) &&
+            (_expiryTime == 0 || _expiryTime >= block.timestamp), "expiry is in the past");
+        suspensionExpiry[keccak256(abi.encodePacked(_orgId))] = SuspensionExpiry(_expiryBlock, _expiryTime);
+        emit OrgSuspensionExpirySet(_orgId, _expiryBlock, _expiryTime);
+    }
+
     /** @notice returns the array of sub org indexes for the given org
       * @param _orgId org id
       * @return array of sub org indexes
@@ -263,9 +301,9 @@ contract OrgManager {
     public view returns (bool){
         if (OrgIndex[keccak256(abi.encodePacked(_orgId))] != 0) {
             uint256 id = _getOrgIndex(_orgId);
-            if (orgList[id].status == 2 || orgList[id].status == 3) {
+            if (orgList[id].status == 2 || orgList[id].status == 3 || _isSuspensionLapsed(id)) {
                 uint256 uid = _getOrgIndex(orgList[id].ultParent);
-                if (orgList[uid].status == 2 || orgList[uid].status == 3) {
+                if (orgList[uid].status == 2 || orgList[uid].status == 3 || _isSuspensionLapsed(uid)) {
                     return true;
                 }
             }
@@ -273,6 +311,20 @@ contract OrgManager {
         return false;
     }
 
+    /** @notice checks if the org is suspended and the suspension expiry
+        block or timestamp has passed
+      * @param _id org index
+      * @return true or false
+      */
+    function _isSuspensionLapsed(uint256 _id) internal view returns (bool) {
+        if (orgList[_id].status != 4) {
+            return false;
+        }
+        SuspensionExpiry memory expiry = suspensionExpiry[keccak256(abi.encodePacked(orgList[_id].fullOrgId))];
+        return ((expiry.expiryBlock != 0 && block.number > expiry.expiryBlock) ||
+        (expiry.expiryTime != 0 && block.timestamp > expiry.expiryTime));
+    }
+
     /** @notice confirms if the org exists in the network
       * @param _orgId org id
       * @return true or false
@@ -322,6 +374,7 @@ contract OrgManager {
         require(checkOrgStatus(_orgId, 5) == true, "nothing to approve");
         uint256 id = _getOrgIndex(_orgId);
         orgList[id].status = 2;
+        delete suspensionExpiry[keccak256(abi.encodePacked(_orgId))];
         emit OrgSuspensionRevoked(orgList[id].orgId, orgList[id].parentId,
             orgList[id].ultParent, orgList[id].level);
     }
@@ -346,7 +399,8 @@ contract OrgManager {
         }
         orgNum++;
         OrgIndex[oid] = orgNum;
-        uint256 id = orgList.length++;
+        uint256 id = orgList.length;
+        orgList.push();
         if (_level == 1) {
             orgList[id].level = _level;
             orgList[id].pindex = 0;
@@ -363,7 +417,8 @@ contract OrgManager {
             orgList[id].level = orgList[parentIndex].level + 1;
             orgList[id].pindex = parentIndex;
             orgList[id].ultParent = orgList[parentIndex].ultParent;
-            uint256 subOrgId = orgList[parentIndex].subOrgIndexList.length++;
+            uint256 subOrgId = orgList[parentIndex].subOrgIndexList.length;
+            orgList[parentIndex].subOrgIndexList.push();
             orgList[parentIndex].subOrgIndexList[subOrgId] = id;
             orgList[id].fullOrgId = string(abi.encodePacked(_pOrgId, ".", _orgId));
         }
@@ -384,7 +439,9 @@ contract OrgManager {
       * @return org index
       */
     function _getOrgIndex(string memory _orgId) private view returns (uint){
-        return OrgIndex[keccak256(abi.encodePacked(_orgId))] - 1;
+        unchecked {
+            return OrgIndex[keccak256(abi.encodePacked(_orgId))] - 1;
+        }
     }
 
 }
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./RoleManager.sol";
 import "./AccountManager.sol";
@@ -108,7 +108,7 @@ contract PermissionsImplementation {
       * @param _nodeManager - address of node manager contract
       */
     constructor (address _permUpgradable, address _orgManager, address _rolesManager,
-        address _accountManager, address _voterManager, address _nodeManager) public {
+        address _accountManager, address _voterManager, address _nodeManager) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
         orgManager = OrgManager(_orgManager);
         roleManager = RoleManager(_rolesManager);
@@ -224,7 +224,7 @@ contract PermissionsImplementation {
     {
         require(networkBoot == true, "Incorrect network boot status");
         require(isNetworkAdmin(_caller) == true, "account is not a network admin account");
-        voterManager.addVotingItem(adminOrg, _orgId, _enodeId, _account, 1);
+        voterManager.addVotingItem(adminOrg, _orgId, _enodeId, _account, OP_ADD_ORG);
         orgManager.addOrg(_orgId);
         nodeManager.addNode(_enodeId, _ip, _port, _raftport, _orgId);
         require(validateAccount(_account, _orgId) == true,
@@ -248,7 +248,7 @@ contract PermissionsImplementation {
     {
         require(isNetworkAdmin(_caller) == true, "account is not a network admin account");
         require(_checkOrgStatus(_orgId, 1) == true, "Nothing to approve");
-        if ((processVote(adminOrg, _caller, 1))) {
+        if ((processVote(adminOrg, _caller, _orgId, _enodeId, _account, OP_ADD_ORG))) {
             orgManager.approveOrg(_orgId);
             roleManager.addRole(orgAdminRole, _orgId, fullAccess, true, true);
             nodeManager.approveNode(_enodeId, _ip, _port, _raftport, _orgId);
@@ -286,9 +286,24 @@ contract PermissionsImplementation {
       */
     function updateOrgStatus(string calldata _orgId, uint256 _action, address _caller)
     external onlyInterface networkAdmin(_caller) {
-        uint256 pendingOp;
-        pendingOp = orgManager.updateOrg(_orgId, _action);
-        voterManager.addVotingItem(adminOrg, _orgId, "", address(0), pendingOp);
+        _updateOrgStatus(_orgId, _action, 0, 0);
+    }
+
+    /** @notice function to update the org status with a time bounded
+        suspension. it updates the org status and adds a voting item for
+        network admins to approve. the suspension lapses once the expiry
+        block or expiry timestamp is passed
+      * @param _orgId unique id of the organization
+      * @param _action 1 for suspending an org and 2 for revoke of suspension
+      * @param _expiryBlock block number after which the suspension lapses
+      * @param _expiryTime block timestamp after which the suspension lapses
+      */
+    function updateOrgStatusWithExpiry(string calldata _orgId, uint256 _action,
+        uint256 _expiryBlock, uint256 _expiryTime, address _caller)
+    external onlyInterface networkAdmin(_caller) {
+        require((_action == 1 || (_expiryBlock == 0 && _expiryTime == 0)),
+            "expiry can be given for suspension only");
+        _updateOrgStatus(_orgId, _action, _expiryBlock, _expiryTime);
     }
 
     /** @notice function to approve org status change. the org status is
@@ -303,15 +318,15 @@ contract PermissionsImplementation {
         uint256 pendingOp;
         uint256 orgStatus;
         if (_action == 1) {
-            pendingOp = 2;
+            pendingOp = OP_SUSPEND_ORG;
             orgStatus = 3;
         }
         else if (_action == 2) {
-            pendingOp = 3;
+            pendingOp = OP_REVOKE_ORG_SUSPENSION;
             orgStatus = 5;
         }
         require(_checkOrgStatus(_orgId, orgStatus) == true, "operation not allowed");
-        if ((processVote(adminOrg, _caller, pendingOp))) {
+        if ((processVote(adminOrg, _caller, _orgId, "", address(0), pendingOp))) {
             orgManager.approveOrgStatusUpdate(_orgId, _action);
         }
     }
@@ -368,9 +383,22 @@ contract PermissionsImplementation {
     function assignAdminRole(string calldata _orgId, address _account,
         string calldata _roleId, address _caller) external
     onlyInterface orgExists(_orgId) networkAdmin(_caller) {
-        accountManager.assignAdminRole(_account, _orgId, _roleId, 1);
-        //add voting item
-        voterManager.addVotingItem(adminOrg, _orgId, "", _account, 4);
+        _assignAdminRole(_orgId, _account, _roleId, 0, 0);
+    }
+
+    /** @notice function to assign network admin/org admin role to an account
+        which is valid till the expiry block or expiry timestamp. this can be
+        executed by network admin accounts only
+      * @param _orgId unique id of the organization to which the account belongs
+      * @param _account account id
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function assignAdminRoleWithExpiry(string calldata _orgId, address _account,
+        string calldata _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) external
+    onlyInterface orgExists(_orgId) networkAdmin(_caller) {
+        _assignAdminRole(_orgId, _account, _roleId, _expiryBlock, _expiryTime);
     }
 
     /** @notice function to approve network admin/org admin role assigment
@@ -380,7 +408,7 @@ contract PermissionsImplementation {
       */
     function approveAdminRole(string calldata _orgId, address _account,
         address _caller) external onlyInterface networkAdmin(_caller) {
-        if ((processVote(adminOrg, _caller, 4))) {
+        if ((processVote(adminOrg, _caller, _orgId, "", _account, OP_ASSIGN_ADMIN_ROLE))) {
             (bool ret, address account) = accountManager.removeExistingAdmin(_orgId);
             if (ret) {
                 updateVoterList(adminOrg, account, false);
@@ -472,7 +500,7 @@ contract PermissionsImplementation {
 
         // add a voting record with pending op of 5 which corresponds to blacklisted node
         // recovery
-        voterManager.addVotingItem(adminOrg, _orgId, _enodeId, address(0), 5);
+        voterManager.addVotingItem(adminOrg, _orgId, _enodeId, address(0), OP_NODE_RECOVERY);
     }
 
     /** @notice function to initiate blacklisted nodes recovery. this can be
@@ -492,7 +520,7 @@ contract PermissionsImplementation {
     {
         // check if majority votes are received. pending op type is passed as 5
         // which stands for black listed node recovery
-        if ((processVote(adminOrg, _caller, 5))) {
+        if ((processVote(adminOrg, _caller, _orgId, _enodeId, address(0), OP_NODE_RECOVERY))) {
             // update the node back to active
             nodeManager.updateNodeStatus(_enodeId, _ip, _port, _raftport, _orgId, 5);
         }
@@ -514,7 +542,7 @@ contract PermissionsImplementation {
         accountManager.updateAccountStatus(_orgId, _account, 4);
         // add a voting record with pending op of 5 which corresponds to blacklisted node
         // recovery
-        voterManager.addVotingItem(adminOrg, _orgId, "", _account, 6);
+        voterManager.addVotingItem(adminOrg, _orgId, "", _account, OP_ACCOUNT_RECOVERY);
     }
 
     /** @notice function to initaite blacklisted nodes recovery. this can be
@@ -530,7 +558,7 @@ contract PermissionsImplementation {
     networkAdmin(_caller) {
         // check if majority votes are received. pending op type is passed as 6
         // which stands for black listed account recovery
-        if ((processVote(adminOrg, _caller, 6))) {
+        if ((processVote(adminOrg, _caller, _orgId, "", _account, OP_ACCOUNT_RECOVERY))) {
             // update the node back to active
             accountManager.updateAccountStatus(_orgId, _account, 5);
         }
@@ -553,6 +581,94 @@ contract PermissionsImplementation {
         return voterManager.getPendingOpDetails(_orgId);
     }
 
+    /** @notice function to fetch the number of voting records for network
+        admin organization
+      * @param _orgId unique id of the organization
+      */
+    function getNumberOfPendingOps(string calldata _orgId) external view
+    returns (uint256){
+        return voterManager.getNumberOfPendingOps(_orgId);
+    }
+
+    /** @notice function to fetch the voting record at the given index along
+        with the votes received and the votes required for approval
+      * @param _orgId unique id of the organization
+      * @param _index index of the voting record
+      */
+    function getPendingOpDetailsFromIndex(string calldata _orgId, uint256 _index) external view
+    returns (string memory, string memory, address, uint256, uint256, uint256){
+        return voterManager.getPendingOpDetailsFromIndex(_orgId, _index);
+    }
+
+    /** @notice function to fetch the operation type and threshold proposed
+        by the pending threshold change at the given index
+      * @param _orgId unique id of the organization
+      * @param _index index of the voting record
+      */
+    function getThresholdProposal(string calldata _orgId, uint256 _index) external view
+    returns (uint256, uint256){
+        return voterManager.getThresholdProposal(_orgId, _index);
+    }
+
+    /** @notice function to check if an operation is pending approval
+      * @param _authOrg org id of the authorizing org
+      * @param _orgId org id of the operation
+      * @param _enodeId enode id of the operation
+      * @param _account account id of the operation
+      * @param _pendingOp type of operation
+      */
+    function isPendingOp(string calldata _authOrg, string calldata _orgId,
+        string calldata _enodeId, address _account, uint256 _pendingOp) external view
+    returns (bool){
+        return voterManager.isPendingOp(_authOrg, _orgId, _enodeId, _account, _pendingOp);
+    }
+
+    /** @notice function to propose an approval threshold for an operation
+        type. the threshold applies once approved by the network admin
+        accounts. can be executed by network admin accounts only
+      * @param _opType operation type
+      * @param _threshold percentage of voters required for approval. 0 for
+        simple majority
+      */
+    function setVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) external
+    onlyInterface networkAdmin(_caller) {
+        voterManager.addThresholdVotingItem(adminOrg, _opType, _threshold);
+    }
+
+    /** @notice function to approve a proposed approval threshold. can be
+        executed by network admin accounts only
+      * @param _opType operation type
+      * @param _threshold proposed threshold
+      */
+    function approveVoteThreshold(uint256 _opType, uint256 _threshold, address _caller) external
+    onlyInterface networkAdmin(_caller) {
+        voterManager.processThresholdVote(adminOrg, _caller, _opType, _threshold);
+    }
+
+    /** @notice registers the passed network admin accounts as voters. used
+        after replacing the voter manager contract as a part of an upgrade.
+        can be executed by guardian account only
+      * @param _accounts active network admin accounts which are not voters
+        in the current voter manager contract
+      */
+    function migrateVoters(address[] calldata _accounts) external
+    networkBootStatus(true) {
+        require(msg.sender == permUpgradable.getGuardian(), "invalid caller");
+        for (uint256 i = 0; i < _accounts.length; i++) {
+            require(isNetworkAdmin(_accounts[i]) && accountManager.getAccountStatus(_accounts[i]) == 2,
+                "account is not an active network admin account");
+            updateVoterList(adminOrg, _accounts[i], true);
+        }
+    }
+
+    /** @notice function to fetch the approval threshold for an operation type
+      * @param _opType operation type
+      */
+    function getVoteThreshold(uint256 _opType) external view
+    returns (uint256){
+        return voterManager.getVoteThreshold(_opType);
+    }
+
     /** @notice function to assigns a role id to the account given account
         can be executed by org admin account only
       * @param _account account id
@@ -564,10 +680,24 @@ contract PermissionsImplementation {
     onlyInterface
     orgAdmin(_caller, _orgId)
     orgApproved(_orgId) {
-        require(validateAccount(_account, _orgId) == true, "operation cannot be performed");
-        require(_roleExists(_roleId, _orgId) == true, "role does not exists");
-        bool admin = roleManager.isAdminRole(_roleId, _orgId, _getUltimateParent(_orgId));
-        accountManager.assignAccountRole(_account, _orgId, _roleId, admin);
+        _assignAccountRole(_account, _orgId, _roleId, 0, 0);
+    }
+
+    /** @notice function to assigns a role id to the account given account
+        which is valid till the expiry block or expiry timestamp. can be
+        executed by org admin account only
+      * @param _account account id
+      * @param _orgId organization id to which the account belongs
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function assignAccountRoleWithExpiry(address _account, string memory _orgId,
+        string memory _roleId, uint256 _expiryBlock, uint256 _expiryTime, address _caller) public
+    onlyInterface
+    orgAdmin(_caller, _orgId)
+    orgApproved(_orgId) {
+        _assignAccountRole(_account, _orgId, _roleId, _expiryBlock, _expiryTime);
     }
 
     /** @notice function to check if passed account is an network admin account
@@ -622,14 +752,18 @@ contract PermissionsImplementation {
 
     /** @notice whenever a network admin account votes on a pending item, this
         function processes the vote.
-      * @param _orgId org id of the caller
+      * @param _authOrg org id of the caller
       * @param _caller account which approving the operation
+      * @param _orgId org id of the operation being approved
+      * @param _enodeId enode id of the operation being approved
+      * @param _account account id of the operation being approved
       * @param _pendingOp operation for which the approval is being done
       * @dev the list of pending ops are managed in voter manager contract
       */
-    function processVote(string memory _orgId, address _caller, uint256 _pendingOp) internal
+    function processVote(string memory _authOrg, address _caller, string memory _orgId,
+        string memory _enodeId, address _account, uint256 _pendingOp) internal
     returns (bool){
-        return voterManager.processVote(_orgId, _caller, _pendingOp);
+        return voterManager.processVote(_authOrg, _caller, _orgId, _enodeId, _account, _pendingOp);
     }
 
     /** @notice returns various permissions policy related parameters
@@ -709,6 +843,57 @@ contract PermissionsImplementation {
         return orgManager.getUltimateParent(_orgId);
     }
 
+    /** @notice updates the org status, sets the suspension expiry and adds
+        a voting item for network admins to approve
+      * @param _orgId unique id of the organization
+      * @param _action 1 for suspending an org and 2 for revoke of suspension
+      * @param _expiryBlock block number after which the suspension lapses
+      * @param _expiryTime block timestamp after which the suspension lapses
+      */
+    function _updateOrgStatus(string memory _orgId, uint256 _action,
+        uint256 _expiryBlock, uint256 _expiryTime) internal {
+        uint256 pendingOp;
+        pendingOp = orgManager.updateOrg(_orgId, _action);
+        if (_action == 1) {
+            orgManager.setSuspensionExpiry(_orgId, _expiryBlock, _expiryTime);
+        }
+        voterManager.addVotingItem(adminOrg, _orgId, "", address(0), pendingOp);
+    }
+
+    /** @notice assigns network admin/org admin role to an account, sets the
+        expiry of the assignment and creates voting record for network admin
+        accounts
+      * @param _orgId unique id of the organization to which the account belongs
+      * @param _account account id
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function _assignAdminRole(string memory _orgId, address _account,
+        string memory _roleId, uint256 _expiryBlock, uint256 _expiryTime) internal {
+        accountManager.assignAdminRole(_account, _orgId, _roleId, 1);
+        accountManager.setAccountExpiry(_account, _expiryBlock, _expiryTime);
+        //add voting item
+        voterManager.addVotingItem(adminOrg, _orgId, "", _account, OP_ASSIGN_ADMIN_ROLE);
+    }
+
+    /** @notice assigns a role id to the account and sets the expiry of the
+        assignment
+      * @param _account account id
+      * @param _orgId organization id to which the account belongs
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function _assignAccountRole(address _account, string memory _orgId,
+        string memory _roleId, uint256 _expiryBlock, uint256 _expiryTime) internal {
+        require(validateAccount(_account, _orgId) == true, "operation cannot be performed");
+        require(_roleExists(_roleId, _orgId) == true, "role does not exists");
+        bool admin = roleManager.isAdminRole(_roleId, _orgId, _getUltimateParent(_orgId));
+        accountManager.assignAccountRole(_account, _orgId, _roleId, admin);
+        accountManager.setAccountExpiry(_account, _expiryBlock, _expiryTime);
+    }
+
     /** @notice checks if the node is allowed to connect or not
       * @param _enodeId enode id
       * @param _ip IP of node
@@ -737,7 +922,7 @@ contract PermissionsImplementation {
             return true;
         }
 
-        if (accountManager.getAccountStatus(_sender) == 2) {
+        if (accountManager.getAccountStatus(_sender) == 2 && !accountManager.isAccountExpired(_sender)) {
             (string memory act_org, string memory act_role) = accountManager.getAccountOrgRole(_sender);
             string memory act_uOrg = _getUltimateParent(act_org);
             if (orgManager.checkOrgActive(act_org)) {
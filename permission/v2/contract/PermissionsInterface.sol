@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsImplementation.sol";
 import "./PermissionsUpgradable.sol";
@@ -16,7 +16,7 @@ contract PermissionsInterface {
     /** @notice constructor
       * @param _permImplUpgradeable permissions upgradable contract address
       */
-    constructor(address _permImplUpgradeable) public {
+    constructor(address _permImplUpgradeable) {
         permImplUpgradeable = _permImplUpgradeable;
     }
 
@@ -127,6 +127,19 @@ contract PermissionsInterface {
         permImplementation.updateOrgStatus(_orgId, _action, msg.sender);
     }
 
+    /** @notice interface to update the org status with a time bounded
+        suspension which lapses after the expiry block or timestamp
+      * @param _orgId unique id for the sub organization
+      * @param _action 1 for suspending an org and 2 for revoke of suspension
+      * @param _expiryBlock block number after which the suspension lapses
+      * @param _expiryTime block timestamp after which the suspension lapses
+      */
+    function updateOrgStatusWithExpiry(string calldata _orgId, uint256 _action,
+        uint256 _expiryBlock, uint256 _expiryTime) external {
+        permImplementation.updateOrgStatusWithExpiry(_orgId, _action, _expiryBlock,
+            _expiryTime, msg.sender);
+    }
+
     /** @notice interface to approve org status change
       * @param _orgId unique id for the sub organization
       * @param _action 1 for suspending an org and 2 for revoke of suspension
@@ -171,6 +184,22 @@ contract PermissionsInterface {
         permImplementation.assignAdminRole(_orgId, _account, _roleId, msg.sender);
 
     }
+
+    /** @notice interface to assign network admin/org admin role to an account
+        which is valid till the expiry block or timestamp. this can be
+        executed by network admin accounts only
+      * @param _orgId unique id of the organization to which the account belongs
+      * @param _account account id
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function assignAdminRoleWithExpiry(string calldata _orgId, address _account,
+        string calldata _roleId, uint256 _expiryBlock, uint256 _expiryTime) external {
+        permImplementation.assignAdminRoleWithExpiry(_orgId, _account, _roleId,
+            _expiryBlock, _expiryTime, msg.sender);
+    }
+
     /** @notice interface to approve network admin/org admin role assigment
         this can be executed by network admin accounts only
       * @param _orgId unique id of the organization to which the account belongs
@@ -268,6 +297,73 @@ contract PermissionsInterface {
         return permImplementation.getPendingOp(_orgId);
     }
 
+    /** @notice interface to fetch the number of voting records for network
+        admin organization
+      * @param _orgId unique id of the organization
+      */
+    function getNumberOfPendingOps(string calldata _orgId) external view
+    returns (uint256) {
+        return permImplementation.getNumberOfPendingOps(_orgId);
+    }
+
+    /** @notice interface to fetch the voting record at the given index along
+        with the votes received and the votes required for approval
+      * @param _orgId unique id of the organization
+      * @param _index index of the voting record
+      */
+    function getPendingOpDetailsFromIndex(string calldata _orgId, uint256 _index) external view
+    returns (string memory, string memory, address, uint256, uint256, uint256) {
+        return permImplementation.getPendingOpDetailsFromIndex(_orgId, _index);
+    }
+
+    /** @notice interface to fetch the operation type and threshold proposed
+        by the pending threshold change at the given index
+      * @param _orgId unique id of the organization
+      * @param _index index of the voting record
+      */
+    function getThresholdProposal(string calldata _orgId, uint256 _index) external view
+    returns (uint256, uint256) {
+        return permImplementation.getThresholdProposal(_orgId, _index);
+    }
+
+    /** @notice interface to check if an operation is pending approval
+      * @param _authOrg org id of the authorizing org
+      * @param _orgId org id of the operation
+      * @param _enodeId enode id of the operation
+      * @param _account account id of the operation
+      * @param _pendingOp type of operation
+      */
+    function isPendingOp(string calldata _authOrg, string calldata _orgId,
+        string calldata _enodeId, address _account, uint256 _pendingOp) external view
+    returns (bool) {
+        return permImplementation.isPendingOp(_authOrg, _orgId, _enodeId, _account, _pendingOp);
+    }
+
+    /** @notice interface to propose an approval threshold for an operation
+        type. the threshold applies once approved by the network admins
+      * @param _opType operation type
+      * @param _threshold percentage of voters required for approval. 0 for
+        simple majority
+      */
+    function setVoteThreshold(uint256 _opType, uint256 _threshold) external {
+        permImplementation.setVoteThreshold(_opType, _threshold, msg.sender);
+    }
+
+    /** @notice interface to approve a proposed approval threshold
+      * @param _opType operation type
+      * @param _threshold proposed threshold
+      */
+    function approveVoteThreshold(uint256 _opType, uint256 _threshold) external {
+        permImplementation.approveVoteThreshold(_opType, _threshold, msg.sender);
+    }
+
+    /** @notice interface to fetch the approval threshold for an operation type
+      * @param _opType operation type
+      */
+    function getVoteThreshold(uint256 _opType) external view returns (uint256) {
+        return permImplementation.getVoteThreshold(_opType);
+    }
+
     /** @notice sets the permissions implementation contract address
         can be called from upgradable contract only
       * @param _permImplementation permissions implementation contract address
@@ -295,6 +391,20 @@ contract PermissionsInterface {
 
     }
 
+    /** @notice interface to assign a role to an account which is valid till
+        the expiry block or timestamp
+      * @param _account account id
+      * @param _orgId organization id to which the account belongs
+      * @param _roleId role id to be assigned to the account
+      * @param _expiryBlock block number after which the role is not valid
+      * @param _expiryTime block timestamp after which the role is not valid
+      */
+    function assignAccountRoleWithExpiry(address _account, string calldata _orgId,
+        string calldata _roleId, uint256 _expiryBlock, uint256 _expiryTime) external {
+        permImplementation.assignAccountRoleWithExpiry(_account, _orgId, _roleId,
+            _expiryBlock, _expiryTime, msg.sender);
+    }
+
     /** @notice interface to check if passed account is an network admin account
       * @param _account account id
       * @return true/false
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsInterface.sol";
 
@@ -19,7 +19,7 @@ contract PermissionsUpgradable {
     /** @notice constructor
       * @param _guardian account address
       */
-    constructor (address _guardian) public{
+    constructor (address _guardian) {
         guardian = _guardian;
         initDone = false;
     }
@@ -1,7 +1,18 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 
+// operation types subject to voting by the network admin accounts. these
+// values are mirrored by the PendingOpType constants in permission/core/types
+uint256 constant OP_NONE = 0;
+uint256 constant OP_ADD_ORG = 1;
+uint256 constant OP_SUSPEND_ORG = 2;
+uint256 constant OP_REVOKE_ORG_SUSPENSION = 3;
+uint256 constant OP_ASSIGN_ADMIN_ROLE = 4;
+uint256 constant OP_NODE_RECOVERY = 5;
+uint256 constant OP_ACCOUNT_RECOVERY = 6;
+uint256 constant OP_VOTE_THRESHOLD = 7;
+
 /** @title Voter manager contract
   * @notice This contract holds implementation logic for all account voter and
     voting functionality. This can be called only by the implementation
@@ -18,6 +29,20 @@ import "./PermissionsUpgradable.sol";
         4 - Assigning admin role for a new account
         5 - Blacklisted node recovery
         6 - Blacklisted account recovery
+        7 - Change of the approval threshold of an operation type
+    multiple operations can be pending approval at the same time. each
+    pending operation carries its own vote count and is approved once the
+    votes received reach the threshold configured for its operation type.
+    the threshold is a percentage of the active voters. when no threshold
+    is configured a simple majority is required
+  * @dev the storage layout differs from the single pending operation
+    layout of earlier releases, so an existing deployment cannot be
+    upgraded in place. to upgrade, deploy this contract along with a new
+    PermissionsImplementation referring to it, have the guardian call
+    confirmImplChange on PermissionsUpgradable and then migrateVoters on
+    the new implementation with the active network admin accounts.
+    operations pending approval in the old contract are not carried over
+    and must be raised again
   */
 contract VoterManager {
     PermissionsUpgradable private permUpgradable;
@@ -26,6 +51,12 @@ contract VoterManager {
         string enodeId;
         address account;
         uint256 opType;
+        uint256 voteCount;
+    }
+
+    struct ThresholdProposal {
+        uint256 opType;
+        uint256 threshold;
     }
 
     struct Voter {
@@ -37,8 +68,12 @@ contract VoterManager {
         string orgId;
         uint256 voterCount;
         uint256 validVoterCount;
-        uint256 voteCount;
-        PendingOpDetails pendingOp;
+        PendingOpDetails [] pendingOps;
+        mapping(bytes32 => uint256) pendingOpIndex;
+        // positions in pendingOps of the operations still pending approval
+        uint256 [] activeOps;
+        mapping(uint256 => uint256) activeOpIndex;
+        mapping(uint256 => ThresholdProposal) thresholdProposals;
         Voter [] voterList;
         mapping(address => uint256) voterIndex;
         mapping(uint256 => mapping(address => bool)) votingStatus;
@@ -48,12 +83,16 @@ contract VoterManager {
     mapping(bytes32 => uint256) private VoterOrgIndex;
     uint256 private orgNum = 0;
 
+    // approval threshold in percentage of active voters for each op type
+    mapping(uint256 => uint256) private voteThreshold;
+
     // events related to managing voting accounts for the org
     event VoterAdded(string _orgId, address _vAccount);
     event VoterDeleted(string _orgId, address _vAccount);
 
     event VotingItemAdded(string _orgId);
     event VoteProcessed(string _orgId);
+    event VoteThresholdSet(uint256 _opType, uint256 _threshold);
 
     /** @notice confirms that the caller is the address of implementation
         contract
@@ -75,7 +114,7 @@ contract VoterManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -91,15 +130,11 @@ contract VoterManager {
         if (VoterOrgIndex[keccak256(abi.encode(_orgId))] == 0) {
             orgNum++;
             VoterOrgIndex[keccak256(abi.encode(_orgId))] = orgNum;
-            uint256 id = orgVoterList.length++;
+            uint256 id = orgVoterList.length;
+            orgVoterList.push();
             orgVoterList[id].orgId = _orgId;
             orgVoterList[id].voterCount = 1;
             orgVoterList[id].validVoterCount = 1;
-            orgVoterList[id].voteCount = 0;
-            orgVoterList[id].pendingOp.orgId = "";
-            orgVoterList[id].pendingOp.enodeId = "";
-            orgVoterList[id].pendingOp.account = address(0);
-            orgVoterList[id].pendingOp.opType = 0;
             orgVoterList[id].voterIndex[_vAccount] = orgVoterList[id].voterCount;
             orgVoterList[id].voterList.push(Voter(_vAccount, true));
         }
@@ -149,62 +184,153 @@ contract VoterManager {
     function addVotingItem(string calldata _authOrg, string calldata _orgId,
         string calldata _enodeId, address _account, uint256 _pendingOp)
     external onlyImplementation {
-        // check if anything is pending approval for the org.
+        // check if the same operation is already pending approval.
         // If yes another item cannot be added
-        require((_checkPendingOp(_authOrg, 0)),
-            "items pending for approval. new item cannot be added");
-        uint256 id = _getVoterOrgIndex(_authOrg);
-        orgVoterList[id].pendingOp.orgId = _orgId;
-        orgVoterList[id].pendingOp.enodeId = _enodeId;
-        orgVoterList[id].pendingOp.account = _account;
-        orgVoterList[id].pendingOp.opType = _pendingOp;
-        // initialize vote status for voter accounts
-        for (uint256 i = 0; i < orgVoterList[id].voterList.length; i++) {
-            if (orgVoterList[id].voterList[i].active) {
-                orgVoterList[id].votingStatus[id][orgVoterList[id].voterList[i].vAccount] = false;
-            }
-        }
-        // set vote count to zero
-        orgVoterList[id].voteCount = 0;
+        require(_checkPendingOp(_authOrg, _orgId, _enodeId, _account, _pendingOp) == false,
+            "item pending for approval. new item cannot be added");
+        _addVotingItem(_getVoterOrgIndex(_authOrg), _getPendingOpKey(_orgId, _enodeId, _account, _pendingOp),
+            PendingOpDetails(_orgId, _enodeId, _account, _pendingOp, 0));
         emit VotingItemAdded(_authOrg);
+    }
 
+    /** @notice function to add a voting item for changing the approval
+        threshold of an operation type
+      * @param _authOrg org id of the authorizing org. it will be network admin org
+      * @param _opType - operation type whose threshold is being changed
+      * @param _threshold - proposed percentage of active voters required for
+        approval. 0 resets the threshold to simple majority
+      */
+    function addThresholdVotingItem(string calldata _authOrg, uint256 _opType,
+        uint256 _threshold) external onlyImplementation {
+        require(_opType >= OP_ADD_ORG && _opType <= OP_VOTE_THRESHOLD, "invalid operation type");
+        require(_threshold <= 100, "invalid threshold");
+        require(_checkThresholdPending(_authOrg, _opType, _threshold) == false,
+            "item pending for approval. new item cannot be added");
+        uint256 id = _getVoterOrgIndex(_authOrg);
+        uint256 opId = _addVotingItem(id, _getThresholdOpKey(_opType, _threshold),
+            PendingOpDetails("", "", address(0), OP_VOTE_THRESHOLD, 0));
+        orgVoterList[id].thresholdProposals[opId] = ThresholdProposal(_opType, _threshold);
+        emit VotingItemAdded(_authOrg);
     }
 
     /** @notice function processing vote of a voter account
       * @param _authOrg org id of the authorizing org. it will be network admin org
       * @param _vAccount - account id of the voter
+      * @param _orgId - org id of the operation being approved
+      * @param _enodeId - enode id of the operation being approved
+      * @param _account - account id of the operation being approved
       * @param _pendingOp - operation which is being approved
       * @return success of the voter process. either true or false
       */
-    function processVote(string calldata _authOrg, address _vAccount, uint256 _pendingOp)
+    function processVote(string calldata _authOrg, address _vAccount, string calldata _orgId,
+        string calldata _enodeId, address _account, uint256 _pendingOp)
     external onlyImplementation voterExists(_authOrg, _vAccount) returns (bool) {
         // check something if anything is pending approval
-        require(_checkPendingOp(_authOrg, _pendingOp) == true, "nothing to approve");
-        uint256 id = _getVoterOrgIndex(_authOrg);
-        // check if vote is already processed
-        require(orgVoterList[id].votingStatus[id][_vAccount] != true, "cannot double vote");
-        orgVoterList[id].voteCount++;
-        orgVoterList[id].votingStatus[id][_vAccount] = true;
+        require(_checkPendingOp(_authOrg, _orgId, _enodeId, _account, _pendingOp) == true, "nothing to approve");
+        bool approved = _processVote(_getVoterOrgIndex(_authOrg), _vAccount,
+            _getPendingOpKey(_orgId, _enodeId, _account, _pendingOp));
+        emit VoteProcessed(_authOrg);
+        return approved;
+    }
+
+    /** @notice function processing vote of a voter account on a change of
+        the approval threshold. the threshold is applied once approved
+      * @param _authOrg org id of the authorizing org. it will be network admin org
+      * @param _vAccount - account id of the voter
+      * @param _opType - operation type whose threshold is being changed
+      * @param _threshold - proposed threshold
+      * @return success of the voter process. either true or false
+      */
+    function processThresholdVote(string calldata _authOrg, address _vAccount,
+        uint256 _opType, uint256 _threshold)
+    external onlyImplementation voterExists(_authOrg, _vAccount) returns (bool) {
+        require(_checkThresholdPending(_authOrg, _opType, _threshold) == true, "nothing to approve");
+        bool approved = _processVote(_getVoterOrgIndex(_authOrg), _vAccount,
+            _getThresholdOpKey(_opType, _threshold));
         emit VoteProcessed(_authOrg);
-        if (orgVoterList[id].voteCount > orgVoterList[id].validVoterCount / 2) {
-            // majority achieved, clean up pending op
-            orgVoterList[id].pendingOp.orgId = "";
-            orgVoterList[id].pendingOp.enodeId = "";
-            orgVoterList[id].pendingOp.account = address(0);
-            orgVoterList[id].pendingOp.opType = 0;
-            return true;
+        if (approved) {
+            voteThreshold[_opType] = _threshold;
+            emit VoteThresholdSet(_opType, _threshold);
+        }
+        return approved;
+    }
+
+    /** @notice checks if the operation is pending approval
+      * @param _authOrg org id of the authorizing org
+      * @param _orgId org id of the operation
+      * @param _enodeId enode id of the operation
+      * @param _account account id of the operation
+      * @param _pendingOp type of operation
+      * @return true or false
+      */
+    function isPendingOp(string calldata _authOrg, string calldata _orgId,
+        string calldata _enodeId, address _account, uint256 _pendingOp)
+    external view returns (bool) {
+        if (VoterOrgIndex[keccak256(abi.encode(_authOrg))] == 0) {
+            return false;
         }
-        return false;
+        return _checkPendingOp(_authOrg, _orgId, _enodeId, _account, _pendingOp);
+    }
+
+    /** @notice returns the approval threshold for an operation type
+      * @param _opType - operation type
+      * @return threshold in percentage. 0 indicates simple majority
+      */
+    function getVoteThreshold(uint256 _opType) external view returns (uint256) {
+        return voteThreshold[_opType];
     }
 
-    /** @notice returns the details of any pending operation to be approved
+    /** @notice returns the details of the latest pending operation to be approved
       * @param _orgId org id. this will be the org id of network admin org
       */
     function getPendingOpDetails(string calldata _orgId) external view
     onlyImplementation returns (string memory, string memory, address, uint256){
         uint256 orgIndex = _getVoterOrgIndex(_orgId);
-        return (orgVoterList[orgIndex].pendingOp.orgId, orgVoterList[orgIndex].pendingOp.enodeId,
-        orgVoterList[orgIndex].pendingOp.account, orgVoterList[orgIndex].pendingOp.opType);
+        uint256 [] storage activeOps = orgVoterList[orgIndex].activeOps;
+        if (activeOps.length == 0) {
+            return ("", "", address(0), OP_NONE);
+        }
+        uint256 latest = activeOps[0];
+        for (uint256 i = 1; i < activeOps.length; i++) {
+            if (activeOps[i] > latest) {
+                latest = activeOps[i];
+            }
+        }
+        PendingOpDetails storage op = orgVoterList[orgIndex].pendingOps[latest];
+        return (op.orgId, op.enodeId, op.account, op.opType);
+    }
+
+    /** @notice returns the number of operations pending approval for the org
+      * @param _orgId org id. this will be the org id of network admin org
+      */
+    function getNumberOfPendingOps(string calldata _orgId) external view
+    onlyImplementation returns (uint256){
+        return orgVoterList[_getVoterOrgIndex(_orgId)].activeOps.length;
+    }
+
+    /** @notice returns the details of the pending operation at the given index
+        along with the votes received and the votes required for approval
+      * @param _orgId org id. this will be the org id of network admin org
+      * @param _index index of the pending operation
+      */
+    function getPendingOpDetailsFromIndex(string calldata _orgId, uint256 _index) external view
+    onlyImplementation returns (string memory, string memory, address, uint256, uint256, uint256){
+        uint256 orgIndex = _getVoterOrgIndex(_orgId);
+        PendingOpDetails storage op = orgVoterList[orgIndex].pendingOps[orgVoterList[orgIndex].activeOps[_index]];
+        return (op.orgId, op.enodeId, op.account, op.opType, op.voteCount,
+        _getRequiredVotes(orgIndex, op.opType));
+    }
+
+    /** @notice returns the operation type and threshold proposed by the
+        pending threshold change at the given index
+      * @param _orgId org id. this will be the org id of network admin org
+      * @param _index index of the pending operation
+      */
+    function getThresholdProposal(string calldata _orgId, uint256 _index) external view
+    onlyImplementation returns (uint256, uint256){
+        uint256 orgIndex = _getVoterOrgIndex(_orgId);
+        ThresholdProposal storage proposal = orgVoterList[orgIndex].thresholdProposals[orgVoterList[orgIndex].activeOps[_index]];
+        return (proposal.opType, proposal.threshold);
     }
 
     /** @notice checks if the voter account exists and is linked to the org
@@ -222,14 +348,98 @@ contract VoterManager {
         return orgVoterList[orgIndex].voterList[voterIndex].active;
     }
 
+    /** @notice records a new pending operation and returns its position
+      */
+    function _addVotingItem(uint256 _orgIndex, bytes32 _key, PendingOpDetails memory _op)
+    internal returns (uint256) {
+        OrgVoterDetails storage org = orgVoterList[_orgIndex];
+        uint256 opId = org.pendingOps.length;
+        org.pendingOps.push(_op);
+        org.pendingOpIndex[_key] = opId + 1;
+        org.activeOps.push(opId);
+        org.activeOpIndex[opId] = org.activeOps.length;
+        return opId;
+    }
+
+    /** @notice records the vote on the pending operation identified by the
+        key and clears the operation once the threshold is achieved
+      * @return true if the operation is approved
+      */
+    function _processVote(uint256 _orgIndex, address _vAccount, bytes32 _key)
+    internal returns (bool) {
+        OrgVoterDetails storage org = orgVoterList[_orgIndex];
+        uint256 opId = org.pendingOpIndex[_key] - 1;
+        // check if vote is already processed. votes are tracked by the
+        // position of the pending op which is never reused
+        require(org.votingStatus[opId][_vAccount] != true, "cannot double vote");
+        org.pendingOps[opId].voteCount++;
+        org.votingStatus[opId][_vAccount] = true;
+        if (org.pendingOps[opId].voteCount < _getRequiredVotes(_orgIndex, org.pendingOps[opId].opType)) {
+            return false;
+        }
+        // threshold achieved, clean up pending op
+        org.pendingOps[opId].opType = OP_NONE;
+        org.pendingOpIndex[_key] = 0;
+        uint256 pos = org.activeOpIndex[opId] - 1;
+        uint256 last = org.activeOps[org.activeOps.length - 1];
+        org.activeOps[pos] = last;
+        org.activeOpIndex[last] = pos + 1;
+        org.activeOps.pop();
+        org.activeOpIndex[opId] = 0;
+        return true;
+    }
+
     /** @notice checks if the pending operation exists or not
-      * @param _orgId org id
+      * @param _authOrg org id of the authorizing org
+      * @param _orgId org id of the operation
+      * @param _enodeId enode id of the operation
+      * @param _account account id of the operation
       * @param _pendingOp type of operation
       * @return true or false
       */
-    function _checkPendingOp(string memory _orgId, uint256 _pendingOp)
+    function _checkPendingOp(string memory _authOrg, string memory _orgId,
+        string memory _enodeId, address _account, uint256 _pendingOp)
     internal view returns (bool){
-        return (orgVoterList[_getVoterOrgIndex(_orgId)].pendingOp.opType == _pendingOp);
+        bytes32 key = _getPendingOpKey(_orgId, _enodeId, _account, _pendingOp);
+        return (orgVoterList[_getVoterOrgIndex(_authOrg)].pendingOpIndex[key] != 0);
+    }
+
+    /** @notice checks if the threshold change is pending approval
+      */
+    function _checkThresholdPending(string memory _authOrg, uint256 _opType,
+        uint256 _threshold) internal view returns (bool){
+        return (orgVoterList[_getVoterOrgIndex(_authOrg)].pendingOpIndex[_getThresholdOpKey(_opType, _threshold)] != 0);
+    }
+
+    /** @notice returns the number of votes required to approve an operation
+        as per the threshold configured for the operation type
+      */
+    function _getRequiredVotes(uint256 _orgIndex, uint256 _opType)
+    internal view returns (uint256) {
+        uint256 validVoters = orgVoterList[_orgIndex].validVoterCount;
+        uint256 threshold = voteThreshold[_opType];
+        if (threshold == 0) {
+            return validVoters / 2 + 1;
+        }
+        uint256 required = (validVoters * threshold + 99) / 100;
+        if (required == 0) {
+            return 1;
+        }
+        return required;
+    }
+
+    /** @notice returns the key identifying a pending operation
+      */
+    function _getPendingOpKey(string memory _orgId, string memory _enodeId,
+        address _account, uint256 _pendingOp) internal pure returns (bytes32) {
+        return keccak256(abi.encode(_orgId, _enodeId, _account, _pendingOp));
+    }
+
+    /** @notice returns the key identifying a pending threshold change
+      */
+    function _getThresholdOpKey(uint256 _opType, uint256 _threshold)
+    internal pure returns (bytes32) {
+        return keccak256(abi.encode(OP_VOTE_THRESHOLD, _opType, _threshold));
     }
 
     /** @notice returns the voter account index
@@ -237,14 +447,18 @@ contract VoterManager {
     function _getVoterIndex(string memory _orgId, address _vAccount)
     internal view returns (uint256) {
         uint256 orgIndex = _getVoterOrgIndex(_orgId);
-        return orgVoterList[orgIndex].voterIndex[_vAccount] - 1;
+        unchecked {
+            return orgVoterList[orgIndex].voterIndex[_vAccount] - 1;
+        }
     }
 
     /** @notice returns the org index for the org from voter list
       */
     function _getVoterOrgIndex(string memory _orgId)
     internal view returns (uint256) {
-        return VoterOrgIndex[keccak256(abi.encode(_orgId))] - 1;
+        unchecked {
+            return VoterOrgIndex[keccak256(abi.encode(_orgId))] - 1;
+        }
     }
 
 }
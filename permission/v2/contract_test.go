@@ -0,0 +1,294 @@
+package v2
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	binding "github.com/ethereum/go-ethereum/permission/v2/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNwAdminOrg   = "NWADMIN"
+	testNwAdminRole  = "NWADMIN"
+	testOrgAdminRole = "OADMIN"
+	testOrg          = "ORG1"
+	testRole         = "TXROLE"
+	testNode         = "enode://ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef@127.0.0.1:21000?discport=0&raftport=50401"
+)
+
+type testNetwork struct {
+	t       *testing.T
+	sim     *backends.SimulatedBackend
+	key     *ecdsa.PrivateKey
+	auth    *bind.TransactOpts
+	backend ptype.ContractBackend
+	init    *Init
+}
+
+// newTestNetwork deploys the v2 contracts on a simulated chain and boots the
+// network with the deployer as the only network admin account
+func newTestNetwork(t *testing.T) *testNetwork {
+	key, _ := crypto.GenerateKey()
+	auth := bind.NewKeyedTransactor(key)
+	sim := backends.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: big.NewInt(1000000000000000000)}}, 100000000)
+	n := &testNetwork{t: t, sim: sim, key: key, auth: auth}
+
+	upgrAddr, tx, upgr, err := binding.DeployPermUpgr(auth, sim, auth.From)
+	n.commit(tx, err)
+	interfAddr, tx, _, err := binding.DeployPermInterface(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	nodeAddr, tx, _, err := binding.DeployNodeManager(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	roleAddr, tx, _, err := binding.DeployRoleManager(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	acctAddr, tx, _, err := binding.DeployAcctManager(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	orgAddr, tx, _, err := binding.DeployOrgManager(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	voterAddr, tx, _, err := binding.DeployVoterManager(auth, sim, upgrAddr)
+	n.commit(tx, err)
+	implAddr, tx, _, err := binding.DeployPermImpl(auth, sim, upgrAddr, orgAddr, roleAddr, acctAddr, voterAddr, nodeAddr)
+	n.commit(tx, err)
+	tx, err = upgr.Init(auth, interfAddr, implAddr)
+	n.commit(tx, err)
+
+	n.backend = ptype.ContractBackend{
+		EthClnt: sim,
+		Key:     key,
+		PermConfig: &ptype.PermissionConfig{
+			UpgrdAddress:   upgrAddr,
+			InterfAddress:  interfAddr,
+			ImplAddress:    implAddr,
+			NodeAddress:    nodeAddr,
+			AccountAddress: acctAddr,
+			RoleAddress:    roleAddr,
+			VoterAddress:   voterAddr,
+			OrgAddress:     orgAddr,
+		},
+	}
+	n.init = &Init{Backend: n.backend}
+	require.NoError(t, n.init.BindContracts())
+
+	tx, err = n.init.SetPolicy(testNwAdminOrg, testNwAdminRole, testOrgAdminRole)
+	n.commit(tx, err)
+	tx, err = n.init.Init(big.NewInt(4), big.NewInt(4))
+	n.commit(tx, err)
+	tx, err = n.init.AddAdminAccount(auth.From)
+	n.commit(tx, err)
+	tx, err = n.init.UpdateNetworkBootStatus()
+	n.commit(tx, err)
+	return n
+}
+
+// commit mines the pending transaction, failing the test if it could not be
+// sent or was reverted
+func (n *testNetwork) commit(tx *types.Transaction, err error) {
+	require.NoError(n.t, err)
+	n.sim.Commit()
+	receipt, err := n.sim.TransactionReceipt(context.Background(), tx.Hash())
+	require.NoError(n.t, err)
+	require.Equal(n.t, types.ReceiptStatusSuccessful, receipt.Status, "transaction reverted")
+}
+
+func (n *testNetwork) blockNumber() uint64 {
+	return n.sim.Blockchain().CurrentBlock().NumberU64()
+}
+
+func (n *testNetwork) head() *big.Int {
+	return new(big.Int).SetUint64(n.blockNumber())
+}
+
+// transactionAllowed checks a contract deployment by acct as of the given
+// block, or against the pending state if block is nil
+func (n *testNetwork) transactionAllowed(acct common.Address, block *big.Int) error {
+	cs, err := (&Backend{}).GetControlService(n.backend)
+	require.NoError(n.t, err)
+	return cs.TransactionAllowed(acct, common.Address{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, pcore.ContractDeployTxn, block)
+}
+
+func TestAccountRoleExpiry(t *testing.T) {
+	n := newTestNetwork(t)
+	as, err := (&Backend{}).GetAccountService(n.auth, n.backend)
+	require.NoError(t, err)
+	rs, err := (&Backend{}).GetRoleService(n.auth, n.backend)
+	require.NoError(t, err)
+
+	tx, err := rs.AddNewRole(ptype.TxArgs{RoleId: testRole, OrgId: testNwAdminOrg, AccessType: uint8(pcore.FullAccess)})
+	n.commit(tx, err)
+
+	acct := common.HexToAddress("0x0000000000000000000000000000000000009999")
+	expiry := pcore.AccessExpiry{Block: n.blockNumber() + 3}
+	tx, err = as.AssignAccountRole(ptype.TxArgs{AcctId: acct, OrgId: testNwAdminOrg, RoleId: testRole, Expiry: expiry})
+	n.commit(tx, err)
+
+	got, err := n.init.GetAccountExpiry(acct)
+	require.NoError(t, err)
+	assert.Equal(t, expiry, got)
+	assert.NoError(t, n.transactionAllowed(acct, nil))
+
+	for n.blockNumber() < expiry.Block {
+		n.sim.Commit()
+	}
+	// the role is valid up to and including the expiry block, so it is
+	// still usable as of the head but no longer in the pending block
+	assert.NoError(t, n.transactionAllowed(acct, n.head()))
+	assert.Equal(t, ptype.ErrNoPermissionForTxn, n.transactionAllowed(acct, nil))
+
+	n.sim.Commit()
+	assert.Equal(t, ptype.ErrNoPermissionForTxn, n.transactionAllowed(acct, n.head()))
+}
+
+func TestOrgSuspensionExpiry(t *testing.T) {
+	n := newTestNetwork(t)
+	orgs, err := (&Backend{}).GetOrgService(n.auth, n.backend)
+	require.NoError(t, err)
+
+	orgAdmin := common.HexToAddress("0x0000000000000000000000000000000000008888")
+	tx, err := orgs.AddOrg(ptype.TxArgs{OrgId: testOrg, Url: testNode, AcctId: orgAdmin})
+	n.commit(tx, err)
+	tx, err = orgs.ApproveOrg(ptype.TxArgs{OrgId: testOrg, Url: testNode, AcctId: orgAdmin})
+	n.commit(tx, err)
+	assert.NoError(t, n.transactionAllowed(orgAdmin, nil))
+
+	expiry := pcore.AccessExpiry{Block: n.blockNumber() + 4}
+	tx, err = orgs.UpdateOrgStatus(ptype.TxArgs{OrgId: testOrg, Action: 1, Expiry: expiry})
+	n.commit(tx, err)
+	tx, err = orgs.ApproveOrgStatus(ptype.TxArgs{OrgId: testOrg, Action: 1})
+	n.commit(tx, err)
+
+	got, err := n.init.GetSuspensionExpiry(testOrg)
+	require.NoError(t, err)
+	assert.Equal(t, expiry, got)
+	assert.Equal(t, ptype.ErrNoPermissionForTxn, n.transactionAllowed(orgAdmin, nil))
+
+	for n.blockNumber() < expiry.Block {
+		n.sim.Commit()
+	}
+	// the suspension holds up to and including the expiry block
+	assert.Equal(t, ptype.ErrNoPermissionForTxn, n.transactionAllowed(orgAdmin, n.head()))
+	assert.NoError(t, n.transactionAllowed(orgAdmin, nil))
+
+	n.sim.Commit()
+	assert.NoError(t, n.transactionAllowed(orgAdmin, n.head()))
+}
+
+// thresholdOps returns the pending threshold changes of the network admin org
+func (n *testNetwork) thresholdOps() []ptype.PendingOp {
+	audit, err := (&Backend{}).GetAuditService(n.backend)
+	require.NoError(n.t, err)
+	ops, err := audit.(ptype.VotingService).GetPendingOps(testNwAdminOrg)
+	require.NoError(n.t, err)
+	var res []ptype.PendingOp
+	for _, op := range ops {
+		if op.OpType == ptype.PendingOpVoteThreshold {
+			res = append(res, op)
+		}
+	}
+	return res
+}
+
+func (n *testNetwork) voteThreshold(opType int64) uint8 {
+	audit, err := (&Backend{}).GetAuditService(n.backend)
+	require.NoError(n.t, err)
+	threshold, err := audit.(ptype.VotingService).GetVoteThreshold(uint8(opType))
+	require.NoError(n.t, err)
+	return threshold
+}
+
+func TestVoteThreshold_needsApproval(t *testing.T) {
+	n := newTestNetwork(t)
+	orgs, err := (&Backend{}).GetOrgService(n.auth, n.backend)
+	require.NoError(t, err)
+	ts := orgs.(ptype.VoteThresholdService)
+
+	args := ptype.TxArgs{Action: uint8(ptype.PendingOpAddOrg), Threshold: 75}
+	tx, err := ts.SetVoteThreshold(args)
+	n.commit(tx, err)
+
+	// the proposal is listed but does not apply until approved
+	ops := n.thresholdOps()
+	require.Len(t, ops, 1)
+	assert.Equal(t, ptype.PendingOpAddOrg, ops[0].TargetOpType)
+	assert.Equal(t, uint8(75), ops[0].Threshold)
+	assert.Equal(t, uint8(0), n.voteThreshold(ptype.PendingOpAddOrg))
+
+	tx, err = ts.ApproveVoteThreshold(args)
+	n.commit(tx, err)
+	assert.Equal(t, uint8(75), n.voteThreshold(ptype.PendingOpAddOrg))
+	assert.Empty(t, n.thresholdOps())
+}
+
+func TestPendingOps_listsActiveOpsOnly(t *testing.T) {
+	n := newTestNetwork(t)
+	orgs, err := (&Backend{}).GetOrgService(n.auth, n.backend)
+	require.NoError(t, err)
+	audit, err := (&Backend{}).GetAuditService(n.backend)
+	require.NoError(t, err)
+
+	orgAdmin := common.HexToAddress("0x0000000000000000000000000000000000008888")
+	tx, err := orgs.AddOrg(ptype.TxArgs{OrgId: testOrg, Url: testNode, AcctId: orgAdmin})
+	n.commit(tx, err)
+	assert.True(t, audit.CheckPendingOp(testNwAdminOrg, testOrg, testNode, orgAdmin, ptype.PendingOpAddOrg))
+	assert.False(t, audit.CheckPendingOp(testNwAdminOrg, testOrg, testNode, orgAdmin, ptype.PendingOpSuspendOrg))
+
+	ops, err := audit.(ptype.VotingService).GetPendingOps(testNwAdminOrg)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, ptype.PendingOpAddOrg, ops[0].OpType)
+
+	tx, err = orgs.ApproveOrg(ptype.TxArgs{OrgId: testOrg, Url: testNode, AcctId: orgAdmin})
+	n.commit(tx, err)
+	assert.False(t, audit.CheckPendingOp(testNwAdminOrg, testOrg, testNode, orgAdmin, ptype.PendingOpAddOrg))
+	ops, err = audit.(ptype.VotingService).GetPendingOps(testNwAdminOrg)
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+}
+
+func TestMigrateVoters(t *testing.T) {
+	n := newTestNetwork(t)
+	cfg := n.backend.PermConfig
+
+	// upgrade to a fresh voter manager as done when the storage layout of
+	// the voter manager changes
+	voterAddr, tx, _, err := binding.DeployVoterManager(n.auth, n.sim, cfg.UpgrdAddress)
+	n.commit(tx, err)
+	implAddr, tx, impl, err := binding.DeployPermImpl(n.auth, n.sim, cfg.UpgrdAddress, cfg.OrgAddress, cfg.RoleAddress, cfg.AccountAddress, voterAddr, cfg.NodeAddress)
+	n.commit(tx, err)
+	tx, err = n.init.PermUpgr.ConfirmImplChange(n.auth, implAddr)
+	n.commit(tx, err)
+
+	// only active network admin accounts can be migrated
+	_, err = impl.MigrateVoters(n.auth, []common.Address{common.HexToAddress("0x0000000000000000000000000000000000009999")})
+	assert.Error(t, err)
+
+	// only the guardian can migrate voters
+	key, _ := crypto.GenerateKey()
+	_, err = impl.MigrateVoters(bind.NewKeyedTransactor(key), []common.Address{n.auth.From})
+	assert.Error(t, err)
+
+	tx, err = impl.MigrateVoters(n.auth, []common.Address{n.auth.From})
+	n.commit(tx, err)
+
+	// the migrated voter can vote on the new voter manager
+	orgs, err := (&Backend{}).GetOrgService(n.auth, n.backend)
+	require.NoError(t, err)
+	ts := orgs.(ptype.VoteThresholdService)
+	args := ptype.TxArgs{Action: uint8(ptype.PendingOpAddOrg), Threshold: 60}
+	tx, err = ts.SetVoteThreshold(args)
+	n.commit(tx, err)
+	tx, err = ts.ApproveVoteThreshold(args)
+	n.commit(tx, err)
+	assert.Equal(t, uint8(60), n.voteThreshold(ptype.PendingOpAddOrg))
+}
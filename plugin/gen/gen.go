@@ -11,9 +11,11 @@
 
 // generate stubs
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --go_out=plugins=grpc:proto_common init.proto
+//go:generate protoc -I . --go_out=plugins=grpc,paths=source_relative:proto_permission permission.proto
 
 // generate mocks for unit testing
 //go:generate mockgen -package proto_common -destination proto_common/mock_init.go -source proto_common/init.pb.go
+//go:generate mockgen -package proto_permission -destination proto_permission/mock_permission.go -source proto_permission/permission.pb.go
 
 // fix fmt
 //go:generate goimports -w ./
@@ -23,5 +25,6 @@
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --doc_out=docs.markdown.tmpl,interface.md:../../docs/PluggableArchitecture/Plugins/helloworld/ helloworld.proto
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --doc_out=docs.markdown.tmpl,interface.md:../../docs/PluggableArchitecture/Plugins/security/ security.proto
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --doc_out=docs.markdown.tmpl,interface.md:../../docs/PluggableArchitecture/Plugins/account/ account.proto
+//go:generate protoc -I . --doc_out=docs.markdown.tmpl,interface.md:../../docs/PluggableArchitecture/Plugins/permission/ permission.proto
 
 package gen
@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: permission.proto
+
+package proto_permission
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// *
+// Type of the transaction being checked
+type TransactionType int32
+
+const (
+	TransactionType_VALUE_TRANSFER  TransactionType = 0
+	TransactionType_CONTRACT_CALL   TransactionType = 1
+	TransactionType_CONTRACT_DEPLOY TransactionType = 2
+)
+
+var TransactionType_name = map[int32]string{
+	0: "VALUE_TRANSFER",
+	1: "CONTRACT_CALL",
+	2: "CONTRACT_DEPLOY",
+}
+
+var TransactionType_value = map[string]int32{
+	"VALUE_TRANSFER":  0,
+	"CONTRACT_CALL":   1,
+	"CONTRACT_DEPLOY": 2,
+}
+
+func (x TransactionType) String() string {
+	return proto.EnumName(TransactionType_name, int32(x))
+}
+
+func (TransactionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{0}
+}
+
+// *
+// A wrapper message to logically group transaction permission messages
+type TransactionPermission struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionPermission) Reset()         { *m = TransactionPermission{} }
+func (m *TransactionPermission) String() string { return proto.CompactTextString(m) }
+func (*TransactionPermission) ProtoMessage()    {}
+func (*TransactionPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{0}
+}
+
+func (m *TransactionPermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionPermission.Unmarshal(m, b)
+}
+func (m *TransactionPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionPermission.Marshal(b, m, deterministic)
+}
+func (m *TransactionPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionPermission.Merge(m, src)
+}
+func (m *TransactionPermission) XXX_Size() int {
+	return xxx_messageInfo_TransactionPermission.Size(m)
+}
+func (m *TransactionPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionPermission proto.InternalMessageInfo
+
+type TransactionPermission_Request struct {
+	// 20-byte sender address
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 20-byte recipient address, zero address for contract deployment
+	To []byte `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// big-endian encoded value
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// big-endian encoded gas price
+	GasPrice []byte `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	// big-endian encoded gas limit
+	GasLimit []byte `protobuf:"bytes,5,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	// transaction data
+	Payload              []byte          `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	TransactionType      TransactionType `protobuf:"varint,7,opt,name=transactionType,proto3,enum=proto_permission.TransactionType" json:"transactionType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TransactionPermission_Request) Reset()         { *m = TransactionPermission_Request{} }
+func (m *TransactionPermission_Request) String() string { return proto.CompactTextString(m) }
+func (*TransactionPermission_Request) ProtoMessage()    {}
+func (*TransactionPermission_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{0, 0}
+}
+
+func (m *TransactionPermission_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionPermission_Request.Unmarshal(m, b)
+}
+func (m *TransactionPermission_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionPermission_Request.Marshal(b, m, deterministic)
+}
+func (m *TransactionPermission_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionPermission_Request.Merge(m, src)
+}
+func (m *TransactionPermission_Request) XXX_Size() int {
+	return xxx_messageInfo_TransactionPermission_Request.Size(m)
+}
+func (m *TransactionPermission_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionPermission_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionPermission_Request proto.InternalMessageInfo
+
+func (m *TransactionPermission_Request) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetTo() []byte {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetGasPrice() []byte {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetGasLimit() []byte {
+	if m != nil {
+		return m.GasLimit
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *TransactionPermission_Request) GetTransactionType() TransactionType {
+	if m != nil {
+		return m.TransactionType
+	}
+	return TransactionType_VALUE_TRANSFER
+}
+
+// *
+// A wrapper message to logically group node permission messages for
+// transaction submission
+type TransactionNodePermission struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionNodePermission) Reset()         { *m = TransactionNodePermission{} }
+func (m *TransactionNodePermission) String() string { return proto.CompactTextString(m) }
+func (*TransactionNodePermission) ProtoMessage()    {}
+func (*TransactionNodePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{1}
+}
+
+func (m *TransactionNodePermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNodePermission.Unmarshal(m, b)
+}
+func (m *TransactionNodePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNodePermission.Marshal(b, m, deterministic)
+}
+func (m *TransactionNodePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNodePermission.Merge(m, src)
+}
+func (m *TransactionNodePermission) XXX_Size() int {
+	return xxx_messageInfo_TransactionNodePermission.Size(m)
+}
+func (m *TransactionNodePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNodePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNodePermission proto.InternalMessageInfo
+
+type TransactionNodePermission_Request struct {
+	// 20-byte sender address
+	From []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// hex encoded id of the node via which the transaction is submitted
+	NodeId               string   `protobuf:"bytes,2,opt,name=nodeId,proto3" json:"nodeId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionNodePermission_Request) Reset()         { *m = TransactionNodePermission_Request{} }
+func (m *TransactionNodePermission_Request) String() string { return proto.CompactTextString(m) }
+func (*TransactionNodePermission_Request) ProtoMessage()    {}
+func (*TransactionNodePermission_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{1, 0}
+}
+
+func (m *TransactionNodePermission_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNodePermission_Request.Unmarshal(m, b)
+}
+func (m *TransactionNodePermission_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNodePermission_Request.Marshal(b, m, deterministic)
+}
+func (m *TransactionNodePermission_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNodePermission_Request.Merge(m, src)
+}
+func (m *TransactionNodePermission_Request) XXX_Size() int {
+	return xxx_messageInfo_TransactionNodePermission_Request.Size(m)
+}
+func (m *TransactionNodePermission_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNodePermission_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNodePermission_Request proto.InternalMessageInfo
+
+func (m *TransactionNodePermission_Request) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *TransactionNodePermission_Request) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+// *
+// A wrapper message to logically group node connection permission messages
+type NodePermission struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodePermission) Reset()         { *m = NodePermission{} }
+func (m *NodePermission) String() string { return proto.CompactTextString(m) }
+func (*NodePermission) ProtoMessage()    {}
+func (*NodePermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{2}
+}
+
+func (m *NodePermission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePermission.Unmarshal(m, b)
+}
+func (m *NodePermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePermission.Marshal(b, m, deterministic)
+}
+func (m *NodePermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePermission.Merge(m, src)
+}
+func (m *NodePermission) XXX_Size() int {
+	return xxx_messageInfo_NodePermission.Size(m)
+}
+func (m *NodePermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePermission proto.InternalMessageInfo
+
+type NodePermission_Request struct {
+	// enode url of the connecting node
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// INCOMING or OUTGOING
+	Direction            string   `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodePermission_Request) Reset()         { *m = NodePermission_Request{} }
+func (m *NodePermission_Request) String() string { return proto.CompactTextString(m) }
+func (*NodePermission_Request) ProtoMessage()    {}
+func (*NodePermission_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{2, 0}
+}
+
+func (m *NodePermission_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodePermission_Request.Unmarshal(m, b)
+}
+func (m *NodePermission_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodePermission_Request.Marshal(b, m, deterministic)
+}
+func (m *NodePermission_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodePermission_Request.Merge(m, src)
+}
+func (m *NodePermission_Request) XXX_Size() int {
+	return xxx_messageInfo_NodePermission_Request.Size(m)
+}
+func (m *NodePermission_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodePermission_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodePermission_Request proto.InternalMessageInfo
+
+func (m *NodePermission_Request) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *NodePermission_Request) GetDirection() string {
+	if m != nil {
+		return m.Direction
+	}
+	return ""
+}
+
+// *
+// Decision returned by the plugin
+type PermissionDecision struct {
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// optional explanation when the request is denied
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PermissionDecision) Reset()         { *m = PermissionDecision{} }
+func (m *PermissionDecision) String() string { return proto.CompactTextString(m) }
+func (*PermissionDecision) ProtoMessage()    {}
+func (*PermissionDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c837ef01cbda0ad8, []int{3}
+}
+
+func (m *PermissionDecision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PermissionDecision.Unmarshal(m, b)
+}
+func (m *PermissionDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PermissionDecision.Marshal(b, m, deterministic)
+}
+func (m *PermissionDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PermissionDecision.Merge(m, src)
+}
+func (m *PermissionDecision) XXX_Size() int {
+	return xxx_messageInfo_PermissionDecision.Size(m)
+}
+func (m *PermissionDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PermissionDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PermissionDecision proto.InternalMessageInfo
+
+func (m *PermissionDecision) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *PermissionDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("proto_permission.TransactionType", TransactionType_name, TransactionType_value)
+	proto.RegisterType((*TransactionPermission)(nil), "proto_permission.TransactionPermission")
+	proto.RegisterType((*TransactionPermission_Request)(nil), "proto_permission.TransactionPermission.Request")
+	proto.RegisterType((*TransactionNodePermission)(nil), "proto_permission.TransactionNodePermission")
+	proto.RegisterType((*TransactionNodePermission_Request)(nil), "proto_permission.TransactionNodePermission.Request")
+	proto.RegisterType((*NodePermission)(nil), "proto_permission.NodePermission")
+	proto.RegisterType((*NodePermission_Request)(nil), "proto_permission.NodePermission.Request")
+	proto.RegisterType((*PermissionDecision)(nil), "proto_permission.PermissionDecision")
+}
+
+func init() {
+	proto.RegisterFile("permission.proto", fileDescriptor_c837ef01cbda0ad8)
+}
+
+var fileDescriptor_c837ef01cbda0ad8 = []byte{
+	// 463 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0xc4, 0x6e, 0x9b, 0x34, 0x4f, 0x90, 0xb8, 0x4b, 0x41, 0x26, 0x70, 0x28, 0x11, 0x87, 0x08,
+	0x09, 0x5b, 0x6a, 0xc5, 0x01, 0x71, 0x32, 0x69, 0x22, 0x45, 0x35, 0x69, 0xb4, 0x35, 0x48, 0x70,
+	0x89, 0xdc, 0xf8, 0xe1, 0xae, 0x64, 0x7b, 0xcd, 0x7a, 0x5d, 0xe8, 0xef, 0xf6, 0x3b, 0x38, 0x20,
+	0x6f, 0x5d, 0xdb, 0x75, 0x51, 0x24, 0xd4, 0x93, 0x77, 0x66, 0x76, 0x67, 0x9e, 0xe7, 0x81, 0x91,
+	0xa2, 0x88, 0x59, 0x96, 0x31, 0x9e, 0x58, 0xa9, 0xe0, 0x92, 0x13, 0x43, 0x7d, 0x56, 0x35, 0x3f,
+	0xfa, 0xa3, 0xc1, 0x33, 0x4f, 0xf8, 0x49, 0xe6, 0xaf, 0x25, 0xe3, 0xc9, 0xb2, 0x52, 0x86, 0xd7,
+	0x1a, 0x74, 0x29, 0xfe, 0xcc, 0x31, 0x93, 0x84, 0xc0, 0xf6, 0x0f, 0xc1, 0x63, 0x53, 0x3b, 0xd0,
+	0xc6, 0x8f, 0xa9, 0x3a, 0x93, 0x3e, 0xe8, 0x92, 0x9b, 0xba, 0x62, 0x74, 0xc9, 0xc9, 0x3e, 0xec,
+	0x5c, 0xfa, 0x51, 0x8e, 0xe6, 0x96, 0xa2, 0x6e, 0x00, 0x19, 0xc2, 0x6e, 0xe8, 0x67, 0x4b, 0xc1,
+	0xd6, 0x68, 0x6e, 0x2b, 0xa1, 0xc2, 0xa5, 0xe6, 0xb2, 0x98, 0x49, 0x73, 0xa7, 0xd2, 0x14, 0x26,
+	0x26, 0x74, 0x53, 0xff, 0x2a, 0xe2, 0x7e, 0x60, 0x76, 0x94, 0x74, 0x0b, 0xc9, 0x09, 0x0c, 0x64,
+	0x3d, 0xb0, 0x77, 0x95, 0xa2, 0xd9, 0x3d, 0xd0, 0xc6, 0xfd, 0xc3, 0xd7, 0x56, 0xfb, 0xef, 0x2c,
+	0xef, 0xee, 0x45, 0xda, 0x7e, 0x39, 0xa2, 0xf0, 0xa2, 0x71, 0x67, 0xc1, 0x03, 0x6c, 0x34, 0xf0,
+	0x7e, 0x73, 0x01, 0xcf, 0xa1, 0x93, 0xf0, 0x00, 0xe7, 0x81, 0x2a, 0xa1, 0x47, 0x4b, 0x34, 0x3a,
+	0x81, 0x7e, 0xcb, 0xe8, 0x43, 0x6d, 0x64, 0xc0, 0x56, 0x2e, 0x22, 0xe5, 0xd3, 0xa3, 0xc5, 0x91,
+	0xbc, 0x82, 0x5e, 0xc0, 0x04, 0xaa, 0x01, 0x4a, 0xa7, 0x9a, 0x18, 0xcd, 0x80, 0xd4, 0x46, 0xc7,
+	0xb8, 0x66, 0xc5, 0xb7, 0x68, 0xc7, 0x8f, 0x22, 0xfe, 0x0b, 0x03, 0xe5, 0xb4, 0x4b, 0x6f, 0x61,
+	0x31, 0x94, 0x40, 0x3f, 0xab, 0xac, 0x4a, 0xf4, 0xf6, 0x33, 0x0c, 0x5a, 0x65, 0x10, 0x02, 0xfd,
+	0xaf, 0x8e, 0xfb, 0x65, 0xba, 0xf2, 0xa8, 0xb3, 0x38, 0x9b, 0x4d, 0xa9, 0xf1, 0x88, 0xec, 0xc1,
+	0x93, 0xc9, 0xe9, 0xc2, 0xa3, 0xce, 0xc4, 0x5b, 0x4d, 0x1c, 0xd7, 0x35, 0x34, 0xf2, 0x14, 0x06,
+	0x15, 0x75, 0x3c, 0x5d, 0xba, 0xa7, 0xdf, 0x0c, 0xfd, 0xf0, 0x5a, 0x87, 0xbd, 0x7a, 0xae, 0x33,
+	0x14, 0x97, 0xc5, 0x42, 0x63, 0xd8, 0x9f, 0x67, 0x8d, 0x18, 0xa7, 0x1c, 0xca, 0xde, 0xb8, 0x99,
+	0xda, 0xc7, 0x2a, 0x5b, 0x1a, 0xbe, 0xb9, 0xff, 0xe0, 0x1f, 0x2d, 0xfc, 0x86, 0x97, 0xf3, 0xac,
+	0xa8, 0xba, 0xcc, 0x99, 0x71, 0xd1, 0xb0, 0x25, 0x47, 0x1b, 0x53, 0xef, 0xae, 0xe8, 0x3f, 0x93,
+	0x03, 0x20, 0x37, 0xc9, 0xb5, 0x86, 0x01, 0x19, 0xdf, 0x7f, 0xfb, 0x90, 0x94, 0x4f, 0x8b, 0xef,
+	0x6e, 0xc8, 0xe4, 0x45, 0x7e, 0x6e, 0xad, 0x79, 0x6c, 0xa3, 0xbc, 0x40, 0x81, 0x79, 0x6c, 0x87,
+	0xfc, 0x5d, 0x75, 0x4e, 0xa3, 0x3c, 0x64, 0x89, 0x1d, 0x62, 0x62, 0xb7, 0x1d, 0x3f, 0xb6, 0x89,
+	0xf3, 0x8e, 0x62, 0x8e, 0xfe, 0x0e, 0x00, 0xb3, 0x45, 0xb1, 0x4e, 0x18, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// PermissionServiceClient is the client API for PermissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PermissionServiceClient interface {
+	// Check if the account is allowed to execute the transaction
+	IsTransactionAllowed(ctx context.Context, in *TransactionPermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error)
+	// Check if the account is allowed to submit transactions via the node
+	IsNodeAllowedForTransaction(ctx context.Context, in *TransactionNodePermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error)
+	// Check if the node is allowed to connect
+	IsNodePermissioned(ctx context.Context, in *NodePermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error)
+}
+
+type permissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPermissionServiceClient(cc grpc.ClientConnInterface) PermissionServiceClient {
+	return &permissionServiceClient{cc}
+}
+
+func (c *permissionServiceClient) IsTransactionAllowed(ctx context.Context, in *TransactionPermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error) {
+	out := new(PermissionDecision)
+	err := c.cc.Invoke(ctx, "/proto_permission.PermissionService/IsTransactionAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) IsNodeAllowedForTransaction(ctx context.Context, in *TransactionNodePermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error) {
+	out := new(PermissionDecision)
+	err := c.cc.Invoke(ctx, "/proto_permission.PermissionService/IsNodeAllowedForTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *permissionServiceClient) IsNodePermissioned(ctx context.Context, in *NodePermission_Request, opts ...grpc.CallOption) (*PermissionDecision, error) {
+	out := new(PermissionDecision)
+	err := c.cc.Invoke(ctx, "/proto_permission.PermissionService/IsNodePermissioned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PermissionServiceServer is the server API for PermissionService service.
+type PermissionServiceServer interface {
+	// Check if the account is allowed to execute the transaction
+	IsTransactionAllowed(context.Context, *TransactionPermission_Request) (*PermissionDecision, error)
+	// Check if the account is allowed to submit transactions via the node
+	IsNodeAllowedForTransaction(context.Context, *TransactionNodePermission_Request) (*PermissionDecision, error)
+	// Check if the node is allowed to connect
+	IsNodePermissioned(context.Context, *NodePermission_Request) (*PermissionDecision, error)
+}
+
+// UnimplementedPermissionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPermissionServiceServer struct {
+}
+
+func (*UnimplementedPermissionServiceServer) IsTransactionAllowed(ctx context.Context, req *TransactionPermission_Request) (*PermissionDecision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsTransactionAllowed not implemented")
+}
+func (*UnimplementedPermissionServiceServer) IsNodeAllowedForTransaction(ctx context.Context, req *TransactionNodePermission_Request) (*PermissionDecision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsNodeAllowedForTransaction not implemented")
+}
+func (*UnimplementedPermissionServiceServer) IsNodePermissioned(ctx context.Context, req *NodePermission_Request) (*PermissionDecision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsNodePermissioned not implemented")
+}
+
+func RegisterPermissionServiceServer(s *grpc.Server, srv PermissionServiceServer) {
+	s.RegisterService(&_PermissionService_serviceDesc, srv)
+}
+
+func _PermissionService_IsTransactionAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionPermission_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).IsTransactionAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto_permission.PermissionService/IsTransactionAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).IsTransactionAllowed(ctx, req.(*TransactionPermission_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_IsNodeAllowedForTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionNodePermission_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).IsNodeAllowedForTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto_permission.PermissionService/IsNodeAllowedForTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).IsNodeAllowedForTransaction(ctx, req.(*TransactionNodePermission_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PermissionService_IsNodePermissioned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodePermission_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PermissionServiceServer).IsNodePermissioned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto_permission.PermissionService/IsNodePermissioned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PermissionServiceServer).IsNodePermissioned(ctx, req.(*NodePermission_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PermissionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "proto_permission.PermissionService",
+	HandlerType: (*PermissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IsTransactionAllowed",
+			Handler:    _PermissionService_IsTransactionAllowed_Handler,
+		},
+		{
+			MethodName: "IsNodeAllowedForTransaction",
+			Handler:    _PermissionService_IsNodeAllowedForTransaction_Handler,
+		},
+		{
+			MethodName: "IsNodePermissioned",
+			Handler:    _PermissionService_IsNodePermissioned_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permission.proto",
+}
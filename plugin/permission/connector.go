@@ -0,0 +1,26 @@
+package permission
+
+import (
+	"context"
+
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_permission"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+const ConnectorName = "permission"
+
+type PluginConnector struct {
+	plugin.Plugin
+}
+
+func (*PluginConnector) GRPCServer(_ *plugin.GRPCBroker, _ *grpc.Server) error {
+	return iplugin.ErrNotSupported
+}
+
+func (*PluginConnector) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, cc *grpc.ClientConn) (interface{}, error) {
+	return &PluginGateway{
+		client: proto_permission.NewPermissionServiceClient(cc),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package permission
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_permission"
+)
+
+type PluginGateway struct {
+	client proto_permission.PermissionServiceClient
+}
+
+func (g *PluginGateway) IsTransactionAllowed(ctx context.Context, from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType pcore.TransactionType) (bool, error) {
+	resp, err := g.client.IsTransactionAllowed(ctx, &proto_permission.TransactionPermission_Request{
+		From:            from.Bytes(),
+		To:              to.Bytes(),
+		Value:           bigIntBytes(value),
+		GasPrice:        bigIntBytes(gasPrice),
+		GasLimit:        bigIntBytes(gasLimit),
+		Payload:         payload,
+		TransactionType: toProtoTransactionType(transactionType),
+	})
+	return decision(resp, err, "from", from)
+}
+
+func (g *PluginGateway) IsNodeAllowedForTransaction(ctx context.Context, hexNodeId string, from common.Address) (bool, error) {
+	resp, err := g.client.IsNodeAllowedForTransaction(ctx, &proto_permission.TransactionNodePermission_Request{
+		From:   from.Bytes(),
+		NodeId: hexNodeId,
+	})
+	return decision(resp, err, "from", from, "node", hexNodeId)
+}
+
+func (g *PluginGateway) IsNodePermissioned(ctx context.Context, url string, direction string) (bool, error) {
+	resp, err := g.client.IsNodePermissioned(ctx, &proto_permission.NodePermission_Request{
+		Url:       url,
+		Direction: direction,
+	})
+	return decision(resp, err, "url", url, "direction", direction)
+}
+
+// returns the decision received from the plugin, logging the reason when
+// the plugin denies
+func decision(resp *proto_permission.PermissionDecision, err error, ctx ...interface{}) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	if !resp.GetAllowed() {
+		log.Debug("permission plugin denied", append(ctx, "reason", resp.GetReason())...)
+	}
+	return resp.GetAllowed(), nil
+}
+
+func bigIntBytes(v *big.Int) []byte {
+	if v == nil {
+		return nil
+	}
+	return v.Bytes()
+}
+
+func toProtoTransactionType(t pcore.TransactionType) proto_permission.TransactionType {
+	switch t {
+	case pcore.ContractCallTxn:
+		return proto_permission.TransactionType_CONTRACT_CALL
+	case pcore.ContractDeployTxn:
+		return proto_permission.TransactionType_CONTRACT_DEPLOY
+	default:
+		return proto_permission.TransactionType_VALUE_TRANSFER
+	}
+}
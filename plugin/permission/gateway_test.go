@@ -0,0 +1,88 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_permission"
+	"github.com/golang/mock/gomock"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+var (
+	arbitraryFrom = common.HexToAddress("0x0638e1574728b6d862dd5d3a3e0942c3be47d996")
+	arbitraryTo   = common.HexToAddress("0x9186eb3d20cbd1f5f992a950d808c4495153abd5")
+	arbitraryNode = "enode://ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef@127.0.0.1:21000?discport=0"
+)
+
+func TestPluginGateway_IsTransactionAllowed(t *testing.T) {
+	assert := testifyassert.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := proto_permission.NewMockPermissionServiceClient(ctrl)
+	mockClient.
+		EXPECT().
+		IsTransactionAllowed(gomock.Any(), gomock.Eq(&proto_permission.TransactionPermission_Request{
+			From:            arbitraryFrom.Bytes(),
+			To:              arbitraryTo.Bytes(),
+			Value:           big.NewInt(10).Bytes(),
+			GasPrice:        nil,
+			GasLimit:        big.NewInt(21000).Bytes(),
+			Payload:         []byte{1, 2},
+			TransactionType: proto_permission.TransactionType_CONTRACT_CALL,
+		})).
+		Return(&proto_permission.PermissionDecision{Allowed: true}, nil)
+	testObject := &PluginGateway{client: mockClient}
+
+	allowed, err := testObject.IsTransactionAllowed(context.Background(), arbitraryFrom, arbitraryTo, big.NewInt(10), nil, big.NewInt(21000), []byte{1, 2}, pcore.ContractCallTxn)
+
+	assert.NoError(err)
+	assert.True(allowed)
+}
+
+func TestPluginGateway_IsNodeAllowedForTransaction(t *testing.T) {
+	assert := testifyassert.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := proto_permission.NewMockPermissionServiceClient(ctrl)
+	mockClient.
+		EXPECT().
+		IsNodeAllowedForTransaction(gomock.Any(), gomock.Eq(&proto_permission.TransactionNodePermission_Request{
+			From:   arbitraryFrom.Bytes(),
+			NodeId: arbitraryNode,
+		})).
+		Return(&proto_permission.PermissionDecision{Allowed: false, Reason: "arbitrary reason"}, nil)
+	testObject := &PluginGateway{client: mockClient}
+
+	allowed, err := testObject.IsNodeAllowedForTransaction(context.Background(), arbitraryNode, arbitraryFrom)
+
+	assert.NoError(err)
+	assert.False(allowed)
+}
+
+func TestPluginGateway_IsNodePermissioned_whenPluginFails(t *testing.T) {
+	assert := testifyassert.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := proto_permission.NewMockPermissionServiceClient(ctrl)
+	mockClient.
+		EXPECT().
+		IsNodePermissioned(gomock.Any(), gomock.Eq(&proto_permission.NodePermission_Request{
+			Url:       arbitraryNode,
+			Direction: "INCOMING",
+		})).
+		Return(nil, errors.New("arbitrary error"))
+	testObject := &PluginGateway{client: mockClient}
+
+	allowed, err := testObject.IsNodePermissioned(context.Background(), arbitraryNode, "INCOMING")
+
+	assert.EqualError(err, "arbitrary error")
+	assert.False(allowed)
+}
@@ -0,0 +1,46 @@
+package permission
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+)
+
+// Service delegates account and node permission decisions to the plugin
+type Service interface {
+	IsTransactionAllowed(ctx context.Context, from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType pcore.TransactionType) (bool, error)
+	IsNodeAllowedForTransaction(ctx context.Context, hexNodeId string, from common.Address) (bool, error)
+	IsNodePermissioned(ctx context.Context, url string, direction string) (bool, error)
+}
+
+type DispenseFunc func() (Service, error)
+
+type ReloadableService struct {
+	DispenseFunc DispenseFunc
+}
+
+func (r *ReloadableService) IsTransactionAllowed(ctx context.Context, from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType pcore.TransactionType) (bool, error) {
+	s, err := r.DispenseFunc()
+	if err != nil {
+		return false, err
+	}
+	return s.IsTransactionAllowed(ctx, from, to, value, gasPrice, gasLimit, payload, transactionType)
+}
+
+func (r *ReloadableService) IsNodeAllowedForTransaction(ctx context.Context, hexNodeId string, from common.Address) (bool, error) {
+	s, err := r.DispenseFunc()
+	if err != nil {
+		return false, err
+	}
+	return s.IsNodeAllowedForTransaction(ctx, hexNodeId, from)
+}
+
+func (r *ReloadableService) IsNodePermissioned(ctx context.Context, url string, direction string) (bool, error) {
+	s, err := r.DispenseFunc()
+	if err != nil {
+		return false, err
+	}
+	return s.IsNodePermissioned(ctx, url, direction)
+}
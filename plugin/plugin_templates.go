@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/plugin/account"
 	"github.com/ethereum/go-ethereum/plugin/helloworld"
+	"github.com/ethereum/go-ethereum/plugin/permission"
 	"github.com/ethereum/go-ethereum/plugin/security"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -79,6 +80,23 @@ func (sp *SecurityPluginTemplate) AuthenticationManager() (security.Authenticati
 	return security.NewDeferredAuthenticationManager(deferFunc), nil
 }
 
+// a template that returns the permission plugin instance
+type PermissionPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *PermissionPluginTemplate) Get() (permission.Service, error) {
+	return &permission.ReloadableService{
+		DispenseFunc: func() (permission.Service, error) {
+			raw, err := p.dispense(permission.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(permission.Service), nil
+		},
+	}, nil
+}
+
 type ReloadableAccountServiceFactory struct {
 	*basePlugin
 }
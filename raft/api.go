@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"github.com/coreos/etcd/pkg/types"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type RaftNodeInfo struct {
@@ -16,6 +18,20 @@ type RaftNodeInfo struct {
 	SnapshotIndex  uint64     `json:"snapshotIndex"`
 }
 
+// SyncStatus is the progress of the chain synchronisation with which a node
+// catches up with the head block of a raft snapshot, e.g. when it joins the
+// cluster
+type SyncStatus struct {
+	Syncing       bool        `json:"syncing"`
+	Mode          string      `json:"mode,omitempty"` // full, executing every block, or state, downloading the state of the target block
+	TargetHash    common.Hash `json:"targetHash,omitempty"`
+	StartingBlock uint64      `json:"startingBlock"`
+	CurrentBlock  uint64      `json:"currentBlock"`
+	HighestBlock  uint64      `json:"highestBlock"`
+	PulledStates  uint64      `json:"pulledStates"`
+	KnownStates   uint64      `json:"knownStates"`
+}
+
 type PublicRaftAPI struct {
 	raftService *RaftService
 }
@@ -57,6 +73,8 @@ func (s *PublicRaftAPI) AddLearner(enodeId string) (uint16, error) {
 	return s.raftService.raftProtocolManager.ProposeNewPeer(enodeId, true)
 }
 
+// PromoteToPeer promotes the given learner to a peer. It is refused if the
+// applied index of the learner lags behind, see ForcePromoteToPeer.
 func (s *PublicRaftAPI) PromoteToPeer(raftId uint16) (bool, error) {
 	if err := s.checkIfNodeInCluster(); err != nil {
 		return false, err
@@ -64,6 +82,24 @@ func (s *PublicRaftAPI) PromoteToPeer(raftId uint16) (bool, error) {
 	return s.raftService.raftProtocolManager.PromoteToPeer(raftId)
 }
 
+// ForcePromoteToPeer promotes the given learner to a peer without checking
+// whether it has caught up with the leader.
+func (s *PublicRaftAPI) ForcePromoteToPeer(raftId uint16) (bool, error) {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return false, err
+	}
+	return s.raftService.raftProtocolManager.ForcePromoteToPeer(raftId)
+}
+
+// TransferLeadership transfers the leadership to the given peer. It must be
+// invoked on the current leader.
+func (s *PublicRaftAPI) TransferLeadership(raftId uint16) (bool, error) {
+	if err := s.checkIfNodeInCluster(); err != nil {
+		return false, err
+	}
+	return s.raftService.raftProtocolManager.TransferLeadership(raftId)
+}
+
 func (s *PublicRaftAPI) RemovePeer(raftId uint16) error {
 	if err := s.checkIfNodeInCluster(); err != nil {
 		return err
@@ -129,6 +165,12 @@ func (s *PublicRaftAPI) checkIfNodeIsActive(raftId uint16) bool {
 	return !activeSince.IsZero()
 }
 
+// SyncStatus returns the progress of the chain synchronisation up to the head
+// block of the last raft snapshot received by this node
+func (s *PublicRaftAPI) SyncStatus() SyncStatus {
+	return s.raftService.raftProtocolManager.SyncStatus()
+}
+
 func (s *PublicRaftAPI) GetRaftId(enodeId string) (uint16, error) {
 	return s.raftService.raftProtocolManager.FetchRaftId(enodeId)
 }
@@ -37,7 +37,7 @@ type RaftService struct {
 	calcGasLimitFunc func(block *types.Block) uint64
 }
 
-func New(stack *node.Node, chainConfig *params.ChainConfig, raftId, raftPort uint16, joinExisting bool, blockTime time.Duration, e *eth.Ethereum, startPeers []*enode.Node, datadir string, useDns bool) (*RaftService, error) {
+func New(stack *node.Node, chainConfig *params.ChainConfig, raftId, raftPort uint16, joinExisting bool, blockTime time.Duration, e *eth.Ethereum, startPeers []*enode.Node, datadir string, useDns bool, tlsConfig *TLSConfig, raftConfig *eth.RaftConfig) (*RaftService, error) {
 	service := &RaftService{
 		eventMux:         stack.EventMux(),
 		chainDb:          e.ChainDb(),
@@ -50,10 +50,14 @@ func New(stack *node.Node, chainConfig *params.ChainConfig, raftId, raftPort uin
 		calcGasLimitFunc: e.CalcGasLimit,
 	}
 
-	service.minter = newMinter(chainConfig, service, blockTime)
+	if raftConfig == nil {
+		defaultConfig := eth.DefaultRaftConfig
+		raftConfig = &defaultConfig
+	}
+	service.minter = newMinter(chainConfig, service, blockTime, raftConfig)
 
 	var err error
-	if service.raftProtocolManager, err = NewProtocolManager(raftId, raftPort, service.blockchain, service.eventMux, startPeers, joinExisting, datadir, service.minter, service.downloader, useDns, stack.Server()); err != nil {
+	if service.raftProtocolManager, err = NewProtocolManager(raftId, raftPort, service.blockchain, service.eventMux, startPeers, joinExisting, datadir, service.minter, service.downloader, useDns, stack.Server(), tlsConfig, raftConfig); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,505 @@
+package raft
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	raftTypes "github.com/coreos/etcd/pkg/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testCluster runs a raft cluster of full nodes in the test process. The
+// nodes keep their chain and raft state on disk, so that they can be crashed
+// and restarted. The raft traffic of each node passes through a proxy which
+// can delay it, and the raft transports between groups of nodes can be cut to
+// simulate network partitions.
+//
+// The cluster does not build on the p2p/simulations adapters: a simulated
+// node has no data directory and its node.Node can't be started again once
+// stopped, so a crashed node could not restart on its chain state. Besides,
+// the raft transport runs over HTTP rather than devp2p, so the simulated
+// connections would not carry the traffic to be partitioned or delayed.
+type testCluster struct {
+	t          *testing.T
+	dir        string
+	raftConfig *eth.RaftConfig
+
+	keys    []*ecdsa.PrivateKey
+	ports   []uint16      // raft ports the nodes listen on
+	enodes  []*enode.Node // advertise the raft ports of the proxies
+	proxies []*raftProxy
+	nodes   []*testClusterNode // nil while crashed
+
+	mu  sync.Mutex
+	cut map[[2]int]bool // pairs of nodes between which the raft transport is cut
+}
+
+type testClusterNode struct {
+	stack   *node.Node
+	eth     *eth.Ethereum
+	service *RaftService
+}
+
+func newTestCluster(t *testing.T, size int, raftConfig *eth.RaftConfig) *testCluster {
+	dir, err := ioutil.TempDir("", "raft-cluster")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &testCluster{
+		t:          t,
+		dir:        dir,
+		raftConfig: raftConfig,
+		keys:       make([]*ecdsa.PrivateKey, size),
+		ports:      make([]uint16, size),
+		enodes:     make([]*enode.Node, size),
+		proxies:    make([]*raftProxy, size),
+		nodes:      make([]*testClusterNode, size),
+		cut:        make(map[[2]int]bool),
+	}
+	for i := 0; i < size; i++ {
+		c.keys[i] = mustNewNodeKey(t)
+		c.ports[i] = nextPort(t)
+		c.proxies[i] = newRaftProxy(t, fmt.Sprintf("127.0.0.1:%d", c.ports[i]))
+		c.enodes[i] = enode.NewV4Hostname(&c.keys[i].PublicKey, net.IPv4(127, 0, 0, 1).String(), i, 0, int(c.proxies[i].port()))
+	}
+	for i := 0; i < size; i++ {
+		c.start(i)
+	}
+	return c
+}
+
+// stops all nodes and proxies and removes the data of the cluster
+func (c *testCluster) stop() {
+	for i := range c.nodes {
+		if c.nodes[i] != nil {
+			c.crash(i)
+		}
+	}
+	for _, p := range c.proxies {
+		p.close()
+	}
+	_ = os.RemoveAll(c.dir)
+}
+
+func (c *testCluster) datadir(i int) string {
+	return filepath.Join(c.dir, fmt.Sprintf("node%d", i+1))
+}
+
+// starts the node, on its existing raft and chain state if it ran before
+func (c *testCluster) start(i int) {
+	stack, err := node.New(&node.Config{
+		DataDir: c.datadir(i),
+		P2P:     p2p.Config{PrivateKey: c.keys[i]},
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	e, err := eth.New(stack, &eth.Config{
+		Genesis: &core.Genesis{Config: params.QuorumTestChainConfig},
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	s, err := New(stack, params.QuorumTestChainConfig, uint16(i+1), c.ports[i], false, 100*time.Millisecond, e, c.enodes, c.datadir(i), false, nil, c.raftConfig)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := stack.Server().Start(); err != nil {
+		c.t.Fatal(err)
+	}
+	if err := s.Start(); err != nil {
+		c.t.Fatal(err)
+	}
+	c.nodes[i] = &testClusterNode{stack, e, s}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for pair := range c.cut {
+		if pair[0] == i || pair[1] == i {
+			c.cutLocked(pair[0], pair[1])
+		}
+	}
+}
+
+// stops the node abruptly, keeping its raft and chain state
+func (c *testCluster) crash(i int) {
+	n := c.nodes[i]
+	c.nodes[i] = nil
+	if err := n.service.Stop(); err != nil {
+		c.t.Fatal(err)
+	}
+	n.eth.TxPool().Stop()
+	n.stack.Server().Stop()
+	_ = n.stack.Close()
+	for isWalDirStillLocked(filepath.Join(c.datadir(i), walDirName)) {
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func (c *testCluster) restart(i int) {
+	c.start(i)
+}
+
+// partition cuts the raft transport between nodes of different groups. Nodes
+// which are not in any group keep their connections.
+func (c *testCluster) partition(groups ...[]int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for gi, group := range groups {
+		for _, other := range groups[gi+1:] {
+			for _, a := range group {
+				for _, b := range other {
+					c.cut[[2]int{a, b}] = true
+					c.cutLocked(a, b)
+				}
+			}
+		}
+	}
+}
+
+func (c *testCluster) cutLocked(a, b int) {
+	if n := c.nodes[a]; n != nil {
+		n.service.raftProtocolManager.transport.CutPeer(raftTypes.ID(b + 1))
+	}
+	if n := c.nodes[b]; n != nil {
+		n.service.raftProtocolManager.transport.CutPeer(raftTypes.ID(a + 1))
+	}
+}
+
+// heal restores the raft transport between all nodes
+func (c *testCluster) heal() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for pair := range c.cut {
+		a, b := pair[0], pair[1]
+		if n := c.nodes[a]; n != nil {
+			n.service.raftProtocolManager.transport.MendPeer(raftTypes.ID(b + 1))
+		}
+		if n := c.nodes[b]; n != nil {
+			n.service.raftProtocolManager.transport.MendPeer(raftTypes.ID(a + 1))
+		}
+		delete(c.cut, pair)
+	}
+}
+
+// delays the raft traffic from and to the node
+func (c *testCluster) delay(i int, d time.Duration) {
+	c.proxies[i].setDelay(d)
+}
+
+func (c *testCluster) running() []int {
+	var running []int
+	for i, n := range c.nodes {
+		if n != nil {
+			running = append(running, i)
+		}
+	}
+	return running
+}
+
+func (c *testCluster) all() []int {
+	all := make([]int, len(c.nodes))
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+func (c *testCluster) isMinter(i int) bool {
+	return c.nodes[i] != nil && c.nodes[i].service.raftProtocolManager.isMinter()
+}
+
+// waits until one of the nodes is the minter and returns it
+func (c *testCluster) waitForLeader(nodes []int) int {
+	leader := -1
+	c.waitFor("a leader to be elected", func() bool {
+		for _, i := range nodes {
+			if c.isMinter(i) {
+				leader = i
+				return true
+			}
+		}
+		return false
+	})
+	return leader
+}
+
+func (c *testCluster) waitFor(what string, cond func() bool) {
+	c.t.Helper()
+	deadline := time.Now().Add(20 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			c.t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// submits a transaction from a new account to the pool of the node, which
+// the node mints into a block if it is the minter
+func (c *testCluster) sendTx(i int) *types.Transaction {
+	c.t.Helper()
+	key := mustNewNodeKey(c.t)
+	signer := types.MakeSigner(params.QuorumTestChainConfig, big.NewInt(0))
+	tx, err := types.SignTx(types.NewTransaction(0, common.Address{}, big.NewInt(0), 21000, big.NewInt(0), nil), signer, key)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := c.nodes[i].eth.TxPool().AddLocal(tx); err != nil {
+		c.t.Fatal(err)
+	}
+	return tx
+}
+
+func (c *testCluster) hasTx(i int, hash common.Hash) bool {
+	tx, _, _, _ := rawdb.ReadTransaction(c.nodes[i].service.chainDb, hash)
+	return tx != nil
+}
+
+func (c *testCluster) waitForTx(nodes []int, tx *types.Transaction) {
+	c.t.Helper()
+	c.waitFor(fmt.Sprintf("tx %x to be included", tx.Hash()), func() bool {
+		for _, i := range nodes {
+			if !c.hasTx(i, tx.Hash()) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func (c *testCluster) head(i int) *types.Block {
+	return c.nodes[i].service.blockchain.CurrentBlock()
+}
+
+func (c *testCluster) speculativeChainLength(i int) int {
+	m := c.nodes[i].service.minter
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.speculativeChain.unappliedBlocks.Size()
+}
+
+// waits until all running nodes have the same head and checks that their
+// chains are consistent
+func (c *testCluster) waitForConvergence() {
+	c.t.Helper()
+	running := c.running()
+	c.waitFor("the chains to converge", func() bool {
+		for _, i := range running[1:] {
+			if c.head(i).Hash() != c.head(running[0]).Hash() {
+				return false
+			}
+		}
+		return true
+	})
+	c.assertConsistent()
+}
+
+// checks that the running nodes agree on every block they all have
+func (c *testCluster) assertConsistent() {
+	c.t.Helper()
+	running := c.running()
+	minHead := c.head(running[0]).NumberU64()
+	for _, i := range running[1:] {
+		if h := c.head(i).NumberU64(); h < minHead {
+			minHead = h
+		}
+	}
+	for number := uint64(0); number <= minHead; number++ {
+		expected := c.nodes[running[0]].service.blockchain.GetHeaderByNumber(number).Hash()
+		for _, i := range running[1:] {
+			if hash := c.nodes[i].service.blockchain.GetHeaderByNumber(number).Hash(); hash != expected {
+				c.t.Fatalf("node %d has block %d %x, node %d has %x", i+1, number, hash, running[0]+1, expected)
+			}
+		}
+	}
+}
+
+// raftProxy forwards the raft traffic to a node, delaying it by the
+// configured latency
+type raftProxy struct {
+	listener net.Listener
+	target   string
+	latency  int64 // atomic, in nanoseconds
+}
+
+func newRaftProxy(t *testing.T, target string) *raftProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &raftProxy{listener: listener, target: target}
+	go p.serve()
+	return p
+}
+
+func (p *raftProxy) port() uint16 {
+	return uint16(p.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (p *raftProxy) setDelay(d time.Duration) {
+	atomic.StoreInt64(&p.latency, int64(d))
+}
+
+func (p *raftProxy) close() {
+	_ = p.listener.Close()
+}
+
+func (p *raftProxy) serve() {
+	for {
+		in, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		out, err := net.Dial("tcp", p.target)
+		if err != nil {
+			_ = in.Close()
+			continue
+		}
+		go p.pipe(out, in)
+		go p.pipe(in, out)
+	}
+}
+
+func (p *raftProxy) pipe(dst, src net.Conn) {
+	defer dst.Close()
+	defer src.Close()
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			if d := time.Duration(atomic.LoadInt64(&p.latency)); d > 0 {
+				time.Sleep(d)
+			}
+			if _, err := dst.Write(buf[:n]); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func TestCluster_leaderPartitionedIntoMinority(t *testing.T) {
+	c := newTestCluster(t, 5, nil)
+	defer c.stop()
+
+	oldLeader := c.waitForLeader(c.all())
+	c.waitForTx(c.all(), c.sendTx(oldLeader))
+
+	minority := []int{oldLeader}
+	var majority []int
+	for _, i := range c.all() {
+		if i == oldLeader {
+			continue
+		}
+		if len(majority) < 3 {
+			majority = append(majority, i)
+		} else {
+			minority = append(minority, i)
+		}
+	}
+	c.partition(minority, majority)
+
+	// the old leader keeps minting blocks which can never be committed
+	stale := c.sendTx(oldLeader)
+	c.waitFor("the old leader to mint a speculative block", func() bool {
+		return c.speculativeChainLength(oldLeader) > 0
+	})
+
+	newLeader := c.waitForLeader(majority)
+	tx := c.sendTx(newLeader)
+	c.waitForTx(majority, tx)
+	c.assertConsistent()
+
+	c.heal()
+	c.waitForTx(c.all(), tx)
+	c.waitFor("the old leader to step down and unwind its speculative chain", func() bool {
+		return !c.isMinter(oldLeader) && c.speculativeChainLength(oldLeader) == 0
+	})
+	c.waitForConvergence()
+	// the block minted by the isolated leader was never committed
+	for _, i := range c.all() {
+		if c.hasTx(i, stale.Hash()) {
+			t.Fatalf("node %d has the transaction minted by the isolated leader", i+1)
+		}
+	}
+}
+
+func TestCluster_leaderCrash(t *testing.T) {
+	c := newTestCluster(t, 3, nil)
+	defer c.stop()
+
+	oldLeader := c.waitForLeader(c.all())
+	c.waitForTx(c.all(), c.sendTx(oldLeader))
+
+	c.crash(oldLeader)
+	newLeader := c.waitForLeader(c.running())
+	tx := c.sendTx(newLeader)
+	c.waitForTx(c.running(), tx)
+
+	c.restart(oldLeader)
+	c.waitForTx(c.all(), tx)
+	c.waitForConvergence()
+}
+
+func TestCluster_crashedFollowerCatchesUp(t *testing.T) {
+	c := newTestCluster(t, 3, nil)
+	defer c.stop()
+
+	leader := c.waitForLeader(c.all())
+	follower := (leader + 1) % 3
+	c.waitForTx(c.all(), c.sendTx(leader))
+
+	c.crash(follower)
+	var txs []*types.Transaction
+	for i := 0; i < 3; i++ {
+		txs = append(txs, c.sendTx(leader))
+	}
+	for _, tx := range txs {
+		c.waitForTx(c.running(), tx)
+	}
+
+	c.restart(follower)
+	for _, tx := range txs {
+		c.waitForTx(c.all(), tx)
+	}
+	c.waitForConvergence()
+}
+
+func TestCluster_delayedFollower(t *testing.T) {
+	c := newTestCluster(t, 3, nil)
+	defer c.stop()
+
+	leader := c.waitForLeader(c.all())
+	follower := (leader + 1) % 3
+	c.delay(follower, 50*time.Millisecond)
+
+	var txs []*types.Transaction
+	for i := 0; i < 5; i++ {
+		txs = append(txs, c.sendTx(leader))
+	}
+	for _, tx := range txs {
+		c.waitForTx(c.all(), tx)
+	}
+	c.waitForConvergence()
+}
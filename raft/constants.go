@@ -13,21 +13,17 @@ const (
 	minterRole = etcdRaft.LEADER
 	//verifierRole = etcdRaft.NOT_LEADER
 
-	// Raft's ticker interval
-	tickerMS = 100
-
 	// We use a bounded channel of constant size buffering incoming messages
 	//msgChanSize = 1000
 
-	// Snapshot after this many raft messages
-	//
-	// TODO: measure and get this as low as possible without affecting performance
-	//
-	snapshotPeriod = 250
-
 	//peerUrlKeyPrefix = "peerUrl-"
 
 	chainExtensionMessage = "Successfully extended chain"
+
+	// directories holding the raft state, relative to the data directory
+	walDirName          = "raft-wal"
+	snapDirName         = "raft-snap"
+	quorumRaftDbDirName = "quorum-raft-state"
 )
 
 var (
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 	"sync"
 	"time"
@@ -23,8 +24,10 @@ import (
 	mapset "github.com/deckarep/golang-set"
 	"github.com/syndtr/goleveldb/leveldb"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -44,6 +47,7 @@ type ProtocolManager struct {
 	bootstrapNodes []*enode.Node
 	raftId         uint16
 	raftPort       uint16
+	tlsConfig      *TLSConfig
 
 	// Local peer state (protected by mu vs concurrent access via JS)
 	address       *Address
@@ -56,10 +60,17 @@ type ProtocolManager struct {
 	peers        map[uint16]*Peer
 	removedPeers mapset.Set // *Permanently removed* peers
 
+	// Chain synchronisation up to the head of a raft snapshot (protected by mu)
+	syncTarget common.Hash // zero unless synchronising
+	syncMode   downloader.SyncMode
+
 	// P2P transport
 	p2pServer *p2p.Server
 	useDns    bool
 
+	// Raft tuning options
+	raftConfig *eth.RaftConfig
+
 	// Blockchain services
 	blockchain *core.BlockChain
 	downloader *downloader.Downloader
@@ -99,10 +110,20 @@ var errNoLeaderElected = errors.New("no leader is currently elected")
 // Public interface
 //
 
-func NewProtocolManager(raftId uint16, raftPort uint16, blockchain *core.BlockChain, mux *event.TypeMux, bootstrapNodes []*enode.Node, joinExisting bool, datadir string, minter *minter, downloader *downloader.Downloader, useDns bool, p2pServer *p2p.Server) (*ProtocolManager, error) {
-	waldir := fmt.Sprintf("%s/raft-wal", datadir)
-	snapdir := fmt.Sprintf("%s/raft-snap", datadir)
-	quorumRaftDbLoc := fmt.Sprintf("%s/quorum-raft-state", datadir)
+func NewProtocolManager(raftId uint16, raftPort uint16, blockchain *core.BlockChain, mux *event.TypeMux, bootstrapNodes []*enode.Node, joinExisting bool, datadir string, minter *minter, downloader *downloader.Downloader, useDns bool, p2pServer *p2p.Server, tlsConfig *TLSConfig, raftConfig *eth.RaftConfig) (*ProtocolManager, error) {
+	if err := tlsConfig.Validate(); err != nil {
+		return nil, err
+	}
+	if raftConfig == nil {
+		defaultConfig := eth.DefaultRaftConfig
+		raftConfig = &defaultConfig
+	}
+	if err := raftConfig.Validate(); err != nil {
+		return nil, err
+	}
+	waldir := filepath.Join(datadir, walDirName)
+	snapdir := filepath.Join(datadir, snapDirName)
+	quorumRaftDbLoc := filepath.Join(datadir, quorumRaftDbDirName)
 
 	manager := &ProtocolManager{
 		bootstrapNodes:      bootstrapNodes,
@@ -121,6 +142,8 @@ func NewProtocolManager(raftId uint16, raftPort uint16, blockchain *core.BlockCh
 		snapshotter:         snap.New(snapdir),
 		raftId:              raftId,
 		raftPort:            raftPort,
+		tlsConfig:           tlsConfig,
+		raftConfig:          raftConfig,
 		quitSync:            make(chan struct{}),
 		raftStorage:         etcdRaft.NewMemoryStorage(),
 		minter:              minter,
@@ -146,6 +169,10 @@ func (pm *ProtocolManager) Start() {
 	// update raft peers info to p2p server
 	pm.p2pServer.SetCheckPeerInRaft(pm.peerExist)
 	go pm.minedBroadcastLoop()
+	if pm.raftConfig.AutoPromoteLearners {
+		go pm.learnerPromotionLoop()
+	}
+	go pm.permissionCheckLoop()
 }
 
 func (pm *ProtocolManager) Stop() {
@@ -353,6 +380,10 @@ func (pm *ProtocolManager) ProposeNewPeer(enodeURL string, isLearner bool) (uint
 		return 0, err
 	}
 
+	if err := checkNodePermission(node); err != nil {
+		return 0, err
+	}
+
 	raftId := pm.nextRaftId()
 	address := newAddress(raftId, node.RaftPort(), node, pm.useDns)
 
@@ -382,7 +413,21 @@ func (pm *ProtocolManager) ProposePeerRemoval(raftId uint16) error {
 	return nil
 }
 
+// PromoteToPeer proposes the promotion of the given learner to a peer. The
+// promotion is refused if the applied index of the learner lags behind by
+// more than the configured maximum lag. If the lag cannot be measured, e.g.
+// as the learner is unreachable, the promotion is proposed regardless.
 func (pm *ProtocolManager) PromoteToPeer(raftId uint16) (bool, error) {
+	return pm.promoteToPeer(raftId, false)
+}
+
+// ForcePromoteToPeer proposes the promotion of the given learner to a peer
+// without checking its lag.
+func (pm *ProtocolManager) ForcePromoteToPeer(raftId uint16) (bool, error) {
+	return pm.promoteToPeer(raftId, true)
+}
+
+func (pm *ProtocolManager) promoteToPeer(raftId uint16, force bool) (bool, error) {
 	if pm.isLearnerNode() {
 		return false, errors.New("learner node can't promote to peer")
 	}
@@ -391,6 +436,14 @@ func (pm *ProtocolManager) PromoteToPeer(raftId uint16) (bool, error) {
 		return false, fmt.Errorf("%d is not a learner. only learner can be promoted to peer", raftId)
 	}
 
+	if !force {
+		if lag, err := pm.learnerLag(raftId); err != nil {
+			log.Warn("unable to check the lag of the learner, promoting anyway", "raft id", raftId, "err", err)
+		} else if lag > pm.raftConfig.LearnerMaxLag {
+			return false, fmt.Errorf("learner %d is %d entries behind, more than the allowed %d. force the promotion to promote anyway", raftId, lag, pm.raftConfig.LearnerMaxLag)
+		}
+	}
+
 	pm.confChangeProposalC <- raftpb.ConfChange{
 		Type:   raftpb.ConfChangeAddNode,
 		NodeID: uint64(raftId),
@@ -398,6 +451,55 @@ func (pm *ProtocolManager) PromoteToPeer(raftId uint16) (bool, error) {
 	return true, nil
 }
 
+// TransferLeadership hands the leadership over to the given peer. It must be
+// invoked on the current leader as only the leader tracks the replication
+// progress of the peers. It waits until the transfer completes or the
+// transfer times out.
+func (pm *ProtocolManager) TransferLeadership(raftId uint16) (bool, error) {
+	status := pm.rawNode().Status()
+	if status.RaftState != etcdRaft.StateLeader {
+		if status.Lead == etcdRaft.None {
+			return false, errNoLeaderElected
+		}
+		return false, fmt.Errorf("node is not the leader. leadership transfer must be initiated on the leader (raft ID %d)", status.Lead)
+	}
+	if raftId == pm.raftId {
+		return false, fmt.Errorf("%d is already the leader", raftId)
+	}
+	if pm.isRaftIdRemoved(raftId) {
+		return false, fmt.Errorf("%d has been removed from the cluster", raftId)
+	}
+	if !pm.isVerifier(raftId) {
+		return false, fmt.Errorf("%d is not a peer. leadership can only be transferred to a voting peer", raftId)
+	}
+	if pm.transport.ActiveSince(raftTypes.ID(raftId)).IsZero() {
+		return false, fmt.Errorf("%d is not active", raftId)
+	}
+	if pr, ok := status.Progress[uint64(raftId)]; !ok || pr.Match < status.Commit {
+		return false, fmt.Errorf("%d is not caught up with the leader (match: %d, commit: %d)", raftId, pr.Match, status.Commit)
+	}
+
+	log.Info("transferring leadership", "from", pm.raftId, "to", raftId)
+	// etcd raft aborts the transfer if it does not complete within an
+	// election timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 2*pm.raftConfig.ElectionTimeout())
+	defer cancel()
+	pm.rawNode().TransferLeadership(ctx, uint64(pm.raftId), uint64(raftId))
+
+	ticker := time.NewTicker(pm.raftConfig.TickInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if pm.rawNode().Status().Lead == uint64(raftId) {
+				return true, nil
+			}
+		case <-ctx.Done():
+			return false, fmt.Errorf("leadership transfer to %d timed out", raftId)
+		}
+	}
+}
+
 //
 // MsgWriter interface (necessary for p2p.Send)
 //
@@ -461,8 +563,11 @@ func (pm *ProtocolManager) startRaft() {
 		ServerStats: ss,
 		LeaderStats: stats.NewLeaderStats(strconv.Itoa(int(pm.raftId))),
 		ErrorC:      make(chan error),
+		TLSInfo:     pm.tlsConfig.tlsInfo(),
+	}
+	if err := pm.transport.Start(); err != nil {
+		fatalf("failed to start raft transport (%v)", err)
 	}
-	pm.transport.Start()
 
 	// We load the snapshot to connect to prev peers before replaying the WAL,
 	// which typically goes further into the future than the snapshot.
@@ -533,25 +638,18 @@ func (pm *ProtocolManager) startRaft() {
 		}
 	}
 
-	// NOTE: cockroach sets this to false for now until they've "worked out the
-	//       bugs"
-	enablePreVote := true
-
 	raftConfig := &etcdRaft.Config{
 		Applied:       lastAppliedIndex,
 		ID:            uint64(pm.raftId),
-		ElectionTick:  10, // NOTE: cockroach sets this to 15
-		HeartbeatTick: 1,  // NOTE: cockroach sets this to 5
+		ElectionTick:  pm.raftConfig.ElectionTick,
+		HeartbeatTick: pm.raftConfig.HeartbeatTick,
 		Storage:       pm.raftStorage,
 
-		// NOTE, from cockroach:
-		// "PreVote and CheckQuorum are two ways of achieving the same thing.
-		// PreVote is more compatible with quiesced ranges, so we want to switch
-		// to it once we've worked out the bugs."
-		//
-		// TODO: vendor again?
-		// PreVote:     enablePreVote,
-		CheckQuorum: !enablePreVote,
+		// PreVote keeps a partitioned node from disrupting the cluster when it
+		// rejoins and CheckQuorum makes a leader step down once it loses
+		// contact with the majority. See eth.RaftConfig for safe combinations.
+		PreVote:     pm.raftConfig.PreVote,
+		CheckQuorum: pm.raftConfig.CheckQuorum,
 
 		// MaxSizePerMsg controls how many Raft log entries the leader will send to
 		// followers in a single MsgApp.
@@ -626,11 +724,22 @@ func (pm *ProtocolManager) serveRaft() {
 		fatalf("Failed parsing URL (%v)", err)
 	}
 
-	listener, err := newStoppableListener(url.Host, pm.httpstopc)
+	var listener net.Listener
+	listener, err = newStoppableListener(url.Host, pm.httpstopc)
 	if err != nil {
 		fatalf("Failed to listen rafthttp (%v)", err)
 	}
-	err = (&http.Server{Handler: pm.transport.Handler()}).Serve(listener)
+	mux := http.NewServeMux()
+	mux.Handle("/", pm.transport.Handler())
+	mux.HandleFunc(appliedIndexPath, pm.serveAppliedIndex)
+	var handler http.Handler = mux
+	if pm.tlsConfig.Enabled() {
+		if listener, err = pm.tlsConfig.newListener(listener); err != nil {
+			fatalf("Failed to listen rafthttp with TLS (%v)", err)
+		}
+		handler = pm.authenticatePeers(handler)
+	}
+	err = (&http.Server{Handler: handler}).Serve(listener)
 	select {
 	case <-pm.httpstopc:
 	default:
@@ -639,6 +748,12 @@ func (pm *ProtocolManager) serveRaft() {
 	close(pm.httpdonec)
 }
 
+func (pm *ProtocolManager) isMinter() bool {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+	return pm.role == minterRole
+}
+
 func (pm *ProtocolManager) isLearner(rid uint16) bool {
 	pm.mu.RLock()
 	defer pm.mu.RUnlock()
@@ -735,7 +850,10 @@ func (pm *ProtocolManager) serveLocalProposals() {
 			r.Read(buffer)
 
 			// blocks until accepted by the raft state machine
+			start := time.Now()
 			pm.rawNode().Propose(context.TODO(), buffer)
+			proposalTimer.UpdateSince(start)
+			blockProposalMeter.Mark(1)
 		case cc, ok := <-pm.confChangeProposalC:
 			if !ok {
 				log.Info("error: read from confChangeProposalC failed")
@@ -745,6 +863,7 @@ func (pm *ProtocolManager) serveLocalProposals() {
 			confChangeCount++
 			cc.ID = confChangeCount
 			pm.rawNode().ProposeConfChange(context.TODO(), cc)
+			confChangeProposalMeter.Mark(1)
 		case <-pm.quitSync:
 			return
 		}
@@ -774,15 +893,19 @@ func (pm *ProtocolManager) entriesToApply(allEntries []raftpb.Entry) (entriesToA
 }
 
 func (pm *ProtocolManager) raftUrl(address *Address) string {
+	scheme := "http"
+	if pm.tlsConfig.Enabled() {
+		scheme = "https"
+	}
 	if parsedIp := net.ParseIP(address.Hostname); parsedIp != nil {
 		if ipv4 := parsedIp.To4(); ipv4 != nil {
 			//this is an IPv4 address
-			return fmt.Sprintf("http://%s:%d", ipv4, address.RaftPort)
+			return fmt.Sprintf("%s://%s:%d", scheme, ipv4, address.RaftPort)
 		}
 		//this is an IPv6 address
-		return fmt.Sprintf("http://[%s]:%d", parsedIp, address.RaftPort)
+		return fmt.Sprintf("%s://[%s]:%d", scheme, parsedIp, address.RaftPort)
 	}
-	return fmt.Sprintf("http://%s:%d", address.Hostname, address.RaftPort)
+	return fmt.Sprintf("%s://%s:%d", scheme, address.Hostname, address.RaftPort)
 }
 
 func (pm *ProtocolManager) addPeer(address *Address) {
@@ -832,7 +955,7 @@ func (pm *ProtocolManager) removePeer(raftId uint16) {
 }
 
 func (pm *ProtocolManager) eventLoop() {
-	ticker := time.NewTicker(tickerMS * time.Millisecond)
+	ticker := time.NewTicker(pm.raftConfig.TickInterval)
 	defer ticker.Stop()
 	defer pm.wal.Close()
 
@@ -848,6 +971,11 @@ func (pm *ProtocolManager) eventLoop() {
 		case rd := <-pm.rawNode().Ready():
 			pm.wal.Save(rd.HardState, rd.Entries)
 
+			if !etcdRaft.IsEmptyHardState(rd.HardState) {
+				commitIndexGauge.Update(int64(rd.HardState.Commit))
+				termGauge.Update(int64(rd.HardState.Term))
+			}
+
 			if rd.SoftState != nil {
 				pm.updateLeader(rd.SoftState.Lead)
 			}
@@ -897,6 +1025,8 @@ func (pm *ProtocolManager) eventLoop() {
 							// stop eventloop
 							return
 						}
+						// raft block timestamps are in nanoseconds
+						blockLatencyTimer.UpdateSince(time.Unix(0, int64(block.Time())))
 					}
 
 				case raftpb.EntryConfChange:
@@ -1060,12 +1190,18 @@ func (pm *ProtocolManager) advanceAppliedIndex(index uint64) {
 	pm.mu.Lock()
 	pm.appliedIndex = index
 	pm.mu.Unlock()
+
+	appliedIndexGauge.Update(int64(index))
 }
 
 func (pm *ProtocolManager) updateLeader(leader uint64) {
 	pm.mu.Lock()
 	defer pm.mu.Unlock()
 
+	if pm.leader != uint16(leader) {
+		leaderChangesMeter.Mark(1)
+		leaderGauge.Update(int64(leader))
+	}
 	pm.leader = uint16(leader)
 }
 
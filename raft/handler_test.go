@@ -3,23 +3,29 @@ package raft
 import (
 	"crypto/ecdsa"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/coreos/etcd/wal"
 	"github.com/coreos/etcd/wal/walpb"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
 )
 
 // pm.advanceAppliedIndex() and state updates are in different
@@ -56,7 +62,7 @@ func TestProtocolManager_whenAppliedIndexOutOfSync(t *testing.T) {
 		for {
 			time.Sleep(10 * time.Millisecond)
 			for i := 0; i < count; i++ {
-				if raftNodes[i].raftProtocolManager.role == minterRole {
+				if raftNodes[i].raftProtocolManager.isMinter() {
 					return
 				}
 			}
@@ -93,6 +99,81 @@ func TestProtocolManager_whenAppliedIndexOutOfSync(t *testing.T) {
 	waitFunc()
 }
 
+func TestProtocolManager_TransferLeadership(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpWorkingDir)
+	}()
+	count := 3
+	ports := make([]uint16, count)
+	nodeKeys := make([]*ecdsa.PrivateKey, count)
+	peers := make([]*enode.Node, count)
+	for i := 0; i < count; i++ {
+		ports[i] = nextPort(t)
+		nodeKeys[i] = mustNewNodeKey(t)
+		peers[i] = enode.NewV4Hostname(&(nodeKeys[i].PublicKey), net.IPv4(127, 0, 0, 1).String(), 0, 0, int(ports[i]))
+	}
+	raftNodes := make([]*RaftService, count)
+	for i := 0; i < count; i++ {
+		if s, err := startRaftNode(uint16(i+1), ports[i], tmpWorkingDir, nodeKeys[i], peers); err != nil {
+			t.Fatal(err)
+		} else {
+			raftNodes[i] = s
+		}
+	}
+	defer func() {
+		for _, s := range raftNodes {
+			_ = s.Stop()
+		}
+	}()
+
+	var leader *ProtocolManager
+	for leader == nil {
+		time.Sleep(10 * time.Millisecond)
+		for _, s := range raftNodes {
+			if s.raftProtocolManager.isMinter() {
+				leader = s.raftProtocolManager
+			}
+		}
+	}
+	var follower *ProtocolManager
+	for _, s := range raftNodes {
+		if s.raftProtocolManager != leader {
+			follower = s.raftProtocolManager
+			break
+		}
+	}
+
+	if _, err := follower.TransferLeadership(leader.raftId); err == nil {
+		t.Fatal("expected transfer initiated on a follower to fail")
+	}
+	if _, err := leader.TransferLeadership(leader.raftId); err == nil {
+		t.Fatal("expected transfer to the leader itself to fail")
+	}
+	if _, err := leader.TransferLeadership(uint16(count + 1)); err == nil {
+		t.Fatal("expected transfer to an unknown peer to fail")
+	}
+
+	// the follower may need a moment to become active and catch up
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		ok, err := leader.TransferLeadership(follower.raftId)
+		if ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("leadership transfer failed: %v", err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if status := follower.rawNode().Status(); status.Lead != uint64(follower.raftId) {
+		t.Fatalf("expected %d to be the leader, got %d", follower.raftId, status.Lead)
+	}
+}
+
 func isWalDirStillLocked(walDir string) bool {
 	var snap walpb.Snapshot
 	w, err := wal.Open(walDir, snap)
@@ -131,6 +212,7 @@ func nextPort(t *testing.T) uint16 {
 	if err != nil {
 		t.Fatal(err)
 	}
+	defer listener.Close()
 	return uint16(listener.Addr().(*net.TCPAddr).Port)
 }
 
@@ -151,7 +233,17 @@ func prepareServiceContext(key *ecdsa.PrivateKey) (stack *node.Node, cfg *node.C
 	return
 }
 
+type raftNodeOptions struct {
+	joinExisting bool
+	tlsConfig    *TLSConfig
+	raftConfig   *eth.RaftConfig
+}
+
 func startRaftNode(id, port uint16, tmpWorkingDir string, key *ecdsa.PrivateKey, nodes []*enode.Node) (*RaftService, error) {
+	return startRaftNodeWithOptions(id, port, tmpWorkingDir, key, nodes, raftNodeOptions{})
+}
+
+func startRaftNodeWithOptions(id, port uint16, tmpWorkingDir string, key *ecdsa.PrivateKey, nodes []*enode.Node, opts raftNodeOptions) (*RaftService, error) {
 	datadir := fmt.Sprintf("%s/node%d", tmpWorkingDir, id)
 
 	stack, _, err := prepareServiceContext(key)
@@ -166,7 +258,7 @@ func startRaftNode(id, port uint16, tmpWorkingDir string, key *ecdsa.PrivateKey,
 		return nil, err
 	}
 
-	s, err := New(stack, params.QuorumTestChainConfig, id, port, false, 100*time.Millisecond, e, nodes, datadir, false)
+	s, err := New(stack, params.QuorumTestChainConfig, id, port, opts.joinExisting, 100*time.Millisecond, e, nodes, datadir, false, opts.tlsConfig, opts.raftConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -180,3 +272,205 @@ func startRaftNode(id, port uint16, tmpWorkingDir string, key *ecdsa.PrivateKey,
 
 	return s, nil
 }
+
+func TestProtocolManager_learnerPromotion(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpWorkingDir)
+	}()
+	raftConfig := eth.DefaultRaftConfig
+	raftConfig.AutoPromoteLearners = true
+	raftConfig.LearnerMaxLag = 0
+	raftConfig.LearnerPromotionPeriod = 500 * time.Millisecond
+
+	count := 3
+	ports := make([]uint16, count+1)
+	nodeKeys := make([]*ecdsa.PrivateKey, count+1)
+	peers := make([]*enode.Node, count+1)
+	for i := 0; i <= count; i++ {
+		ports[i] = nextPort(t)
+		nodeKeys[i] = mustNewNodeKey(t)
+		peers[i] = enode.NewV4Hostname(&(nodeKeys[i].PublicKey), net.IPv4(127, 0, 0, 1).String(), i, 0, int(ports[i]))
+	}
+	raftNodes := make([]*RaftService, 0, count+1)
+	defer func() {
+		for _, s := range raftNodes {
+			_ = s.Stop()
+		}
+	}()
+	for i := 0; i < count; i++ {
+		s, err := startRaftNodeWithOptions(uint16(i+1), ports[i], tmpWorkingDir, nodeKeys[i], peers[:count], raftNodeOptions{raftConfig: &raftConfig})
+		if err != nil {
+			t.Fatal(err)
+		}
+		raftNodes = append(raftNodes, s)
+	}
+	waitForMinter(t, raftNodes)
+	var leader, follower *ProtocolManager
+	for _, s := range raftNodes {
+		if s.raftProtocolManager.isMinter() {
+			leader = s.raftProtocolManager
+		} else {
+			follower = s.raftProtocolManager
+		}
+	}
+
+	learnerId, err := leader.ProposeNewPeer(peers[count].String(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for !leader.isLearner(learnerId) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// until the learner is reachable its lag is unknown, so stand in for it
+	// with a transport reporting that nothing has been applied yet
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", ports[count]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stub := &httptest.Server{Listener: listener, Config: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != appliedIndexPath {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(appliedIndexResponse{AppliedIndex: 0})
+	})}}
+	stub.Start()
+	for _, pm := range []*ProtocolManager{leader, follower} {
+		if _, err := pm.PromoteToPeer(learnerId); err == nil {
+			t.Fatal("expected promotion of a lagging learner to be refused")
+		}
+	}
+	stub.Close()
+
+	s, err := startRaftNodeWithOptions(learnerId, ports[count], tmpWorkingDir, nodeKeys[count], peers, raftNodeOptions{joinExisting: true, raftConfig: &raftConfig})
+	if err != nil {
+		t.Fatal(err)
+	}
+	raftNodes = append(raftNodes, s)
+
+	deadline := time.Now().Add(15 * time.Second)
+	for leader.isLearner(learnerId) || !leader.isVerifier(learnerId) {
+		if time.Now().After(deadline) {
+			t.Fatal("learner was not promoted automatically")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func TestProtocolManager_whenPermissionsEnabled(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpWorkingDir)
+	}()
+	defer func(enabled func() bool, nodeInfoMap *pcore.NodeCache) {
+		permissionsEnabled = enabled
+		pcore.NodeInfoMap = nodeInfoMap
+	}(permissionsEnabled, pcore.NodeInfoMap)
+
+	count := 3
+	ports := make([]uint16, count+2)
+	nodeKeys := make([]*ecdsa.PrivateKey, count+2)
+	peers := make([]*enode.Node, count+2)
+	for i := 0; i < count+2; i++ {
+		ports[i] = nextPort(t)
+		nodeKeys[i] = mustNewNodeKey(t)
+		peers[i] = enode.NewV4Hostname(&(nodeKeys[i].PublicKey), net.IPv4(127, 0, 0, 1).String(), i, 0, int(ports[i]))
+	}
+	raftNodes := make([]*RaftService, count)
+	defer func() {
+		for _, s := range raftNodes {
+			_ = s.Stop()
+		}
+	}()
+	for i := 0; i < count; i++ {
+		s, err := startRaftNode(uint16(i+1), ports[i], tmpWorkingDir, nodeKeys[i], peers[:count])
+		if err != nil {
+			t.Fatal(err)
+		}
+		raftNodes[i] = s
+	}
+	waitForMinter(t, raftNodes)
+	var leader *ProtocolManager
+	var followerId uint16
+	for _, s := range raftNodes {
+		if s.raftProtocolManager.role == minterRole {
+			leader = s.raftProtocolManager
+		} else {
+			followerId = s.raftProtocolManager.raftId
+		}
+	}
+
+	pcore.NodeInfoMap = pcore.NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	for i := 0; i < count; i++ {
+		pcore.NodeInfoMap.UpsertNode("ORG", peers[i].String(), pcore.NodeApproved)
+	}
+	pcore.NodeInfoMap.UpsertNode("ORG", peers[count].String(), pcore.NodePendingApproval)
+	permissionsEnabled = func() bool { return true }
+
+	if _, err := leader.ProposeNewPeer(peers[count].String(), true); err == nil {
+		t.Fatal("expected node pending approval to be refused")
+	}
+	if _, err := leader.ProposeNewPeer(peers[count+1].String(), false); err == nil {
+		t.Fatal("expected unknown node to be refused")
+	}
+
+	// deactivating a member makes the leader remove it
+	pcore.NodeInfoMap.UpsertNode("ORG", peers[followerId-1].String(), pcore.NodeDeactivated)
+	leader.removeRevokedPeers()
+	deadline := time.Now().Add(10 * time.Second)
+	for !leader.isRaftIdRemoved(followerId) {
+		if time.Now().After(deadline) {
+			t.Fatal("deactivated node was not removed from the cluster")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	pcore.NodeInfoMap.UpsertNode("ORG", peers[count].String(), pcore.NodeApproved)
+	if _, err := leader.ProposeNewPeer(peers[count].String(), true); err != nil {
+		t.Fatalf("expected approved node to be added, got %v", err)
+	}
+}
+
+func TestProtocolManager_SyncStatus(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpWorkingDir)
+	}()
+	key := mustNewNodeKey(t)
+	port := nextPort(t)
+	peers := []*enode.Node{enode.NewV4Hostname(&key.PublicKey, net.IPv4(127, 0, 0, 1).String(), 0, 0, int(port))}
+	s, err := startRaftNode(1, port, tmpWorkingDir, key, peers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = s.Stop() }()
+	pm := s.raftProtocolManager
+
+	status := pm.SyncStatus()
+	if status.Syncing || status.Mode != "" || status.CurrentBlock != 0 || status.HighestBlock != 0 {
+		t.Fatalf("unexpected status of an idle node: %+v", status)
+	}
+
+	target := common.HexToHash("0x01")
+	for mode, want := range map[downloader.SyncMode]string{downloader.BoundedFullSync: "full", downloader.BoundedFastSync: "state"} {
+		pm.mu.Lock()
+		pm.syncTarget, pm.syncMode = target, mode
+		pm.mu.Unlock()
+
+		status = pm.SyncStatus()
+		if !status.Syncing || status.Mode != want || status.TargetHash != target {
+			t.Fatalf("unexpected status while synchronising in mode %v: %+v", mode, status)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package raft
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coreos/etcd/pkg/transport"
+	raftTypes "github.com/coreos/etcd/pkg/types"
+	etcdRaft "github.com/coreos/etcd/raft"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// path on the raft transport where a node serves its applied index, which is
+// used to measure how far a learner lags behind
+const appliedIndexPath = "/raft/applied"
+
+const appliedIndexTimeout = 2 * time.Second
+
+type appliedIndexResponse struct {
+	AppliedIndex uint64 `json:"appliedIndex"`
+}
+
+func (pm *ProtocolManager) serveAppliedIndex(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	pm.mu.RLock()
+	appliedIndex := pm.appliedIndex
+	pm.mu.RUnlock()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(appliedIndexResponse{AppliedIndex: appliedIndex})
+}
+
+// fetches the applied index of the learner from its raft transport
+func (pm *ProtocolManager) learnerAppliedIndex(raftId uint16) (uint64, error) {
+	pm.mu.RLock()
+	peer := pm.peers[raftId]
+	pm.mu.RUnlock()
+	if peer == nil {
+		return 0, fmt.Errorf("unknown learner %d", raftId)
+	}
+	tr, err := transport.NewTransport(pm.tlsConfig.tlsInfo(), appliedIndexTimeout)
+	if err != nil {
+		return 0, err
+	}
+	defer tr.CloseIdleConnections()
+	req, err := http.NewRequest(http.MethodGet, pm.raftUrl(peer.address)+appliedIndexPath, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("X-Server-From", raftTypes.ID(pm.raftId).String())
+	resp, err := (&http.Client{Transport: tr, Timeout: appliedIndexTimeout}).Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("learner %d responded with %s", raftId, resp.Status)
+	}
+	var res appliedIndexResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return 0, err
+	}
+	return res.AppliedIndex, nil
+}
+
+// returns the number of entries the applied index of the learner lags behind
+// the commit index known to this node
+func (pm *ProtocolManager) learnerLag(raftId uint16) (uint64, error) {
+	appliedIndex, err := pm.learnerAppliedIndex(raftId)
+	if err != nil {
+		return 0, err
+	}
+	commit := pm.rawNode().Status().Commit
+	if appliedIndex >= commit {
+		return 0, nil
+	}
+	return commit - appliedIndex, nil
+}
+
+func (pm *ProtocolManager) learners() []uint16 {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+	learners := make([]uint16, len(pm.confState.Learners))
+	for i, id := range pm.confState.Learners {
+		learners[i] = uint16(id)
+	}
+	return learners
+}
+
+// learnerPromotionLoop promotes learners which have been caught up with the
+// leader for the configured promotion period. It only acts while this node
+// is the leader.
+func (pm *ProtocolManager) learnerPromotionLoop() {
+	ticker := time.NewTicker(learnerPromotionCheckInterval(pm.raftConfig.LearnerPromotionPeriod))
+	defer ticker.Stop()
+
+	caughtUpSince := make(map[uint16]time.Time)
+	for {
+		select {
+		case <-ticker.C:
+			pm.promoteCaughtUpLearners(caughtUpSince, time.Now())
+		case <-pm.quitSync:
+			return
+		}
+	}
+}
+
+func learnerPromotionCheckInterval(period time.Duration) time.Duration {
+	if interval := period / 10; interval > time.Second {
+		return time.Second
+	} else if interval > 0 {
+		return interval
+	}
+	return time.Millisecond
+}
+
+// checks every learner and promotes those which have been caught up since at
+// least the promotion period. caughtUpSince tracks when each learner was
+// first seen caught up and is reset when the learner falls behind or this
+// node is not the leader.
+func (pm *ProtocolManager) promoteCaughtUpLearners(caughtUpSince map[uint16]time.Time, now time.Time) {
+	if pm.rawNode().Status().RaftState != etcdRaft.StateLeader {
+		for id := range caughtUpSince {
+			delete(caughtUpSince, id)
+		}
+		return
+	}
+	learners := make(map[uint16]bool)
+	for _, raftId := range pm.learners() {
+		learners[raftId] = true
+		lag, err := pm.learnerLag(raftId)
+		if err != nil || lag > pm.raftConfig.LearnerMaxLag || pm.transport.ActiveSince(raftTypes.ID(raftId)).IsZero() {
+			delete(caughtUpSince, raftId)
+			continue
+		}
+		since, ok := caughtUpSince[raftId]
+		if !ok {
+			caughtUpSince[raftId] = now
+			continue
+		}
+		if now.Sub(since) < pm.raftConfig.LearnerPromotionPeriod {
+			continue
+		}
+		log.Info("promoting caught up learner", "raft id", raftId, "lag", lag, "caught up since", since)
+		if _, err := pm.PromoteToPeer(raftId); err != nil {
+			log.Warn("failed to promote learner", "raft id", raftId, "err", err)
+		}
+		delete(caughtUpSince, raftId)
+	}
+	// forget learners which have been promoted or removed meanwhile
+	for raftId := range caughtUpSince {
+		if !learners[raftId] {
+			delete(caughtUpSince, raftId)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package raft
+
+import (
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	// raft log progress
+	appliedIndexGauge  = metrics.NewRegisteredGauge("raft/index/applied", nil)
+	commitIndexGauge   = metrics.NewRegisteredGauge("raft/index/commit", nil)
+	snapshotIndexGauge = metrics.NewRegisteredGauge("raft/index/snapshot", nil)
+	termGauge          = metrics.NewRegisteredGauge("raft/term", nil)
+
+	// leadership
+	leaderGauge        = metrics.NewRegisteredGauge("raft/leader", nil)
+	leaderChangesMeter = metrics.NewRegisteredMeter("raft/leader/changes", nil)
+
+	// proposals
+	blockProposalMeter      = metrics.NewRegisteredMeter("raft/proposal/block", nil)
+	confChangeProposalMeter = metrics.NewRegisteredMeter("raft/proposal/confchange", nil)
+	proposalTimer           = metrics.NewRegisteredTimer("raft/proposal/duration", nil)
+	// time from minting a block to applying it on this node
+	blockLatencyTimer = metrics.NewRegisteredTimer("raft/block/latency", nil)
+
+	// snapshots
+	snapshotTimer      = metrics.NewRegisteredTimer("raft/snapshot/duration", nil)
+	snapshotSizeGauge  = metrics.NewRegisteredGauge("raft/snapshot/size", nil)
+	snapshotApplyTimer = metrics.NewRegisteredTimer("raft/snapshot/apply", nil)
+
+	// minting
+	mintTimer        = metrics.NewRegisteredTimer("raft/minter/mint", nil)
+	mintedBlockMeter = metrics.NewRegisteredMeter("raft/minter/blocks", nil)
+	mintedTxMeter    = metrics.NewRegisteredMeter("raft/minter/txs", nil)
+
+	// speculative chain
+	speculativeChainLengthGauge = metrics.NewRegisteredGauge("raft/speculative/length", nil)
+	speculativeUnwindMeter      = metrics.NewRegisteredMeter("raft/speculative/unwind", nil)
+)
@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -64,6 +65,7 @@ type minter struct {
 	shouldMine       *channels.RingChannel
 	blockTime        time.Duration
 	speculativeChain *speculativeChain
+	raftConfig       *eth.RaftConfig // transaction ordering policy and quotas
 
 	invalidRaftOrderingChan chan InvalidRaftOrdering
 	chainHeadChan           chan core.ChainHeadEvent
@@ -77,7 +79,7 @@ type extraSeal struct {
 	Signature []byte // Signature of the block minter
 }
 
-func newMinter(config *params.ChainConfig, eth *RaftService, blockTime time.Duration) *minter {
+func newMinter(config *params.ChainConfig, eth *RaftService, blockTime time.Duration, raftConfig *eth.RaftConfig) *minter {
 	minter := &minter{
 		config:           config,
 		eth:              eth,
@@ -87,6 +89,7 @@ func newMinter(config *params.ChainConfig, eth *RaftService, blockTime time.Dura
 		shouldMine:       channels.NewRingChannel(1),
 		blockTime:        blockTime,
 		speculativeChain: newSpeculativeChain(),
+		raftConfig:       raftConfig,
 
 		invalidRaftOrderingChan: make(chan InvalidRaftOrdering, 1),
 		chainHeadChan:           make(chan core.ChainHeadEvent, core.GetChainHeadChannleSize()),
@@ -281,14 +284,14 @@ func (minter *minter) createWork() *work {
 	}
 }
 
-func (minter *minter) getTransactions() *types.TransactionsByPriceAndNonce {
+func (minter *minter) getTransactions() transactionSet {
 	allAddrTxes, err := minter.eth.TxPool().Pending()
 	if err != nil { // TODO: handle
 		panic(err)
 	}
 	addrTxes := minter.speculativeChain.withoutProposedTxes(allAddrTxes)
 	signer := types.MakeSigner(minter.chain.Config(), minter.chain.CurrentBlock().Number())
-	return types.NewTransactionsByPriceAndNonce(signer, addrTxes)
+	return newTransactionSet(minter.raftConfig, signer, addrTxes)
 }
 
 // Sends-off events asynchronously.
@@ -309,6 +312,7 @@ func (minter *minter) firePendingBlockEvents(logs []*types.Log) {
 func (minter *minter) mintNewBlock() {
 	minter.mu.Lock()
 	defer minter.mu.Unlock()
+	defer func(start time.Time) { mintTimer.UpdateSince(start) }(time.Now())
 
 	work := minter.createWork()
 	transactions := minter.getTransactions()
@@ -354,6 +358,8 @@ func (minter *minter) mintNewBlock() {
 	}
 
 	minter.speculativeChain.extend(block)
+	mintedBlockMeter.Mark(1)
+	mintedTxMeter.Mark(int64(txCount))
 
 	minter.mux.Post(core.NewMinedBlockEvent{Block: block})
 
@@ -361,7 +367,7 @@ func (minter *minter) mintNewBlock() {
 	log.Info("🔨  Mined block", "number", block.Number(), "hash", fmt.Sprintf("%x", block.Hash().Bytes()[:4]), "elapsed", elapsed)
 }
 
-func (env *work) commitTransactions(txes *types.TransactionsByPriceAndNonce, bc *core.BlockChain) (types.Transactions, types.Receipts, types.Receipts, []*types.Log) {
+func (env *work) commitTransactions(txes transactionSet, bc *core.BlockChain) (types.Transactions, types.Receipts, types.Receipts, []*types.Log) {
 	var allLogs []*types.Log
 	var committedTxes types.Transactions
 	var publicReceipts types.Receipts
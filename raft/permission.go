@@ -0,0 +1,93 @@
+package raft
+
+import (
+	"fmt"
+	"time"
+
+	etcdRaft "github.com/coreos/etcd/raft"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+)
+
+// interval at which the leader checks the permission status of the raft
+// members
+const permissionCheckInterval = 5 * time.Second
+
+// reports whether smart contract permissioning is in force; replaced in tests
+var permissionsEnabled = pcore.PermissionsEnabled
+
+// returns an error unless the node is approved in the node permission cache.
+// Nodes are only checked once smart contract permissioning is in force.
+func checkNodePermission(node *enode.Node) error {
+	if !permissionsEnabled() {
+		return nil
+	}
+	nodeInfo, err := pcore.NodeInfoMap.GetNodeByEnodeUrl(node.URLv4())
+	if err != nil {
+		return fmt.Errorf("node %v is not permissioned: %v", node.ID(), err)
+	}
+	if nodeInfo.Status != pcore.NodeApproved {
+		return fmt.Errorf("node %v is not approved in the permission contract (status %d)", node.ID(), nodeInfo.Status)
+	}
+	return nil
+}
+
+// reports whether the node has been deactivated or blacklisted in the
+// permission contract. Nodes unknown to the cache are kept, as they may not
+// have been loaded into the cache yet.
+func isNodeRevoked(node *enode.Node) bool {
+	if !permissionsEnabled() {
+		return false
+	}
+	nodeInfo, err := pcore.NodeInfoMap.GetNodeByEnodeUrl(node.URLv4())
+	if err != nil {
+		return false
+	}
+	return nodeInfo.Status == pcore.NodeDeactivated || nodeInfo.Status == pcore.NodeBlackListed
+}
+
+// permissionCheckLoop removes raft members whose node has been deactivated or
+// blacklisted in the permission contract. It only acts while this node is the
+// leader.
+func (pm *ProtocolManager) permissionCheckLoop() {
+	ticker := time.NewTicker(permissionCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			pm.removeRevokedPeers()
+		case <-pm.quitSync:
+			return
+		}
+	}
+}
+
+// proposes the removal of the raft members whose node has been deactivated
+// or blacklisted
+func (pm *ProtocolManager) removeRevokedPeers() {
+	if !permissionsEnabled() || pm.rawNode().Status().RaftState != etcdRaft.StateLeader {
+		return
+	}
+	for _, raftId := range pm.revokedPeers() {
+		log.Info("removing raft member whose node is no longer permissioned", "raft id", raftId)
+		if err := pm.ProposePeerRemoval(raftId); err != nil {
+			log.Warn("failed to remove raft member", "raft id", raftId, "err", err)
+		}
+	}
+}
+
+func (pm *ProtocolManager) revokedPeers() []uint16 {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	var revoked []uint16
+	for raftId, peer := range pm.peers {
+		if isNodeRevoked(peer.p2pNode) {
+			revoked = append(revoked, raftId)
+		}
+	}
+	return revoked
+}
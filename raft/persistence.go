@@ -29,15 +29,26 @@ func openQuorumRaftDb(path string) (db *leveldb.DB, err error) {
 	return
 }
 
-func (pm *ProtocolManager) loadAppliedIndex() uint64 {
-	dat, err := pm.quorumRaftDb.Get(appliedDbKey, nil)
-	var lastAppliedIndex uint64
+func readAppliedIndex(db *leveldb.DB) (uint64, error) {
+	dat, err := db.Get(appliedDbKey, nil)
 	if err == errors.ErrNotFound {
-		lastAppliedIndex = 0
+		return 0, nil
 	} else if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(dat), nil
+}
+
+func putAppliedIndex(db *leveldb.DB, index uint64, wo *opt.WriteOptions) error {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, index)
+	return db.Put(appliedDbKey, buf, wo)
+}
+
+func (pm *ProtocolManager) loadAppliedIndex() uint64 {
+	lastAppliedIndex, err := readAppliedIndex(pm.quorumRaftDb)
+	if err != nil {
 		fatalf("loadAppliedIndex error: %s", err)
-	} else {
-		lastAppliedIndex = binary.LittleEndian.Uint64(dat)
 	}
 
 	pm.mu.Lock()
@@ -51,7 +62,5 @@ func (pm *ProtocolManager) loadAppliedIndex() uint64 {
 
 func (pm *ProtocolManager) writeAppliedIndex(index uint64) {
 	log.Info("persisted the latest applied index", "index", index)
-	buf := make([]byte, 8)
-	binary.LittleEndian.PutUint64(buf, index)
-	pm.quorumRaftDb.Put(appliedDbKey, buf, noFsync)
+	putAppliedIndex(pm.quorumRaftDb, index, noFsync)
 }
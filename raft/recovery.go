@@ -0,0 +1,401 @@
+package raft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/snap"
+	"github.com/coreos/etcd/wal"
+	"github.com/coreos/etcd/wal/walpb"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// The functions in this file operate on the raft state of a stopped node.
+// They are used by the `geth raft` commands to inspect the raft state and to
+// recover a cluster which has permanently lost its quorum.
+
+const backupManifestName = "raft-backup.json"
+
+var ErrNoRaftState = errors.New("no raft state found")
+
+// RaftState describes the raft state persisted by a node: the latest raft
+// snapshot, the WAL and the applied index
+type RaftState struct {
+	SnapshotIndex  uint64      `json:"snapshotIndex"`
+	SnapshotTerm   uint64      `json:"snapshotTerm"`
+	HeadBlockHash  common.Hash `json:"headBlockHash"` // chain head recorded in the snapshot
+	Term           uint64      `json:"term"`
+	Vote           uint64      `json:"vote"`
+	CommitIndex    uint64      `json:"commitIndex"`
+	LastIndex      uint64      `json:"lastIndex"`
+	AppliedIndex   uint64      `json:"appliedIndex"`
+	Peers          []uint16    `json:"peers"`
+	Learners       []uint16    `json:"learners"`
+	RemovedRaftIds []uint16    `json:"removedRaftIds"`
+	Addresses      []*Address  `json:"addresses"`
+}
+
+// RaftBackupManifest describes a backup of the raft state taken by
+// BackupRaftState
+type RaftBackupManifest struct {
+	Created         time.Time   `json:"created"`
+	AppliedIndex    uint64      `json:"appliedIndex"`
+	SnapshotIndex   uint64      `json:"snapshotIndex"`
+	ChainHeadHash   common.Hash `json:"chainHeadHash"`
+	ChainHeadNumber uint64      `json:"chainHeadNumber"`
+}
+
+// InspectRaftState reads the raft state from the raft directories in
+// datadir. The cluster membership is taken from the latest snapshot and
+// updated with the committed configuration changes in the WAL.
+func InspectRaftState(datadir string) (*RaftState, error) {
+	waldir := filepath.Join(datadir, walDirName)
+	if !wal.Exist(waldir) {
+		return nil, ErrNoRaftState
+	}
+
+	state := &RaftState{}
+	peers := make(map[uint16]bool)
+	learners := make(map[uint16]bool)
+	removed := make(map[uint16]bool)
+	addresses := make(map[uint16]*Address)
+
+	raftSnapshot, err := snap.New(filepath.Join(datadir, snapDirName)).Load()
+	if err != nil && err != snap.ErrNoSnapshot {
+		return nil, fmt.Errorf("failed to load raft snapshot: %v", err)
+	}
+	walsnap := walpb.Snapshot{}
+	if raftSnapshot != nil {
+		walsnap.Index, walsnap.Term = raftSnapshot.Metadata.Index, raftSnapshot.Metadata.Term
+		state.SnapshotIndex, state.SnapshotTerm = walsnap.Index, walsnap.Term
+
+		snapshot := bytesToSnapshot(raftSnapshot.Data)
+		state.HeadBlockHash = snapshot.HeadBlockHash
+		for _, id := range raftSnapshot.Metadata.ConfState.Nodes {
+			peers[uint16(id)] = true
+		}
+		for _, id := range raftSnapshot.Metadata.ConfState.Learners {
+			learners[uint16(id)] = true
+		}
+		for _, id := range snapshot.RemovedRaftIds {
+			removed[id] = true
+		}
+		for i := range snapshot.Addresses {
+			addresses[snapshot.Addresses[i].RaftId] = &snapshot.Addresses[i]
+		}
+	}
+
+	w, err := wal.OpenForRead(waldir, walsnap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open raft WAL: %v", err)
+	}
+	defer w.Close()
+	_, hardState, entries, err := w.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read raft WAL: %v", err)
+	}
+	state.Term, state.Vote, state.CommitIndex = hardState.Term, hardState.Vote, hardState.Commit
+	state.LastIndex = state.SnapshotIndex
+	if len(entries) > 0 {
+		state.LastIndex = entries[len(entries)-1].Index
+	}
+
+	// replay the committed configuration changes, as done when applying them
+	for _, entry := range entries {
+		if entry.Index > hardState.Commit || entry.Type != raftpb.EntryConfChange {
+			continue
+		}
+		var cc raftpb.ConfChange
+		if err := cc.Unmarshal(entry.Data); err != nil {
+			return nil, fmt.Errorf("failed to decode configuration change at index %d: %v", entry.Index, err)
+		}
+		raftId := uint16(cc.NodeID)
+		switch cc.Type {
+		case raftpb.ConfChangeAddNode, raftpb.ConfChangeAddLearnerNode:
+			if removed[raftId] {
+				continue
+			}
+			if cc.Type == raftpb.ConfChangeAddNode {
+				peers[raftId] = true
+				delete(learners, raftId)
+			} else {
+				learners[raftId] = true
+			}
+			if _, ok := addresses[raftId]; !ok && len(cc.Context) > 0 {
+				addresses[raftId] = bytesToAddress(cc.Context)
+			}
+		case raftpb.ConfChangeRemoveNode:
+			delete(peers, raftId)
+			delete(learners, raftId)
+			delete(addresses, raftId)
+			removed[raftId] = true
+		}
+	}
+
+	state.Peers = sortedRaftIds(peers)
+	state.Learners = sortedRaftIds(learners)
+	state.RemovedRaftIds = sortedRaftIds(removed)
+	for _, raftId := range append(append([]uint16{}, state.Peers...), state.Learners...) {
+		if address, ok := addresses[raftId]; ok {
+			state.Addresses = append(state.Addresses, address)
+		}
+	}
+	sort.Slice(state.Addresses, func(i, j int) bool { return state.Addresses[i].RaftId < state.Addresses[j].RaftId })
+
+	db, err := openQuorumRaftDb(filepath.Join(datadir, quorumRaftDbDirName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open raft state db: %v", err)
+	}
+	defer db.Close()
+	if state.AppliedIndex, err = readAppliedIndex(db); err != nil {
+		return nil, fmt.Errorf("failed to read applied index: %v", err)
+	}
+	return state, nil
+}
+
+func sortedRaftIds(set map[uint16]bool) []uint16 {
+	ids := make([]uint16, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// ForceNewCluster rewrites the raft state in datadir so that the node with
+// the given enode ID forms a new cluster on its own, keeping its raft ID.
+// All other members are marked as removed; they must rejoin the new cluster
+// with new raft IDs via raft.addPeer. headBlockHash must be the head of the
+// local chain, which becomes the starting point of the new cluster.
+//
+// The raft state is not backed up, callers should take a backup first.
+func ForceNewCluster(datadir string, nodeId enode.EnodeID, headBlockHash common.Hash) (*RaftState, error) {
+	state, err := InspectRaftState(datadir)
+	if err != nil {
+		return nil, err
+	}
+	var self *Address
+	for _, address := range state.Addresses {
+		if address.NodeId == nodeId {
+			self = address
+		}
+	}
+	if self == nil {
+		return nil, fmt.Errorf("node %s is not a member of the raft cluster", nodeId)
+	}
+
+	removed := make(map[uint16]bool)
+	for _, id := range state.RemovedRaftIds {
+		removed[id] = true
+	}
+	for _, id := range append(append([]uint16{}, state.Peers...), state.Learners...) {
+		if id != self.RaftId {
+			removed[id] = true
+		}
+	}
+
+	// the new cluster starts after everything the node has seen so far, with
+	// a new term
+	index := state.LastIndex
+	if state.AppliedIndex > index {
+		index = state.AppliedIndex
+	}
+	term := state.Term
+	if state.SnapshotTerm > term {
+		term = state.SnapshotTerm
+	}
+	term++
+
+	snapshot := &SnapshotWithHostnames{
+		Addresses:      []Address{*self},
+		RemovedRaftIds: sortedRaftIds(removed),
+		HeadBlockHash:  headBlockHash,
+	}
+	raftSnapshot := raftpb.Snapshot{
+		Data: snapshot.toBytes(),
+		Metadata: raftpb.SnapshotMetadata{
+			ConfState: raftpb.ConfState{Nodes: []uint64{uint64(self.RaftId)}},
+			Index:     index,
+			Term:      term,
+		},
+	}
+
+	waldir := filepath.Join(datadir, walDirName)
+	snapdir := filepath.Join(datadir, snapDirName)
+	for _, dir := range []string{waldir, snapdir} {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, err
+		}
+	}
+	if err := os.Mkdir(snapdir, 0750); err != nil {
+		return nil, err
+	}
+	if err := snap.New(snapdir).SaveSnap(raftSnapshot); err != nil {
+		return nil, fmt.Errorf("failed to save raft snapshot: %v", err)
+	}
+	w, err := wal.Create(waldir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raft WAL: %v", err)
+	}
+	if err := w.SaveSnapshot(walpb.Snapshot{Index: index, Term: term}); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("failed to save raft WAL snapshot: %v", err)
+	}
+	if err := w.Save(raftpb.HardState{Term: term, Vote: uint64(self.RaftId), Commit: index}, nil); err != nil {
+		w.Close()
+		return nil, fmt.Errorf("failed to save raft hard state: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	if err := writeAppliedIndexTo(datadir, index); err != nil {
+		return nil, err
+	}
+	log.Info("forced new raft cluster", "raft id", self.RaftId, "index", index, "term", term, "removed", snapshot.RemovedRaftIds)
+
+	return InspectRaftState(datadir)
+}
+
+func writeAppliedIndexTo(datadir string, index uint64) error {
+	db, err := openQuorumRaftDb(filepath.Join(datadir, quorumRaftDbDirName))
+	if err != nil {
+		return fmt.Errorf("failed to open raft state db: %v", err)
+	}
+	defer db.Close()
+	if err := putAppliedIndex(db, index, nil); err != nil {
+		return fmt.Errorf("failed to write applied index: %v", err)
+	}
+	return nil
+}
+
+// BackupRaftState copies the raft WAL, snapshots and applied index from
+// datadir into backupDir, together with a manifest recording the chain head
+// the raft state belongs to. backupDir must not exist or be empty.
+func BackupRaftState(datadir, backupDir string, chainHeadHash common.Hash, chainHeadNumber uint64) (*RaftBackupManifest, error) {
+	state, err := InspectRaftState(datadir)
+	if err != nil {
+		return nil, err
+	}
+	if entries, err := ioutil.ReadDir(backupDir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("backup directory %s is not empty", backupDir)
+	}
+	for _, name := range []string{walDirName, snapDirName, quorumRaftDbDirName} {
+		if err := copyDir(filepath.Join(datadir, name), filepath.Join(backupDir, name)); err != nil {
+			return nil, fmt.Errorf("failed to back up %s: %v", name, err)
+		}
+	}
+	manifest := &RaftBackupManifest{
+		Created:         time.Now().UTC(),
+		AppliedIndex:    state.AppliedIndex,
+		SnapshotIndex:   state.SnapshotIndex,
+		ChainHeadHash:   chainHeadHash,
+		ChainHeadNumber: chainHeadNumber,
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(backupDir, backupManifestName), data, 0640); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// RestoreRaftState replaces the raft state in datadir with the backup in
+// backupDir. The existing raft directories are moved aside rather than
+// deleted.
+//
+// The chain DB of the node may be ahead of or behind the backup, so the
+// applied index is rolled back to the snapshot index. On start, the raft
+// entries after the snapshot are applied again, skipping blocks which are
+// already in the chain, and the chain is synced up to the snapshot head if it
+// is behind.
+func RestoreRaftState(backupDir, datadir string) (*RaftBackupManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(backupDir, backupManifestName))
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a raft backup: %v", backupDir, err)
+	}
+	manifest := new(RaftBackupManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid raft backup manifest: %v", err)
+	}
+	if _, err := InspectRaftState(backupDir); err != nil {
+		return nil, fmt.Errorf("invalid raft backup: %v", err)
+	}
+
+	suffix := fmt.Sprintf(".replaced-%d", time.Now().Unix())
+	for _, name := range []string{walDirName, snapDirName, quorumRaftDbDirName} {
+		dst := filepath.Join(datadir, name)
+		if _, err := os.Stat(dst); err == nil {
+			if err := os.Rename(dst, dst+suffix); err != nil {
+				return nil, err
+			}
+			log.Info("moved existing raft state aside", "path", dst+suffix)
+		}
+		if err := copyDir(filepath.Join(backupDir, name), dst); err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %v", name, err)
+		}
+	}
+	if err := writeAppliedIndexTo(datadir, manifest.SnapshotIndex); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// copies the directory src to dst. src not existing is not an error, as
+// e.g. the snapshot directory only exists once a snapshot has been taken
+func copyDir(src, dst string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		// leveldb lock files are recreated on open
+		if info.Name() == "LOCK" {
+			return nil
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,123 @@
+package raft
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+func TestRaftRecovery_ForceNewClusterAndRestore(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.RemoveAll(tmpWorkingDir)
+	}()
+	count := 3
+	ports := make([]uint16, count)
+	nodeKeys := make([]*ecdsa.PrivateKey, count)
+	peers := make([]*enode.Node, count)
+	for i := 0; i < count; i++ {
+		ports[i] = nextPort(t)
+		nodeKeys[i] = mustNewNodeKey(t)
+		peers[i] = enode.NewV4Hostname(&(nodeKeys[i].PublicKey), net.IPv4(127, 0, 0, 1).String(), 0, 0, int(ports[i]))
+	}
+	raftNodes := make([]*RaftService, count)
+	for i := 0; i < count; i++ {
+		if s, err := startRaftNode(uint16(i+1), ports[i], tmpWorkingDir, nodeKeys[i], peers); err != nil {
+			t.Fatal(err)
+		} else {
+			raftNodes[i] = s
+		}
+	}
+	waitForMinter(t, raftNodes)
+	headBlockHash := raftNodes[0].blockchain.CurrentBlock().Hash()
+	for i := 0; i < count; i++ {
+		if err := raftNodes[i].Stop(); err != nil {
+			t.Fatal(err)
+		}
+		for isWalDirStillLocked(fmt.Sprintf("%s/node%d/raft-wal", tmpWorkingDir, i+1)) {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	datadir := filepath.Join(tmpWorkingDir, "node1")
+	state, err := InspectRaftState(datadir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []uint16{1, 2, 3}, state.Peers)
+	assert.Len(t, state.Addresses, count)
+	assert.True(t, state.SnapshotIndex > 0)
+
+	_, err = InspectRaftState(tmpWorkingDir)
+	assert.Equal(t, ErrNoRaftState, err)
+
+	backupDir := filepath.Join(tmpWorkingDir, "backup")
+	manifest, err := BackupRaftState(datadir, backupDir, headBlockHash, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, state.AppliedIndex, manifest.AppliedIndex)
+	_, err = BackupRaftState(datadir, backupDir, headBlockHash, 0)
+	assert.Error(t, err, "backup into a non-empty directory must fail")
+
+	_, err = ForceNewCluster(datadir, enode.EnodeID{}, headBlockHash)
+	assert.Error(t, err, "unknown node must not force a new cluster")
+
+	newState, err := ForceNewCluster(datadir, state.Addresses[0].NodeId, headBlockHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []uint16{1}, newState.Peers)
+	assert.Equal(t, []uint16{2, 3}, newState.RemovedRaftIds)
+	assert.Equal(t, headBlockHash, newState.HeadBlockHash)
+	assert.True(t, newState.Term > state.Term)
+
+	// the surviving node elects itself
+	s, err := startRaftNode(1, ports[0], tmpWorkingDir, nodeKeys[0], peers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForMinter(t, []*RaftService{s})
+	assert.True(t, s.raftProtocolManager.isRaftIdRemoved(2))
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	for isWalDirStillLocked(filepath.Join(datadir, walDirName)) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if _, err := RestoreRaftState(backupDir, datadir); err != nil {
+		t.Fatal(err)
+	}
+	restored, err := InspectRaftState(datadir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []uint16{1, 2, 3}, restored.Peers)
+	assert.Equal(t, restored.SnapshotIndex, restored.AppliedIndex)
+}
+
+func waitForMinter(t *testing.T, raftNodes []*RaftService) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, s := range raftNodes {
+			if s.raftProtocolManager.isMinter() {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no minter elected")
+}
@@ -2,6 +2,7 @@ package raft
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -94,10 +95,12 @@ func (pm *ProtocolManager) triggerSnapshot(index uint64) {
 	pm.mu.RUnlock()
 
 	log.Info("start snapshot", "applied index", pm.appliedIndex, "last snapshot index", snapshotIndex)
+	defer func(start time.Time) { snapshotTimer.UpdateSince(start) }(time.Now())
 
 	//snapData := pm.blockchain.CurrentBlock().Hash().Bytes()
 	//snap, err := pm.raftStorage.CreateSnapshot(pm.appliedIndex, &pm.confState, snapData)
 	snapData := pm.buildSnapshot().toBytes()
+	snapshotSizeGauge.Update(int64(len(snapData)))
 	snap, err := pm.raftStorage.CreateSnapshot(index, &pm.confState, snapData)
 	if err != nil {
 		panic(err)
@@ -114,6 +117,8 @@ func (pm *ProtocolManager) triggerSnapshot(index uint64) {
 	pm.mu.Lock()
 	pm.snapshotIndex = index
 	pm.mu.Unlock()
+
+	snapshotIndexGauge.Update(int64(index))
 }
 
 func confStateIdSet(confState raftpb.ConfState) mapset.Set {
@@ -186,7 +191,7 @@ func (pm *ProtocolManager) maybeTriggerSnapshot() {
 	entriesSinceLastSnap := appliedIndex - pm.snapshotIndex
 	pm.mu.RUnlock()
 
-	if entriesSinceLastSnap < snapshotPeriod {
+	if entriesSinceLastSnap < pm.raftConfig.SnapshotPeriod {
 		return
 	}
 
@@ -318,6 +323,7 @@ func (pm *ProtocolManager) readRaftSnapshot() *raftpb.Snapshot {
 
 func (pm *ProtocolManager) applyRaftSnapshot(raftSnapshot raftpb.Snapshot) {
 	log.Info("applying snapshot to raft storage")
+	defer func(start time.Time) { snapshotApplyTimer.UpdateSince(start) }(time.Now())
 	if err := pm.raftStorage.ApplySnapshot(raftSnapshot); err != nil {
 		fatalf("failed to apply snapshot: %s", err)
 	}
@@ -345,15 +351,30 @@ func (pm *ProtocolManager) applyRaftSnapshot(raftSnapshot raftpb.Snapshot) {
 	pm.mu.Lock()
 	pm.snapshotIndex = snapMeta.Index
 	pm.mu.Unlock()
+
+	snapshotIndexGauge.Update(int64(snapMeta.Index))
 }
 
 func (pm *ProtocolManager) syncBlockchainUntil(hash common.Hash) {
-	pm.mu.RLock()
+	mode := downloader.BoundedFullSync
+	if pm.raftConfig.StateSync && pm.blockchain.CurrentBlock().NumberU64() == 0 {
+		log.Info("downloading the state of the raft snapshot head instead of executing all blocks", "hash", hash)
+		mode = downloader.BoundedFastSync
+	}
+
+	pm.mu.Lock()
 	peerMap := make(map[uint16]*Peer, len(pm.peers))
 	for raftId, peer := range pm.peers {
 		peerMap[raftId] = peer
 	}
-	pm.mu.RUnlock()
+	pm.syncTarget, pm.syncMode = hash, mode
+	pm.mu.Unlock()
+
+	defer func() {
+		pm.mu.Lock()
+		pm.syncTarget = common.Hash{}
+		pm.mu.Unlock()
+	}()
 
 	for {
 		for peerId, peer := range peerMap {
@@ -362,8 +383,16 @@ func (pm *ProtocolManager) syncBlockchainUntil(hash common.Hash) {
 			peerId := peer.p2pNode.ID().String()
 			peerIdPrefix := fmt.Sprintf("%x", peer.p2pNode.ID().Bytes()[:8])
 
-			if err := pm.downloader.Synchronise(peerIdPrefix, hash, big.NewInt(0), downloader.BoundedFullSync); err != nil {
-				log.Info("failed to synchronize with peer", "peer id", peerId)
+			if err := pm.downloader.Synchronise(peerIdPrefix, hash, big.NewInt(0), mode); err != nil {
+				log.Info("failed to synchronize with peer", "peer id", peerId, "err", err)
+
+				if errors.Is(err, downloader.ErrPrivateState) {
+					log.Warn("falling back to executing the blocks to rebuild the private state", "hash", hash)
+					mode = downloader.BoundedFullSync
+					pm.mu.Lock()
+					pm.syncMode = mode
+					pm.mu.Unlock()
+				}
 
 				time.Sleep(500 * time.Millisecond)
 			} else {
@@ -373,6 +402,30 @@ func (pm *ProtocolManager) syncBlockchainUntil(hash common.Hash) {
 	}
 }
 
+// SyncStatus returns the progress of the chain synchronisation up to the head
+// block of a raft snapshot
+func (pm *ProtocolManager) SyncStatus() SyncStatus {
+	pm.mu.RLock()
+	target, mode := pm.syncTarget, pm.syncMode
+	pm.mu.RUnlock()
+
+	if target == (common.Hash{}) {
+		head := pm.blockchain.CurrentBlock().NumberU64()
+		return SyncStatus{CurrentBlock: head, HighestBlock: head}
+	}
+	status := SyncStatus{Syncing: true, Mode: "full", TargetHash: target}
+	if mode == downloader.BoundedFastSync {
+		status.Mode = "state"
+	}
+	progress := pm.downloader.Progress()
+	status.StartingBlock = progress.StartingBlock
+	status.CurrentBlock = progress.CurrentBlock
+	status.HighestBlock = progress.HighestBlock
+	status.PulledStates = progress.PulledStates
+	status.KnownStates = progress.KnownStates
+	return status
+}
+
 func (pm *ProtocolManager) logNewlyAcceptedTransactions(preSyncHead *types.Block) {
 	newHead := pm.blockchain.CurrentBlock()
 	numBlocks := newHead.NumberU64() - preSyncHead.NumberU64()
@@ -39,6 +39,11 @@ func (chain *speculativeChain) clear(block *types.Block) {
 	chain.unappliedBlocks = lane.NewDeque()
 	chain.expectedInvalidBlockHashes.Clear()
 	chain.proposedTxes.Clear()
+	chain.updateLengthGauge()
+}
+
+func (chain *speculativeChain) updateLengthGauge() {
+	speculativeChainLengthGauge.Update(int64(chain.unappliedBlocks.Size()))
 }
 
 // Append a new speculative block
@@ -46,6 +51,7 @@ func (chain *speculativeChain) extend(block *types.Block) {
 	chain.head = block
 	chain.recordProposedTransactions(block.Transactions())
 	chain.unappliedBlocks.Append(block)
+	chain.updateLengthGauge()
 }
 
 // Set the parent of the speculative chain
@@ -58,6 +64,7 @@ func (chain *speculativeChain) setHead(block *types.Block) {
 // Accept this block, removing it from the speculative chain
 func (chain *speculativeChain) accept(acceptedBlock *types.Block) {
 	earliestProposedI := chain.unappliedBlocks.Shift()
+	defer chain.updateLengthGauge()
 	var earliestProposed *types.Block
 	if nil != earliestProposedI {
 		earliestProposed = earliestProposedI.(*types.Block)
@@ -95,6 +102,9 @@ func (chain *speculativeChain) unwindFrom(invalidHash common.Hash, headBlock *ty
 		return
 	}
 
+	speculativeUnwindMeter.Mark(1)
+	defer chain.updateLengthGauge()
+
 	// pop from the RHS repeatedly, updating minter.parent each time. if not
 	// our block, add to guard. in all cases, call removeProposedTxes
 	for {
@@ -0,0 +1,257 @@
+package raft
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/coreos/etcd/pkg/transport"
+	raftTypes "github.com/coreos/etcd/pkg/types"
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/rafthttp"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	TLSModeOff    = "off"
+	TLSModeStrict = "strict"
+
+	// scheme of the URI SAN carrying the enode ID of the node
+	enodeURIScheme = "enode"
+
+	// limit of the raft message heading a snapshot, the snapshot data
+	// follows the message
+	maxSnapshotMessageSize = 512 * 1024 * 1024
+)
+
+var (
+	errNoCertIdentity = errors.New("certificate does not carry an enode ID")
+	errNoSender       = errors.New("request does not name the sending raft ID")
+)
+
+// TLSConfig holds the settings for TLS with mutual authentication on the
+// raft transport.
+//
+// Every node presents the same certificate as a server and as a client. The
+// certificate must be issued by RootCA and must carry the enode ID of the
+// node, either as the subject common name or as a URI SAN of the form
+// enode://<enode ID>. Certificate and key are read on every handshake, so
+// renewed certificates are picked up without a restart.
+type TLSConfig struct {
+	Mode   string // "off" or "strict"
+	RootCA string // path to the CA certificate(s) used to verify peers
+	Cert   string // path to the node certificate (or chain of certs)
+	Key    string // path to the node private key
+}
+
+func (c *TLSConfig) Enabled() bool {
+	return c != nil && c.Mode == TLSModeStrict
+}
+
+// Validate checks the mode and, when TLS is enabled, that the certificate,
+// key and root CA can be loaded
+func (c *TLSConfig) Validate() error {
+	if c == nil || c.Mode == "" || c.Mode == TLSModeOff {
+		return nil
+	}
+	if c.Mode != TLSModeStrict {
+		return fmt.Errorf("invalid raft TLS mode %q, must be %q or %q", c.Mode, TLSModeOff, TLSModeStrict)
+	}
+	if c.RootCA == "" || c.Cert == "" || c.Key == "" {
+		return errors.New("raft TLS requires root CA, certificate and key")
+	}
+	if _, err := c.tlsInfo().ServerConfig(); err != nil {
+		return fmt.Errorf("invalid raft TLS configuration: %v", err)
+	}
+	return nil
+}
+
+func (c *TLSConfig) tlsInfo() transport.TLSInfo {
+	if !c.Enabled() {
+		return transport.TLSInfo{}
+	}
+	return transport.TLSInfo{
+		CertFile:       c.Cert,
+		KeyFile:        c.Key,
+		TrustedCAFile:  c.RootCA,
+		ClientCertAuth: true,
+	}
+}
+
+// wraps the listener so that only peers presenting a certificate issued by
+// the root CA can connect
+func (c *TLSConfig) newListener(ln net.Listener) (net.Listener, error) {
+	cfg, err := c.tlsInfo().ServerConfig()
+	if err != nil {
+		return nil, err
+	}
+	// rafthttp speaks HTTP/1.1 only
+	cfg.NextProtos = nil
+	return tls.NewListener(ln, cfg), nil
+}
+
+// returns the enode ID carried by the certificate
+func certEnodeId(cert *x509.Certificate) (string, error) {
+	for _, u := range cert.URIs {
+		if u.Scheme == enodeURIScheme {
+			if id := strings.ToLower(u.Host); isEnodeId(id) {
+				return id, nil
+			}
+		}
+	}
+	if id := strings.ToLower(cert.Subject.CommonName); isEnodeId(id) {
+		return id, nil
+	}
+	return "", errNoCertIdentity
+}
+
+func isEnodeId(s string) bool {
+	b, err := hex.DecodeString(s)
+	return err == nil && len(b) == 64
+}
+
+// authenticatePeers checks that the certificate presented by the remote
+// node belongs to the raft peer the request comes from. Every request except
+// health probes must name the sending raft ID, which must be a member of the
+// cluster unless this node is still joining the cluster and has not yet
+// learned its members.
+//
+// Raft messages received over streams arrive on connections this node dialed
+// to the address of the peer, so those are covered by the verification of
+// the server certificate against the peer address.
+func (pm *ProtocolManager) authenticatePeers(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			http.Error(w, "client certificate required", http.StatusUnauthorized)
+			return
+		}
+		if err := pm.authenticatePeer(r); err != nil {
+			log.Warn("rejected raft connection", "remote", r.RemoteAddr, "path", r.URL.Path, "err", err)
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (pm *ProtocolManager) authenticatePeer(r *http.Request) error {
+	certId, err := certEnodeId(r.TLS.PeerCertificates[0])
+	if err != nil {
+		return err
+	}
+	// probes carry neither a sender nor raft data
+	if r.URL.Path == rafthttp.ProbingPrefix {
+		return nil
+	}
+	from := r.Header.Get("X-Server-From")
+	if from == "" {
+		return errNoSender
+	}
+	if err := pm.checkPeerIdentity(from, certId); err != nil {
+		return err
+	}
+	id, _ := raftTypes.IDFromString(from)
+
+	var m raftpb.Message
+	switch {
+	case strings.HasPrefix(r.URL.Path, rafthttp.RaftStreamPrefix+"/"):
+		// the stream is opened for the raft ID at the end of the path
+		if streamId, err := raftTypes.IDFromString(path.Base(r.URL.Path)); err != nil || streamId != id {
+			return fmt.Errorf("stream for raft ID %s requested by raft ID %s", path.Base(r.URL.Path), from)
+		}
+		return nil
+	case r.URL.Path == rafthttp.RaftPrefix:
+		m, err = peekPipelineMessage(r)
+	case r.URL.Path == rafthttp.RaftSnapshotPrefix:
+		m, err = peekSnapshotMessage(r)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read raft message: %v", err)
+	}
+	if raftTypes.ID(m.From) != id {
+		return fmt.Errorf("raft message from %s sent by raft ID %s", raftTypes.ID(m.From), from)
+	}
+	return nil
+}
+
+// checks the enode ID of the certificate against the enode ID of the raft
+// peer the request claims to come from
+func (pm *ProtocolManager) checkPeerIdentity(from string, certId string) error {
+	id, err := raftTypes.IDFromString(from)
+	if err != nil {
+		return fmt.Errorf("invalid raft ID %q", from)
+	}
+	raftId := uint16(id)
+	if pm.isRaftIdRemoved(raftId) {
+		return fmt.Errorf("raft ID %d has been removed from the cluster", raftId)
+	}
+	pm.mu.RLock()
+	peer, ok := pm.peers[raftId]
+	joining := pm.isJoining()
+	pm.mu.RUnlock()
+	if !ok {
+		if joining {
+			return nil
+		}
+		return fmt.Errorf("raft ID %d is not a member of the cluster", raftId)
+	}
+	if peerId := peer.address.NodeId.String(); peerId != certId {
+		return fmt.Errorf("certificate enode ID %s does not match enode ID %s of raft ID %d", certId, peerId, raftId)
+	}
+	return nil
+}
+
+// reports if the node is joining an existing cluster and has not learned
+// about itself yet. the members of the cluster are known once it has, as the
+// node is added to the cluster after them. must be called with mu held
+func (pm *ProtocolManager) isJoining() bool {
+	return pm.joinExisting && pm.address == nil && pm.peers[pm.raftId] == nil
+}
+
+// reads the raft message of a pipeline request. the body is left to be read
+// again by the raft transport
+func peekPipelineMessage(r *http.Request) (raftpb.Message, error) {
+	var m raftpb.Message
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return m, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return m, m.Unmarshal(b)
+}
+
+// reads the raft message heading the snapshot of a snapshot request. the
+// body is left to be read again by the raft transport
+func peekSnapshotMessage(r *http.Request) (raftpb.Message, error) {
+	var m raftpb.Message
+	var size [8]byte
+	if _, err := io.ReadFull(r.Body, size[:]); err != nil {
+		return m, err
+	}
+	n := binary.BigEndian.Uint64(size[:])
+	if n > maxSnapshotMessageSize {
+		return m, fmt.Errorf("snapshot message of %d bytes exceeds limit", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.Body, b); err != nil {
+		return m, err
+	}
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(size[:]), bytes.NewReader(b), r.Body), r.Body}
+	return m, m.Unmarshal(b)
+}
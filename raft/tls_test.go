@@ -0,0 +1,285 @@
+package raft
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/rafthttp"
+	mapset "github.com/deckarep/golang-set"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "raft test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key}
+}
+
+// issues a certificate carrying the given enode ID as URI SAN and writes the
+// CA, certificate and key into dir
+func (ca *testCA) issue(t *testing.T, dir string, serial int64, enodeId string) *TLSConfig {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "raft node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		URIs:         []*url.URL{{Scheme: enodeURIScheme, Host: enodeId}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &TLSConfig{
+		Mode:   TLSModeStrict,
+		RootCA: filepath.Join(dir, "ca.pem"),
+		Cert:   filepath.Join(dir, "cert.pem"),
+		Key:    filepath.Join(dir, "key.pem"),
+	}
+	writePem(t, cfg.RootCA, "CERTIFICATE", ca.cert.Raw)
+	writePem(t, cfg.Cert, "CERTIFICATE", der)
+	writePem(t, cfg.Key, "EC PRIVATE KEY", keyDer)
+	return cfg
+}
+
+func writePem(t *testing.T, path, typ string, der []byte) {
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLSConfig_Validate(t *testing.T) {
+	assert.NoError(t, (*TLSConfig)(nil).Validate())
+	assert.NoError(t, (&TLSConfig{Mode: TLSModeOff}).Validate())
+	assert.Error(t, (&TLSConfig{Mode: "on"}).Validate())
+	assert.Error(t, (&TLSConfig{Mode: TLSModeStrict}).Validate())
+	assert.Error(t, (&TLSConfig{Mode: TLSModeStrict, RootCA: "ca", Cert: "cert", Key: "key"}).Validate())
+
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	cfg := newTestCA(t).issue(t, tmpDir, 2, enode.NewV4(&mustNewNodeKey(t).PublicKey, nil, 0, 0).EnodeID())
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestCertEnodeId(t *testing.T) {
+	id := enode.NewV4(&mustNewNodeKey(t).PublicKey, nil, 0, 0).EnodeID()
+
+	actual, err := certEnodeId(&x509.Certificate{URIs: []*url.URL{{Scheme: enodeURIScheme, Host: id}}})
+	assert.NoError(t, err)
+	assert.Equal(t, id, actual)
+
+	actual, err = certEnodeId(&x509.Certificate{Subject: pkix.Name{CommonName: id}})
+	assert.NoError(t, err)
+	assert.Equal(t, id, actual)
+
+	_, err = certEnodeId(&x509.Certificate{Subject: pkix.Name{CommonName: "node1"}})
+	assert.Equal(t, errNoCertIdentity, err)
+}
+
+func TestProtocolManager_whenTLSEnabled(t *testing.T) {
+	tmpWorkingDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpWorkingDir)
+
+	ca := newTestCA(t)
+	count := 3
+	ports := make([]uint16, count)
+	nodeKeys := make([]*ecdsa.PrivateKey, count)
+	peers := make([]*enode.Node, count)
+	tlsConfigs := make([]*TLSConfig, count)
+	for i := 0; i < count; i++ {
+		ports[i] = nextPort(t)
+		nodeKeys[i] = mustNewNodeKey(t)
+		peers[i] = enode.NewV4Hostname(&(nodeKeys[i].PublicKey), net.IPv4(127, 0, 0, 1).String(), 0, 0, int(ports[i]))
+		tlsConfigs[i] = ca.issue(t, fmt.Sprintf("%s/tls%d", tmpWorkingDir, i+1), int64(i+2), peers[i].EnodeID())
+	}
+	raftNodes := make([]*RaftService, count)
+	for i := 0; i < count; i++ {
+		s, err := startRaftNodeWithOptions(uint16(i+1), ports[i], tmpWorkingDir, nodeKeys[i], peers, raftNodeOptions{tlsConfig: tlsConfigs[i]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		raftNodes[i] = s
+	}
+	defer func() {
+		for _, s := range raftNodes {
+			_ = s.Stop()
+		}
+	}()
+
+	pm := raftNodes[0].raftProtocolManager
+	assert.Equal(t, fmt.Sprintf("https://127.0.0.1:%d", ports[1]), pm.raftUrl(pm.peers[2].address))
+	// the certificate of node 2 cannot be used by node 3
+	assert.NoError(t, pm.checkPeerIdentity("2", peers[1].EnodeID()))
+	assert.Error(t, pm.checkPeerIdentity("3", peers[1].EnodeID()))
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, s := range raftNodes {
+			if s.raftProtocolManager.isMinter() {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("no leader elected over TLS")
+}
+
+func TestProtocolManager_authenticatePeer(t *testing.T) {
+	id2 := enode.NewV4(&mustNewNodeKey(t).PublicKey, nil, 0, 0).EnodeID()
+	id3 := enode.NewV4(&mustNewNodeKey(t).PublicKey, nil, 0, 0).EnodeID()
+	nodeId2, err := enode.RaftHexID(id2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm := &ProtocolManager{
+		raftId:       1,
+		address:      &Address{RaftId: 1},
+		peers:        map[uint16]*Peer{2: {address: &Address{RaftId: 2, NodeId: nodeId2}}},
+		removedPeers: mapset.NewSet(),
+	}
+	newRequest := func(method, path, from, certId string, body []byte) *http.Request {
+		r := httptest.NewRequest(method, path, bytes.NewReader(body))
+		if from != "" {
+			r.Header.Set("X-Server-From", from)
+		}
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{{Scheme: enodeURIScheme, Host: certId}}}}}
+		return r
+	}
+	message := func(from uint64) []byte {
+		b, err := (&raftpb.Message{Type: raftpb.MsgHeartbeat, From: from, To: 1}).Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	// probes need a certificate only
+	assert.NoError(t, pm.authenticatePeer(newRequest("GET", rafthttp.ProbingPrefix, "", id3, nil)))
+
+	// the sender is required
+	assert.Equal(t, errNoSender, pm.authenticatePeer(newRequest("POST", rafthttp.RaftPrefix, "", id2, message(2))))
+
+	// unknown raft IDs are rejected
+	assert.Error(t, pm.authenticatePeer(newRequest("POST", rafthttp.RaftPrefix, "3", id3, message(3))))
+
+	// the certificate of raft ID 2 cannot be used by others and vice versa
+	assert.Error(t, pm.authenticatePeer(newRequest("POST", rafthttp.RaftPrefix, "2", id3, message(2))))
+
+	// the raft message must come from the sender
+	assert.Error(t, pm.authenticatePeer(newRequest("POST", rafthttp.RaftPrefix, "2", id2, message(3))))
+	r := newRequest("POST", rafthttp.RaftPrefix, "2", id2, message(2))
+	assert.NoError(t, pm.authenticatePeer(r))
+	body, _ := ioutil.ReadAll(r.Body)
+	assert.Equal(t, message(2), body, "message must be left for the raft transport")
+
+	// streams are opened for the sender only
+	assert.NoError(t, pm.authenticatePeer(newRequest("GET", rafthttp.RaftStreamPrefix+"/message/2", "2", id2, nil)))
+	assert.Error(t, pm.authenticatePeer(newRequest("GET", rafthttp.RaftStreamPrefix+"/message/3", "2", id2, nil)))
+
+	// snapshots are headed by the raft message
+	snapshot := func(from uint64) []byte {
+		m := message(from)
+		b := make([]byte, 8, 8+len(m)+4)
+		binary.BigEndian.PutUint64(b, uint64(len(m)))
+		return append(append(b, m...), "data"...)
+	}
+	assert.Error(t, pm.authenticatePeer(newRequest("POST", rafthttp.RaftSnapshotPrefix, "2", id2, snapshot(3))))
+	r = newRequest("POST", rafthttp.RaftSnapshotPrefix, "2", id2, snapshot(2))
+	assert.NoError(t, pm.authenticatePeer(r))
+	body, _ = ioutil.ReadAll(r.Body)
+	assert.Equal(t, snapshot(2), body, "snapshot must be left for the raft transport")
+
+	// removed raft IDs are rejected
+	pm.removedPeers.Add(uint16(2))
+	assert.Error(t, pm.authenticatePeer(newRequest("POST", rafthttp.RaftPrefix, "2", id2, message(2))))
+}
+
+func TestProtocolManager_authenticatePeer_whenJoining(t *testing.T) {
+	id := enode.NewV4(&mustNewNodeKey(t).PublicKey, nil, 0, 0).EnodeID()
+	message, err := (&raftpb.Message{Type: raftpb.MsgApp, From: 2, To: 4}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pm := &ProtocolManager{
+		raftId:       4,
+		joinExisting: true,
+		peers:        map[uint16]*Peer{},
+		removedPeers: mapset.NewSet(),
+	}
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest("POST", rafthttp.RaftPrefix, bytes.NewReader(message))
+		r.Header.Set("X-Server-From", "2")
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{URIs: []*url.URL{{Scheme: enodeURIScheme, Host: id}}}}}
+		return r
+	}
+
+	// the members are not known before the node has learned about itself
+	assert.NoError(t, pm.authenticatePeer(newRequest()))
+
+	pm.address = &Address{RaftId: 4}
+	assert.Error(t, pm.authenticatePeer(newRequest()))
+}
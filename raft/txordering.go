@@ -0,0 +1,215 @@
+package raft
+
+import (
+	"bytes"
+	"container/heap"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+)
+
+// transactionSet yields the pending transactions in the order in which the
+// minter commits them into a block. Shift moves on past the current
+// transaction, while Pop also drops the remaining transactions of its sender,
+// e.g. when the current transaction fails.
+type transactionSet interface {
+	Peek() *types.Transaction
+	Shift()
+	Pop()
+}
+
+// newTransactionSet orders the nonce-sorted pending transactions of each
+// sender according to the ordering policy and bounds the number of
+// transactions per sender and per org if quotas are given
+func newTransactionSet(config *eth.RaftConfig, signer types.Signer, txs map[common.Address]types.Transactions) transactionSet {
+	var set transactionSet
+	switch config.TxOrdering {
+	case eth.RaftTxOrderingFIFO:
+		set = newTxsByArrival(signer, txs)
+	case eth.RaftTxOrderingRoundRobin:
+		set = newTxsRoundRobin(txs)
+	default:
+		set = types.NewTransactionsByPriceAndNonce(signer, txs)
+	}
+	if config.TxQuotaPerSender > 0 || config.TxQuotaPerOrg > 0 {
+		set = &txsWithQuota{
+			transactionSet: set,
+			signer:         signer,
+			senderQuota:    config.TxQuotaPerSender,
+			orgQuota:       config.TxQuotaPerOrg,
+			senderCount:    make(map[common.Address]int),
+			orgCount:       make(map[string]int),
+		}
+	}
+	return set
+}
+
+// returns true if tx arrived before other, using the hash to break ties so
+// that the order is deterministic
+func arrivedBefore(tx, other *types.Transaction) bool {
+	if tx.Time().Equal(other.Time()) {
+		return bytes.Compare(tx.Hash().Bytes(), other.Hash().Bytes()) < 0
+	}
+	return tx.Time().Before(other.Time())
+}
+
+// heap of the next transaction of each sender, by arrival time
+type txHeadsByArrival []*types.Transaction
+
+func (s txHeadsByArrival) Len() int            { return len(s) }
+func (s txHeadsByArrival) Less(i, j int) bool  { return arrivedBefore(s[i], s[j]) }
+func (s txHeadsByArrival) Swap(i, j int)       { s[i], s[j] = s[j], s[i] }
+func (s *txHeadsByArrival) Push(x interface{}) { *s = append(*s, x.(*types.Transaction)) }
+func (s *txHeadsByArrival) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	*s = old[0 : n-1]
+	return x
+}
+
+// txsByArrival yields the transactions in the order they arrived at this
+// node, honouring the nonce order of each sender
+type txsByArrival struct {
+	txs    map[common.Address]types.Transactions // remaining nonce-sorted transactions of each sender
+	heads  txHeadsByArrival                      // next transaction of each sender
+	signer types.Signer
+}
+
+func newTxsByArrival(signer types.Signer, txs map[common.Address]types.Transactions) *txsByArrival {
+	heads := make(txHeadsByArrival, 0, len(txs))
+	for from, accTxs := range txs {
+		if len(accTxs) == 0 {
+			continue
+		}
+		heads = append(heads, accTxs[0])
+		txs[from] = accTxs[1:]
+	}
+	heap.Init(&heads)
+	return &txsByArrival{txs: txs, heads: heads, signer: signer}
+}
+
+func (t *txsByArrival) Peek() *types.Transaction {
+	if len(t.heads) == 0 {
+		return nil
+	}
+	return t.heads[0]
+}
+
+func (t *txsByArrival) Shift() {
+	acc, _ := types.Sender(t.signer, t.heads[0])
+	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
+		t.heads[0], t.txs[acc] = txs[0], txs[1:]
+		heap.Fix(&t.heads, 0)
+	} else {
+		heap.Pop(&t.heads)
+	}
+}
+
+func (t *txsByArrival) Pop() {
+	heap.Pop(&t.heads)
+}
+
+// txsRoundRobin yields one transaction of each sender in turn. Senders take
+// their turn in the order their first pending transaction arrived.
+type txsRoundRobin struct {
+	senders []common.Address                      // senders with remaining transactions
+	txs     map[common.Address]types.Transactions // remaining nonce-sorted transactions of each sender
+	next    int                                   // index of the sender whose turn it is
+}
+
+func newTxsRoundRobin(txs map[common.Address]types.Transactions) *txsRoundRobin {
+	senders := make([]common.Address, 0, len(txs))
+	for from, accTxs := range txs {
+		if len(accTxs) > 0 {
+			senders = append(senders, from)
+		}
+	}
+	sort.Slice(senders, func(i, j int) bool {
+		return arrivedBefore(txs[senders[i]][0], txs[senders[j]][0])
+	})
+	return &txsRoundRobin{senders: senders, txs: txs}
+}
+
+func (t *txsRoundRobin) Peek() *types.Transaction {
+	if len(t.senders) == 0 {
+		return nil
+	}
+	return t.txs[t.senders[t.next]][0]
+}
+
+func (t *txsRoundRobin) Shift() {
+	sender := t.senders[t.next]
+	if t.txs[sender] = t.txs[sender][1:]; len(t.txs[sender]) == 0 {
+		t.Pop()
+		return
+	}
+	t.next = (t.next + 1) % len(t.senders)
+}
+
+func (t *txsRoundRobin) Pop() {
+	t.senders = append(t.senders[:t.next], t.senders[t.next+1:]...)
+	if t.next >= len(t.senders) {
+		t.next = 0
+	}
+}
+
+// txsWithQuota skips the transactions of senders, and of the permission orgs
+// of senders, which have used up their quota of transactions in the block
+type txsWithQuota struct {
+	transactionSet
+	signer      types.Signer
+	senderQuota int
+	orgQuota    int
+	senderCount map[common.Address]int
+	orgCount    map[string]int
+}
+
+func (t *txsWithQuota) Peek() *types.Transaction {
+	for {
+		tx := t.transactionSet.Peek()
+		if tx == nil {
+			return nil
+		}
+		from, _ := types.Sender(t.signer, tx)
+		if t.withinQuota(from) {
+			return tx
+		}
+		t.transactionSet.Pop()
+	}
+}
+
+func (t *txsWithQuota) Shift() {
+	from, _ := types.Sender(t.signer, t.transactionSet.Peek())
+	t.senderCount[from]++
+	if org, ok := senderOrg(from); ok {
+		t.orgCount[org]++
+	}
+	t.transactionSet.Shift()
+}
+
+func (t *txsWithQuota) withinQuota(from common.Address) bool {
+	if t.senderQuota > 0 && t.senderCount[from] >= t.senderQuota {
+		return false
+	}
+	if org, ok := senderOrg(from); ok && t.orgQuota > 0 && t.orgCount[org] >= t.orgQuota {
+		return false
+	}
+	return true
+}
+
+// returns the permission org of the account. Accounts only have an org when
+// smart contract permissioning is in force.
+func senderOrg(from common.Address) (string, bool) {
+	if !permissionsEnabled() {
+		return "", false
+	}
+	acct, err := pcore.AcctInfoMap.GetAccount(from)
+	if err != nil || acct == nil {
+		return "", false
+	}
+	return acct.OrgId, true
+}
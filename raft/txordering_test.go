@@ -0,0 +1,130 @@
+package raft
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+)
+
+type testSender struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newTestSenders(t *testing.T, count int) []testSender {
+	senders := make([]testSender, count)
+	for i := range senders {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		senders[i] = testSender{key, crypto.PubkeyToAddress(key.PublicKey)}
+	}
+	return senders
+}
+
+// signs the transactions in the given order of senders, one nonce after the
+// other for each sender, so that they arrive in that order
+func newPendingTxs(t *testing.T, signer types.Signer, order ...testSender) map[common.Address]types.Transactions {
+	pending := make(map[common.Address]types.Transactions)
+	for _, s := range order {
+		nonce := uint64(len(pending[s.addr]))
+		tx, err := types.SignTx(types.NewTransaction(nonce, common.Address{}, big.NewInt(0), 21000, big.NewInt(0), nil), signer, s.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending[s.addr] = append(pending[s.addr], tx)
+		time.Sleep(time.Millisecond)
+	}
+	return pending
+}
+
+// drains the transaction set, shifting past every transaction
+func orderedSenders(signer types.Signer, set transactionSet) []common.Address {
+	var senders []common.Address
+	for tx := set.Peek(); tx != nil; tx = set.Peek() {
+		from, _ := types.Sender(signer, tx)
+		senders = append(senders, from)
+		set.Shift()
+	}
+	return senders
+}
+
+func TestTransactionSet_fifo(t *testing.T) {
+	signer := types.HomesteadSigner{}
+	s := newTestSenders(t, 2)
+	a, b := s[0], s[1]
+	pending := newPendingTxs(t, signer, a, b, a, a, b)
+
+	set := newTransactionSet(&eth.RaftConfig{TxOrdering: eth.RaftTxOrderingFIFO}, signer, pending)
+
+	assert.Equal(t, []common.Address{a.addr, b.addr, a.addr, a.addr, b.addr}, orderedSenders(signer, set))
+}
+
+func TestTransactionSet_roundRobin(t *testing.T) {
+	signer := types.HomesteadSigner{}
+	s := newTestSenders(t, 3)
+	a, b, c := s[0], s[1], s[2]
+	// a busy sender a sends first
+	pending := newPendingTxs(t, signer, a, a, a, a, b, c, c)
+
+	set := newTransactionSet(&eth.RaftConfig{TxOrdering: eth.RaftTxOrderingRoundRobin}, signer, pending)
+
+	assert.Equal(t, []common.Address{a.addr, b.addr, c.addr, a.addr, c.addr, a.addr, a.addr}, orderedSenders(signer, set))
+}
+
+func TestTransactionSet_roundRobinPop(t *testing.T) {
+	signer := types.HomesteadSigner{}
+	s := newTestSenders(t, 2)
+	a, b := s[0], s[1]
+	pending := newPendingTxs(t, signer, a, b, a, b)
+
+	set := newTransactionSet(&eth.RaftConfig{TxOrdering: eth.RaftTxOrderingRoundRobin}, signer, pending)
+	// the first transaction of a fails, dropping its remaining transactions
+	set.Pop()
+
+	assert.Equal(t, []common.Address{b.addr, b.addr}, orderedSenders(signer, set))
+}
+
+func TestTransactionSet_senderQuota(t *testing.T) {
+	signer := types.HomesteadSigner{}
+	s := newTestSenders(t, 2)
+	a, b := s[0], s[1]
+	pending := newPendingTxs(t, signer, a, a, a, b, b, b)
+
+	set := newTransactionSet(&eth.RaftConfig{TxOrdering: eth.RaftTxOrderingFIFO, TxQuotaPerSender: 2}, signer, pending)
+
+	assert.Equal(t, []common.Address{a.addr, a.addr, b.addr, b.addr}, orderedSenders(signer, set))
+}
+
+func TestTransactionSet_orgQuota(t *testing.T) {
+	defer func(enabled func() bool, acctInfoMap *pcore.AcctCache) {
+		permissionsEnabled = enabled
+		pcore.AcctInfoMap = acctInfoMap
+	}(permissionsEnabled, pcore.AcctInfoMap)
+
+	signer := types.HomesteadSigner{}
+	s := newTestSenders(t, 3)
+	a, b, c := s[0], s[1], s[2]
+	pending := newPendingTxs(t, signer, a, b, a, b, c, c)
+
+	permissionsEnabled = func() bool { return true }
+	pcore.AcctInfoMap = pcore.NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	pcore.AcctInfoMap.UpsertAccount("ORG1", "ROLE", a.addr, false, pcore.AcctActive)
+	pcore.AcctInfoMap.UpsertAccount("ORG1", "ROLE", b.addr, false, pcore.AcctActive)
+	pcore.AcctInfoMap.UpsertAccount("ORG2", "ROLE", c.addr, false, pcore.AcctActive)
+
+	set := newTransactionSet(&eth.RaftConfig{TxOrdering: eth.RaftTxOrderingRoundRobin, TxQuotaPerOrg: 3}, signer, pending)
+
+	assert.Equal(t, []common.Address{a.addr, b.addr, c.addr, a.addr, c.addr}, orderedSenders(signer, set))
+}